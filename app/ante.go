@@ -0,0 +1,63 @@
+package app
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	simpleswapante "github.com/cosmos/simpleswap/ante"
+	expectedkeepers "github.com/cosmos/simpleswap/expected_keepers"
+	simpleswapkeeper "github.com/cosmos/simpleswap/keeper"
+)
+
+// HandlerOptions are the options required for constructing the minid ante handler.
+type HandlerOptions struct {
+	ante.HandlerOptions
+
+	StakingKeeper    expectedkeepers.StakingKeeper
+	SimpleSwapKeeper simpleswapkeeper.Keeper
+	SwapBankKeeper   expectedkeepers.BankKeeper
+}
+
+// NewAnteHandler returns the auth ante handler, whose fee decorator is wrapped by the
+// simpleswap fee swap decorator so that the fees can be paid in the whitelisted coins.
+func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
+	if options.AccountKeeper == nil {
+		return nil, errors.New("account keeper is required for ante builder")
+	}
+
+	if options.BankKeeper == nil || options.SwapBankKeeper == nil {
+		return nil, errors.New("bank keeper is required for ante builder")
+	}
+
+	if options.SignModeHandler == nil {
+		return nil, errors.New("sign mode handler is required for ante builder")
+	}
+
+	if options.StakingKeeper == nil {
+		return nil, errors.New("staking keeper is required for ante builder")
+	}
+
+	anteDecorators := []sdk.AnteDecorator{
+		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
+		ante.NewValidateBasicDecorator(),
+		ante.NewTxTimeoutHeightDecorator(),
+		ante.NewValidateMemoDecorator(options.AccountKeeper),
+		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		simpleswapante.NewFeeSwapDecorator(
+			options.AccountKeeper,
+			options.SwapBankKeeper,
+			options.StakingKeeper,
+			options.SimpleSwapKeeper,
+			ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+		),
+		ante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
+		ante.NewValidateSigCountDecorator(options.AccountKeeper),
+		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
+		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
+	}
+
+	return sdk.ChainAnteDecorators(anteDecorators...), nil
+}
@@ -0,0 +1,167 @@
+package app_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/cosmos/simpleswap"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmosregistry/chain-minimal/app"
+)
+
+type FeeSwapTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chain       *ibctesting.TestChain
+
+	payer ibctesting.SenderAccount
+}
+
+func TestFeeSwapTestSuite(t *testing.T) {
+	suite.Run(t, new(FeeSwapTestSuite))
+}
+
+func (s *FeeSwapTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = SetupTestingApp
+
+	s.coordinator = ibctesting.NewCoordinator(s.T(), 1)
+	s.chain = s.coordinator.GetChain(ibctesting.GetChainID(1))
+	s.payer = s.chain.SenderAccounts[1]
+
+	// Whitelist the bond denom, accept the fees in WETH and fund the payer with WETH
+	miniApp := s.app()
+	ctx := s.chain.GetContext()
+	params, err := miniApp.SimpleSwapKeeper.Params.Get(ctx)
+	s.Require().NoError(err)
+	params.WhitelistedCoins = append(params.WhitelistedCoins, &sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: math.ZeroInt()})
+	params.FeeSwapDenoms = []string{"WETH"}
+	s.Require().NoError(params.Validate())
+	s.Require().NoError(miniApp.SimpleSwapKeeper.Params.Set(ctx, params))
+
+	liquidity := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000_000_000)
+	funds := sdk.NewInt64Coin("WETH", 1_000_000_000_000)
+	s.Require().NoError(miniApp.BankKeeper.MintCoins(ctx, simpleswap.ModuleName, sdk.NewCoins(liquidity, funds)))
+	s.Require().NoError(miniApp.SimpleSwapKeeper.CoinsReserve.Set(ctx, liquidity.Denom, liquidity))
+	s.Require().NoError(miniApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, simpleswap.ModuleName, s.payer.SenderAccount.GetAddress(), sdk.NewCoins(funds)))
+	s.coordinator.CommitBlock(s.chain)
+}
+
+func (s *FeeSwapTestSuite) app() *app.MiniApp {
+	return s.chain.App.(*app.MiniApp)
+}
+
+// signTx returns a transaction of the payer sending 1 WETH, paying the fee.
+func (s *FeeSwapTestSuite) signTx(fee sdk.Coins) []byte {
+	msg := banktypes.NewMsgSend(s.payer.SenderAccount.GetAddress(), s.chain.SenderAccount.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("WETH", 1)))
+	tx, err := simtestutil.GenSignedMockTx(
+		rand.New(rand.NewSource(1)),
+		s.chain.TxConfig,
+		[]sdk.Msg{msg},
+		fee,
+		simtestutil.DefaultGenTxGas,
+		s.chain.ChainID,
+		[]uint64{s.payer.SenderAccount.GetAccountNumber()},
+		[]uint64{s.payer.SenderAccount.GetSequence()},
+		s.payer.SenderPrivKey,
+	)
+	s.Require().NoError(err)
+
+	txBytes, err := s.chain.TxConfig.TxEncoder()(tx)
+	s.Require().NoError(err)
+
+	return txBytes
+}
+
+// deliverTx executes the transaction in a block and commits it.
+func (s *FeeSwapTestSuite) deliverTx(txBytes []byte) *abci.ExecTxResult {
+	baseApp := s.app().GetBaseApp()
+	resp, err := baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height:             baseApp.LastBlockHeight() + 1,
+		Time:               s.chain.CurrentHeader.Time,
+		NextValidatorsHash: s.chain.NextVals.Hash(),
+		Txs:                [][]byte{txBytes},
+	})
+	s.Require().NoError(err)
+
+	_, err = baseApp.Commit()
+	s.Require().NoError(err)
+
+	return resp.TxResults[0]
+}
+
+func (s *FeeSwapTestSuite) TestFeeSwap() {
+	feeCollector := s.app().AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+	testCases := []struct {
+		name         string
+		fee          sdk.Coins
+		maxSlippage  math.LegacyDec
+		expectErr    error
+		expectedFees sdk.Coins
+	}{
+		{
+			name:         "fee in WETH swapped into the bond denom, net of the swap fee",
+			fee:          sdk.NewCoins(sdk.NewInt64Coin("WETH", 100_000_000)),
+			maxSlippage:  math.LegacyNewDecWithPrec(1, 2),
+			expectedFees: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 99_970_000)),
+		},
+		{
+			name:        "swap fee above the max slippage",
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("WETH", 100_000_000)),
+			maxSlippage: math.LegacyNewDecWithPrec(1, 4),
+			expectErr:   simpleswap.ErrMinOutput,
+		},
+		{
+			name:         "fee in a denom not accepted for fee swaps",
+			fee:          sdk.NewCoins(sdk.NewInt64Coin("ETH", 0)),
+			maxSlippage:  math.LegacyNewDecWithPrec(1, 2),
+			expectedFees: sdk.NewCoins(),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			ctx := s.chain.GetContext()
+			params, err := s.app().SimpleSwapKeeper.Params.Get(ctx)
+			s.Require().NoError(err)
+			params.FeeSwapMaxSlippage = tc.maxSlippage
+			s.Require().NoError(s.app().SimpleSwapKeeper.Params.Set(ctx, params))
+			s.coordinator.CommitBlock(s.chain)
+
+			payerBalance := s.app().BankKeeper.GetBalance(s.chain.GetContext(), s.payer.SenderAccount.GetAddress(), "WETH")
+
+			txBytes := s.signTx(tc.fee)
+			checkResp, err := s.app().CheckTx(&abci.RequestCheckTx{Tx: txBytes, Type: abci.CheckTxType_New})
+			s.Require().NoError(err)
+
+			txResult := s.deliverTx(txBytes)
+			if tc.expectErr != nil {
+				s.Require().NotZero(checkResp.Code)
+				s.Require().Contains(checkResp.Log, tc.expectErr.Error())
+				s.Require().NotZero(txResult.Code)
+				s.Require().Contains(txResult.Log, tc.expectErr.Error())
+				return
+			}
+			s.Require().Zero(checkResp.Code, checkResp.Log)
+			s.Require().Zero(txResult.Code, txResult.Log)
+
+			// The payer paid the fee and the WETH sent, the fee collector received the fee
+			ctx = s.chain.GetContext()
+			spent := payerBalance.Sub(s.app().BankKeeper.GetBalance(ctx, s.payer.SenderAccount.GetAddress(), "WETH"))
+			s.Require().Equal(tc.fee.AmountOf("WETH").AddRaw(1), spent.Amount)
+			s.Require().Equal(tc.expectedFees, s.app().BankKeeper.GetAllBalances(ctx, feeCollector))
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
+	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 	simpleswap "github.com/cosmos/simpleswap/keeper"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -24,22 +25,34 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	consensuskeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
+	ibctransferkeeper "github.com/cosmos/ibc-go/v8/modules/apps/transfer/keeper"
+	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 
 	_ "github.com/cosmos/simpleswap/module" // import for side-effects
 	_ "cosmossdk.io/api/cosmos/tx/config/v1"          // import for side-effects
-	_ "github.com/cosmos/cosmos-sdk/x/auth"           // import for side-effects
+	_ "cosmossdk.io/x/upgrade"                        // import for side-effects
 	_ "github.com/cosmos/cosmos-sdk/x/auth/tx/config" // import for side-effects
+	_ "github.com/cosmos/cosmos-sdk/x/authz/module"   // import for side-effects
 	_ "github.com/cosmos/cosmos-sdk/x/bank"           // import for side-effects
 	_ "github.com/cosmos/cosmos-sdk/x/consensus"      // import for side-effects
 	_ "github.com/cosmos/cosmos-sdk/x/distribution"   // import for side-effects
+	_ "github.com/cosmos/cosmos-sdk/x/gov"            // import for side-effects
 	_ "github.com/cosmos/cosmos-sdk/x/mint"           // import for side-effects
+	_ "github.com/cosmos/cosmos-sdk/x/params"         // import for side-effects
 	_ "github.com/cosmos/cosmos-sdk/x/staking"        // import for side-effects
 )
 
@@ -66,12 +79,23 @@ type MiniApp struct {
 
 	// keepers
 	AccountKeeper         authkeeper.AccountKeeper
+	AuthzKeeper           authzkeeper.Keeper
 	BankKeeper            bankkeeper.Keeper
 	StakingKeeper         *stakingkeeper.Keeper
 	DistrKeeper           distrkeeper.Keeper
+	GovKeeper             *govkeeper.Keeper
 	ConsensusParamsKeeper consensuskeeper.Keeper
+	ParamsKeeper          paramskeeper.Keeper
+	UpgradeKeeper         *upgradekeeper.Keeper
 
-	SimpleSwapKeeper simpleswap.Keeper
+	// IBC keepers
+	CapabilityKeeper        *capabilitykeeper.Keeper
+	IBCKeeper               *ibckeeper.Keeper
+	TransferKeeper          ibctransferkeeper.Keeper
+	ScopedIBCKeeper         capabilitykeeper.ScopedKeeper
+	ScopedIBCTransferKeeper capabilitykeeper.ScopedKeeper
+
+	SimpleSwapKeeper *simpleswap.Keeper
 	// simulation manager
 	sm *module.SimulationManager
 }
@@ -126,10 +150,14 @@ func NewMiniApp(
 		&app.txConfig,
 		&app.interfaceRegistry,
 		&app.AccountKeeper,
+		&app.AuthzKeeper,
 		&app.BankKeeper,
 		&app.StakingKeeper,
 		&app.DistrKeeper,
+		&app.GovKeeper,
 		&app.ConsensusParamsKeeper,
+		&app.ParamsKeeper,
+		&app.UpgradeKeeper,
 		&app.SimpleSwapKeeper,
 	); err != nil {
 		return nil, err
@@ -137,6 +165,28 @@ func NewMiniApp(
 
 	app.App = appBuilder.Build(db, traceStore, baseAppOptions...)
 
+	// register legacy modules
+	if err := app.registerIBCModules(); err != nil {
+		return nil, err
+	}
+
+	// set the ante handler, which pays the fees in the whitelisted coins through the pool
+	anteHandler, err := NewAnteHandler(HandlerOptions{
+		HandlerOptions: ante.HandlerOptions{
+			AccountKeeper:   app.AccountKeeper,
+			BankKeeper:      app.BankKeeper,
+			SignModeHandler: app.txConfig.SignModeHandler(),
+			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+		},
+		StakingKeeper:    app.StakingKeeper,
+		SimpleSwapKeeper: *app.SimpleSwapKeeper,
+		SwapBankKeeper:   app.BankKeeper,
+	})
+	if err != nil {
+		return nil, err
+	}
+	app.SetAnteHandler(anteHandler)
+
 	// register streaming services
 	if err := app.RegisterStreamingServices(appOpts, app.kvStoreKeys()); err != nil {
 		return nil, err
@@ -146,9 +196,17 @@ func NewMiniApp(
 
 	// create the simulation manager and define the order of the modules for deterministic simulations
 	// NOTE: this is not required apps that don't use the simulator for fuzz testing transactions
-	app.sm = module.NewSimulationManagerFromAppModules(app.ModuleManager.Modules, make(map[string]module.AppModuleSimulation, 0))
+	overrideModules := map[string]module.AppModuleSimulation{
+		authtypes.ModuleName: auth.NewAppModule(app.appCodec, app.AccountKeeper, randomGenesisAccounts, nil),
+	}
+	app.sm = module.NewSimulationManagerFromAppModules(app.ModuleManager.Modules, overrideModules)
 	app.sm.RegisterStoreDecoders()
 
+	// register the upgrade handlers, before the stores are loaded
+	if err := app.registerUpgradeHandlers(); err != nil {
+		return nil, err
+	}
+
 	if err := app.Load(loadLatest); err != nil {
 		return nil, err
 	}
@@ -156,11 +214,27 @@ func NewMiniApp(
 	return app, nil
 }
 
+// randomGenesisAccounts returns a base account for each simulation account, minid has no vesting
+// accounts.
+func randomGenesisAccounts(simState *module.SimulationState) authtypes.GenesisAccounts {
+	genesisAccs := make(authtypes.GenesisAccounts, len(simState.Accounts))
+	for i, acc := range simState.Accounts {
+		genesisAccs[i] = authtypes.NewBaseAccountWithAddress(acc.Address)
+	}
+
+	return genesisAccs
+}
+
 // LegacyAmino returns MiniApp's amino codec.
 func (app *MiniApp) LegacyAmino() *codec.LegacyAmino {
 	return app.legacyAmino
 }
 
+// AppCodec returns MiniApp's app codec.
+func (app *MiniApp) AppCodec() codec.Codec {
+	return app.appCodec
+}
+
 // GetKey returns the KVStoreKey for the provided store key.
 func (app *MiniApp) GetKey(storeKey string) *storetypes.KVStoreKey {
 	sk := app.UnsafeFindStoreKey(storeKey)
@@ -171,6 +245,16 @@ func (app *MiniApp) GetKey(storeKey string) *storetypes.KVStoreKey {
 	return kvStoreKey
 }
 
+// GetMemKey returns the MemoryStoreKey for the provided store key.
+func (app *MiniApp) GetMemKey(storeKey string) *storetypes.MemoryStoreKey {
+	key, ok := app.UnsafeFindStoreKey(storeKey).(*storetypes.MemoryStoreKey)
+	if !ok {
+		return nil
+	}
+
+	return key
+}
+
 func (app *MiniApp) kvStoreKeys() map[string]*storetypes.KVStoreKey {
 	keys := make(map[string]*storetypes.KVStoreKey)
 	for _, k := range app.GetStoreKeys() {
@@ -0,0 +1,116 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/cosmos/simpleswap"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmosregistry/chain-minimal/app"
+)
+
+type SwapAuthorizationTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chain       *ibctesting.TestChain
+
+	granter ibctesting.SenderAccount
+	grantee ibctesting.SenderAccount
+}
+
+func TestSwapAuthorizationTestSuite(t *testing.T) {
+	suite.Run(t, new(SwapAuthorizationTestSuite))
+}
+
+func (s *SwapAuthorizationTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = SetupTestingApp
+
+	s.coordinator = ibctesting.NewCoordinator(s.T(), 1)
+	s.chain = s.coordinator.GetChain(ibctesting.GetChainID(1))
+	s.granter = s.chain.SenderAccounts[1]
+	s.grantee = s.chain.SenderAccounts[2]
+
+	// Provide ETH liquidity and fund the granter with WETH
+	miniApp := s.app()
+	ctx := s.chain.GetContext()
+	liquidity := sdk.NewInt64Coin("ETH", 10_000_000)
+	funds := sdk.NewInt64Coin("WETH", 10_000_000)
+	s.Require().NoError(miniApp.BankKeeper.MintCoins(ctx, simpleswap.ModuleName, sdk.NewCoins(liquidity, funds)))
+	s.Require().NoError(miniApp.SimpleSwapKeeper.CoinsReserve.Set(ctx, liquidity.Denom, liquidity))
+	s.Require().NoError(miniApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, simpleswap.ModuleName, s.granter.SenderAccount.GetAddress(), sdk.NewCoins(funds)))
+	s.coordinator.CommitBlock(s.chain)
+}
+
+func (s *SwapAuthorizationTestSuite) app() *app.MiniApp {
+	return s.chain.App.(*app.MiniApp)
+}
+
+// sendMsgs delivers the messages in a transaction signed by the account.
+func (s *SwapAuthorizationTestSuite) sendMsgs(account ibctesting.SenderAccount, msgs ...sdk.Msg) error {
+	senderPrivKey, senderAccount := s.chain.SenderPrivKey, s.chain.SenderAccount
+	defer func() {
+		s.chain.SenderPrivKey, s.chain.SenderAccount = senderPrivKey, senderAccount
+	}()
+
+	s.chain.SenderPrivKey, s.chain.SenderAccount = account.SenderPrivKey, account.SenderAccount
+	_, err := s.chain.SendMsgs(msgs...)
+	return err
+}
+
+// execSwap delivers a swap of the granter coins executed by the grantee.
+func (s *SwapAuthorizationTestSuite) execSwap(input, output sdk.Coin, maxSwapFeePercentage int32) error {
+	msgExec := authz.NewMsgExec(s.grantee.SenderAccount.GetAddress(), []sdk.Msg{&simpleswap.MsgSwapLiquidity{
+		Trader:               s.granter.SenderAccount.GetAddress().String(),
+		Input:                input,
+		Output:               output,
+		MaxSwapFeePercentage: maxSwapFeePercentage,
+	}})
+
+	return s.sendMsgs(s.grantee, &msgExec)
+}
+
+func (s *SwapAuthorizationTestSuite) TestSwapAuthorization() {
+	granter := s.granter.SenderAccount.GetAddress()
+	grantee := s.grantee.SenderAccount.GetAddress()
+	expiration := s.chain.CurrentHeader.Time.Add(time.Hour)
+
+	// The grantee cannot swap without a grant
+	s.Require().ErrorContains(s.execSwap(sdk.NewInt64Coin("WETH", 1_000_000), sdk.NewInt64Coin("ETH", 1_000_000), 30000), "authorization not found")
+
+	authorization := simpleswap.NewSwapAuthorization(
+		[]simpleswap.DenomPair{{Input: "WETH", Output: "ETH"}},
+		sdk.NewCoins(sdk.NewInt64Coin("WETH", 1_500_000)),
+		30000,
+		expiration,
+	)
+	msgGrant, err := authz.NewMsgGrant(granter, grantee, authorization, &expiration)
+	s.Require().NoError(err)
+	s.Require().NoError(s.sendMsgs(s.granter, msgGrant))
+
+	// The swap is paid by the granter, net of the swap fee, and decrements the spend limit
+	s.Require().NoError(s.execSwap(sdk.NewInt64Coin("WETH", 1_000_000), sdk.NewInt64Coin("ETH", 1_000_000), 30000))
+
+	ctx := s.chain.GetContext()
+	balances := s.app().BankKeeper.GetAllBalances(ctx, granter)
+	s.Require().Equal(math.NewInt(9_000_000), balances.AmountOf("WETH"))
+	s.Require().Equal(math.NewInt(999_700), balances.AmountOf("ETH"))
+
+	updated, _ := s.app().AuthzKeeper.GetAuthorization(ctx, grantee, granter, authorization.MsgTypeURL())
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("WETH", 500_000)), updated.(*simpleswap.SwapAuthorization).SpendLimit)
+
+	// The swaps out of the bounds of the authorization are rejected
+	s.Require().ErrorContains(s.execSwap(sdk.NewInt64Coin("WETH", 600_000), sdk.NewInt64Coin("ETH", 600_000), 30000), "more than spend limit")
+	s.Require().ErrorContains(s.execSwap(sdk.NewInt64Coin("ETH", 100_000), sdk.NewInt64Coin("WETH", 100_000), 30000), "cannot swap ETH for WETH")
+	s.Require().ErrorContains(s.execSwap(sdk.NewInt64Coin("WETH", 100_000), sdk.NewInt64Coin("ETH", 100_000), 30001), simpleswap.ErrSwapFeeTooHigh.Error())
+
+	// The swap of the rest of the spend limit deletes the grant
+	s.Require().NoError(s.execSwap(sdk.NewInt64Coin("WETH", 500_000), sdk.NewInt64Coin("ETH", 500_000), 30000))
+	updated, _ = s.app().AuthzKeeper.GetAuthorization(s.chain.GetContext(), grantee, granter, authorization.MsgTypeURL())
+	s.Require().Nil(updated)
+}
@@ -0,0 +1,90 @@
+package app_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/cosmos/simpleswap"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmosregistry/chain-minimal/app"
+)
+
+type FlashSwapTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chain       *ibctesting.TestChain
+}
+
+func TestFlashSwapTestSuite(t *testing.T) {
+	suite.Run(t, new(FlashSwapTestSuite))
+}
+
+func (s *FlashSwapTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = SetupTestingApp
+
+	s.coordinator = ibctesting.NewCoordinator(s.T(), 1)
+	s.chain = s.coordinator.GetChain(ibctesting.GetChainID(1))
+
+	// Provide ETH and WETH liquidity and fund the trader with a little WETH
+	miniApp := s.app()
+	ctx := s.chain.GetContext()
+	eth := sdk.NewInt64Coin("ETH", 10_000_000)
+	weth := sdk.NewInt64Coin("WETH", 10_000_000)
+	funds := sdk.NewInt64Coin("WETH", 1000)
+	s.Require().NoError(miniApp.BankKeeper.MintCoins(ctx, simpleswap.ModuleName, sdk.NewCoins(eth, weth.Add(funds))))
+	s.Require().NoError(miniApp.SimpleSwapKeeper.CoinsReserve.Set(ctx, eth.Denom, eth))
+	s.Require().NoError(miniApp.SimpleSwapKeeper.CoinsReserve.Set(ctx, weth.Denom, weth))
+	s.Require().NoError(miniApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, simpleswap.ModuleName, s.chain.SenderAccount.GetAddress(), sdk.NewCoins(funds)))
+	s.coordinator.CommitBlock(s.chain)
+}
+
+func (s *FlashSwapTestSuite) app() *app.MiniApp {
+	return s.chain.App.(*app.MiniApp)
+}
+
+func (s *FlashSwapTestSuite) reserve(denom string) math.Int {
+	reserve, err := s.app().SimpleSwapKeeper.CoinsReserve.Get(s.chain.GetContext(), denom)
+	s.Require().NoError(err)
+	return reserve.Amount
+}
+
+func (s *FlashSwapTestSuite) TestFlashSwap() {
+	trader := s.chain.SenderAccount.GetAddress()
+	eth := sdk.NewInt64Coin("ETH", 1_000_000)
+
+	// Without nested messages the trader cannot repay 1000300 WETH, the whole transaction reverts
+	msg, err := simpleswap.NewMsgFlashSwap(trader.String(), eth, "WETH", nil)
+	s.Require().NoError(err)
+	_, err = s.chain.SendMsgs(msg)
+	s.Require().ErrorContains(err, simpleswap.ErrFlashSwapNotRepaid.Error())
+
+	balances := s.app().BankKeeper.GetAllBalances(s.chain.GetContext(), trader)
+	s.Require().True(balances.AmountOf("ETH").IsZero())
+	s.Require().Equal(math.NewInt(10_000_000), s.reserve("ETH"))
+
+	// The borrowed ETH is swapped for 999700 WETH, the trader tops it up to repay the output and the flash fee
+	msg, err = simpleswap.NewMsgFlashSwap(trader.String(), eth, "WETH", []sdk.Msg{
+		&simpleswap.MsgSwapLiquidity{
+			Trader: trader.String(),
+			Input:  eth,
+			Output: sdk.NewInt64Coin("WETH", 1_000_000),
+		},
+	})
+	s.Require().NoError(err)
+	_, err = s.chain.SendMsgs(msg)
+	s.Require().NoError(err)
+
+	balances = s.app().BankKeeper.GetAllBalances(s.chain.GetContext(), trader)
+	s.Require().True(balances.AmountOf("ETH").IsZero())
+	s.Require().Equal(math.NewInt(400), balances.AmountOf("WETH"))
+	s.Require().Equal(math.NewInt(10_000_000), s.reserve("ETH"))
+	s.Require().Equal(math.NewInt(10_000_000), s.reserve("WETH"))
+
+	pool, err := s.app().SimpleSwapKeeper.Pool.Get(s.chain.GetContext())
+	s.Require().NoError(err)
+	s.Require().Equal(int64(600), pool.TotalAccruedFees)
+}
@@ -0,0 +1,136 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/cosmos/simpleswap"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmosregistry/chain-minimal/app"
+)
+
+// votingPeriod is the voting period of the test chain, shortened from the default two days.
+const votingPeriod = time.Minute
+
+type GovTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chain       *ibctesting.TestChain
+}
+
+func TestGovTestSuite(t *testing.T) {
+	suite.Run(t, new(GovTestSuite))
+}
+
+func (s *GovTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = SetupTestingApp
+
+	s.coordinator = ibctesting.NewCoordinator(s.T(), 1)
+	s.chain = s.coordinator.GetChain(ibctesting.GetChainID(1))
+
+	// Shorten the voting periods, the expedited one must stay shorter
+	ctx := s.chain.GetContext()
+	params, err := s.app().GovKeeper.Params.Get(ctx)
+	s.Require().NoError(err)
+	period, expeditedPeriod := votingPeriod, votingPeriod/2
+	params.VotingPeriod = &period
+	params.ExpeditedVotingPeriod = &expeditedPeriod
+	s.Require().NoError(s.app().GovKeeper.Params.Set(ctx, params))
+	s.coordinator.CommitBlock(s.chain)
+}
+
+func (s *GovTestSuite) app() *app.MiniApp {
+	return s.chain.App.(*app.MiniApp)
+}
+
+// submitProposal submits a proposal executing the messages with the minimum deposit, and returns its id.
+func (s *GovTestSuite) submitProposal(msgs ...sdk.Msg) (uint64, error) {
+	ctx := s.chain.GetContext()
+	params, err := s.app().GovKeeper.Params.Get(ctx)
+	s.Require().NoError(err)
+	proposalID, err := s.app().GovKeeper.ProposalID.Peek(ctx)
+	s.Require().NoError(err)
+
+	msg, err := govv1.NewMsgSubmitProposal(msgs, params.MinDeposit, s.chain.SenderAccount.GetAddress().String(), "", "Update the simpleswap params", "Update the simpleswap params", false)
+	s.Require().NoError(err)
+	_, err = s.chain.SendMsgs(msg)
+
+	return proposalID, err
+}
+
+// voteThrough votes yes on the proposal with the delegations of the sender account, which holds all
+// the voting power, and ends the voting period.
+func (s *GovTestSuite) voteThrough(proposalID uint64) govv1.Proposal {
+	vote := govv1.NewMsgVote(s.chain.SenderAccount.GetAddress(), proposalID, govv1.OptionYes, "")
+	_, err := s.chain.SendMsgs(vote)
+	s.Require().NoError(err)
+
+	s.coordinator.IncrementTimeBy(votingPeriod)
+	s.coordinator.CommitBlock(s.chain)
+
+	proposal, err := s.app().GovKeeper.Proposals.Get(s.chain.GetContext(), proposalID)
+	s.Require().NoError(err)
+	return proposal
+}
+
+func (s *GovTestSuite) TestUpdateParamsProposal() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	params, err := s.app().SimpleSwapKeeper.Params.Get(s.chain.GetContext())
+	s.Require().NoError(err)
+	params.SwapFeePercentage = 20000
+	params.BatchAuction = true
+
+	// The params are only updated by the gov module account
+	_, err = s.submitProposal(&simpleswap.MsgUpdateParams{
+		Authority: s.chain.SenderAccount.GetAddress().String(),
+		Params:    params,
+	})
+	s.Require().ErrorContains(err, "expected gov account as only signer for proposal message")
+
+	proposalID, err := s.submitProposal(&simpleswap.MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	})
+	s.Require().NoError(err)
+
+	// The params are unchanged during the voting period
+	proposal, err := s.app().GovKeeper.Proposals.Get(s.chain.GetContext(), proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(govv1.StatusVotingPeriod, proposal.Status)
+
+	current, err := s.app().SimpleSwapKeeper.Params.Get(s.chain.GetContext())
+	s.Require().NoError(err)
+	s.Require().Equal(simpleswap.DefaultParams().SwapFeePercentage, current.SwapFeePercentage)
+	s.Require().False(current.BatchAuction)
+
+	// The params are updated once the proposal passes
+	proposal = s.voteThrough(proposalID)
+	s.Require().Equal(govv1.StatusPassed, proposal.Status)
+
+	current, err = s.app().SimpleSwapKeeper.Params.Get(s.chain.GetContext())
+	s.Require().NoError(err)
+	s.Require().Equal(params, current)
+}
+
+func (s *GovTestSuite) TestUpdateParamsProposalWithInvalidParams() {
+	params := simpleswap.DefaultParams()
+	params.SwapFeePercentage = 0
+
+	// The proposal is rejected on submission, the params are checked by the validation of the message
+	_, err := s.submitProposal(&simpleswap.MsgUpdateParams{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Params:    params,
+	})
+	s.Require().ErrorContains(err, simpleswap.ErrZeroSwapFee.Error())
+
+	current, err := s.app().SimpleSwapKeeper.Params.Get(s.chain.GetContext())
+	s.Require().NoError(err)
+	s.Require().Equal(simpleswap.DefaultParams(), current)
+}
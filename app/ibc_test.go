@@ -0,0 +1,229 @@
+package app_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	dbm "github.com/cosmos/cosmos-db"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/cosmos/simpleswap"
+	"github.com/cosmos/simpleswap/keeper"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmosregistry/chain-minimal/app"
+	_ "github.com/cosmosregistry/chain-minimal/app/params" // import for the address prefixes
+)
+
+// SetupTestingApp returns a MiniApp for the IBC testing package.
+func SetupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	miniApp, err := app.NewMiniApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{})
+	if err != nil {
+		panic(err)
+	}
+
+	return miniApp, miniApp.DefaultGenesis()
+}
+
+type SwapOnReceiveTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chainA      *ibctesting.TestChain
+	chainB      *ibctesting.TestChain
+	path        *ibctesting.Path
+
+	// receivedTrace and receivedDenom are the trace and denom of the chainA bond denom received on chainB
+	receivedTrace string
+	receivedDenom string
+}
+
+func TestSwapOnReceiveTestSuite(t *testing.T) {
+	suite.Run(t, new(SwapOnReceiveTestSuite))
+}
+
+func (s *SwapOnReceiveTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = SetupTestingApp
+
+	s.coordinator = ibctesting.NewCoordinator(s.T(), 2)
+	s.chainA = s.coordinator.GetChain(ibctesting.GetChainID(1))
+	s.chainB = s.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	s.path = ibctesting.NewTransferPath(s.chainA, s.chainB)
+	s.coordinator.Setup(s.path)
+
+	s.receivedTrace = transfertypes.GetPrefixedDenom(s.path.EndpointB.ChannelConfig.PortID, s.path.EndpointB.ChannelID, sdk.DefaultBondDenom)
+	s.receivedDenom = transfertypes.ParseDenomTrace(s.receivedTrace).IBCDenom()
+
+	// Whitelist the received denom on chainB by its trace and provide ETH liquidity
+	appB := s.appB()
+	ctx := s.chainB.GetContext()
+	params, err := appB.SimpleSwapKeeper.Params.Get(ctx)
+	s.Require().NoError(err)
+	params.WhitelistedCoins = append(params.WhitelistedCoins, &sdk.Coin{Denom: s.receivedTrace, Amount: math.ZeroInt()})
+	s.Require().NoError(params.Validate())
+	s.Require().NoError(appB.SimpleSwapKeeper.Params.Set(ctx, params))
+
+	liquidity := sdk.NewInt64Coin("ETH", 10_000_000)
+	s.Require().NoError(appB.BankKeeper.MintCoins(ctx, simpleswap.ModuleName, sdk.NewCoins(liquidity)))
+	s.Require().NoError(appB.SimpleSwapKeeper.CoinsReserve.Set(ctx, liquidity.Denom, liquidity))
+	s.coordinator.CommitBlock(s.chainB)
+}
+
+func (s *SwapOnReceiveTestSuite) appB() *app.MiniApp {
+	return s.chainB.App.(*app.MiniApp)
+}
+
+// transfer sends the bond denom of chainA to the receiver on chainB, relays the packet and
+// its acknowledgement, and returns the acknowledgement.
+func (s *SwapOnReceiveTestSuite) transfer(amount int64, receiver, memo string) channeltypes.Acknowledgement {
+	msg := transfertypes.NewMsgTransfer(
+		s.path.EndpointA.ChannelConfig.PortID,
+		s.path.EndpointA.ChannelID,
+		sdk.NewInt64Coin(sdk.DefaultBondDenom, amount),
+		s.chainA.SenderAccount.GetAddress().String(),
+		receiver,
+		s.chainB.GetTimeoutHeight(),
+		0,
+		memo,
+	)
+
+	res, err := s.chainA.SendMsgs(msg)
+	s.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	s.Require().NoError(err)
+
+	_, ackBytes, err := s.path.RelayPacketWithResults(packet)
+	s.Require().NoError(err)
+
+	var ack channeltypes.Acknowledgement
+	s.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(ackBytes, &ack))
+
+	return ack
+}
+
+func (s *SwapOnReceiveTestSuite) TestSwapOnReceive() {
+	receiver := s.chainB.SenderAccounts[1].SenderAccount.GetAddress()
+	swapReceiver := s.chainB.SenderAccounts[2].SenderAccount.GetAddress()
+
+	testCases := []struct {
+		name             string
+		receiver         string
+		memo             string
+		expectSuccess    bool
+		expectedReceiver sdk.AccAddress
+		expectedBalance  sdk.Coins
+	}{
+		{
+			name:             "plain transfer",
+			receiver:         receiver.String(),
+			memo:             "",
+			expectSuccess:    true,
+			expectedReceiver: receiver,
+			expectedBalance:  sdk.NewCoins(sdk.NewInt64Coin(s.receivedDenom, 1_000_000)),
+		},
+		{
+			name:             "memo of another middleware",
+			receiver:         receiver.String(),
+			memo:             `{"wasm":{"contract":"mini1"}}`,
+			expectSuccess:    true,
+			expectedReceiver: receiver,
+			expectedBalance:  sdk.NewCoins(sdk.NewInt64Coin(s.receivedDenom, 1_000_000)),
+		},
+		{
+			name:             "swap to the transfer receiver, net of the swap fee",
+			receiver:         receiver.String(),
+			memo:             `{"simpleswap":{"out_denom":"ETH","min_out":"999000"}}`,
+			expectSuccess:    true,
+			expectedReceiver: receiver,
+			expectedBalance:  sdk.NewCoins(sdk.NewInt64Coin("ETH", 999_700)),
+		},
+		{
+			name:             "swap to the memo receiver",
+			receiver:         receiver.String(),
+			memo:             fmt.Sprintf(`{"simpleswap":{"out_denom":"ETH","min_out":"999700","receiver":"%s"}}`, swapReceiver),
+			expectSuccess:    true,
+			expectedReceiver: swapReceiver,
+			expectedBalance:  sdk.NewCoins(sdk.NewInt64Coin("ETH", 999_700)),
+		},
+		{
+			name:             "output below the minimum",
+			receiver:         receiver.String(),
+			memo:             `{"simpleswap":{"out_denom":"ETH","min_out":"999701"}}`,
+			expectSuccess:    false,
+			expectedReceiver: receiver,
+		},
+		{
+			name:             "output not whitelisted",
+			receiver:         receiver.String(),
+			memo:             `{"simpleswap":{"out_denom":"BTC","min_out":"1"}}`,
+			expectSuccess:    false,
+			expectedReceiver: receiver,
+		},
+		{
+			name:             "insufficient liquidity",
+			receiver:         receiver.String(),
+			memo:             `{"simpleswap":{"out_denom":"WETH","min_out":"1"}}`,
+			expectSuccess:    false,
+			expectedReceiver: receiver,
+		},
+		{
+			name:             "invalid memo",
+			receiver:         receiver.String(),
+			memo:             `{"simpleswap":{"out_denom":"ETH","min_out":"-1"}}`,
+			expectSuccess:    false,
+			expectedReceiver: receiver,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			sender := s.chainA.SenderAccount.GetAddress()
+			balanceA := s.chainA.App.(*app.MiniApp).BankKeeper.GetBalance(s.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+			ack := s.transfer(1_000_000, tc.receiver, tc.memo)
+			s.Require().Equal(tc.expectSuccess, ack.Success(), ack.GetError())
+
+			balanceB := s.appB().BankKeeper.GetAllBalances(s.chainB.GetContext(), tc.expectedReceiver)
+			if !tc.expectSuccess {
+				// The transfer is reverted on chainB and refunded on chainA
+				s.Require().True(balanceB.AmountOf(s.receivedDenom).IsZero())
+				s.Require().True(balanceB.AmountOf("ETH").IsZero())
+				s.Require().Equal(balanceA, s.chainA.App.(*app.MiniApp).BankKeeper.GetBalance(s.chainA.GetContext(), sender, sdk.DefaultBondDenom))
+				return
+			}
+
+			for _, denom := range []string{s.receivedDenom, "ETH"} {
+				s.Require().Equal(tc.expectedBalance.AmountOf(denom), balanceB.AmountOf(denom), denom)
+			}
+		})
+	}
+}
+
+func (s *SwapOnReceiveTestSuite) TestReceivedDenomOrigin() {
+	receiver := s.chainB.SenderAccounts[1].SenderAccount.GetAddress()
+	queryServer := keeper.NewQueryServerImpl(s.appB().SimpleSwapKeeper)
+
+	// The swap into the pool adds the received coins to the reserve of their ibc denom
+	ack := s.transfer(1_000_000, receiver.String(), `{"simpleswap":{"out_denom":"ETH","min_out":"1"}}`)
+	s.Require().True(ack.Success(), ack.GetError())
+
+	resp, err := queryServer.CoinReserve(s.chainB.GetContext(), &simpleswap.QueryCoinReserveRequest{CoinDenom: s.receivedTrace})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(s.receivedDenom, 1_000_000), resp.CoinReserve)
+	s.Require().Equal(s.receivedTrace, resp.Origin)
+
+	poolResp, err := queryServer.Pool(s.chainB.GetContext(), &simpleswap.QueryPoolRequest{})
+	s.Require().NoError(err)
+	s.Require().Contains(poolResp.Assets, simpleswap.DenomOrigin{Denom: s.receivedDenom, Origin: s.receivedTrace})
+}
@@ -0,0 +1,72 @@
+package app_test
+
+import (
+	"math/rand"
+	"testing"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/cosmos/simpleswap"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmosregistry/chain-minimal/app"
+)
+
+func TestMsgValidateBasicInCheckTx(t *testing.T) {
+	ibctesting.DefaultTestingAppInit = SetupTestingApp
+	coordinator := ibctesting.NewCoordinator(t, 1)
+	chain := coordinator.GetChain(ibctesting.GetChainID(1))
+	sender := chain.SenderAccount.GetAddress().String()
+
+	testCases := []struct {
+		name      string
+		msg       sdk.Msg
+		expectErr *errorsmod.Error
+	}{
+		{
+			name:      "add a zero amount",
+			msg:       &simpleswap.MsgAddLiquidity{LiquidityProvider: sender, Token: sdk.NewInt64Coin("ETH", 0)},
+			expectErr: simpleswap.ErrZeroAmount,
+		},
+		{
+			name:      "swap a negative input",
+			msg:       &simpleswap.MsgSwapLiquidity{Trader: sender, Input: sdk.Coin{Denom: "ETH", Amount: math.NewInt(-1)}, Output: sdk.NewInt64Coin("WETH", 1)},
+			expectErr: simpleswap.ErrCoinInvalid,
+		},
+		{
+			name:      "remove an invalid denom",
+			msg:       &simpleswap.MsgRemoveLiquidity{LiquidityProvider: sender, Token: sdk.Coin{Denom: "1ETH", Amount: math.NewInt(1)}},
+			expectErr: simpleswap.ErrCoinInvalid,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx, err := simtestutil.GenSignedMockTx(
+				rand.New(rand.NewSource(1)),
+				chain.TxConfig,
+				[]sdk.Msg{tc.msg},
+				sdk.NewCoins(),
+				simtestutil.DefaultGenTxGas,
+				chain.ChainID,
+				[]uint64{chain.SenderAccount.GetAccountNumber()},
+				[]uint64{chain.SenderAccount.GetSequence()},
+				chain.SenderPrivKey,
+			)
+			require.NoError(t, err)
+
+			txBytes, err := chain.TxConfig.TxEncoder()(tx)
+			require.NoError(t, err)
+
+			// The malformed message is rejected in CheckTx, before it reaches the mempool
+			resp, err := chain.App.(*app.MiniApp).CheckTx(&abci.RequestCheckTx{Tx: txBytes, Type: abci.CheckTxType_New})
+			require.NoError(t, err)
+			require.Equal(t, simpleswap.ModuleName, resp.Codespace)
+			require.Equal(t, tc.expectErr.ABCICode(), resp.Code, resp.Log)
+		})
+	}
+}
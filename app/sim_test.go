@@ -0,0 +1,268 @@
+package app_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"runtime/debug"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	simcli "github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmosregistry/chain-minimal/app"
+)
+
+// SimAppChainID is the chain id of the simulated minid chains.
+const SimAppChainID = "simulation-app"
+
+// The simulations are run with the flags of the simulator, e.g.
+//
+//	go test ./app -run TestFullAppSimulation -Enabled=true -NumBlocks=100 -BlockSize=50 -Commit=true -Seed=42 -v
+func init() {
+	simcli.GetSimulatorFlags()
+}
+
+// fauxMerkleModeOpt returns a BaseApp option to use a dbStoreAdapter instead of an IAVLStore
+// for faster simulation speed.
+func fauxMerkleModeOpt(bapp *baseapp.BaseApp) {
+	bapp.SetFauxMerkleMode()
+}
+
+// interBlockCacheOpt returns a BaseApp option function that sets the persistent inter-block
+// write-through cache.
+func interBlockCacheOpt() func(*baseapp.BaseApp) {
+	return baseapp.SetInterBlockCache(store.NewCommitKVStoreCacheManager())
+}
+
+// newSimApp returns a MiniApp for the simulations.
+func newSimApp(t *testing.T, logger log.Logger, db dbm.DB, baseAppOptions ...func(*baseapp.BaseApp)) *app.MiniApp {
+	t.Helper()
+
+	appOptions := make(simtestutil.AppOptionsMap, 0)
+	appOptions[flags.FlagHome] = app.DefaultNodeHome
+	appOptions[server.FlagInvCheckPeriod] = simcli.FlagPeriodValue
+
+	miniApp, err := app.NewMiniApp(logger, db, nil, true, appOptions, append(baseAppOptions, baseapp.SetChainID(SimAppChainID))...)
+	require.NoError(t, err)
+	require.Equal(t, "MiniApp", miniApp.Name())
+
+	return miniApp
+}
+
+// simulateFromSeed runs the randomized simulation of the app from the seed of the config.
+func simulateFromSeed(t *testing.T, miniApp *app.MiniApp, config simtypes.Config) (simtypes.Params, error) {
+	t.Helper()
+
+	_, simParams, simErr := simulation.SimulateFromSeed(
+		t,
+		os.Stdout,
+		miniApp.BaseApp,
+		simtestutil.AppStateFn(miniApp.AppCodec(), miniApp.SimulationManager(), miniApp.DefaultGenesis()),
+		simtypes.RandomAccounts,
+		simtestutil.SimulationOperations(miniApp, miniApp.AppCodec(), config),
+		miniApp.BankKeeper.GetBlockedAddresses(),
+		config,
+		miniApp.AppCodec(),
+	)
+
+	return simParams, simErr
+}
+
+func TestFullAppSimulation(t *testing.T) {
+	config := simcli.NewConfigFromFlags()
+	config.ChainID = SimAppChainID
+
+	db, dir, logger, skip, err := simtestutil.SetupSimulation(config, "leveldb-app-sim", "Simulation", simcli.FlagVerboseValue, simcli.FlagEnabledValue)
+	if skip {
+		t.Skip("skipping application simulation")
+	}
+	require.NoError(t, err, "simulation setup failed")
+
+	defer func() {
+		require.NoError(t, db.Close())
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	miniApp := newSimApp(t, logger, db, fauxMerkleModeOpt)
+	simParams, simErr := simulateFromSeed(t, miniApp, config)
+
+	// export state and simParams before the simulation error is checked
+	require.NoError(t, simtestutil.CheckExportSimulation(miniApp, config, simParams))
+	require.NoError(t, simErr)
+
+	if config.Commit {
+		simtestutil.PrintStats(db)
+	}
+}
+
+func TestAppImportExport(t *testing.T) {
+	config := simcli.NewConfigFromFlags()
+	config.ChainID = SimAppChainID
+
+	db, dir, logger, skip, err := simtestutil.SetupSimulation(config, "leveldb-app-sim", "Simulation", simcli.FlagVerboseValue, simcli.FlagEnabledValue)
+	if skip {
+		t.Skip("skipping application import/export simulation")
+	}
+	require.NoError(t, err, "simulation setup failed")
+
+	defer func() {
+		require.NoError(t, db.Close())
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	miniApp := newSimApp(t, logger, db, fauxMerkleModeOpt)
+	simParams, simErr := simulateFromSeed(t, miniApp, config)
+
+	// export state and simParams before the simulation error is checked
+	require.NoError(t, simtestutil.CheckExportSimulation(miniApp, config, simParams))
+	require.NoError(t, simErr)
+
+	if config.Commit {
+		simtestutil.PrintStats(db)
+	}
+
+	fmt.Printf("exporting genesis...\n")
+
+	exported, err := miniApp.ExportAppStateAndValidators(false, []string{}, []string{})
+	require.NoError(t, err)
+
+	fmt.Printf("importing genesis...\n")
+
+	newDB, newDir, _, _, err := simtestutil.SetupSimulation(config, "leveldb-app-sim-2", "Simulation-2", simcli.FlagVerboseValue, simcli.FlagEnabledValue)
+	require.NoError(t, err, "simulation setup failed")
+
+	defer func() {
+		require.NoError(t, newDB.Close())
+		require.NoError(t, os.RemoveAll(newDir))
+	}()
+
+	newApp := newSimApp(t, log.NewNopLogger(), newDB, fauxMerkleModeOpt)
+
+	var genesisState map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(exported.AppState, &genesisState))
+
+	ctxA := miniApp.NewContextLegacy(true, cmtproto.Header{Height: miniApp.LastBlockHeight()})
+	ctxB := newApp.NewContextLegacy(true, cmtproto.Header{Height: miniApp.LastBlockHeight()})
+	_, err = newApp.ModuleManager.InitGenesis(ctxB, miniApp.AppCodec(), genesisState)
+	if err != nil && strings.Contains(err.Error(), "validator set is empty after InitGenesis") {
+		logger.Info("Skipping simulation as all validators have been unbonded")
+		logger.Info("err", err, "stacktrace", string(debug.Stack()))
+		return
+	}
+	require.NoError(t, err)
+	require.NoError(t, newApp.StoreConsensusParams(ctxB, exported.ConsensusParams))
+
+	fmt.Printf("comparing stores...\n")
+
+	// skip the prefixes which are not exported, or rebuilt differently at genesis
+	skipPrefixes := map[string][][]byte{
+		stakingtypes.StoreKey: {
+			stakingtypes.UnbondingQueueKey, stakingtypes.RedelegationQueueKey, stakingtypes.ValidatorQueueKey,
+			stakingtypes.HistoricalInfoKey, stakingtypes.UnbondingIDKey, stakingtypes.UnbondingIndexKey,
+			stakingtypes.UnbondingTypeKey, stakingtypes.ValidatorUpdatesKey,
+		},
+		authzkeeper.StoreKey: {authzkeeper.GrantQueuePrefix},
+	}
+
+	storeKeys := miniApp.GetStoreKeys()
+	require.NotEmpty(t, storeKeys)
+
+	for _, appKeyA := range storeKeys {
+		// only compare kvstores
+		if _, ok := appKeyA.(*storetypes.KVStoreKey); !ok {
+			continue
+		}
+
+		keyName := appKeyA.Name()
+		appKeyB := newApp.GetKey(keyName)
+
+		storeA := ctxA.KVStore(appKeyA)
+		storeB := ctxB.KVStore(appKeyB)
+
+		failedKVAs, failedKVBs := simtestutil.DiffKVStores(storeA, storeB, skipPrefixes[keyName])
+		require.Equal(t, len(failedKVAs), len(failedKVBs), "unequal sets of key-values to compare %s", keyName)
+
+		fmt.Printf("compared %d different key/value pairs between %s and %s\n", len(failedKVAs), appKeyA, appKeyB)
+
+		require.Equal(t, 0, len(failedKVAs), simtestutil.GetSimulationLog(keyName, miniApp.SimulationManager().StoreDecoders, failedKVAs, failedKVBs))
+	}
+}
+
+func TestAppStateDeterminism(t *testing.T) {
+	if !simcli.FlagEnabledValue {
+		t.Skip("skipping application simulation")
+	}
+
+	config := simcli.NewConfigFromFlags()
+	config.InitialBlockHeight = 1
+	config.ExportParamsPath = ""
+	config.OnOperation = true
+	config.AllInvariants = true
+	config.ChainID = SimAppChainID
+
+	numSeeds := 3
+	numTimesToRunPerSeed := 3
+	appHashList := make([]json.RawMessage, numTimesToRunPerSeed)
+
+	// a seed given on the command line is the only one simulated
+	seeds := make([]int64, numSeeds)
+	for i := range seeds {
+		seeds[i] = rand.Int63()
+	}
+	if config.Seed != simcli.DefaultSeedValue {
+		seeds = []int64{config.Seed}
+	}
+
+	for i, seed := range seeds {
+		config.Seed = seed
+
+		for j := 0; j < numTimesToRunPerSeed; j++ {
+			var logger log.Logger
+			if simcli.FlagVerboseValue {
+				logger = log.NewTestLogger(t)
+			} else {
+				logger = log.NewNopLogger()
+			}
+
+			db := dbm.NewMemDB()
+			miniApp := newSimApp(t, logger, db, interBlockCacheOpt())
+
+			fmt.Printf(
+				"running non-determinism simulation; seed %d: %d/%d, attempt: %d/%d\n",
+				config.Seed, i+1, len(seeds), j+1, numTimesToRunPerSeed,
+			)
+
+			_, err := simulateFromSeed(t, miniApp, config)
+			require.NoError(t, err)
+
+			if config.Commit {
+				simtestutil.PrintStats(db)
+			}
+
+			appHashList[j] = miniApp.LastCommitID().Hash
+
+			if j != 0 {
+				require.Equal(
+					t, string(appHashList[0]), string(appHashList[j]),
+					"non-determinism in seed %d: %d/%d, attempt: %d/%d\n", config.Seed, i+1, len(seeds), j+1, numTimesToRunPerSeed,
+				)
+			}
+		}
+	}
+}
@@ -0,0 +1,461 @@
+package app_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibcclienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/cosmos/simpleswap"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/cosmosregistry/chain-minimal/app"
+)
+
+type UpgradeTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chain       *ibctesting.TestChain
+}
+
+func TestUpgradeTestSuite(t *testing.T) {
+	suite.Run(t, new(UpgradeTestSuite))
+}
+
+func (s *UpgradeTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = SetupTestingApp
+
+	s.coordinator = ibctesting.NewCoordinator(s.T(), 1)
+	s.chain = s.coordinator.GetChain(ibctesting.GetChainID(1))
+}
+
+func (s *UpgradeTestSuite) app() *app.MiniApp {
+	return s.chain.App.(*app.MiniApp)
+}
+
+// legacyShareToken is the share token param of the version 1 state.
+var legacyShareToken = sdk.NewInt64Coin("USDT", 100)
+
+// v1Params returns the encoded version 1 params: they have no field added since then, and the
+// share token as field 4.
+func (s *UpgradeTestSuite) v1Params() []byte {
+	params := simpleswap.Params{
+		WhitelistedCoins:  simpleswap.DefaultParams().WhitelistedCoins,
+		SwapFeePercentage: 20000,
+		Decimals:          6,
+	}
+	paramsBz, err := params.Marshal()
+	s.Require().NoError(err)
+	shareTokenBz, err := legacyShareToken.Marshal()
+	s.Require().NoError(err)
+	paramsBz = protowire.AppendTag(paramsBz, 4, protowire.BytesType)
+	return protowire.AppendBytes(paramsBz, shareTokenBz)
+}
+
+// setV1State replaces the simpleswap state with a version 1 state: the params hold the share token,
+// and the shares of the liquidity providers are minted in its denom.
+func (s *UpgradeTestSuite) setV1State(providers map[string]sdk.Coin) {
+	miniApp := s.app()
+	ctx := s.chain.GetContext()
+
+	ctx.KVStore(miniApp.GetKey(simpleswap.ModuleName)).Set(simpleswap.ParamsKey, s.v1Params())
+
+	// The version 1 liquidity providers are not indexed
+	totalLiquidity := int64(0)
+	for address, stableCoin := range providers {
+		shares := sdk.NewCoin(legacyShareToken.Denom, stableCoin.Amount)
+		provider := simpleswap.LiquidityProvider{
+			StableCoin: &stableCoin,
+			PoolShare:  &shares,
+		}
+		s.Require().NoError(miniApp.SimpleSwapKeeper.LiquidityProviders.Set(ctx, address, provider))
+		s.Require().NoError(miniApp.SimpleSwapKeeper.LiquidityProviders.Indexes.Denom.Unreference(ctx, address, func() (simpleswap.LiquidityProvider, error) {
+			return provider, nil
+		}))
+
+		reserve, err := miniApp.SimpleSwapKeeper.CoinsReserve.Get(ctx, stableCoin.Denom)
+		s.Require().NoError(err)
+		s.Require().NoError(miniApp.SimpleSwapKeeper.CoinsReserve.Set(ctx, stableCoin.Denom, reserve.Add(stableCoin)))
+
+		s.Require().NoError(miniApp.BankKeeper.MintCoins(ctx, simpleswap.ModuleName, sdk.NewCoins(stableCoin, shares)))
+		s.Require().NoError(miniApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, simpleswap.ModuleName, sdk.MustAccAddressFromBech32(address), sdk.NewCoins(shares)))
+		totalLiquidity += stableCoin.Amount.Int64()
+	}
+
+	// The version 1 pool has no id, and holds the share token param
+	s.Require().NoError(miniApp.SimpleSwapKeeper.Pool.Set(ctx, simpleswap.Pool{
+		TotalLiquidity:    totalLiquidity,
+		Decimals:          6,
+		ShareToken:        &legacyShareToken,
+		SwapFeePercentage: 20000,
+	}))
+
+	versionMap, err := miniApp.UpgradeKeeper.GetModuleVersionMap(ctx)
+	s.Require().NoError(err)
+	versionMap[simpleswap.ModuleName] = 1
+	s.Require().NoError(miniApp.UpgradeKeeper.SetModuleVersionMap(ctx, versionMap))
+}
+
+// upgrade runs the upgrade, and checks the modules are migrated to their current versions.
+func (s *UpgradeTestSuite) upgrade(name string) {
+	miniApp := s.app()
+
+	// Schedule the upgrade at the block being built, as the previous binary would have at an earlier
+	// height, this binary refuses to run a block below the height of an upgrade it knows
+	ctx := s.chain.GetContext()
+	upgradeHeight := ctx.BlockHeight()
+	s.Require().NoError(miniApp.UpgradeKeeper.ScheduleUpgrade(ctx.WithBlockHeight(upgradeHeight-1), upgradetypes.Plan{Name: name, Height: upgradeHeight}))
+	s.coordinator.CommitBlock(s.chain)
+
+	ctx = s.chain.GetContext()
+	s.Require().Greater(ctx.BlockHeight(), upgradeHeight)
+	done, err := miniApp.UpgradeKeeper.GetDoneHeight(ctx, name)
+	s.Require().NoError(err)
+	s.Require().Equal(upgradeHeight, done)
+
+	versionMap, err := miniApp.UpgradeKeeper.GetModuleVersionMap(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(miniApp.ModuleManager.GetVersionMap(), versionMap)
+	s.Require().Equal(uint64(2), versionMap[simpleswap.ModuleName])
+}
+
+// indexedProviders returns the addresses of the liquidity providers in the denom index.
+func (s *UpgradeTestSuite) indexedProviders(denom string) []string {
+	iter, err := s.app().SimpleSwapKeeper.LiquidityProviders.Indexes.Denom.MatchExact(s.chain.GetContext(), denom)
+	s.Require().NoError(err)
+	addresses, err := iter.PrimaryKeys()
+	s.Require().NoError(err)
+	return addresses
+}
+
+// exposure returns the total of the stable coins of the liquidity providers of the denom.
+func (s *UpgradeTestSuite) exposure(denom string) math.Int {
+	exposure, err := s.app().SimpleSwapKeeper.LiquidityProviders.Indexes.Denom.Exposure(s.chain.GetContext(), denom)
+	s.Require().NoError(err)
+	return exposure
+}
+
+// accountLocks returns the ids of the share locks of the owner in the owner index.
+func (s *UpgradeTestSuite) accountLocks(owner string) []uint64 {
+	locks, err := s.app().SimpleSwapKeeper.AccountLocks(s.chain.GetContext(), owner)
+	s.Require().NoError(err)
+
+	ids := []uint64{}
+	for _, lock := range locks {
+		ids = append(ids, lock.Id)
+	}
+	return ids
+}
+
+// indexedLocks returns the ids of the share locks in the end time index.
+func (s *UpgradeTestSuite) indexedLocks(endTime time.Time) []uint64 {
+	iter, err := s.app().SimpleSwapKeeper.Locks.Indexes.EndTime.MatchExact(s.chain.GetContext(), endTime)
+	s.Require().NoError(err)
+	ids, err := iter.PrimaryKeys()
+	s.Require().NoError(err)
+	return ids
+}
+
+// indexedLimitOrders returns the ids of the limit orders of the owner and of the pair in the
+// owner and pair indexes.
+func (s *UpgradeTestSuite) indexedLimitOrders(owner, inputDenom, outputDenom string) (byOwner, byPair []uint64) {
+	indexes := s.app().SimpleSwapKeeper.LimitOrders.Indexes
+	ownerIter, err := indexes.Owner.MatchExact(s.chain.GetContext(), owner)
+	s.Require().NoError(err)
+	byOwner, err = ownerIter.PrimaryKeys()
+	s.Require().NoError(err)
+
+	pairIter, err := indexes.Pair.MatchExact(s.chain.GetContext(), collections.Join(inputDenom, outputDenom))
+	s.Require().NoError(err)
+	byPair, err = pairIter.PrimaryKeys()
+	s.Require().NoError(err)
+	return byOwner, byPair
+}
+
+// indexedDCAPlans returns the ids of the DCA plans in the next execution height index.
+func (s *UpgradeTestSuite) indexedDCAPlans(height int64) []uint64 {
+	iter, err := s.app().SimpleSwapKeeper.DCAPlans.Indexes.NextExecution.MatchExact(s.chain.GetContext(), height)
+	s.Require().NoError(err)
+	ids, err := iter.PrimaryKeys()
+	s.Require().NoError(err)
+	return ids
+}
+
+// dcaPlansByOwner returns the ids of the DCA plans of the owner in the owner index.
+func (s *UpgradeTestSuite) dcaPlansByOwner(owner string) []uint64 {
+	iter, err := s.app().SimpleSwapKeeper.DCAPlans.Indexes.Owner.MatchExact(s.chain.GetContext(), owner)
+	s.Require().NoError(err)
+	ids, err := iter.PrimaryKeys()
+	s.Require().NoError(err)
+	return ids
+}
+
+func (s *UpgradeTestSuite) TestUpgradeFromV1() {
+	miniApp := s.app()
+	alice := s.chain.SenderAccounts[0].SenderAccount.GetAddress()
+	bob := s.chain.SenderAccounts[1].SenderAccount.GetAddress()
+	s.setV1State(map[string]sdk.Coin{
+		alice.String(): sdk.NewInt64Coin("ETH", 2_000_000),
+		bob.String():   sdk.NewInt64Coin("WETH", 1_000_000),
+	})
+	s.Require().Empty(s.indexedProviders("ETH"))
+	s.Require().True(s.exposure("ETH").IsZero())
+
+	// The share locks, the limit orders and the DCA plans of the version 1 state are not indexed
+	// either
+	ctx := s.chain.GetContext()
+	shares := sdk.NewInt64Coin(simpleswap.PoolShareDenom(simpleswap.DefaultPoolId), 1_000)
+	endTime := ctx.BlockTime().Add(24 * time.Hour).UTC()
+	locks := []simpleswap.PeriodLock{
+		{Id: 0, Owner: alice.String(), Shares: shares, Duration: 24 * time.Hour},
+		{Id: 1, Owner: alice.String(), Shares: shares, Duration: 24 * time.Hour, EndTime: endTime},
+		{Id: 2, Owner: bob.String(), Shares: shares, Duration: 14 * 24 * time.Hour},
+	}
+	for _, lock := range locks {
+		s.Require().NoError(miniApp.SimpleSwapKeeper.Locks.Set(ctx, lock.Id, lock))
+		for _, index := range miniApp.SimpleSwapKeeper.Locks.Indexes.IndexesList() {
+			s.Require().NoError(index.Unreference(ctx, lock.Id, func() (simpleswap.PeriodLock, error) {
+				return lock, nil
+			}))
+		}
+	}
+	s.Require().Empty(s.indexedLocks(time.Time{}))
+	s.Require().Empty(s.accountLocks(alice.String()))
+
+	order := simpleswap.LimitOrder{Id: 0, Owner: bob.String(), Input: sdk.NewInt64Coin("WETH", 1_000), OutputDenom: "ETH", LimitPrice: math.LegacyOneDec()}
+	s.Require().NoError(miniApp.SimpleSwapKeeper.LimitOrders.Set(ctx, order.Id, order))
+	for _, index := range miniApp.SimpleSwapKeeper.LimitOrders.Indexes.IndexesList() {
+		s.Require().NoError(index.Unreference(ctx, order.Id, func() (simpleswap.LimitOrder, error) {
+			return order, nil
+		}))
+	}
+	byOwner, byPair := s.indexedLimitOrders(bob.String(), "WETH", "ETH")
+	s.Require().Empty(byOwner)
+	s.Require().Empty(byPair)
+
+	plan := simpleswap.DCAPlan{Id: 0, Owner: alice.String(), Total: shares, Remaining: shares, SliceAmount: shares.Amount, OutputDenom: "ETH", NextExecutionHeight: 1_000}
+	s.Require().NoError(miniApp.SimpleSwapKeeper.DCAPlans.Set(ctx, plan.Id, plan))
+	for _, index := range miniApp.SimpleSwapKeeper.DCAPlans.Indexes.IndexesList() {
+		s.Require().NoError(index.Unreference(ctx, plan.Id, func() (simpleswap.DCAPlan, error) {
+			return plan, nil
+		}))
+	}
+	s.Require().Empty(s.indexedDCAPlans(plan.NextExecutionHeight))
+	s.Require().Empty(s.dcaPlansByOwner(alice.String()))
+
+	s.upgrade(app.UpgradeName)
+	ctx = s.chain.GetContext()
+
+	// The params of version 1 are kept, the new params get their defaults
+	params, err := miniApp.SimpleSwapKeeper.Params.Get(ctx)
+	s.Require().NoError(err)
+	expectedParams := simpleswap.DefaultParams()
+	expectedParams.SwapFeePercentage = 20000
+	s.Require().Equal(expectedParams, params)
+
+	// The shares are reissued in the share denom of the pool
+	shareDenom := simpleswap.PoolShareDenom(simpleswap.DefaultPoolId)
+	pool, err := miniApp.SimpleSwapKeeper.Pool.Get(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(simpleswap.DefaultPoolId, pool.Id)
+	s.Require().Equal(sdk.NewInt64Coin(shareDenom, 3_000_000), *pool.ShareToken)
+
+	for address, amount := range map[string]int64{alice.String(): 2_000_000, bob.String(): 1_000_000} {
+		provider, err := miniApp.SimpleSwapKeeper.LiquidityProviders.Get(ctx, address)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewInt64Coin(shareDenom, amount), *provider.PoolShare)
+
+		balances := miniApp.BankKeeper.GetAllBalances(ctx, sdk.MustAccAddressFromBech32(address))
+		s.Require().Equal(math.NewInt(amount), balances.AmountOf(shareDenom))
+		s.Require().True(balances.AmountOf("USDT").IsZero())
+	}
+	s.Require().True(miniApp.BankKeeper.GetSupply(ctx, "USDT").IsZero())
+
+	_, found := miniApp.BankKeeper.GetDenomMetaData(ctx, shareDenom)
+	s.Require().True(found)
+
+	// The liquidity providers are indexed by denom, with the exposure to every denom
+	s.Require().Equal([]string{alice.String()}, s.indexedProviders("ETH"))
+	s.Require().Equal([]string{bob.String()}, s.indexedProviders("WETH"))
+	s.Require().Equal(math.NewInt(2_000_000), s.exposure("ETH"))
+	s.Require().Equal(math.NewInt(1_000_000), s.exposure("WETH"))
+
+	// The locks are indexed by their end time, the zero time for the lock not unlocking, the limit
+	// orders by their owner and pair, and the DCA plans by their next execution height and owner
+	s.Require().Equal([]uint64{0, 2}, s.indexedLocks(time.Time{}))
+	s.Require().Equal([]uint64{1}, s.indexedLocks(endTime))
+	s.Require().Equal([]uint64{0, 1}, s.accountLocks(alice.String()))
+	s.Require().Equal([]uint64{2}, s.accountLocks(bob.String()))
+	byOwner, byPair = s.indexedLimitOrders(bob.String(), "WETH", "ETH")
+	s.Require().Equal([]uint64{0}, byOwner)
+	s.Require().Equal([]uint64{0}, byPair)
+	s.Require().Equal([]uint64{0}, s.indexedDCAPlans(plan.NextExecutionHeight))
+	s.Require().Equal([]uint64{0}, s.dcaPlansByOwner(alice.String()))
+
+	// The locks are weighted by the multiplier of their duration, by owner and in total
+	for id, weight := range map[uint64]int64{0: 1_000, 1: 1_000, 2: 2_000} {
+		lock, err := miniApp.SimpleSwapKeeper.Locks.Get(ctx, id)
+		s.Require().NoError(err)
+		s.Require().Equal(math.NewInt(weight), lock.RewardWeight)
+	}
+	for address, weight := range map[string]int64{alice.String(): 2_000, bob.String(): 2_000} {
+		rewards, err := miniApp.SimpleSwapKeeper.Rewards.Get(ctx, address)
+		s.Require().NoError(err)
+		s.Require().Equal(math.NewInt(weight), rewards.Weight)
+	}
+	acc, err := miniApp.SimpleSwapKeeper.RewardAccumulator.Get(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(4_000), acc.TotalWeight)
+
+	// The liquidity is removed against the reissued shares
+	_, err = s.chain.SendMsgs(&simpleswap.MsgRemoveLiquidity{
+		LiquidityProvider: alice.String(),
+		Token:             sdk.NewInt64Coin("ETH", 1_000_000),
+	})
+	s.Require().NoError(err)
+
+	ctx = s.chain.GetContext()
+	balances := miniApp.BankKeeper.GetAllBalances(ctx, alice)
+	s.Require().Equal(math.NewInt(1_000_000), balances.AmountOf("ETH"))
+	s.Require().Equal(math.NewInt(1_000_000), balances.AmountOf(shareDenom))
+	s.Require().Equal(math.NewInt(1_000_000), s.exposure("ETH"))
+}
+
+// TestUpgradeFromBaseline runs the upgrade on the stores of a chain started before it, without the
+// gov, authz, params, upgrade and IBC modules, and with simpleswap at version 1. The upgrade has no
+// plan, the node is restarted with the upgrade info written to disk.
+func (s *UpgradeTestSuite) TestUpgradeFromBaseline() {
+	db := dbm.NewMemDB()
+	home := s.T().TempDir()
+	appOptions := simtestutil.AppOptionsMap{flags.FlagHome: home}
+	ibctesting.DefaultTestingAppInit = func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+		miniApp, err := app.NewMiniApp(log.NewNopLogger(), db, nil, true, appOptions)
+		if err != nil {
+			panic(err)
+		}
+
+		return miniApp, miniApp.DefaultGenesis()
+	}
+	chain := ibctesting.NewCoordinator(s.T(), 1).GetChain(ibctesting.GetChainID(1))
+
+	// The stores of the modules added by the upgrade are deleted, and simpleswap holds a version 1
+	// state, in a block committed by the previous binary
+	addedStores := []string{
+		govtypes.StoreKey,
+		authzkeeper.StoreKey,
+		paramstypes.StoreKey,
+		upgradetypes.StoreKey,
+		capabilitytypes.StoreKey,
+		ibcexported.StoreKey,
+		ibctransfertypes.StoreKey,
+	}
+	cms := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	for _, key := range chain.App.(*app.MiniApp).GetStoreKeys() {
+		if _, ok := key.(*storetypes.KVStoreKey); ok {
+			cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+		}
+	}
+	s.Require().NoError(cms.LoadLatestVersionAndUpgrade(&storetypes.StoreUpgrades{Deleted: addedStores}))
+
+	simpleswapStore := cms.GetKVStore(chain.App.(*app.MiniApp).GetKey(simpleswap.ModuleName))
+	var keys [][]byte
+	iter := simpleswapStore.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	s.Require().NoError(iter.Close())
+	for _, key := range keys {
+		simpleswapStore.Delete(key)
+	}
+	simpleswapStore.Set(simpleswap.ParamsKey, s.v1Params())
+	poolBz, err := (&simpleswap.Pool{Decimals: 6, ShareToken: &legacyShareToken, SwapFeePercentage: 20000}).Marshal()
+	s.Require().NoError(err)
+	simpleswapStore.Set(simpleswap.PoolKey, poolBz)
+	upgradeHeight := cms.Commit().Version + 1
+
+	// The deleted stores keep their trees, which a chain started before the upgrade never had
+	for _, name := range addedStores {
+		prefix := []byte("s/k:" + name + "/")
+		iter, err := db.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+		s.Require().NoError(err)
+		var keys [][]byte
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		s.Require().NoError(iter.Close())
+		for _, key := range keys {
+			s.Require().NoError(db.Delete(key))
+		}
+	}
+
+	// The node halted below the upgrade height is restarted with the upgrade info written to disk
+	upgradeInfo, err := json.Marshal(upgradetypes.Plan{Name: app.UpgradeName, Height: upgradeHeight})
+	s.Require().NoError(err)
+	dataDir := filepath.Join(home, "data")
+	s.Require().NoError(os.MkdirAll(dataDir, 0o755))
+	s.Require().NoError(os.WriteFile(filepath.Join(dataDir, upgradetypes.UpgradeInfoFilename), upgradeInfo, 0o600))
+
+	miniApp, err := app.NewMiniApp(log.NewNopLogger(), db, nil, true, appOptions)
+	s.Require().NoError(err)
+
+	_, err = miniApp.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height: upgradeHeight,
+		Time:   chain.CurrentHeader.Time.Add(5 * time.Second),
+	})
+	s.Require().NoError(err)
+	_, err = miniApp.Commit()
+	s.Require().NoError(err)
+	ctx := miniApp.NewContext(true)
+
+	done, err := miniApp.UpgradeKeeper.GetDoneHeight(ctx, app.UpgradeName)
+	s.Require().NoError(err)
+	s.Require().Equal(upgradeHeight, done)
+
+	versionMap, err := miniApp.UpgradeKeeper.GetModuleVersionMap(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(miniApp.ModuleManager.GetVersionMap(), versionMap)
+
+	// The genesis of the added modules is initialized, the transfer port is bound
+	_, err = miniApp.GovKeeper.Params.Get(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(ibcclienttypes.DefaultParams(), miniApp.IBCKeeper.ClientKeeper.GetParams(ctx))
+	s.Require().Equal(ibctransfertypes.DefaultParams(), miniApp.TransferKeeper.GetParams(ctx))
+	_, found := miniApp.ScopedIBCTransferKeeper.GetCapability(ctx, host.PortPath(ibctransfertypes.PortID))
+	s.Require().True(found)
+
+	// simpleswap is migrated from version 1
+	params, err := miniApp.SimpleSwapKeeper.Params.Get(ctx)
+	s.Require().NoError(err)
+	expectedParams := simpleswap.DefaultParams()
+	expectedParams.SwapFeePercentage = 20000
+	s.Require().Equal(expectedParams, params)
+
+	pool, err := miniApp.SimpleSwapKeeper.Pool.Get(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(simpleswap.DefaultPoolId, pool.Id)
+}
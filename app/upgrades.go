@@ -0,0 +1,140 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/cosmos/simpleswap"
+)
+
+// UpgradeName is the name of the upgrade migrating simpleswap to its consensus version 2, and
+// adding the gov, authz, params, upgrade and IBC modules.
+const UpgradeName = "v2"
+
+// addedStoreKeys are the stores of the modules added by the upgrade.
+var addedStoreKeys = []string{
+	govtypes.StoreKey,
+	authzkeeper.StoreKey,
+	paramstypes.StoreKey,
+	upgradetypes.StoreKey,
+	capabilitytypes.StoreKey,
+	ibcexported.StoreKey,
+	ibctransfertypes.StoreKey,
+}
+
+// baselineModules are the modules of the chains started before the upgrade. They ran without the
+// upgrade module, so their versions are not in the store.
+var baselineModules = []string{
+	authtypes.ModuleName,
+	banktypes.ModuleName,
+	distrtypes.ModuleName,
+	stakingtypes.ModuleName,
+	consensustypes.ModuleName,
+	genutiltypes.ModuleName,
+}
+
+// registerUpgradeHandlers registers the handler of the upgrade, which runs the migrations of the
+// modules, and the store loader adding the stores of the new modules at the upgrade height.
+func (app *MiniApp) registerUpgradeHandlers() error {
+	// The version map set at genesis is populated during the dependency injection, the IBC modules
+	// registered afterwards must be added to it
+	app.UpgradeKeeper.SetInitVersionMap(app.ModuleManager.GetVersionMap())
+
+	app.UpgradeKeeper.SetUpgradeHandler(
+		UpgradeName,
+		func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			// The chains started before the upgrade have no version map, their modules are at their
+			// current versions but simpleswap at version 1. The modules added by the upgrade are not
+			// in the map, so their genesis is initialized.
+			if len(fromVM) == 0 {
+				fromVM = module.VersionMap{}
+				versionMap := app.ModuleManager.GetVersionMap()
+				for _, moduleName := range baselineModules {
+					fromVM[moduleName] = versionMap[moduleName]
+				}
+				fromVM[simpleswap.ModuleName] = 1
+			}
+
+			return app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM)
+		},
+	)
+
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		return fmt.Errorf("failed to read upgrade info from disk: %w", err)
+	}
+
+	if upgradeInfo.Name == UpgradeName && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		storeUpgrades := storetypes.StoreUpgrades{
+			Added: addedStoreKeys,
+		}
+
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+		app.SetPreBlocker(app.preBlockUpgrade(upgradeInfo.Height))
+	}
+
+	return nil
+}
+
+// preBlockUpgrade returns the pre blocker applying the upgrade at its height on the chains started
+// before it, which had no upgrade module to schedule it: their nodes are halted below the height,
+// and restarted with the upgrade info written to disk. The upgrades scheduled by a plan are applied
+// by the upgrade module.
+func (app *MiniApp) preBlockUpgrade(height int64) sdk.PreBlocker {
+	return func(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+		if ctx.BlockHeight() != height {
+			return app.PreBlocker(ctx, req)
+		}
+
+		_, err := app.UpgradeKeeper.GetUpgradePlan(ctx)
+		switch {
+		case err == nil:
+			return app.PreBlocker(ctx, req)
+		case !errors.Is(err, upgradetypes.ErrNoUpgradePlanFound):
+			return nil, err
+		}
+
+		done, err := app.UpgradeKeeper.GetDoneHeight(ctx, UpgradeName)
+		if err != nil {
+			return nil, err
+		}
+		if done != 0 {
+			return app.PreBlocker(ctx, req)
+		}
+
+		app.Logger().Info(fmt.Sprintf("applying upgrade \"%s\" at height %d without a plan", UpgradeName, height))
+		plan := upgradetypes.Plan{Name: UpgradeName, Height: height}
+		if err := app.UpgradeKeeper.ApplyUpgrade(ctx.WithBlockGasMeter(storetypes.NewInfiniteGasMeter()), plan); err != nil {
+			return nil, err
+		}
+
+		res, err := app.PreBlocker(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		// The consensus params may be modified by the migrations
+		res.ConsensusParamsChanged = true
+		return res, nil
+	}
+}
@@ -14,13 +14,16 @@ $MINID_BIN keys add traderC
 $MINID_BIN keys add simpleswap
 $MINID_BIN init test --chain-id demo --default-denom mini
 # update genesis
-$MINID_BIN genesis add-genesis-account validator 10000000mini --keyring-backend test
+$MINID_BIN genesis add-genesis-account validator 100000000mini --keyring-backend test
 $MINID_BIN genesis add-genesis-account alice 10000mini,10000000000ETH --keyring-backend test
 $MINID_BIN genesis add-genesis-account bob 10000mini,10000000000WETH --keyring-backend test
 $MINID_BIN genesis add-genesis-account traderA 2000mini,1000000000ETH --keyring-backend test
 $MINID_BIN genesis add-genesis-account traderB 2000mini,1000000000WETH --keyring-backend test
 $MINID_BIN genesis add-genesis-account traderC 2000mini,1000000000stkETH --keyring-backend test
 $MINID_BIN genesis add-genesis-account simpleswap 20000mini --keyring-backend test --module-name simpleswap
+# shorten the governance voting periods of the local chain
+sed -i.bak -e 's/"voting_period": "172800s"/"voting_period": "120s"/' \
+  -e 's/"expedited_voting_period": "86400s"/"expedited_voting_period": "60s"/' ~/.minid/config/genesis.json
 # create default validator
 $MINID_BIN genesis gentx validator 1000000mini --chain-id demo
 $MINID_BIN genesis collect-gentxs
@@ -0,0 +1,166 @@
+package ante
+
+import (
+	stdmath "math"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	expectedkeepers "github.com/cosmos/simpleswap/expected_keepers"
+	"github.com/cosmos/simpleswap/keeper"
+)
+
+// FeeSwapDecorator deducts the fees of the transactions paying them in a fee swap denom of
+// the simpleswap params. The fees are swapped through the pool into the native fee denom,
+// the staking bond denom, and the native coins are sent to the fee collector. The other
+// transactions are handed to the wrapped fee decorator, e.g. the auth DeductFeeDecorator.
+type FeeSwapDecorator struct {
+	accountKeeper authante.AccountKeeper
+	bankKeeper    expectedkeepers.BankKeeper
+	stakingKeeper expectedkeepers.StakingKeeper
+	keeper        keeper.Keeper
+	feeDecorator  sdk.AnteDecorator
+}
+
+// NewFeeSwapDecorator creates a new FeeSwapDecorator wrapping the fee decorator.
+func NewFeeSwapDecorator(ak authante.AccountKeeper, bk expectedkeepers.BankKeeper, sk expectedkeepers.StakingKeeper, k keeper.Keeper, feeDecorator sdk.AnteDecorator) FeeSwapDecorator {
+	return FeeSwapDecorator{
+		accountKeeper: ak,
+		bankKeeper:    bk,
+		stakingKeeper: sk,
+		keeper:        k,
+		feeDecorator:  feeDecorator,
+	}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface.
+func (d FeeSwapDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	params, err := d.keeper.Params.Get(ctx)
+	if err != nil {
+		return ctx, err
+	}
+
+	fee := feeTx.GetFee()
+	if len(fee) != 1 || !params.IsFeeSwapDenom(fee[0].Denom) {
+		return d.feeDecorator.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	if !simulate && ctx.BlockHeight() > 0 && feeTx.GetGas() == 0 {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidGasLimit, "must provide positive gas")
+	}
+
+	if feeTx.FeeGranter() != nil {
+		return ctx, sdkerrors.ErrInvalidRequest.Wrap("fee grants cannot pay swapped fees")
+	}
+
+	if addr := d.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName); addr == nil {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrLogic, "fee collector module account (%s) has not been set", authtypes.FeeCollectorName)
+	}
+
+	feePayer := sdk.AccAddress(feeTx.FeePayer())
+	if d.accountKeeper.GetAccount(ctx, feePayer) == nil {
+		return ctx, sdkerrors.ErrUnknownAddress.Wrapf("fee payer address: %s does not exist", feePayer)
+	}
+
+	nativeDenom, err := d.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return ctx, err
+	}
+
+	// Swap the fee into the native denom, for at most the max slippage below the value of the fee
+	// at an independent price
+	reference, err := d.referenceOutput(ctx, fee[0], nativeDenom)
+	if err != nil {
+		return ctx, err
+	}
+
+	minOutput := math.LegacyNewDecFromInt(reference).Mul(math.LegacyOneDec().Sub(params.FeeSwapMaxSlippage)).Ceil().TruncateInt()
+	nativeFee, err := d.keeper.SwapExactAmountIn(ctx, feePayer, fee[0], nativeDenom, minOutput)
+	if err != nil {
+		return ctx, err
+	}
+
+	var priority int64
+	if !simulate {
+		priority, err = checkNativeFee(ctx, nativeFee, feeTx.GetGas())
+		if err != nil {
+			return ctx, err
+		}
+	}
+
+	if err := d.bankKeeper.SendCoinsFromAccountToModule(ctx, feePayer, authtypes.FeeCollectorName, sdk.NewCoins(nativeFee)); err != nil {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyFee, nativeFee.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, feePayer.String()),
+		),
+	})
+
+	return next(ctx.WithPriority(priority), tx, simulate)
+}
+
+// referenceOutput returns the amount of the native denom the fee is worth at a price independent
+// of the pool: at the oracle prices if the keeper has an oracle, otherwise in CheckTx at the ratio
+// of the validator minimum gas prices of the two denoms. Without either, it is the pool quote, and
+// the slippage bound only caps the swap fee.
+func (d FeeSwapDecorator) referenceOutput(ctx sdk.Context, fee sdk.Coin, nativeDenom string) (math.Int, error) {
+	quote, err := d.keeper.EquivalentCoin(ctx, fee, nativeDenom)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	valueRatio, ok, err := d.keeper.OracleValueRatio(ctx, fee.Denom, nativeDenom)
+	if err != nil {
+		return math.Int{}, err
+	} else if ok {
+		return valueRatio.MulInt(quote.Amount).TruncateInt(), nil
+	}
+
+	if ctx.IsCheckTx() {
+		// The validator accepts the same gas for either fee, so it values the fee denom at the
+		// ratio of their gas prices
+		feeGasPrice := ctx.MinGasPrices().AmountOf(fee.Denom)
+		nativeGasPrice := ctx.MinGasPrices().AmountOf(nativeDenom)
+		if feeGasPrice.IsPositive() && nativeGasPrice.IsPositive() {
+			return nativeGasPrice.Quo(feeGasPrice).MulInt(fee.Amount).TruncateInt(), nil
+		}
+	}
+
+	return quote.Amount, nil
+}
+
+// checkNativeFee checks in CheckTx that the swapped fee covers the minimum gas price of the
+// validator in the native denom, and returns the priority of the transaction, i.e. its
+// native fee per unit of gas.
+func checkNativeFee(ctx sdk.Context, nativeFee sdk.Coin, gas uint64) (int64, error) {
+	if ctx.IsCheckTx() {
+		minGasPrice := ctx.MinGasPrices().AmountOf(nativeFee.Denom)
+		requiredFee := minGasPrice.MulInt(math.NewIntFromUint64(gas)).Ceil().RoundInt()
+		if nativeFee.Amount.LT(requiredFee) {
+			return 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", nativeFee, sdk.NewCoin(nativeFee.Denom, requiredFee))
+		}
+	}
+
+	if gas == 0 {
+		return 0, nil
+	}
+
+	priority := nativeFee.Amount.Quo(math.NewIntFromUint64(gas))
+	if !priority.IsInt64() {
+		return stdmath.MaxInt64, nil
+	}
+
+	return priority.Int64(), nil
+}
@@ -0,0 +1,159 @@
+package ante
+
+import (
+	"errors"
+	stdmath "math"
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/simpleswap"
+	expectedkeepers "github.com/cosmos/simpleswap/expected_keepers"
+	"github.com/cosmos/simpleswap/keeper"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckNativeFee(t *testing.T) {
+	key := storetypes.NewKVStoreKey(simpleswap.ModuleName)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("mini", math.LegacyMustNewDecFromStr("0.5")))
+
+	testCases := []struct {
+		name             string
+		ctx              sdk.Context
+		nativeFee        sdk.Coin
+		gas              uint64
+		expectErr        error
+		expectedPriority int64
+	}{
+		{
+			name:             "fee above the minimum gas price",
+			ctx:              ctx.WithIsCheckTx(true).WithMinGasPrices(minGasPrices),
+			nativeFee:        sdk.NewInt64Coin("mini", 300_000),
+			gas:              200_000,
+			expectedPriority: 1,
+		},
+		{
+			name:      "fee below the minimum gas price",
+			ctx:       ctx.WithIsCheckTx(true).WithMinGasPrices(minGasPrices),
+			nativeFee: sdk.NewInt64Coin("mini", 99_999),
+			gas:       200_000,
+			expectErr: sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:             "minimum gas price not checked in DeliverTx",
+			ctx:              ctx.WithMinGasPrices(minGasPrices),
+			nativeFee:        sdk.NewInt64Coin("mini", 99_999),
+			gas:              200_000,
+			expectedPriority: 0,
+		},
+		{
+			name:             "priority capped",
+			ctx:              ctx,
+			nativeFee:        sdk.NewCoin("mini", math.NewIntFromUint64(stdmath.MaxUint64).MulRaw(2)),
+			gas:              1,
+			expectedPriority: stdmath.MaxInt64,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			priority, err := checkNativeFee(tc.ctx, tc.nativeFee, tc.gas)
+			if tc.expectErr != nil {
+				require.ErrorIs(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedPriority, priority)
+		})
+	}
+}
+
+func TestReferenceOutput(t *testing.T) {
+	key := storetypes.NewKVStoreKey(simpleswap.ModuleName)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	ctrl := gomock.NewController(t)
+	bankKeeper := expectedkeepers.NewMockBankKeeper(ctrl)
+	bankKeeper.EXPECT().GetDenomMetaData(gomock.Any(), gomock.Any()).Return(banktypes.Metadata{}, false).AnyTimes()
+	bankKeeper.EXPECT().SetDenomMetaData(gomock.Any(), gomock.Any()).AnyTimes()
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	k := keeper.NewKeeper(moduletestutil.MakeTestEncodingConfig().Codec, addresscodec.NewBech32Codec("cosmos"), runtime.NewKVStoreService(key), bankKeeper, authority)
+	require.NoError(t, k.InitGenesis(ctx, simpleswap.NewGenesisState()))
+
+	// The validator asks for 0.25 WETH or 0.5 ETH per unit of gas, i.e. 1 WETH for 2 ETH
+	minGasPrices := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("ETH", math.LegacyMustNewDecFromStr("0.5")),
+		sdk.NewDecCoinFromDec("WETH", math.LegacyMustNewDecFromStr("0.25")),
+	)
+	fee := sdk.NewInt64Coin("WETH", 1000)
+
+	testCases := []struct {
+		name        string
+		ctx         sdk.Context
+		wethPrice   math.LegacyDec
+		oracleErr   error
+		expectErr   error
+		expectedOut math.Int
+	}{
+		{
+			name:        "pool quote without an oracle in DeliverTx",
+			ctx:         ctx.WithMinGasPrices(minGasPrices),
+			expectedOut: math.NewInt(1000),
+		},
+		{
+			name:        "ratio of the minimum gas prices without an oracle in CheckTx",
+			ctx:         ctx.WithIsCheckTx(true).WithMinGasPrices(minGasPrices),
+			expectedOut: math.NewInt(2000),
+		},
+		{
+			name:        "pool quote without a minimum gas price for the fee denom",
+			ctx:         ctx.WithIsCheckTx(true).WithMinGasPrices(sdk.NewDecCoins(minGasPrices[0])),
+			expectedOut: math.NewInt(1000),
+		},
+		{
+			name:        "oracle prices over the minimum gas prices",
+			ctx:         ctx.WithIsCheckTx(true).WithMinGasPrices(minGasPrices),
+			wethPrice:   math.LegacyMustNewDecFromStr("0.9"),
+			expectedOut: math.NewInt(900),
+		},
+		{
+			name:      "oracle price not available",
+			ctx:       ctx,
+			oracleErr: errors.New("no price"),
+			expectErr: simpleswap.ErrInvalidOraclePrice,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			k := k
+			if !tc.wethPrice.IsNil() || tc.oracleErr != nil {
+				oracleKeeper := expectedkeepers.NewMockOracleKeeper(ctrl)
+				oracleKeeper.EXPECT().GetPrice(gomock.Any(), "WETH").Return(tc.wethPrice, tc.oracleErr).Times(1)
+				oracleKeeper.EXPECT().GetPrice(gomock.Any(), "ETH").Return(math.LegacyOneDec(), nil).MaxTimes(1)
+				k.SetOracleKeeper(oracleKeeper)
+			}
+
+			d := NewFeeSwapDecorator(nil, bankKeeper, nil, k, nil)
+			out, err := d.referenceOutput(tc.ctx, fee, "ETH")
+			if tc.expectErr != nil {
+				require.ErrorIs(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedOut, out)
+		})
+	}
+}
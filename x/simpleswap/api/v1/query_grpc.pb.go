@@ -19,11 +19,23 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName            = "/cosmos.simpleswap.v1.Query/Params"
-	Query_Pool_FullMethodName              = "/cosmos.simpleswap.v1.Query/Pool"
-	Query_LiquidityProvider_FullMethodName = "/cosmos.simpleswap.v1.Query/LiquidityProvider"
-	Query_CoinReserve_FullMethodName       = "/cosmos.simpleswap.v1.Query/CoinReserve"
-	Query_CoinReserves_FullMethodName      = "/cosmos.simpleswap.v1.Query/CoinReserves"
+	Query_Params_FullMethodName                    = "/cosmos.simpleswap.v1.Query/Params"
+	Query_Pool_FullMethodName                      = "/cosmos.simpleswap.v1.Query/Pool"
+	Query_PoolStats_FullMethodName                 = "/cosmos.simpleswap.v1.Query/PoolStats"
+	Query_LiquidityProvider_FullMethodName         = "/cosmos.simpleswap.v1.Query/LiquidityProvider"
+	Query_Position_FullMethodName                  = "/cosmos.simpleswap.v1.Query/Position"
+	Query_LiquidityProviders_FullMethodName        = "/cosmos.simpleswap.v1.Query/LiquidityProviders"
+	Query_LiquidityProvidersByDenom_FullMethodName = "/cosmos.simpleswap.v1.Query/LiquidityProvidersByDenom"
+	Query_CoinReserve_FullMethodName               = "/cosmos.simpleswap.v1.Query/CoinReserve"
+	Query_CoinReserves_FullMethodName              = "/cosmos.simpleswap.v1.Query/CoinReserves"
+	Query_Gauge_FullMethodName                     = "/cosmos.simpleswap.v1.Query/Gauge"
+	Query_Gauges_FullMethodName                    = "/cosmos.simpleswap.v1.Query/Gauges"
+	Query_PendingRewards_FullMethodName            = "/cosmos.simpleswap.v1.Query/PendingRewards"
+	Query_AccountLocks_FullMethodName              = "/cosmos.simpleswap.v1.Query/AccountLocks"
+	Query_AssetRate_FullMethodName                 = "/cosmos.simpleswap.v1.Query/AssetRate"
+	Query_LimitOrdersByOwner_FullMethodName        = "/cosmos.simpleswap.v1.Query/LimitOrdersByOwner"
+	Query_LimitOrdersByPair_FullMethodName         = "/cosmos.simpleswap.v1.Query/LimitOrdersByPair"
+	Query_DCAPlansByOwner_FullMethodName           = "/cosmos.simpleswap.v1.Query/DCAPlansByOwner"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,12 +46,40 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// GetPool returns the pool information.
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
+	// PoolStats returns the volume, the fees and the number of the swaps of the pool over the last
+	// 24 hours and 7 days, with the fee APR they earn the liquidity providers.
+	PoolStats(ctx context.Context, in *QueryPoolStatsRequest, opts ...grpc.CallOption) (*QueryPoolStatsResponse, error)
 	// GetLiquidityProvider returns the liquidity provider information.
 	LiquidityProvider(ctx context.Context, in *QueryLiquidityProviderRequest, opts ...grpc.CallOption) (*QueryLiquidityProviderResponse, error)
+	// Position returns the valuation of the position of a liquidity provider: its pending fees, its
+	// share of the pool and of each reserve, and the payout of a full exit.
+	Position(ctx context.Context, in *QueryPositionRequest, opts ...grpc.CallOption) (*QueryPositionResponse, error)
+	// LiquidityProviders returns the liquidity providers with their share of the pool and their
+	// pending fees, optionally filtered by the denom of their stable coin.
+	LiquidityProviders(ctx context.Context, in *QueryLiquidityProvidersRequest, opts ...grpc.CallOption) (*QueryLiquidityProvidersResponse, error)
+	// LiquidityProvidersByDenom returns the liquidity providers of a stable coin, found by the denom
+	// index, and the total amount of the stable coin they provided.
+	LiquidityProvidersByDenom(ctx context.Context, in *QueryLiquidityProvidersByDenomRequest, opts ...grpc.CallOption) (*QueryLiquidityProvidersByDenomResponse, error)
 	// GetCoinReserve returns the coin reserve information for a given coin denom.
 	CoinReserve(ctx context.Context, in *QueryCoinReserveRequest, opts ...grpc.CallOption) (*QueryCoinReserveResponse, error)
 	// GetCoinReserves returns the coin reserves information for all coin denoms.
 	CoinReserves(ctx context.Context, in *QueryCoinReservesRequest, opts ...grpc.CallOption) (*QueryCoinReservesResponse, error)
+	// Gauge returns the gauge information for a given gauge id.
+	Gauge(ctx context.Context, in *QueryGaugeRequest, opts ...grpc.CallOption) (*QueryGaugeResponse, error)
+	// Gauges returns all the gauges.
+	Gauges(ctx context.Context, in *QueryGaugesRequest, opts ...grpc.CallOption) (*QueryGaugesResponse, error)
+	// PendingRewards returns the gauge rewards earned by the share locks of an owner and not yet claimed.
+	PendingRewards(ctx context.Context, in *QueryPendingRewardsRequest, opts ...grpc.CallOption) (*QueryPendingRewardsResponse, error)
+	// AccountLocks returns the share locks of an account.
+	AccountLocks(ctx context.Context, in *QueryAccountLocksRequest, opts ...grpc.CallOption) (*QueryAccountLocksResponse, error)
+	// AssetRate returns the redemption rate of a whitelisted coin from its rate provider.
+	AssetRate(ctx context.Context, in *QueryAssetRateRequest, opts ...grpc.CallOption) (*QueryAssetRateResponse, error)
+	// LimitOrdersByOwner returns the open limit orders of an account.
+	LimitOrdersByOwner(ctx context.Context, in *QueryLimitOrdersByOwnerRequest, opts ...grpc.CallOption) (*QueryLimitOrdersByOwnerResponse, error)
+	// LimitOrdersByPair returns the open limit orders swapping the input denom for the output denom.
+	LimitOrdersByPair(ctx context.Context, in *QueryLimitOrdersByPairRequest, opts ...grpc.CallOption) (*QueryLimitOrdersByPairResponse, error)
+	// DCAPlansByOwner returns the DCA plans of an account.
+	DCAPlansByOwner(ctx context.Context, in *QueryDCAPlansByOwnerRequest, opts ...grpc.CallOption) (*QueryDCAPlansByOwnerResponse, error)
 }
 
 type queryClient struct {
@@ -68,6 +108,15 @@ func (c *queryClient) Pool(ctx context.Context, in *QueryPoolRequest, opts ...gr
 	return out, nil
 }
 
+func (c *queryClient) PoolStats(ctx context.Context, in *QueryPoolStatsRequest, opts ...grpc.CallOption) (*QueryPoolStatsResponse, error) {
+	out := new(QueryPoolStatsResponse)
+	err := c.cc.Invoke(ctx, Query_PoolStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) LiquidityProvider(ctx context.Context, in *QueryLiquidityProviderRequest, opts ...grpc.CallOption) (*QueryLiquidityProviderResponse, error) {
 	out := new(QueryLiquidityProviderResponse)
 	err := c.cc.Invoke(ctx, Query_LiquidityProvider_FullMethodName, in, out, opts...)
@@ -77,6 +126,33 @@ func (c *queryClient) LiquidityProvider(ctx context.Context, in *QueryLiquidityP
 	return out, nil
 }
 
+func (c *queryClient) Position(ctx context.Context, in *QueryPositionRequest, opts ...grpc.CallOption) (*QueryPositionResponse, error) {
+	out := new(QueryPositionResponse)
+	err := c.cc.Invoke(ctx, Query_Position_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LiquidityProviders(ctx context.Context, in *QueryLiquidityProvidersRequest, opts ...grpc.CallOption) (*QueryLiquidityProvidersResponse, error) {
+	out := new(QueryLiquidityProvidersResponse)
+	err := c.cc.Invoke(ctx, Query_LiquidityProviders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LiquidityProvidersByDenom(ctx context.Context, in *QueryLiquidityProvidersByDenomRequest, opts ...grpc.CallOption) (*QueryLiquidityProvidersByDenomResponse, error) {
+	out := new(QueryLiquidityProvidersByDenomResponse)
+	err := c.cc.Invoke(ctx, Query_LiquidityProvidersByDenom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) CoinReserve(ctx context.Context, in *QueryCoinReserveRequest, opts ...grpc.CallOption) (*QueryCoinReserveResponse, error) {
 	out := new(QueryCoinReserveResponse)
 	err := c.cc.Invoke(ctx, Query_CoinReserve_FullMethodName, in, out, opts...)
@@ -95,6 +171,78 @@ func (c *queryClient) CoinReserves(ctx context.Context, in *QueryCoinReservesReq
 	return out, nil
 }
 
+func (c *queryClient) Gauge(ctx context.Context, in *QueryGaugeRequest, opts ...grpc.CallOption) (*QueryGaugeResponse, error) {
+	out := new(QueryGaugeResponse)
+	err := c.cc.Invoke(ctx, Query_Gauge_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Gauges(ctx context.Context, in *QueryGaugesRequest, opts ...grpc.CallOption) (*QueryGaugesResponse, error) {
+	out := new(QueryGaugesResponse)
+	err := c.cc.Invoke(ctx, Query_Gauges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingRewards(ctx context.Context, in *QueryPendingRewardsRequest, opts ...grpc.CallOption) (*QueryPendingRewardsResponse, error) {
+	out := new(QueryPendingRewardsResponse)
+	err := c.cc.Invoke(ctx, Query_PendingRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountLocks(ctx context.Context, in *QueryAccountLocksRequest, opts ...grpc.CallOption) (*QueryAccountLocksResponse, error) {
+	out := new(QueryAccountLocksResponse)
+	err := c.cc.Invoke(ctx, Query_AccountLocks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AssetRate(ctx context.Context, in *QueryAssetRateRequest, opts ...grpc.CallOption) (*QueryAssetRateResponse, error) {
+	out := new(QueryAssetRateResponse)
+	err := c.cc.Invoke(ctx, Query_AssetRate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LimitOrdersByOwner(ctx context.Context, in *QueryLimitOrdersByOwnerRequest, opts ...grpc.CallOption) (*QueryLimitOrdersByOwnerResponse, error) {
+	out := new(QueryLimitOrdersByOwnerResponse)
+	err := c.cc.Invoke(ctx, Query_LimitOrdersByOwner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LimitOrdersByPair(ctx context.Context, in *QueryLimitOrdersByPairRequest, opts ...grpc.CallOption) (*QueryLimitOrdersByPairResponse, error) {
+	out := new(QueryLimitOrdersByPairResponse)
+	err := c.cc.Invoke(ctx, Query_LimitOrdersByPair_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DCAPlansByOwner(ctx context.Context, in *QueryDCAPlansByOwnerRequest, opts ...grpc.CallOption) (*QueryDCAPlansByOwnerResponse, error) {
+	out := new(QueryDCAPlansByOwnerResponse)
+	err := c.cc.Invoke(ctx, Query_DCAPlansByOwner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -103,12 +251,40 @@ type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// GetPool returns the pool information.
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
+	// PoolStats returns the volume, the fees and the number of the swaps of the pool over the last
+	// 24 hours and 7 days, with the fee APR they earn the liquidity providers.
+	PoolStats(context.Context, *QueryPoolStatsRequest) (*QueryPoolStatsResponse, error)
 	// GetLiquidityProvider returns the liquidity provider information.
 	LiquidityProvider(context.Context, *QueryLiquidityProviderRequest) (*QueryLiquidityProviderResponse, error)
+	// Position returns the valuation of the position of a liquidity provider: its pending fees, its
+	// share of the pool and of each reserve, and the payout of a full exit.
+	Position(context.Context, *QueryPositionRequest) (*QueryPositionResponse, error)
+	// LiquidityProviders returns the liquidity providers with their share of the pool and their
+	// pending fees, optionally filtered by the denom of their stable coin.
+	LiquidityProviders(context.Context, *QueryLiquidityProvidersRequest) (*QueryLiquidityProvidersResponse, error)
+	// LiquidityProvidersByDenom returns the liquidity providers of a stable coin, found by the denom
+	// index, and the total amount of the stable coin they provided.
+	LiquidityProvidersByDenom(context.Context, *QueryLiquidityProvidersByDenomRequest) (*QueryLiquidityProvidersByDenomResponse, error)
 	// GetCoinReserve returns the coin reserve information for a given coin denom.
 	CoinReserve(context.Context, *QueryCoinReserveRequest) (*QueryCoinReserveResponse, error)
 	// GetCoinReserves returns the coin reserves information for all coin denoms.
 	CoinReserves(context.Context, *QueryCoinReservesRequest) (*QueryCoinReservesResponse, error)
+	// Gauge returns the gauge information for a given gauge id.
+	Gauge(context.Context, *QueryGaugeRequest) (*QueryGaugeResponse, error)
+	// Gauges returns all the gauges.
+	Gauges(context.Context, *QueryGaugesRequest) (*QueryGaugesResponse, error)
+	// PendingRewards returns the gauge rewards earned by the share locks of an owner and not yet claimed.
+	PendingRewards(context.Context, *QueryPendingRewardsRequest) (*QueryPendingRewardsResponse, error)
+	// AccountLocks returns the share locks of an account.
+	AccountLocks(context.Context, *QueryAccountLocksRequest) (*QueryAccountLocksResponse, error)
+	// AssetRate returns the redemption rate of a whitelisted coin from its rate provider.
+	AssetRate(context.Context, *QueryAssetRateRequest) (*QueryAssetRateResponse, error)
+	// LimitOrdersByOwner returns the open limit orders of an account.
+	LimitOrdersByOwner(context.Context, *QueryLimitOrdersByOwnerRequest) (*QueryLimitOrdersByOwnerResponse, error)
+	// LimitOrdersByPair returns the open limit orders swapping the input denom for the output denom.
+	LimitOrdersByPair(context.Context, *QueryLimitOrdersByPairRequest) (*QueryLimitOrdersByPairResponse, error)
+	// DCAPlansByOwner returns the DCA plans of an account.
+	DCAPlansByOwner(context.Context, *QueryDCAPlansByOwnerRequest) (*QueryDCAPlansByOwnerResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -122,15 +298,51 @@ func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*Q
 func (UnimplementedQueryServer) Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
 }
+func (UnimplementedQueryServer) PoolStats(context.Context, *QueryPoolStatsRequest) (*QueryPoolStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolStats not implemented")
+}
 func (UnimplementedQueryServer) LiquidityProvider(context.Context, *QueryLiquidityProviderRequest) (*QueryLiquidityProviderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LiquidityProvider not implemented")
 }
+func (UnimplementedQueryServer) Position(context.Context, *QueryPositionRequest) (*QueryPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Position not implemented")
+}
+func (UnimplementedQueryServer) LiquidityProviders(context.Context, *QueryLiquidityProvidersRequest) (*QueryLiquidityProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityProviders not implemented")
+}
+func (UnimplementedQueryServer) LiquidityProvidersByDenom(context.Context, *QueryLiquidityProvidersByDenomRequest) (*QueryLiquidityProvidersByDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityProvidersByDenom not implemented")
+}
 func (UnimplementedQueryServer) CoinReserve(context.Context, *QueryCoinReserveRequest) (*QueryCoinReserveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CoinReserve not implemented")
 }
 func (UnimplementedQueryServer) CoinReserves(context.Context, *QueryCoinReservesRequest) (*QueryCoinReservesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CoinReserves not implemented")
 }
+func (UnimplementedQueryServer) Gauge(context.Context, *QueryGaugeRequest) (*QueryGaugeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Gauge not implemented")
+}
+func (UnimplementedQueryServer) Gauges(context.Context, *QueryGaugesRequest) (*QueryGaugesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Gauges not implemented")
+}
+func (UnimplementedQueryServer) PendingRewards(context.Context, *QueryPendingRewardsRequest) (*QueryPendingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingRewards not implemented")
+}
+func (UnimplementedQueryServer) AccountLocks(context.Context, *QueryAccountLocksRequest) (*QueryAccountLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountLocks not implemented")
+}
+func (UnimplementedQueryServer) AssetRate(context.Context, *QueryAssetRateRequest) (*QueryAssetRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssetRate not implemented")
+}
+func (UnimplementedQueryServer) LimitOrdersByOwner(context.Context, *QueryLimitOrdersByOwnerRequest) (*QueryLimitOrdersByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LimitOrdersByOwner not implemented")
+}
+func (UnimplementedQueryServer) LimitOrdersByPair(context.Context, *QueryLimitOrdersByPairRequest) (*QueryLimitOrdersByPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LimitOrdersByPair not implemented")
+}
+func (UnimplementedQueryServer) DCAPlansByOwner(context.Context, *QueryDCAPlansByOwnerRequest) (*QueryDCAPlansByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DCAPlansByOwner not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -180,6 +392,24 @@ func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PoolStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PoolStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolStats(ctx, req.(*QueryPoolStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_LiquidityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryLiquidityProviderRequest)
 	if err := dec(in); err != nil {
@@ -198,6 +428,60 @@ func _Query_LiquidityProvider_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Position_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Position(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Position_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Position(ctx, req.(*QueryPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiquidityProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LiquidityProviders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityProviders(ctx, req.(*QueryLiquidityProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiquidityProvidersByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityProvidersByDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityProvidersByDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LiquidityProvidersByDenom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityProvidersByDenom(ctx, req.(*QueryLiquidityProvidersByDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_CoinReserve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryCoinReserveRequest)
 	if err := dec(in); err != nil {
@@ -234,6 +518,150 @@ func _Query_CoinReserves_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Gauge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGaugeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Gauge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Gauge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Gauge(ctx, req.(*QueryGaugeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Gauges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGaugesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Gauges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Gauges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Gauges(ctx, req.(*QueryGaugesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingRewards(ctx, req.(*QueryPendingRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountLocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountLocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountLocks(ctx, req.(*QueryAccountLocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AssetRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAssetRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AssetRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AssetRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AssetRate(ctx, req.(*QueryAssetRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LimitOrdersByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLimitOrdersByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LimitOrdersByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LimitOrdersByOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LimitOrdersByOwner(ctx, req.(*QueryLimitOrdersByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LimitOrdersByPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLimitOrdersByPairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LimitOrdersByPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LimitOrdersByPair_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LimitOrdersByPair(ctx, req.(*QueryLimitOrdersByPairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DCAPlansByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDCAPlansByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DCAPlansByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DCAPlansByOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DCAPlansByOwner(ctx, req.(*QueryDCAPlansByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -249,10 +677,26 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Pool",
 			Handler:    _Query_Pool_Handler,
 		},
+		{
+			MethodName: "PoolStats",
+			Handler:    _Query_PoolStats_Handler,
+		},
 		{
 			MethodName: "LiquidityProvider",
 			Handler:    _Query_LiquidityProvider_Handler,
 		},
+		{
+			MethodName: "Position",
+			Handler:    _Query_Position_Handler,
+		},
+		{
+			MethodName: "LiquidityProviders",
+			Handler:    _Query_LiquidityProviders_Handler,
+		},
+		{
+			MethodName: "LiquidityProvidersByDenom",
+			Handler:    _Query_LiquidityProvidersByDenom_Handler,
+		},
 		{
 			MethodName: "CoinReserve",
 			Handler:    _Query_CoinReserve_Handler,
@@ -261,6 +705,38 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CoinReserves",
 			Handler:    _Query_CoinReserves_Handler,
 		},
+		{
+			MethodName: "Gauge",
+			Handler:    _Query_Gauge_Handler,
+		},
+		{
+			MethodName: "Gauges",
+			Handler:    _Query_Gauges_Handler,
+		},
+		{
+			MethodName: "PendingRewards",
+			Handler:    _Query_PendingRewards_Handler,
+		},
+		{
+			MethodName: "AccountLocks",
+			Handler:    _Query_AccountLocks_Handler,
+		},
+		{
+			MethodName: "AssetRate",
+			Handler:    _Query_AssetRate_Handler,
+		},
+		{
+			MethodName: "LimitOrdersByOwner",
+			Handler:    _Query_LimitOrdersByOwner_Handler,
+		},
+		{
+			MethodName: "LimitOrdersByPair",
+			Handler:    _Query_LimitOrdersByPair_Handler,
+		},
+		{
+			MethodName: "DCAPlansByOwner",
+			Handler:    _Query_DCAPlansByOwner_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/simpleswap/v1/query.proto",
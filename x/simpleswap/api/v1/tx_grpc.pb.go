@@ -19,10 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_AddLiquidity_FullMethodName    = "/cosmos.simpleswap.v1.Msg/AddLiquidity"
-	Msg_SwapLiquidity_FullMethodName   = "/cosmos.simpleswap.v1.Msg/SwapLiquidity"
-	Msg_RemoveLiquidity_FullMethodName = "/cosmos.simpleswap.v1.Msg/RemoveLiquidity"
-	Msg_UpdateParams_FullMethodName    = "/cosmos.simpleswap.v1.Msg/UpdateParams"
+	Msg_AddLiquidity_FullMethodName     = "/cosmos.simpleswap.v1.Msg/AddLiquidity"
+	Msg_SwapLiquidity_FullMethodName    = "/cosmos.simpleswap.v1.Msg/SwapLiquidity"
+	Msg_RemoveLiquidity_FullMethodName  = "/cosmos.simpleswap.v1.Msg/RemoveLiquidity"
+	Msg_UpdateParams_FullMethodName     = "/cosmos.simpleswap.v1.Msg/UpdateParams"
+	Msg_CreateGauge_FullMethodName      = "/cosmos.simpleswap.v1.Msg/CreateGauge"
+	Msg_AddToGauge_FullMethodName       = "/cosmos.simpleswap.v1.Msg/AddToGauge"
+	Msg_ClaimRewards_FullMethodName     = "/cosmos.simpleswap.v1.Msg/ClaimRewards"
+	Msg_LockShares_FullMethodName       = "/cosmos.simpleswap.v1.Msg/LockShares"
+	Msg_BeginUnlock_FullMethodName      = "/cosmos.simpleswap.v1.Msg/BeginUnlock"
+	Msg_SetAssetRate_FullMethodName     = "/cosmos.simpleswap.v1.Msg/SetAssetRate"
+	Msg_PlaceLimitOrder_FullMethodName  = "/cosmos.simpleswap.v1.Msg/PlaceLimitOrder"
+	Msg_CancelLimitOrder_FullMethodName = "/cosmos.simpleswap.v1.Msg/CancelLimitOrder"
+	Msg_CreateDCAPlan_FullMethodName    = "/cosmos.simpleswap.v1.Msg/CreateDCAPlan"
+	Msg_CancelDCAPlan_FullMethodName    = "/cosmos.simpleswap.v1.Msg/CancelDCAPlan"
+	Msg_FlashSwap_FullMethodName        = "/cosmos.simpleswap.v1.Msg/FlashSwap"
 )
 
 // MsgClient is the client API for Msg service.
@@ -37,6 +48,30 @@ type MsgClient interface {
 	RemoveLiquidity(ctx context.Context, in *MsgRemoveLiquidity, opts ...grpc.CallOption) (*MsgRemoveLiquidityResponse, error)
 	// UpdateParams updates the module parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// CreateGauge creates a gauge funding rewards for the share locks of a pool.
+	CreateGauge(ctx context.Context, in *MsgCreateGauge, opts ...grpc.CallOption) (*MsgCreateGaugeResponse, error)
+	// AddToGauge adds reward coins to an existing gauge.
+	AddToGauge(ctx context.Context, in *MsgAddToGauge, opts ...grpc.CallOption) (*MsgAddToGaugeResponse, error)
+	// ClaimRewards claims the gauge rewards earned by the share locks of the owner.
+	ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error)
+	// LockShares locks pool shares for one of the allowed lock durations.
+	LockShares(ctx context.Context, in *MsgLockShares, opts ...grpc.CallOption) (*MsgLockSharesResponse, error)
+	// BeginUnlock starts the unbonding of a lock, the shares are released once it is mature.
+	BeginUnlock(ctx context.Context, in *MsgBeginUnlock, opts ...grpc.CallOption) (*MsgBeginUnlockResponse, error)
+	// SetAssetRate sets the governance redemption rate of a whitelisted coin.
+	SetAssetRate(ctx context.Context, in *MsgSetAssetRate, opts ...grpc.CallOption) (*MsgSetAssetRateResponse, error)
+	// PlaceLimitOrder escrows the input coin of a limit order, filled at the end of the first block
+	// the pool pays at least the limit price.
+	PlaceLimitOrder(ctx context.Context, in *MsgPlaceLimitOrder, opts ...grpc.CallOption) (*MsgPlaceLimitOrderResponse, error)
+	// CancelLimitOrder cancels an open limit order and refunds its escrowed input.
+	CancelLimitOrder(ctx context.Context, in *MsgCancelLimitOrder, opts ...grpc.CallOption) (*MsgCancelLimitOrderResponse, error)
+	// CreateDCAPlan escrows the total input of a plan swapping it in slices at the end of the blocks.
+	CreateDCAPlan(ctx context.Context, in *MsgCreateDCAPlan, opts ...grpc.CallOption) (*MsgCreateDCAPlanResponse, error)
+	// CancelDCAPlan cancels a plan and refunds its escrowed input not yet swapped.
+	CancelDCAPlan(ctx context.Context, in *MsgCancelDCAPlan, opts ...grpc.CallOption) (*MsgCancelDCAPlanResponse, error)
+	// FlashSwap sends an output coin of the reserves to the trader, executes nested messages and
+	// collects the input worth the output plus the flash fee from the trader in the same transaction.
+	FlashSwap(ctx context.Context, in *MsgFlashSwap, opts ...grpc.CallOption) (*MsgFlashSwapResponse, error)
 }
 
 type msgClient struct {
@@ -83,6 +118,105 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) CreateGauge(ctx context.Context, in *MsgCreateGauge, opts ...grpc.CallOption) (*MsgCreateGaugeResponse, error) {
+	out := new(MsgCreateGaugeResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateGauge_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddToGauge(ctx context.Context, in *MsgAddToGauge, opts ...grpc.CallOption) (*MsgAddToGaugeResponse, error) {
+	out := new(MsgAddToGaugeResponse)
+	err := c.cc.Invoke(ctx, Msg_AddToGauge_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error) {
+	out := new(MsgClaimRewardsResponse)
+	err := c.cc.Invoke(ctx, Msg_ClaimRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LockShares(ctx context.Context, in *MsgLockShares, opts ...grpc.CallOption) (*MsgLockSharesResponse, error) {
+	out := new(MsgLockSharesResponse)
+	err := c.cc.Invoke(ctx, Msg_LockShares_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BeginUnlock(ctx context.Context, in *MsgBeginUnlock, opts ...grpc.CallOption) (*MsgBeginUnlockResponse, error) {
+	out := new(MsgBeginUnlockResponse)
+	err := c.cc.Invoke(ctx, Msg_BeginUnlock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAssetRate(ctx context.Context, in *MsgSetAssetRate, opts ...grpc.CallOption) (*MsgSetAssetRateResponse, error) {
+	out := new(MsgSetAssetRateResponse)
+	err := c.cc.Invoke(ctx, Msg_SetAssetRate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PlaceLimitOrder(ctx context.Context, in *MsgPlaceLimitOrder, opts ...grpc.CallOption) (*MsgPlaceLimitOrderResponse, error) {
+	out := new(MsgPlaceLimitOrderResponse)
+	err := c.cc.Invoke(ctx, Msg_PlaceLimitOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelLimitOrder(ctx context.Context, in *MsgCancelLimitOrder, opts ...grpc.CallOption) (*MsgCancelLimitOrderResponse, error) {
+	out := new(MsgCancelLimitOrderResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelLimitOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateDCAPlan(ctx context.Context, in *MsgCreateDCAPlan, opts ...grpc.CallOption) (*MsgCreateDCAPlanResponse, error) {
+	out := new(MsgCreateDCAPlanResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateDCAPlan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelDCAPlan(ctx context.Context, in *MsgCancelDCAPlan, opts ...grpc.CallOption) (*MsgCancelDCAPlanResponse, error) {
+	out := new(MsgCancelDCAPlanResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelDCAPlan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FlashSwap(ctx context.Context, in *MsgFlashSwap, opts ...grpc.CallOption) (*MsgFlashSwapResponse, error) {
+	out := new(MsgFlashSwapResponse)
+	err := c.cc.Invoke(ctx, Msg_FlashSwap_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -95,6 +229,30 @@ type MsgServer interface {
 	RemoveLiquidity(context.Context, *MsgRemoveLiquidity) (*MsgRemoveLiquidityResponse, error)
 	// UpdateParams updates the module parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// CreateGauge creates a gauge funding rewards for the share locks of a pool.
+	CreateGauge(context.Context, *MsgCreateGauge) (*MsgCreateGaugeResponse, error)
+	// AddToGauge adds reward coins to an existing gauge.
+	AddToGauge(context.Context, *MsgAddToGauge) (*MsgAddToGaugeResponse, error)
+	// ClaimRewards claims the gauge rewards earned by the share locks of the owner.
+	ClaimRewards(context.Context, *MsgClaimRewards) (*MsgClaimRewardsResponse, error)
+	// LockShares locks pool shares for one of the allowed lock durations.
+	LockShares(context.Context, *MsgLockShares) (*MsgLockSharesResponse, error)
+	// BeginUnlock starts the unbonding of a lock, the shares are released once it is mature.
+	BeginUnlock(context.Context, *MsgBeginUnlock) (*MsgBeginUnlockResponse, error)
+	// SetAssetRate sets the governance redemption rate of a whitelisted coin.
+	SetAssetRate(context.Context, *MsgSetAssetRate) (*MsgSetAssetRateResponse, error)
+	// PlaceLimitOrder escrows the input coin of a limit order, filled at the end of the first block
+	// the pool pays at least the limit price.
+	PlaceLimitOrder(context.Context, *MsgPlaceLimitOrder) (*MsgPlaceLimitOrderResponse, error)
+	// CancelLimitOrder cancels an open limit order and refunds its escrowed input.
+	CancelLimitOrder(context.Context, *MsgCancelLimitOrder) (*MsgCancelLimitOrderResponse, error)
+	// CreateDCAPlan escrows the total input of a plan swapping it in slices at the end of the blocks.
+	CreateDCAPlan(context.Context, *MsgCreateDCAPlan) (*MsgCreateDCAPlanResponse, error)
+	// CancelDCAPlan cancels a plan and refunds its escrowed input not yet swapped.
+	CancelDCAPlan(context.Context, *MsgCancelDCAPlan) (*MsgCancelDCAPlanResponse, error)
+	// FlashSwap sends an output coin of the reserves to the trader, executes nested messages and
+	// collects the input worth the output plus the flash fee from the trader in the same transaction.
+	FlashSwap(context.Context, *MsgFlashSwap) (*MsgFlashSwapResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -114,6 +272,39 @@ func (UnimplementedMsgServer) RemoveLiquidity(context.Context, *MsgRemoveLiquidi
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) CreateGauge(context.Context, *MsgCreateGauge) (*MsgCreateGaugeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGauge not implemented")
+}
+func (UnimplementedMsgServer) AddToGauge(context.Context, *MsgAddToGauge) (*MsgAddToGaugeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToGauge not implemented")
+}
+func (UnimplementedMsgServer) ClaimRewards(context.Context, *MsgClaimRewards) (*MsgClaimRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewards not implemented")
+}
+func (UnimplementedMsgServer) LockShares(context.Context, *MsgLockShares) (*MsgLockSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockShares not implemented")
+}
+func (UnimplementedMsgServer) BeginUnlock(context.Context, *MsgBeginUnlock) (*MsgBeginUnlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginUnlock not implemented")
+}
+func (UnimplementedMsgServer) SetAssetRate(context.Context, *MsgSetAssetRate) (*MsgSetAssetRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAssetRate not implemented")
+}
+func (UnimplementedMsgServer) PlaceLimitOrder(context.Context, *MsgPlaceLimitOrder) (*MsgPlaceLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceLimitOrder not implemented")
+}
+func (UnimplementedMsgServer) CancelLimitOrder(context.Context, *MsgCancelLimitOrder) (*MsgCancelLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelLimitOrder not implemented")
+}
+func (UnimplementedMsgServer) CreateDCAPlan(context.Context, *MsgCreateDCAPlan) (*MsgCreateDCAPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDCAPlan not implemented")
+}
+func (UnimplementedMsgServer) CancelDCAPlan(context.Context, *MsgCancelDCAPlan) (*MsgCancelDCAPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDCAPlan not implemented")
+}
+func (UnimplementedMsgServer) FlashSwap(context.Context, *MsgFlashSwap) (*MsgFlashSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlashSwap not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -199,6 +390,204 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateGauge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateGauge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateGauge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateGauge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateGauge(ctx, req.(*MsgCreateGauge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddToGauge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddToGauge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddToGauge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddToGauge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddToGauge(ctx, req.(*MsgAddToGauge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClaimRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimRewards(ctx, req.(*MsgClaimRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LockShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLockShares)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LockShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_LockShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LockShares(ctx, req.(*MsgLockShares))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BeginUnlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBeginUnlock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BeginUnlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BeginUnlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BeginUnlock(ctx, req.(*MsgBeginUnlock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAssetRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAssetRate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAssetRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetAssetRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAssetRate(ctx, req.(*MsgSetAssetRate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PlaceLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceLimitOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PlaceLimitOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceLimitOrder(ctx, req.(*MsgPlaceLimitOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelLimitOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelLimitOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelLimitOrder(ctx, req.(*MsgCancelLimitOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateDCAPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateDCAPlan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateDCAPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateDCAPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateDCAPlan(ctx, req.(*MsgCreateDCAPlan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelDCAPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelDCAPlan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelDCAPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelDCAPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelDCAPlan(ctx, req.(*MsgCancelDCAPlan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FlashSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFlashSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FlashSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FlashSwap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FlashSwap(ctx, req.(*MsgFlashSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -222,6 +611,50 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "CreateGauge",
+			Handler:    _Msg_CreateGauge_Handler,
+		},
+		{
+			MethodName: "AddToGauge",
+			Handler:    _Msg_AddToGauge_Handler,
+		},
+		{
+			MethodName: "ClaimRewards",
+			Handler:    _Msg_ClaimRewards_Handler,
+		},
+		{
+			MethodName: "LockShares",
+			Handler:    _Msg_LockShares_Handler,
+		},
+		{
+			MethodName: "BeginUnlock",
+			Handler:    _Msg_BeginUnlock_Handler,
+		},
+		{
+			MethodName: "SetAssetRate",
+			Handler:    _Msg_SetAssetRate_Handler,
+		},
+		{
+			MethodName: "PlaceLimitOrder",
+			Handler:    _Msg_PlaceLimitOrder_Handler,
+		},
+		{
+			MethodName: "CancelLimitOrder",
+			Handler:    _Msg_CancelLimitOrder_Handler,
+		},
+		{
+			MethodName: "CreateDCAPlan",
+			Handler:    _Msg_CreateDCAPlan_Handler,
+		},
+		{
+			MethodName: "CancelDCAPlan",
+			Handler:    _Msg_CancelDCAPlan_Handler,
+		},
+		{
+			MethodName: "FlashSwap",
+			Handler:    _Msg_FlashSwap_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/simpleswap/v1/tx.proto",
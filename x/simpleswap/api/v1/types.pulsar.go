@@ -11,6 +11,8 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
@@ -67,12 +69,172 @@ func (x *_Params_1_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Params_8_list)(nil)
+
+type _Params_8_list struct {
+	list *[]*LockDuration
+}
+
+func (x *_Params_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LockDuration)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LockDuration)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_8_list) AppendMutable() protoreflect.Value {
+	v := new(LockDuration)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_8_list) NewElement() protoreflect.Value {
+	v := new(LockDuration)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_9_list)(nil)
+
+type _Params_9_list struct {
+	list *[]*AssetExponent
+}
+
+func (x *_Params_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AssetExponent)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AssetExponent)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_9_list) AppendMutable() protoreflect.Value {
+	v := new(AssetExponent)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_9_list) NewElement() protoreflect.Value {
+	v := new(AssetExponent)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_10_list)(nil)
+
+type _Params_10_list struct {
+	list *[]string
+}
+
+func (x *_Params_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_10_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field FeeSwapDenoms as it is not of Message kind"))
+}
+
+func (x *_Params_10_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_10_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_10_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params                   protoreflect.MessageDescriptor
-	fd_Params_whitelistedCoins  protoreflect.FieldDescriptor
-	fd_Params_swapFeePercentage protoreflect.FieldDescriptor
-	fd_Params_decimals          protoreflect.FieldDescriptor
-	fd_Params_shareToken        protoreflect.FieldDescriptor
+	md_Params                           protoreflect.MessageDescriptor
+	fd_Params_whitelistedCoins          protoreflect.FieldDescriptor
+	fd_Params_swapFeePercentage         protoreflect.FieldDescriptor
+	fd_Params_decimals                  protoreflect.FieldDescriptor
+	fd_Params_oracleGuardMode           protoreflect.FieldDescriptor
+	fd_Params_oracleMaxDeviation        protoreflect.FieldDescriptor
+	fd_Params_incentivesEpochBlocks     protoreflect.FieldDescriptor
+	fd_Params_lockDurations             protoreflect.FieldDescriptor
+	fd_Params_assetExponents            protoreflect.FieldDescriptor
+	fd_Params_feeSwapDenoms             protoreflect.FieldDescriptor
+	fd_Params_feeSwapMaxSlippage        protoreflect.FieldDescriptor
+	fd_Params_batchAuction              protoreflect.FieldDescriptor
+	fd_Params_flashSwapFeePercentage    protoreflect.FieldDescriptor
+	fd_Params_statsRetention            protoreflect.FieldDescriptor
+	fd_Params_limitOrderMatchesPerBlock protoreflect.FieldDescriptor
+	fd_Params_dcaPlanMaxSkips           protoreflect.FieldDescriptor
+	fd_Params_dcaSlicesPerBlock         protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -81,7 +243,19 @@ func init() {
 	fd_Params_whitelistedCoins = md_Params.Fields().ByName("whitelistedCoins")
 	fd_Params_swapFeePercentage = md_Params.Fields().ByName("swapFeePercentage")
 	fd_Params_decimals = md_Params.Fields().ByName("decimals")
-	fd_Params_shareToken = md_Params.Fields().ByName("shareToken")
+	fd_Params_oracleGuardMode = md_Params.Fields().ByName("oracleGuardMode")
+	fd_Params_oracleMaxDeviation = md_Params.Fields().ByName("oracleMaxDeviation")
+	fd_Params_incentivesEpochBlocks = md_Params.Fields().ByName("incentivesEpochBlocks")
+	fd_Params_lockDurations = md_Params.Fields().ByName("lockDurations")
+	fd_Params_assetExponents = md_Params.Fields().ByName("assetExponents")
+	fd_Params_feeSwapDenoms = md_Params.Fields().ByName("feeSwapDenoms")
+	fd_Params_feeSwapMaxSlippage = md_Params.Fields().ByName("feeSwapMaxSlippage")
+	fd_Params_batchAuction = md_Params.Fields().ByName("batchAuction")
+	fd_Params_flashSwapFeePercentage = md_Params.Fields().ByName("flashSwapFeePercentage")
+	fd_Params_statsRetention = md_Params.Fields().ByName("statsRetention")
+	fd_Params_limitOrderMatchesPerBlock = md_Params.Fields().ByName("limitOrderMatchesPerBlock")
+	fd_Params_dcaPlanMaxSkips = md_Params.Fields().ByName("dcaPlanMaxSkips")
+	fd_Params_dcaSlicesPerBlock = md_Params.Fields().ByName("dcaSlicesPerBlock")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -167,9 +341,81 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
-	if x.ShareToken != nil {
-		value := protoreflect.ValueOfMessage(x.ShareToken.ProtoReflect())
-		if !f(fd_Params_shareToken, value) {
+	if x.OracleGuardMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.OracleGuardMode))
+		if !f(fd_Params_oracleGuardMode, value) {
+			return
+		}
+	}
+	if x.OracleMaxDeviation != "" {
+		value := protoreflect.ValueOfString(x.OracleMaxDeviation)
+		if !f(fd_Params_oracleMaxDeviation, value) {
+			return
+		}
+	}
+	if x.IncentivesEpochBlocks != int64(0) {
+		value := protoreflect.ValueOfInt64(x.IncentivesEpochBlocks)
+		if !f(fd_Params_incentivesEpochBlocks, value) {
+			return
+		}
+	}
+	if len(x.LockDurations) != 0 {
+		value := protoreflect.ValueOfList(&_Params_8_list{list: &x.LockDurations})
+		if !f(fd_Params_lockDurations, value) {
+			return
+		}
+	}
+	if len(x.AssetExponents) != 0 {
+		value := protoreflect.ValueOfList(&_Params_9_list{list: &x.AssetExponents})
+		if !f(fd_Params_assetExponents, value) {
+			return
+		}
+	}
+	if len(x.FeeSwapDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_Params_10_list{list: &x.FeeSwapDenoms})
+		if !f(fd_Params_feeSwapDenoms, value) {
+			return
+		}
+	}
+	if x.FeeSwapMaxSlippage != "" {
+		value := protoreflect.ValueOfString(x.FeeSwapMaxSlippage)
+		if !f(fd_Params_feeSwapMaxSlippage, value) {
+			return
+		}
+	}
+	if x.BatchAuction != false {
+		value := protoreflect.ValueOfBool(x.BatchAuction)
+		if !f(fd_Params_batchAuction, value) {
+			return
+		}
+	}
+	if x.FlashSwapFeePercentage != int32(0) {
+		value := protoreflect.ValueOfInt32(x.FlashSwapFeePercentage)
+		if !f(fd_Params_flashSwapFeePercentage, value) {
+			return
+		}
+	}
+	if x.StatsRetention != nil {
+		value := protoreflect.ValueOfMessage(x.StatsRetention.ProtoReflect())
+		if !f(fd_Params_statsRetention, value) {
+			return
+		}
+	}
+	if x.LimitOrderMatchesPerBlock != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.LimitOrderMatchesPerBlock)
+		if !f(fd_Params_limitOrderMatchesPerBlock, value) {
+			return
+		}
+	}
+	if x.DcaPlanMaxSkips != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.DcaPlanMaxSkips)
+		if !f(fd_Params_dcaPlanMaxSkips, value) {
+			return
+		}
+	}
+	if x.DcaSlicesPerBlock != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.DcaSlicesPerBlock)
+		if !f(fd_Params_dcaSlicesPerBlock, value) {
 			return
 		}
 	}
@@ -194,8 +440,32 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.SwapFeePercentage != int32(0)
 	case "cosmos.simpleswap.v1.Params.decimals":
 		return x.Decimals != int64(0)
-	case "cosmos.simpleswap.v1.Params.shareToken":
-		return x.ShareToken != nil
+	case "cosmos.simpleswap.v1.Params.oracleGuardMode":
+		return x.OracleGuardMode != 0
+	case "cosmos.simpleswap.v1.Params.oracleMaxDeviation":
+		return x.OracleMaxDeviation != ""
+	case "cosmos.simpleswap.v1.Params.incentivesEpochBlocks":
+		return x.IncentivesEpochBlocks != int64(0)
+	case "cosmos.simpleswap.v1.Params.lockDurations":
+		return len(x.LockDurations) != 0
+	case "cosmos.simpleswap.v1.Params.assetExponents":
+		return len(x.AssetExponents) != 0
+	case "cosmos.simpleswap.v1.Params.feeSwapDenoms":
+		return len(x.FeeSwapDenoms) != 0
+	case "cosmos.simpleswap.v1.Params.feeSwapMaxSlippage":
+		return x.FeeSwapMaxSlippage != ""
+	case "cosmos.simpleswap.v1.Params.batchAuction":
+		return x.BatchAuction != false
+	case "cosmos.simpleswap.v1.Params.flashSwapFeePercentage":
+		return x.FlashSwapFeePercentage != int32(0)
+	case "cosmos.simpleswap.v1.Params.statsRetention":
+		return x.StatsRetention != nil
+	case "cosmos.simpleswap.v1.Params.limitOrderMatchesPerBlock":
+		return x.LimitOrderMatchesPerBlock != uint32(0)
+	case "cosmos.simpleswap.v1.Params.dcaPlanMaxSkips":
+		return x.DcaPlanMaxSkips != uint32(0)
+	case "cosmos.simpleswap.v1.Params.dcaSlicesPerBlock":
+		return x.DcaSlicesPerBlock != uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.Params"))
@@ -218,8 +488,32 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.SwapFeePercentage = int32(0)
 	case "cosmos.simpleswap.v1.Params.decimals":
 		x.Decimals = int64(0)
-	case "cosmos.simpleswap.v1.Params.shareToken":
-		x.ShareToken = nil
+	case "cosmos.simpleswap.v1.Params.oracleGuardMode":
+		x.OracleGuardMode = 0
+	case "cosmos.simpleswap.v1.Params.oracleMaxDeviation":
+		x.OracleMaxDeviation = ""
+	case "cosmos.simpleswap.v1.Params.incentivesEpochBlocks":
+		x.IncentivesEpochBlocks = int64(0)
+	case "cosmos.simpleswap.v1.Params.lockDurations":
+		x.LockDurations = nil
+	case "cosmos.simpleswap.v1.Params.assetExponents":
+		x.AssetExponents = nil
+	case "cosmos.simpleswap.v1.Params.feeSwapDenoms":
+		x.FeeSwapDenoms = nil
+	case "cosmos.simpleswap.v1.Params.feeSwapMaxSlippage":
+		x.FeeSwapMaxSlippage = ""
+	case "cosmos.simpleswap.v1.Params.batchAuction":
+		x.BatchAuction = false
+	case "cosmos.simpleswap.v1.Params.flashSwapFeePercentage":
+		x.FlashSwapFeePercentage = int32(0)
+	case "cosmos.simpleswap.v1.Params.statsRetention":
+		x.StatsRetention = nil
+	case "cosmos.simpleswap.v1.Params.limitOrderMatchesPerBlock":
+		x.LimitOrderMatchesPerBlock = uint32(0)
+	case "cosmos.simpleswap.v1.Params.dcaPlanMaxSkips":
+		x.DcaPlanMaxSkips = uint32(0)
+	case "cosmos.simpleswap.v1.Params.dcaSlicesPerBlock":
+		x.DcaSlicesPerBlock = uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.Params"))
@@ -248,9 +542,54 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "cosmos.simpleswap.v1.Params.decimals":
 		value := x.Decimals
 		return protoreflect.ValueOfInt64(value)
-	case "cosmos.simpleswap.v1.Params.shareToken":
-		value := x.ShareToken
+	case "cosmos.simpleswap.v1.Params.oracleGuardMode":
+		value := x.OracleGuardMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "cosmos.simpleswap.v1.Params.oracleMaxDeviation":
+		value := x.OracleMaxDeviation
+		return protoreflect.ValueOfString(value)
+	case "cosmos.simpleswap.v1.Params.incentivesEpochBlocks":
+		value := x.IncentivesEpochBlocks
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.simpleswap.v1.Params.lockDurations":
+		if len(x.LockDurations) == 0 {
+			return protoreflect.ValueOfList(&_Params_8_list{})
+		}
+		listValue := &_Params_8_list{list: &x.LockDurations}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.simpleswap.v1.Params.assetExponents":
+		if len(x.AssetExponents) == 0 {
+			return protoreflect.ValueOfList(&_Params_9_list{})
+		}
+		listValue := &_Params_9_list{list: &x.AssetExponents}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.simpleswap.v1.Params.feeSwapDenoms":
+		if len(x.FeeSwapDenoms) == 0 {
+			return protoreflect.ValueOfList(&_Params_10_list{})
+		}
+		listValue := &_Params_10_list{list: &x.FeeSwapDenoms}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.simpleswap.v1.Params.feeSwapMaxSlippage":
+		value := x.FeeSwapMaxSlippage
+		return protoreflect.ValueOfString(value)
+	case "cosmos.simpleswap.v1.Params.batchAuction":
+		value := x.BatchAuction
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.simpleswap.v1.Params.flashSwapFeePercentage":
+		value := x.FlashSwapFeePercentage
+		return protoreflect.ValueOfInt32(value)
+	case "cosmos.simpleswap.v1.Params.statsRetention":
+		value := x.StatsRetention
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.simpleswap.v1.Params.limitOrderMatchesPerBlock":
+		value := x.LimitOrderMatchesPerBlock
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.simpleswap.v1.Params.dcaPlanMaxSkips":
+		value := x.DcaPlanMaxSkips
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.simpleswap.v1.Params.dcaSlicesPerBlock":
+		value := x.DcaSlicesPerBlock
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.Params"))
@@ -279,8 +618,38 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.SwapFeePercentage = int32(value.Int())
 	case "cosmos.simpleswap.v1.Params.decimals":
 		x.Decimals = value.Int()
-	case "cosmos.simpleswap.v1.Params.shareToken":
-		x.ShareToken = value.Message().Interface().(*v1beta1.Coin)
+	case "cosmos.simpleswap.v1.Params.oracleGuardMode":
+		x.OracleGuardMode = (OracleGuardMode)(value.Enum())
+	case "cosmos.simpleswap.v1.Params.oracleMaxDeviation":
+		x.OracleMaxDeviation = value.Interface().(string)
+	case "cosmos.simpleswap.v1.Params.incentivesEpochBlocks":
+		x.IncentivesEpochBlocks = value.Int()
+	case "cosmos.simpleswap.v1.Params.lockDurations":
+		lv := value.List()
+		clv := lv.(*_Params_8_list)
+		x.LockDurations = *clv.list
+	case "cosmos.simpleswap.v1.Params.assetExponents":
+		lv := value.List()
+		clv := lv.(*_Params_9_list)
+		x.AssetExponents = *clv.list
+	case "cosmos.simpleswap.v1.Params.feeSwapDenoms":
+		lv := value.List()
+		clv := lv.(*_Params_10_list)
+		x.FeeSwapDenoms = *clv.list
+	case "cosmos.simpleswap.v1.Params.feeSwapMaxSlippage":
+		x.FeeSwapMaxSlippage = value.Interface().(string)
+	case "cosmos.simpleswap.v1.Params.batchAuction":
+		x.BatchAuction = value.Bool()
+	case "cosmos.simpleswap.v1.Params.flashSwapFeePercentage":
+		x.FlashSwapFeePercentage = int32(value.Int())
+	case "cosmos.simpleswap.v1.Params.statsRetention":
+		x.StatsRetention = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.simpleswap.v1.Params.limitOrderMatchesPerBlock":
+		x.LimitOrderMatchesPerBlock = uint32(value.Uint())
+	case "cosmos.simpleswap.v1.Params.dcaPlanMaxSkips":
+		x.DcaPlanMaxSkips = uint32(value.Uint())
+	case "cosmos.simpleswap.v1.Params.dcaSlicesPerBlock":
+		x.DcaSlicesPerBlock = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.Params"))
@@ -307,15 +676,51 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		}
 		value := &_Params_1_list{list: &x.WhitelistedCoins}
 		return protoreflect.ValueOfList(value)
-	case "cosmos.simpleswap.v1.Params.shareToken":
-		if x.ShareToken == nil {
-			x.ShareToken = new(v1beta1.Coin)
+	case "cosmos.simpleswap.v1.Params.lockDurations":
+		if x.LockDurations == nil {
+			x.LockDurations = []*LockDuration{}
 		}
-		return protoreflect.ValueOfMessage(x.ShareToken.ProtoReflect())
+		value := &_Params_8_list{list: &x.LockDurations}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.simpleswap.v1.Params.assetExponents":
+		if x.AssetExponents == nil {
+			x.AssetExponents = []*AssetExponent{}
+		}
+		value := &_Params_9_list{list: &x.AssetExponents}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.simpleswap.v1.Params.feeSwapDenoms":
+		if x.FeeSwapDenoms == nil {
+			x.FeeSwapDenoms = []string{}
+		}
+		value := &_Params_10_list{list: &x.FeeSwapDenoms}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.simpleswap.v1.Params.statsRetention":
+		if x.StatsRetention == nil {
+			x.StatsRetention = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.StatsRetention.ProtoReflect())
 	case "cosmos.simpleswap.v1.Params.swapFeePercentage":
 		panic(fmt.Errorf("field swapFeePercentage of message cosmos.simpleswap.v1.Params is not mutable"))
 	case "cosmos.simpleswap.v1.Params.decimals":
 		panic(fmt.Errorf("field decimals of message cosmos.simpleswap.v1.Params is not mutable"))
+	case "cosmos.simpleswap.v1.Params.oracleGuardMode":
+		panic(fmt.Errorf("field oracleGuardMode of message cosmos.simpleswap.v1.Params is not mutable"))
+	case "cosmos.simpleswap.v1.Params.oracleMaxDeviation":
+		panic(fmt.Errorf("field oracleMaxDeviation of message cosmos.simpleswap.v1.Params is not mutable"))
+	case "cosmos.simpleswap.v1.Params.incentivesEpochBlocks":
+		panic(fmt.Errorf("field incentivesEpochBlocks of message cosmos.simpleswap.v1.Params is not mutable"))
+	case "cosmos.simpleswap.v1.Params.feeSwapMaxSlippage":
+		panic(fmt.Errorf("field feeSwapMaxSlippage of message cosmos.simpleswap.v1.Params is not mutable"))
+	case "cosmos.simpleswap.v1.Params.batchAuction":
+		panic(fmt.Errorf("field batchAuction of message cosmos.simpleswap.v1.Params is not mutable"))
+	case "cosmos.simpleswap.v1.Params.flashSwapFeePercentage":
+		panic(fmt.Errorf("field flashSwapFeePercentage of message cosmos.simpleswap.v1.Params is not mutable"))
+	case "cosmos.simpleswap.v1.Params.limitOrderMatchesPerBlock":
+		panic(fmt.Errorf("field limitOrderMatchesPerBlock of message cosmos.simpleswap.v1.Params is not mutable"))
+	case "cosmos.simpleswap.v1.Params.dcaPlanMaxSkips":
+		panic(fmt.Errorf("field dcaPlanMaxSkips of message cosmos.simpleswap.v1.Params is not mutable"))
+	case "cosmos.simpleswap.v1.Params.dcaSlicesPerBlock":
+		panic(fmt.Errorf("field dcaSlicesPerBlock of message cosmos.simpleswap.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.Params"))
@@ -336,9 +741,36 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfInt32(int32(0))
 	case "cosmos.simpleswap.v1.Params.decimals":
 		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.simpleswap.v1.Params.shareToken":
-		m := new(v1beta1.Coin)
+	case "cosmos.simpleswap.v1.Params.oracleGuardMode":
+		return protoreflect.ValueOfEnum(0)
+	case "cosmos.simpleswap.v1.Params.oracleMaxDeviation":
+		return protoreflect.ValueOfString("")
+	case "cosmos.simpleswap.v1.Params.incentivesEpochBlocks":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.simpleswap.v1.Params.lockDurations":
+		list := []*LockDuration{}
+		return protoreflect.ValueOfList(&_Params_8_list{list: &list})
+	case "cosmos.simpleswap.v1.Params.assetExponents":
+		list := []*AssetExponent{}
+		return protoreflect.ValueOfList(&_Params_9_list{list: &list})
+	case "cosmos.simpleswap.v1.Params.feeSwapDenoms":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_10_list{list: &list})
+	case "cosmos.simpleswap.v1.Params.feeSwapMaxSlippage":
+		return protoreflect.ValueOfString("")
+	case "cosmos.simpleswap.v1.Params.batchAuction":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.simpleswap.v1.Params.flashSwapFeePercentage":
+		return protoreflect.ValueOfInt32(int32(0))
+	case "cosmos.simpleswap.v1.Params.statsRetention":
+		m := new(durationpb.Duration)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.simpleswap.v1.Params.limitOrderMatchesPerBlock":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.simpleswap.v1.Params.dcaPlanMaxSkips":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.simpleswap.v1.Params.dcaSlicesPerBlock":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.Params"))
@@ -420,10 +852,57 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.Decimals != 0 {
 			n += 1 + runtime.Sov(uint64(x.Decimals))
 		}
-		if x.ShareToken != nil {
-			l = options.Size(x.ShareToken)
+		if x.OracleGuardMode != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleGuardMode))
+		}
+		l = len(x.OracleMaxDeviation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.IncentivesEpochBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.IncentivesEpochBlocks))
+		}
+		if len(x.LockDurations) > 0 {
+			for _, e := range x.LockDurations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AssetExponents) > 0 {
+			for _, e := range x.AssetExponents {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.FeeSwapDenoms) > 0 {
+			for _, s := range x.FeeSwapDenoms {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.FeeSwapMaxSlippage)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BatchAuction {
+			n += 2
+		}
+		if x.FlashSwapFeePercentage != 0 {
+			n += 1 + runtime.Sov(uint64(x.FlashSwapFeePercentage))
+		}
+		if x.StatsRetention != nil {
+			l = options.Size(x.StatsRetention)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.LimitOrderMatchesPerBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.LimitOrderMatchesPerBlock))
+		}
+		if x.DcaPlanMaxSkips != 0 {
+			n += 2 + runtime.Sov(uint64(x.DcaPlanMaxSkips))
+		}
+		if x.DcaSlicesPerBlock != 0 {
+			n += 2 + runtime.Sov(uint64(x.DcaSlicesPerBlock))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -453,8 +932,27 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.ShareToken != nil {
-			encoded, err := options.Marshal(x.ShareToken)
+		if x.DcaSlicesPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DcaSlicesPerBlock))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
+		}
+		if x.DcaPlanMaxSkips != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DcaPlanMaxSkips))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if x.LimitOrderMatchesPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LimitOrderMatchesPerBlock))
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.StatsRetention != nil {
+			encoded, err := options.Marshal(x.StatsRetention)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -465,7 +963,87 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x72
+		}
+		if x.FlashSwapFeePercentage != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FlashSwapFeePercentage))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.BatchAuction {
+			i--
+			if x.BatchAuction {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x60
+		}
+		if len(x.FeeSwapMaxSlippage) > 0 {
+			i -= len(x.FeeSwapMaxSlippage)
+			copy(dAtA[i:], x.FeeSwapMaxSlippage)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FeeSwapMaxSlippage)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.FeeSwapDenoms) > 0 {
+			for iNdEx := len(x.FeeSwapDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.FeeSwapDenoms[iNdEx])
+				copy(dAtA[i:], x.FeeSwapDenoms[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FeeSwapDenoms[iNdEx])))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.AssetExponents) > 0 {
+			for iNdEx := len(x.AssetExponents) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AssetExponents[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.LockDurations) > 0 {
+			for iNdEx := len(x.LockDurations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LockDurations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if x.IncentivesEpochBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IncentivesEpochBlocks))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.OracleMaxDeviation) > 0 {
+			i -= len(x.OracleMaxDeviation)
+			copy(dAtA[i:], x.OracleMaxDeviation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OracleMaxDeviation)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.OracleGuardMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleGuardMode))
+			i--
+			dAtA[i] = 0x28
 		}
 		if x.Decimals != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.Decimals))
@@ -614,11 +1192,11 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ShareToken", wireType)
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleGuardMode", wireType)
 				}
-				var msglen int
+				x.OracleGuardMode = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -628,28 +1206,326 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.OracleGuardMode |= OracleGuardMode(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleMaxDeviation", wireType)
 				}
-				if postIndex > l {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.ShareToken == nil {
-					x.ShareToken = &v1beta1.Coin{}
+				x.OracleMaxDeviation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IncentivesEpochBlocks", wireType)
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ShareToken); err != nil {
+				x.IncentivesEpochBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IncentivesEpochBlocks |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockDurations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LockDurations = append(x.LockDurations, &LockDuration{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LockDurations[len(x.LockDurations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AssetExponents", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AssetExponents = append(x.AssetExponents, &AssetExponent{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AssetExponents[len(x.AssetExponents)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeSwapDenoms", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeSwapDenoms = append(x.FeeSwapDenoms, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeSwapMaxSlippage", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeSwapMaxSlippage = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchAuction", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.BatchAuction = bool(v != 0)
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FlashSwapFeePercentage", wireType)
+				}
+				x.FlashSwapFeePercentage = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FlashSwapFeePercentage |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StatsRetention", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.StatsRetention == nil {
+					x.StatsRetention = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StatsRetention); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LimitOrderMatchesPerBlock", wireType)
+				}
+				x.LimitOrderMatchesPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LimitOrderMatchesPerBlock |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DcaPlanMaxSkips", wireType)
+				}
+				x.DcaPlanMaxSkips = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DcaPlanMaxSkips |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 17:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DcaSlicesPerBlock", wireType)
+				}
+				x.DcaSlicesPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DcaSlicesPerBlock |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -686,31 +1562,27 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_LiquidityProvider                     protoreflect.MessageDescriptor
-	fd_LiquidityProvider_stableCoin          protoreflect.FieldDescriptor
-	fd_LiquidityProvider_poolShare           protoreflect.FieldDescriptor
-	fd_LiquidityProvider_accruedFees         protoreflect.FieldDescriptor
-	fd_LiquidityProvider_globallyAccruedFees protoreflect.FieldDescriptor
+	md_AssetExponent          protoreflect.MessageDescriptor
+	fd_AssetExponent_denom    protoreflect.FieldDescriptor
+	fd_AssetExponent_exponent protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_simpleswap_v1_types_proto_init()
-	md_LiquidityProvider = File_cosmos_simpleswap_v1_types_proto.Messages().ByName("LiquidityProvider")
-	fd_LiquidityProvider_stableCoin = md_LiquidityProvider.Fields().ByName("stableCoin")
-	fd_LiquidityProvider_poolShare = md_LiquidityProvider.Fields().ByName("poolShare")
-	fd_LiquidityProvider_accruedFees = md_LiquidityProvider.Fields().ByName("accruedFees")
-	fd_LiquidityProvider_globallyAccruedFees = md_LiquidityProvider.Fields().ByName("globallyAccruedFees")
+	md_AssetExponent = File_cosmos_simpleswap_v1_types_proto.Messages().ByName("AssetExponent")
+	fd_AssetExponent_denom = md_AssetExponent.Fields().ByName("denom")
+	fd_AssetExponent_exponent = md_AssetExponent.Fields().ByName("exponent")
 }
 
-var _ protoreflect.Message = (*fastReflection_LiquidityProvider)(nil)
+var _ protoreflect.Message = (*fastReflection_AssetExponent)(nil)
 
-type fastReflection_LiquidityProvider LiquidityProvider
+type fastReflection_AssetExponent AssetExponent
 
-func (x *LiquidityProvider) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_LiquidityProvider)(x)
+func (x *AssetExponent) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AssetExponent)(x)
 }
 
-func (x *LiquidityProvider) slowProtoReflect() protoreflect.Message {
+func (x *AssetExponent) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -722,43 +1594,43 @@ func (x *LiquidityProvider) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_LiquidityProvider_messageType fastReflection_LiquidityProvider_messageType
-var _ protoreflect.MessageType = fastReflection_LiquidityProvider_messageType{}
+var _fastReflection_AssetExponent_messageType fastReflection_AssetExponent_messageType
+var _ protoreflect.MessageType = fastReflection_AssetExponent_messageType{}
 
-type fastReflection_LiquidityProvider_messageType struct{}
+type fastReflection_AssetExponent_messageType struct{}
 
-func (x fastReflection_LiquidityProvider_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_LiquidityProvider)(nil)
+func (x fastReflection_AssetExponent_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AssetExponent)(nil)
 }
-func (x fastReflection_LiquidityProvider_messageType) New() protoreflect.Message {
-	return new(fastReflection_LiquidityProvider)
+func (x fastReflection_AssetExponent_messageType) New() protoreflect.Message {
+	return new(fastReflection_AssetExponent)
 }
-func (x fastReflection_LiquidityProvider_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_LiquidityProvider
+func (x fastReflection_AssetExponent_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AssetExponent
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_LiquidityProvider) Descriptor() protoreflect.MessageDescriptor {
-	return md_LiquidityProvider
+func (x *fastReflection_AssetExponent) Descriptor() protoreflect.MessageDescriptor {
+	return md_AssetExponent
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_LiquidityProvider) Type() protoreflect.MessageType {
-	return _fastReflection_LiquidityProvider_messageType
+func (x *fastReflection_AssetExponent) Type() protoreflect.MessageType {
+	return _fastReflection_AssetExponent_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_LiquidityProvider) New() protoreflect.Message {
-	return new(fastReflection_LiquidityProvider)
+func (x *fastReflection_AssetExponent) New() protoreflect.Message {
+	return new(fastReflection_AssetExponent)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_LiquidityProvider) Interface() protoreflect.ProtoMessage {
-	return (*LiquidityProvider)(x)
+func (x *fastReflection_AssetExponent) Interface() protoreflect.ProtoMessage {
+	return (*AssetExponent)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -766,28 +1638,16 @@ func (x *fastReflection_LiquidityProvider) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_LiquidityProvider) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.StableCoin != nil {
-		value := protoreflect.ValueOfMessage(x.StableCoin.ProtoReflect())
-		if !f(fd_LiquidityProvider_stableCoin, value) {
-			return
-		}
-	}
-	if x.PoolShare != nil {
-		value := protoreflect.ValueOfMessage(x.PoolShare.ProtoReflect())
-		if !f(fd_LiquidityProvider_poolShare, value) {
-			return
-		}
-	}
-	if x.AccruedFees != int64(0) {
-		value := protoreflect.ValueOfInt64(x.AccruedFees)
-		if !f(fd_LiquidityProvider_accruedFees, value) {
+func (x *fastReflection_AssetExponent) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_AssetExponent_denom, value) {
 			return
 		}
 	}
-	if x.GloballyAccruedFees != int64(0) {
-		value := protoreflect.ValueOfInt64(x.GloballyAccruedFees)
-		if !f(fd_LiquidityProvider_globallyAccruedFees, value) {
+	if x.Exponent != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Exponent)
+		if !f(fd_AssetExponent_exponent, value) {
 			return
 		}
 	}
@@ -804,21 +1664,17 @@ func (x *fastReflection_LiquidityProvider) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_LiquidityProvider) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_AssetExponent) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.simpleswap.v1.LiquidityProvider.stableCoin":
-		return x.StableCoin != nil
-	case "cosmos.simpleswap.v1.LiquidityProvider.poolShare":
-		return x.PoolShare != nil
-	case "cosmos.simpleswap.v1.LiquidityProvider.accruedFees":
-		return x.AccruedFees != int64(0)
-	case "cosmos.simpleswap.v1.LiquidityProvider.globallyAccruedFees":
-		return x.GloballyAccruedFees != int64(0)
+	case "cosmos.simpleswap.v1.AssetExponent.denom":
+		return x.Denom != ""
+	case "cosmos.simpleswap.v1.AssetExponent.exponent":
+		return x.Exponent != uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.LiquidityProvider"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.AssetExponent"))
 		}
-		panic(fmt.Errorf("message cosmos.simpleswap.v1.LiquidityProvider does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.AssetExponent does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -828,21 +1684,17 @@ func (x *fastReflection_LiquidityProvider) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_LiquidityProvider) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_AssetExponent) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.simpleswap.v1.LiquidityProvider.stableCoin":
-		x.StableCoin = nil
-	case "cosmos.simpleswap.v1.LiquidityProvider.poolShare":
-		x.PoolShare = nil
-	case "cosmos.simpleswap.v1.LiquidityProvider.accruedFees":
-		x.AccruedFees = int64(0)
-	case "cosmos.simpleswap.v1.LiquidityProvider.globallyAccruedFees":
-		x.GloballyAccruedFees = int64(0)
+	case "cosmos.simpleswap.v1.AssetExponent.denom":
+		x.Denom = ""
+	case "cosmos.simpleswap.v1.AssetExponent.exponent":
+		x.Exponent = uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.LiquidityProvider"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.AssetExponent"))
 		}
-		panic(fmt.Errorf("message cosmos.simpleswap.v1.LiquidityProvider does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.AssetExponent does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -852,25 +1704,19 @@ func (x *fastReflection_LiquidityProvider) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_LiquidityProvider) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AssetExponent) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.simpleswap.v1.LiquidityProvider.stableCoin":
-		value := x.StableCoin
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.simpleswap.v1.LiquidityProvider.poolShare":
-		value := x.PoolShare
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.simpleswap.v1.LiquidityProvider.accruedFees":
-		value := x.AccruedFees
-		return protoreflect.ValueOfInt64(value)
-	case "cosmos.simpleswap.v1.LiquidityProvider.globallyAccruedFees":
-		value := x.GloballyAccruedFees
-		return protoreflect.ValueOfInt64(value)
+	case "cosmos.simpleswap.v1.AssetExponent.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "cosmos.simpleswap.v1.AssetExponent.exponent":
+		value := x.Exponent
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.LiquidityProvider"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.AssetExponent"))
 		}
-		panic(fmt.Errorf("message cosmos.simpleswap.v1.LiquidityProvider does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.AssetExponent does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -884,21 +1730,17 @@ func (x *fastReflection_LiquidityProvider) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_LiquidityProvider) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_AssetExponent) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.simpleswap.v1.LiquidityProvider.stableCoin":
-		x.StableCoin = value.Message().Interface().(*v1beta1.Coin)
-	case "cosmos.simpleswap.v1.LiquidityProvider.poolShare":
-		x.PoolShare = value.Message().Interface().(*v1beta1.Coin)
-	case "cosmos.simpleswap.v1.LiquidityProvider.accruedFees":
-		x.AccruedFees = value.Int()
-	case "cosmos.simpleswap.v1.LiquidityProvider.globallyAccruedFees":
-		x.GloballyAccruedFees = value.Int()
+	case "cosmos.simpleswap.v1.AssetExponent.denom":
+		x.Denom = value.Interface().(string)
+	case "cosmos.simpleswap.v1.AssetExponent.exponent":
+		x.Exponent = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.LiquidityProvider"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.AssetExponent"))
 		}
-		panic(fmt.Errorf("message cosmos.simpleswap.v1.LiquidityProvider does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.AssetExponent does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -912,60 +1754,44 @@ func (x *fastReflection_LiquidityProvider) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_LiquidityProvider) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AssetExponent) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.simpleswap.v1.LiquidityProvider.stableCoin":
-		if x.StableCoin == nil {
-			x.StableCoin = new(v1beta1.Coin)
-		}
-		return protoreflect.ValueOfMessage(x.StableCoin.ProtoReflect())
-	case "cosmos.simpleswap.v1.LiquidityProvider.poolShare":
-		if x.PoolShare == nil {
-			x.PoolShare = new(v1beta1.Coin)
-		}
-		return protoreflect.ValueOfMessage(x.PoolShare.ProtoReflect())
-	case "cosmos.simpleswap.v1.LiquidityProvider.accruedFees":
-		panic(fmt.Errorf("field accruedFees of message cosmos.simpleswap.v1.LiquidityProvider is not mutable"))
-	case "cosmos.simpleswap.v1.LiquidityProvider.globallyAccruedFees":
-		panic(fmt.Errorf("field globallyAccruedFees of message cosmos.simpleswap.v1.LiquidityProvider is not mutable"))
+	case "cosmos.simpleswap.v1.AssetExponent.denom":
+		panic(fmt.Errorf("field denom of message cosmos.simpleswap.v1.AssetExponent is not mutable"))
+	case "cosmos.simpleswap.v1.AssetExponent.exponent":
+		panic(fmt.Errorf("field exponent of message cosmos.simpleswap.v1.AssetExponent is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.LiquidityProvider"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.AssetExponent"))
 		}
-		panic(fmt.Errorf("message cosmos.simpleswap.v1.LiquidityProvider does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.AssetExponent does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_LiquidityProvider) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AssetExponent) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.simpleswap.v1.LiquidityProvider.stableCoin":
-		m := new(v1beta1.Coin)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.simpleswap.v1.LiquidityProvider.poolShare":
-		m := new(v1beta1.Coin)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.simpleswap.v1.LiquidityProvider.accruedFees":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.simpleswap.v1.LiquidityProvider.globallyAccruedFees":
-		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.simpleswap.v1.AssetExponent.denom":
+		return protoreflect.ValueOfString("")
+	case "cosmos.simpleswap.v1.AssetExponent.exponent":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.LiquidityProvider"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.AssetExponent"))
 		}
-		panic(fmt.Errorf("message cosmos.simpleswap.v1.LiquidityProvider does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.AssetExponent does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_LiquidityProvider) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_AssetExponent) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.simpleswap.v1.LiquidityProvider", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.simpleswap.v1.AssetExponent", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -973,7 +1799,7 @@ func (x *fastReflection_LiquidityProvider) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_LiquidityProvider) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_AssetExponent) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -984,7 +1810,7 @@ func (x *fastReflection_LiquidityProvider) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_LiquidityProvider) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_AssetExponent) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -996,7 +1822,7 @@ func (x *fastReflection_LiquidityProvider) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_LiquidityProvider) IsValid() bool {
+func (x *fastReflection_AssetExponent) IsValid() bool {
 	return x != nil
 }
 
@@ -1006,9 +1832,9 @@ func (x *fastReflection_LiquidityProvider) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_LiquidityProvider) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_AssetExponent) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*LiquidityProvider)
+		x := input.Message.Interface().(*AssetExponent)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1020,19 +1846,12 @@ func (x *fastReflection_LiquidityProvider) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.StableCoin != nil {
-			l = options.Size(x.StableCoin)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.PoolShare != nil {
-			l = options.Size(x.PoolShare)
+		l = len(x.Denom)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.AccruedFees != 0 {
-			n += 1 + runtime.Sov(uint64(x.AccruedFees))
-		}
-		if x.GloballyAccruedFees != 0 {
-			n += 1 + runtime.Sov(uint64(x.GloballyAccruedFees))
+		if x.Exponent != 0 {
+			n += 1 + runtime.Sov(uint64(x.Exponent))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1044,7 +1863,7 @@ func (x *fastReflection_LiquidityProvider) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*LiquidityProvider)
+		x := input.Message.Interface().(*AssetExponent)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1063,41 +1882,15 @@ func (x *fastReflection_LiquidityProvider) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.GloballyAccruedFees != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.GloballyAccruedFees))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.AccruedFees != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.AccruedFees))
-			i--
-			dAtA[i] = 0x18
-		}
-		if x.PoolShare != nil {
-			encoded, err := options.Marshal(x.PoolShare)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if x.Exponent != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Exponent))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if x.StableCoin != nil {
-			encoded, err := options.Marshal(x.StableCoin)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -1112,7 +1905,7 @@ func (x *fastReflection_LiquidityProvider) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*LiquidityProvider)
+		x := input.Message.Interface().(*AssetExponent)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1144,17 +1937,17 @@ func (x *fastReflection_LiquidityProvider) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LiquidityProvider: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AssetExponent: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LiquidityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AssetExponent: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StableCoin", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1164,88 +1957,29 @@ func (x *fastReflection_LiquidityProvider) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.StableCoin == nil {
-					x.StableCoin = &v1beta1.Coin{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StableCoin); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolShare", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.PoolShare == nil {
-					x.PoolShare = &v1beta1.Coin{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PoolShare); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccruedFees", wireType)
-				}
-				x.AccruedFees = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.AccruedFees |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
 				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GloballyAccruedFees", wireType)
-				}
-				x.GloballyAccruedFees = 0
+				x.Exponent = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1255,7 +1989,7 @@ func (x *fastReflection_LiquidityProvider) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.GloballyAccruedFees |= int64(b&0x7F) << shift
+					x.Exponent |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -1296,33 +2030,27 @@ func (x *fastReflection_LiquidityProvider) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Pool                   protoreflect.MessageDescriptor
-	fd_Pool_totalAccruedFees  protoreflect.FieldDescriptor
-	fd_Pool_totalLiquidity    protoreflect.FieldDescriptor
-	fd_Pool_decimals          protoreflect.FieldDescriptor
-	fd_Pool_shareToken        protoreflect.FieldDescriptor
-	fd_Pool_swapFeePercentage protoreflect.FieldDescriptor
+	md_LockDuration            protoreflect.MessageDescriptor
+	fd_LockDuration_duration   protoreflect.FieldDescriptor
+	fd_LockDuration_multiplier protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_simpleswap_v1_types_proto_init()
-	md_Pool = File_cosmos_simpleswap_v1_types_proto.Messages().ByName("Pool")
-	fd_Pool_totalAccruedFees = md_Pool.Fields().ByName("totalAccruedFees")
-	fd_Pool_totalLiquidity = md_Pool.Fields().ByName("totalLiquidity")
-	fd_Pool_decimals = md_Pool.Fields().ByName("decimals")
-	fd_Pool_shareToken = md_Pool.Fields().ByName("shareToken")
-	fd_Pool_swapFeePercentage = md_Pool.Fields().ByName("swapFeePercentage")
+	md_LockDuration = File_cosmos_simpleswap_v1_types_proto.Messages().ByName("LockDuration")
+	fd_LockDuration_duration = md_LockDuration.Fields().ByName("duration")
+	fd_LockDuration_multiplier = md_LockDuration.Fields().ByName("multiplier")
 }
 
-var _ protoreflect.Message = (*fastReflection_Pool)(nil)
+var _ protoreflect.Message = (*fastReflection_LockDuration)(nil)
 
-type fastReflection_Pool Pool
+type fastReflection_LockDuration LockDuration
 
-func (x *Pool) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Pool)(x)
+func (x *LockDuration) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LockDuration)(x)
 }
 
-func (x *Pool) slowProtoReflect() protoreflect.Message {
+func (x *LockDuration) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1334,43 +2062,43 @@ func (x *Pool) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Pool_messageType fastReflection_Pool_messageType
-var _ protoreflect.MessageType = fastReflection_Pool_messageType{}
+var _fastReflection_LockDuration_messageType fastReflection_LockDuration_messageType
+var _ protoreflect.MessageType = fastReflection_LockDuration_messageType{}
 
-type fastReflection_Pool_messageType struct{}
+type fastReflection_LockDuration_messageType struct{}
 
-func (x fastReflection_Pool_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Pool)(nil)
+func (x fastReflection_LockDuration_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LockDuration)(nil)
 }
-func (x fastReflection_Pool_messageType) New() protoreflect.Message {
-	return new(fastReflection_Pool)
+func (x fastReflection_LockDuration_messageType) New() protoreflect.Message {
+	return new(fastReflection_LockDuration)
 }
-func (x fastReflection_Pool_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Pool
+func (x fastReflection_LockDuration_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LockDuration
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Pool) Descriptor() protoreflect.MessageDescriptor {
-	return md_Pool
+func (x *fastReflection_LockDuration) Descriptor() protoreflect.MessageDescriptor {
+	return md_LockDuration
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Pool) Type() protoreflect.MessageType {
-	return _fastReflection_Pool_messageType
+func (x *fastReflection_LockDuration) Type() protoreflect.MessageType {
+	return _fastReflection_LockDuration_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Pool) New() protoreflect.Message {
-	return new(fastReflection_Pool)
+func (x *fastReflection_LockDuration) New() protoreflect.Message {
+	return new(fastReflection_LockDuration)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Pool) Interface() protoreflect.ProtoMessage {
-	return (*Pool)(x)
+func (x *fastReflection_LockDuration) Interface() protoreflect.ProtoMessage {
+	return (*LockDuration)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1378,34 +2106,16 @@ func (x *fastReflection_Pool) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Pool) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.TotalAccruedFees != int64(0) {
-		value := protoreflect.ValueOfInt64(x.TotalAccruedFees)
-		if !f(fd_Pool_totalAccruedFees, value) {
-			return
-		}
-	}
-	if x.TotalLiquidity != int64(0) {
-		value := protoreflect.ValueOfInt64(x.TotalLiquidity)
-		if !f(fd_Pool_totalLiquidity, value) {
-			return
-		}
-	}
-	if x.Decimals != int64(0) {
-		value := protoreflect.ValueOfInt64(x.Decimals)
-		if !f(fd_Pool_decimals, value) {
+func (x *fastReflection_LockDuration) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Duration != nil {
+		value := protoreflect.ValueOfMessage(x.Duration.ProtoReflect())
+		if !f(fd_LockDuration_duration, value) {
 			return
 		}
 	}
-	if x.ShareToken != nil {
-		value := protoreflect.ValueOfMessage(x.ShareToken.ProtoReflect())
-		if !f(fd_Pool_shareToken, value) {
-			return
-		}
-	}
-	if x.SwapFeePercentage != int32(0) {
-		value := protoreflect.ValueOfInt32(x.SwapFeePercentage)
-		if !f(fd_Pool_swapFeePercentage, value) {
+	if x.Multiplier != "" {
+		value := protoreflect.ValueOfString(x.Multiplier)
+		if !f(fd_LockDuration_multiplier, value) {
 			return
 		}
 	}
@@ -1422,23 +2132,17 @@ func (x *fastReflection_Pool) Range(f func(protoreflect.FieldDescriptor, protore
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Pool) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_LockDuration) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.simpleswap.v1.Pool.totalAccruedFees":
-		return x.TotalAccruedFees != int64(0)
-	case "cosmos.simpleswap.v1.Pool.totalLiquidity":
-		return x.TotalLiquidity != int64(0)
-	case "cosmos.simpleswap.v1.Pool.decimals":
-		return x.Decimals != int64(0)
-	case "cosmos.simpleswap.v1.Pool.shareToken":
-		return x.ShareToken != nil
-	case "cosmos.simpleswap.v1.Pool.swapFeePercentage":
-		return x.SwapFeePercentage != int32(0)
+	case "cosmos.simpleswap.v1.LockDuration.duration":
+		return x.Duration != nil
+	case "cosmos.simpleswap.v1.LockDuration.multiplier":
+		return x.Multiplier != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.Pool"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.LockDuration"))
 		}
-		panic(fmt.Errorf("message cosmos.simpleswap.v1.Pool does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.LockDuration does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1448,23 +2152,17 @@ func (x *fastReflection_Pool) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Pool) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_LockDuration) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.simpleswap.v1.Pool.totalAccruedFees":
-		x.TotalAccruedFees = int64(0)
-	case "cosmos.simpleswap.v1.Pool.totalLiquidity":
-		x.TotalLiquidity = int64(0)
-	case "cosmos.simpleswap.v1.Pool.decimals":
-		x.Decimals = int64(0)
-	case "cosmos.simpleswap.v1.Pool.shareToken":
-		x.ShareToken = nil
-	case "cosmos.simpleswap.v1.Pool.swapFeePercentage":
-		x.SwapFeePercentage = int32(0)
+	case "cosmos.simpleswap.v1.LockDuration.duration":
+		x.Duration = nil
+	case "cosmos.simpleswap.v1.LockDuration.multiplier":
+		x.Multiplier = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.Pool"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.LockDuration"))
 		}
-		panic(fmt.Errorf("message cosmos.simpleswap.v1.Pool does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.LockDuration does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1474,28 +2172,19 @@ func (x *fastReflection_Pool) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Pool) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LockDuration) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.simpleswap.v1.Pool.totalAccruedFees":
-		value := x.TotalAccruedFees
-		return protoreflect.ValueOfInt64(value)
-	case "cosmos.simpleswap.v1.Pool.totalLiquidity":
-		value := x.TotalLiquidity
-		return protoreflect.ValueOfInt64(value)
-	case "cosmos.simpleswap.v1.Pool.decimals":
-		value := x.Decimals
-		return protoreflect.ValueOfInt64(value)
-	case "cosmos.simpleswap.v1.Pool.shareToken":
-		value := x.ShareToken
+	case "cosmos.simpleswap.v1.LockDuration.duration":
+		value := x.Duration
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.simpleswap.v1.Pool.swapFeePercentage":
-		value := x.SwapFeePercentage
-		return protoreflect.ValueOfInt32(value)
+	case "cosmos.simpleswap.v1.LockDuration.multiplier":
+		value := x.Multiplier
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.Pool"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.LockDuration"))
 		}
-		panic(fmt.Errorf("message cosmos.simpleswap.v1.Pool does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.LockDuration does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1509,23 +2198,17 @@ func (x *fastReflection_Pool) Get(descriptor protoreflect.FieldDescriptor) proto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Pool) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_LockDuration) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.simpleswap.v1.Pool.totalAccruedFees":
-		x.TotalAccruedFees = value.Int()
-	case "cosmos.simpleswap.v1.Pool.totalLiquidity":
-		x.TotalLiquidity = value.Int()
-	case "cosmos.simpleswap.v1.Pool.decimals":
-		x.Decimals = value.Int()
-	case "cosmos.simpleswap.v1.Pool.shareToken":
-		x.ShareToken = value.Message().Interface().(*v1beta1.Coin)
-	case "cosmos.simpleswap.v1.Pool.swapFeePercentage":
-		x.SwapFeePercentage = int32(value.Int())
+	case "cosmos.simpleswap.v1.LockDuration.duration":
+		x.Duration = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.simpleswap.v1.LockDuration.multiplier":
+		x.Multiplier = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.Pool"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.LockDuration"))
 		}
-		panic(fmt.Errorf("message cosmos.simpleswap.v1.Pool does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.LockDuration does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1539,60 +2222,48 @@ func (x *fastReflection_Pool) Set(fd protoreflect.FieldDescriptor, value protore
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Pool) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LockDuration) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.simpleswap.v1.Pool.shareToken":
-		if x.ShareToken == nil {
-			x.ShareToken = new(v1beta1.Coin)
+	case "cosmos.simpleswap.v1.LockDuration.duration":
+		if x.Duration == nil {
+			x.Duration = new(durationpb.Duration)
 		}
-		return protoreflect.ValueOfMessage(x.ShareToken.ProtoReflect())
-	case "cosmos.simpleswap.v1.Pool.totalAccruedFees":
-		panic(fmt.Errorf("field totalAccruedFees of message cosmos.simpleswap.v1.Pool is not mutable"))
-	case "cosmos.simpleswap.v1.Pool.totalLiquidity":
-		panic(fmt.Errorf("field totalLiquidity of message cosmos.simpleswap.v1.Pool is not mutable"))
-	case "cosmos.simpleswap.v1.Pool.decimals":
-		panic(fmt.Errorf("field decimals of message cosmos.simpleswap.v1.Pool is not mutable"))
-	case "cosmos.simpleswap.v1.Pool.swapFeePercentage":
-		panic(fmt.Errorf("field swapFeePercentage of message cosmos.simpleswap.v1.Pool is not mutable"))
+		return protoreflect.ValueOfMessage(x.Duration.ProtoReflect())
+	case "cosmos.simpleswap.v1.LockDuration.multiplier":
+		panic(fmt.Errorf("field multiplier of message cosmos.simpleswap.v1.LockDuration is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.Pool"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.LockDuration"))
 		}
-		panic(fmt.Errorf("message cosmos.simpleswap.v1.Pool does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.LockDuration does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Pool) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LockDuration) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.simpleswap.v1.Pool.totalAccruedFees":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.simpleswap.v1.Pool.totalLiquidity":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.simpleswap.v1.Pool.decimals":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.simpleswap.v1.Pool.shareToken":
-		m := new(v1beta1.Coin)
+	case "cosmos.simpleswap.v1.LockDuration.duration":
+		m := new(durationpb.Duration)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.simpleswap.v1.Pool.swapFeePercentage":
-		return protoreflect.ValueOfInt32(int32(0))
+	case "cosmos.simpleswap.v1.LockDuration.multiplier":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.Pool"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.LockDuration"))
 		}
-		panic(fmt.Errorf("message cosmos.simpleswap.v1.Pool does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.LockDuration does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Pool) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_LockDuration) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.simpleswap.v1.Pool", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.simpleswap.v1.LockDuration", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1600,7 +2271,7 @@ func (x *fastReflection_Pool) WhichOneof(d protoreflect.OneofDescriptor) protore
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Pool) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_LockDuration) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1611,7 +2282,7 @@ func (x *fastReflection_Pool) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Pool) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_LockDuration) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1623,7 +2294,7 @@ func (x *fastReflection_Pool) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Pool) IsValid() bool {
+func (x *fastReflection_LockDuration) IsValid() bool {
 	return x != nil
 }
 
@@ -1633,9 +2304,9 @@ func (x *fastReflection_Pool) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_LockDuration) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Pool)
+		x := input.Message.Interface().(*LockDuration)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1647,21 +2318,13 @@ func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.TotalAccruedFees != 0 {
-			n += 1 + runtime.Sov(uint64(x.TotalAccruedFees))
-		}
-		if x.TotalLiquidity != 0 {
-			n += 1 + runtime.Sov(uint64(x.TotalLiquidity))
-		}
-		if x.Decimals != 0 {
-			n += 1 + runtime.Sov(uint64(x.Decimals))
-		}
-		if x.ShareToken != nil {
-			l = options.Size(x.ShareToken)
+		if x.Duration != nil {
+			l = options.Size(x.Duration)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.SwapFeePercentage != 0 {
-			n += 1 + runtime.Sov(uint64(x.SwapFeePercentage))
+		l = len(x.Multiplier)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1673,7 +2336,7 @@ func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Pool)
+		x := input.Message.Interface().(*LockDuration)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1692,13 +2355,15 @@ func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.SwapFeePercentage != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.SwapFeePercentage))
+		if len(x.Multiplier) > 0 {
+			i -= len(x.Multiplier)
+			copy(dAtA[i:], x.Multiplier)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Multiplier)))
 			i--
-			dAtA[i] = 0x28
+			dAtA[i] = 0x12
 		}
-		if x.ShareToken != nil {
-			encoded, err := options.Marshal(x.ShareToken)
+		if x.Duration != nil {
+			encoded, err := options.Marshal(x.Duration)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1709,22 +2374,7 @@ func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x22
-		}
-		if x.Decimals != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Decimals))
-			i--
-			dAtA[i] = 0x18
-		}
-		if x.TotalLiquidity != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalLiquidity))
-			i--
-			dAtA[i] = 0x10
-		}
-		if x.TotalAccruedFees != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalAccruedFees))
-			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1737,7 +2387,7 @@ func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Pool)
+		x := input.Message.Interface().(*LockDuration)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1769,72 +2419,15 @@ func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Pool: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LockDuration: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LockDuration: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalAccruedFees", wireType)
-				}
-				x.TotalAccruedFees = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.TotalAccruedFees |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidity", wireType)
-				}
-				x.TotalLiquidity = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.TotalLiquidity |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
-				}
-				x.Decimals = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Decimals |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ShareToken", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1861,18 +2454,18 @@ func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.ShareToken == nil {
-					x.ShareToken = &v1beta1.Coin{}
+				if x.Duration == nil {
+					x.Duration = &durationpb.Duration{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ShareToken); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Duration); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SwapFeePercentage", wireType)
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
 				}
-				x.SwapFeePercentage = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1882,18 +2475,31 @@ func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.SwapFeePercentage |= int32(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Multiplier = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if (iNdEx + skippy) > l {
@@ -1923,27 +2529,31 @@ func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_GenesisState        protoreflect.MessageDescriptor
-	fd_GenesisState_pool   protoreflect.FieldDescriptor
-	fd_GenesisState_params protoreflect.FieldDescriptor
+	md_LiquidityProvider                     protoreflect.MessageDescriptor
+	fd_LiquidityProvider_stableCoin          protoreflect.FieldDescriptor
+	fd_LiquidityProvider_poolShare           protoreflect.FieldDescriptor
+	fd_LiquidityProvider_accruedFees         protoreflect.FieldDescriptor
+	fd_LiquidityProvider_globallyAccruedFees protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_simpleswap_v1_types_proto_init()
-	md_GenesisState = File_cosmos_simpleswap_v1_types_proto.Messages().ByName("GenesisState")
-	fd_GenesisState_pool = md_GenesisState.Fields().ByName("pool")
-	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	md_LiquidityProvider = File_cosmos_simpleswap_v1_types_proto.Messages().ByName("LiquidityProvider")
+	fd_LiquidityProvider_stableCoin = md_LiquidityProvider.Fields().ByName("stableCoin")
+	fd_LiquidityProvider_poolShare = md_LiquidityProvider.Fields().ByName("poolShare")
+	fd_LiquidityProvider_accruedFees = md_LiquidityProvider.Fields().ByName("accruedFees")
+	fd_LiquidityProvider_globallyAccruedFees = md_LiquidityProvider.Fields().ByName("globallyAccruedFees")
 }
 
-var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+var _ protoreflect.Message = (*fastReflection_LiquidityProvider)(nil)
 
-type fastReflection_GenesisState GenesisState
+type fastReflection_LiquidityProvider LiquidityProvider
 
-func (x *GenesisState) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GenesisState)(x)
+func (x *LiquidityProvider) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LiquidityProvider)(x)
 }
 
-func (x *GenesisState) slowProtoReflect() protoreflect.Message {
+func (x *LiquidityProvider) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1955,43 +2565,43 @@ func (x *GenesisState) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
-var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+var _fastReflection_LiquidityProvider_messageType fastReflection_LiquidityProvider_messageType
+var _ protoreflect.MessageType = fastReflection_LiquidityProvider_messageType{}
 
-type fastReflection_GenesisState_messageType struct{}
+type fastReflection_LiquidityProvider_messageType struct{}
 
-func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GenesisState)(nil)
+func (x fastReflection_LiquidityProvider_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LiquidityProvider)(nil)
 }
-func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+func (x fastReflection_LiquidityProvider_messageType) New() protoreflect.Message {
+	return new(fastReflection_LiquidityProvider)
 }
-func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+func (x fastReflection_LiquidityProvider_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LiquidityProvider
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+func (x *fastReflection_LiquidityProvider) Descriptor() protoreflect.MessageDescriptor {
+	return md_LiquidityProvider
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
-	return _fastReflection_GenesisState_messageType
+func (x *fastReflection_LiquidityProvider) Type() protoreflect.MessageType {
+	return _fastReflection_LiquidityProvider_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GenesisState) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+func (x *fastReflection_LiquidityProvider) New() protoreflect.Message {
+	return new(fastReflection_LiquidityProvider)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
-	return (*GenesisState)(x)
+func (x *fastReflection_LiquidityProvider) Interface() protoreflect.ProtoMessage {
+	return (*LiquidityProvider)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1999,16 +2609,28 @@ func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Pool != nil {
-		value := protoreflect.ValueOfMessage(x.Pool.ProtoReflect())
-		if !f(fd_GenesisState_pool, value) {
+func (x *fastReflection_LiquidityProvider) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.StableCoin != nil {
+		value := protoreflect.ValueOfMessage(x.StableCoin.ProtoReflect())
+		if !f(fd_LiquidityProvider_stableCoin, value) {
 			return
 		}
 	}
-	if x.Params != nil {
-		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-		if !f(fd_GenesisState_params, value) {
+	if x.PoolShare != nil {
+		value := protoreflect.ValueOfMessage(x.PoolShare.ProtoReflect())
+		if !f(fd_LiquidityProvider_poolShare, value) {
+			return
+		}
+	}
+	if x.AccruedFees != int64(0) {
+		value := protoreflect.ValueOfInt64(x.AccruedFees)
+		if !f(fd_LiquidityProvider_accruedFees, value) {
+			return
+		}
+	}
+	if x.GloballyAccruedFees != int64(0) {
+		value := protoreflect.ValueOfInt64(x.GloballyAccruedFees)
+		if !f(fd_LiquidityProvider_globallyAccruedFees, value) {
 			return
 		}
 	}
@@ -2025,17 +2647,21 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_LiquidityProvider) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.simpleswap.v1.GenesisState.pool":
-		return x.Pool != nil
-	case "cosmos.simpleswap.v1.GenesisState.params":
-		return x.Params != nil
+	case "cosmos.simpleswap.v1.LiquidityProvider.stableCoin":
+		return x.StableCoin != nil
+	case "cosmos.simpleswap.v1.LiquidityProvider.poolShare":
+		return x.PoolShare != nil
+	case "cosmos.simpleswap.v1.LiquidityProvider.accruedFees":
+		return x.AccruedFees != int64(0)
+	case "cosmos.simpleswap.v1.LiquidityProvider.globallyAccruedFees":
+		return x.GloballyAccruedFees != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.GenesisState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.LiquidityProvider"))
 		}
-		panic(fmt.Errorf("message cosmos.simpleswap.v1.GenesisState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.LiquidityProvider does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2045,17 +2671,21 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_LiquidityProvider) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.simpleswap.v1.GenesisState.pool":
-		x.Pool = nil
-	case "cosmos.simpleswap.v1.GenesisState.params":
-		x.Params = nil
+	case "cosmos.simpleswap.v1.LiquidityProvider.stableCoin":
+		x.StableCoin = nil
+	case "cosmos.simpleswap.v1.LiquidityProvider.poolShare":
+		x.PoolShare = nil
+	case "cosmos.simpleswap.v1.LiquidityProvider.accruedFees":
+		x.AccruedFees = int64(0)
+	case "cosmos.simpleswap.v1.LiquidityProvider.globallyAccruedFees":
+		x.GloballyAccruedFees = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.GenesisState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.LiquidityProvider"))
 		}
-		panic(fmt.Errorf("message cosmos.simpleswap.v1.GenesisState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.LiquidityProvider does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2065,19 +2695,25 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LiquidityProvider) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.simpleswap.v1.GenesisState.pool":
-		value := x.Pool
+	case "cosmos.simpleswap.v1.LiquidityProvider.stableCoin":
+		value := x.StableCoin
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.simpleswap.v1.GenesisState.params":
-		value := x.Params
+	case "cosmos.simpleswap.v1.LiquidityProvider.poolShare":
+		value := x.PoolShare
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.simpleswap.v1.LiquidityProvider.accruedFees":
+		value := x.AccruedFees
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.simpleswap.v1.LiquidityProvider.globallyAccruedFees":
+		value := x.GloballyAccruedFees
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.GenesisState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.LiquidityProvider"))
 		}
-		panic(fmt.Errorf("message cosmos.simpleswap.v1.GenesisState does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.LiquidityProvider does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2091,17 +2727,21 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_LiquidityProvider) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.simpleswap.v1.GenesisState.pool":
-		x.Pool = value.Message().Interface().(*Pool)
-	case "cosmos.simpleswap.v1.GenesisState.params":
-		x.Params = value.Message().Interface().(*Params)
+	case "cosmos.simpleswap.v1.LiquidityProvider.stableCoin":
+		x.StableCoin = value.Message().Interface().(*v1beta1.Coin)
+	case "cosmos.simpleswap.v1.LiquidityProvider.poolShare":
+		x.PoolShare = value.Message().Interface().(*v1beta1.Coin)
+	case "cosmos.simpleswap.v1.LiquidityProvider.accruedFees":
+		x.AccruedFees = value.Int()
+	case "cosmos.simpleswap.v1.LiquidityProvider.globallyAccruedFees":
+		x.GloballyAccruedFees = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.GenesisState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.LiquidityProvider"))
 		}
-		panic(fmt.Errorf("message cosmos.simpleswap.v1.GenesisState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.LiquidityProvider does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2115,52 +2755,60 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LiquidityProvider) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.simpleswap.v1.GenesisState.pool":
-		if x.Pool == nil {
-			x.Pool = new(Pool)
+	case "cosmos.simpleswap.v1.LiquidityProvider.stableCoin":
+		if x.StableCoin == nil {
+			x.StableCoin = new(v1beta1.Coin)
 		}
-		return protoreflect.ValueOfMessage(x.Pool.ProtoReflect())
-	case "cosmos.simpleswap.v1.GenesisState.params":
-		if x.Params == nil {
-			x.Params = new(Params)
+		return protoreflect.ValueOfMessage(x.StableCoin.ProtoReflect())
+	case "cosmos.simpleswap.v1.LiquidityProvider.poolShare":
+		if x.PoolShare == nil {
+			x.PoolShare = new(v1beta1.Coin)
 		}
-		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.PoolShare.ProtoReflect())
+	case "cosmos.simpleswap.v1.LiquidityProvider.accruedFees":
+		panic(fmt.Errorf("field accruedFees of message cosmos.simpleswap.v1.LiquidityProvider is not mutable"))
+	case "cosmos.simpleswap.v1.LiquidityProvider.globallyAccruedFees":
+		panic(fmt.Errorf("field globallyAccruedFees of message cosmos.simpleswap.v1.LiquidityProvider is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.GenesisState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.LiquidityProvider"))
 		}
-		panic(fmt.Errorf("message cosmos.simpleswap.v1.GenesisState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.LiquidityProvider does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LiquidityProvider) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.simpleswap.v1.GenesisState.pool":
-		m := new(Pool)
+	case "cosmos.simpleswap.v1.LiquidityProvider.stableCoin":
+		m := new(v1beta1.Coin)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.simpleswap.v1.GenesisState.params":
-		m := new(Params)
+	case "cosmos.simpleswap.v1.LiquidityProvider.poolShare":
+		m := new(v1beta1.Coin)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.simpleswap.v1.LiquidityProvider.accruedFees":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.simpleswap.v1.LiquidityProvider.globallyAccruedFees":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.GenesisState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.LiquidityProvider"))
 		}
-		panic(fmt.Errorf("message cosmos.simpleswap.v1.GenesisState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.LiquidityProvider does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_LiquidityProvider) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.simpleswap.v1.GenesisState", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.simpleswap.v1.LiquidityProvider", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2168,7 +2816,7 @@ func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_LiquidityProvider) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2179,7 +2827,7 @@ func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_LiquidityProvider) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2191,7 +2839,7 @@ func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_GenesisState) IsValid() bool {
+func (x *fastReflection_LiquidityProvider) IsValid() bool {
 	return x != nil
 }
 
@@ -2201,9 +2849,9 @@ func (x *fastReflection_GenesisState) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_LiquidityProvider) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GenesisState)
+		x := input.Message.Interface().(*LiquidityProvider)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2215,14 +2863,20 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Pool != nil {
-			l = options.Size(x.Pool)
+		if x.StableCoin != nil {
+			l = options.Size(x.StableCoin)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Params != nil {
-			l = options.Size(x.Params)
+		if x.PoolShare != nil {
+			l = options.Size(x.PoolShare)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.AccruedFees != 0 {
+			n += 1 + runtime.Sov(uint64(x.AccruedFees))
+		}
+		if x.GloballyAccruedFees != 0 {
+			n += 1 + runtime.Sov(uint64(x.GloballyAccruedFees))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2233,7 +2887,7 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
+		x := input.Message.Interface().(*LiquidityProvider)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2252,22 +2906,32 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
+		if x.GloballyAccruedFees != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GloballyAccruedFees))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.AccruedFees != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AccruedFees))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.PoolShare != nil {
+			encoded, err := options.Marshal(x.PoolShare)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if x.Pool != nil {
-			encoded, err := options.Marshal(x.Pool)
+		if x.StableCoin != nil {
+			encoded, err := options.Marshal(x.StableCoin)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2291,7 +2955,7 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
+		x := input.Message.Interface().(*LiquidityProvider)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2323,15 +2987,15 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LiquidityProvider: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LiquidityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StableCoin", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -2358,16 +3022,16 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pool == nil {
-					x.Pool = &Pool{}
+				if x.StableCoin == nil {
+					x.StableCoin = &v1beta1.Coin{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pool); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StableCoin); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolShare", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -2394,13 +3058,51 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
+				if x.PoolShare == nil {
+					x.PoolShare = &v1beta1.Coin{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PoolShare); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccruedFees", wireType)
+				}
+				x.AccruedFees = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AccruedFees |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GloballyAccruedFees", wireType)
+				}
+				x.GloballyAccruedFees = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GloballyAccruedFees |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2420,263 +3122,12681 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			}
 		}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Pool                   protoreflect.MessageDescriptor
+	fd_Pool_totalAccruedFees  protoreflect.FieldDescriptor
+	fd_Pool_totalLiquidity    protoreflect.FieldDescriptor
+	fd_Pool_decimals          protoreflect.FieldDescriptor
+	fd_Pool_shareToken        protoreflect.FieldDescriptor
+	fd_Pool_swapFeePercentage protoreflect.FieldDescriptor
+	fd_Pool_id                protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_simpleswap_v1_types_proto_init()
+	md_Pool = File_cosmos_simpleswap_v1_types_proto.Messages().ByName("Pool")
+	fd_Pool_totalAccruedFees = md_Pool.Fields().ByName("totalAccruedFees")
+	fd_Pool_totalLiquidity = md_Pool.Fields().ByName("totalLiquidity")
+	fd_Pool_decimals = md_Pool.Fields().ByName("decimals")
+	fd_Pool_shareToken = md_Pool.Fields().ByName("shareToken")
+	fd_Pool_swapFeePercentage = md_Pool.Fields().ByName("swapFeePercentage")
+	fd_Pool_id = md_Pool.Fields().ByName("id")
+}
+
+var _ protoreflect.Message = (*fastReflection_Pool)(nil)
+
+type fastReflection_Pool Pool
+
+func (x *Pool) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Pool)(x)
+}
+
+func (x *Pool) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Pool_messageType fastReflection_Pool_messageType
+var _ protoreflect.MessageType = fastReflection_Pool_messageType{}
+
+type fastReflection_Pool_messageType struct{}
+
+func (x fastReflection_Pool_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Pool)(nil)
+}
+func (x fastReflection_Pool_messageType) New() protoreflect.Message {
+	return new(fastReflection_Pool)
+}
+func (x fastReflection_Pool_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Pool
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Pool) Descriptor() protoreflect.MessageDescriptor {
+	return md_Pool
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Pool) Type() protoreflect.MessageType {
+	return _fastReflection_Pool_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Pool) New() protoreflect.Message {
+	return new(fastReflection_Pool)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Pool) Interface() protoreflect.ProtoMessage {
+	return (*Pool)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Pool) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TotalAccruedFees != int64(0) {
+		value := protoreflect.ValueOfInt64(x.TotalAccruedFees)
+		if !f(fd_Pool_totalAccruedFees, value) {
+			return
+		}
+	}
+	if x.TotalLiquidity != int64(0) {
+		value := protoreflect.ValueOfInt64(x.TotalLiquidity)
+		if !f(fd_Pool_totalLiquidity, value) {
+			return
+		}
+	}
+	if x.Decimals != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Decimals)
+		if !f(fd_Pool_decimals, value) {
+			return
+		}
+	}
+	if x.ShareToken != nil {
+		value := protoreflect.ValueOfMessage(x.ShareToken.ProtoReflect())
+		if !f(fd_Pool_shareToken, value) {
+			return
+		}
+	}
+	if x.SwapFeePercentage != int32(0) {
+		value := protoreflect.ValueOfInt32(x.SwapFeePercentage)
+		if !f(fd_Pool_swapFeePercentage, value) {
+			return
+		}
+	}
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_Pool_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Pool) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.Pool.totalAccruedFees":
+		return x.TotalAccruedFees != int64(0)
+	case "cosmos.simpleswap.v1.Pool.totalLiquidity":
+		return x.TotalLiquidity != int64(0)
+	case "cosmos.simpleswap.v1.Pool.decimals":
+		return x.Decimals != int64(0)
+	case "cosmos.simpleswap.v1.Pool.shareToken":
+		return x.ShareToken != nil
+	case "cosmos.simpleswap.v1.Pool.swapFeePercentage":
+		return x.SwapFeePercentage != int32(0)
+	case "cosmos.simpleswap.v1.Pool.id":
+		return x.Id != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.Pool"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.Pool does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Pool) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.Pool.totalAccruedFees":
+		x.TotalAccruedFees = int64(0)
+	case "cosmos.simpleswap.v1.Pool.totalLiquidity":
+		x.TotalLiquidity = int64(0)
+	case "cosmos.simpleswap.v1.Pool.decimals":
+		x.Decimals = int64(0)
+	case "cosmos.simpleswap.v1.Pool.shareToken":
+		x.ShareToken = nil
+	case "cosmos.simpleswap.v1.Pool.swapFeePercentage":
+		x.SwapFeePercentage = int32(0)
+	case "cosmos.simpleswap.v1.Pool.id":
+		x.Id = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.Pool"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.Pool does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Pool) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.simpleswap.v1.Pool.totalAccruedFees":
+		value := x.TotalAccruedFees
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.simpleswap.v1.Pool.totalLiquidity":
+		value := x.TotalLiquidity
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.simpleswap.v1.Pool.decimals":
+		value := x.Decimals
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.simpleswap.v1.Pool.shareToken":
+		value := x.ShareToken
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.simpleswap.v1.Pool.swapFeePercentage":
+		value := x.SwapFeePercentage
+		return protoreflect.ValueOfInt32(value)
+	case "cosmos.simpleswap.v1.Pool.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.Pool"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.Pool does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Pool) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.Pool.totalAccruedFees":
+		x.TotalAccruedFees = value.Int()
+	case "cosmos.simpleswap.v1.Pool.totalLiquidity":
+		x.TotalLiquidity = value.Int()
+	case "cosmos.simpleswap.v1.Pool.decimals":
+		x.Decimals = value.Int()
+	case "cosmos.simpleswap.v1.Pool.shareToken":
+		x.ShareToken = value.Message().Interface().(*v1beta1.Coin)
+	case "cosmos.simpleswap.v1.Pool.swapFeePercentage":
+		x.SwapFeePercentage = int32(value.Int())
+	case "cosmos.simpleswap.v1.Pool.id":
+		x.Id = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.Pool"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.Pool does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Pool) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.Pool.shareToken":
+		if x.ShareToken == nil {
+			x.ShareToken = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.ShareToken.ProtoReflect())
+	case "cosmos.simpleswap.v1.Pool.totalAccruedFees":
+		panic(fmt.Errorf("field totalAccruedFees of message cosmos.simpleswap.v1.Pool is not mutable"))
+	case "cosmos.simpleswap.v1.Pool.totalLiquidity":
+		panic(fmt.Errorf("field totalLiquidity of message cosmos.simpleswap.v1.Pool is not mutable"))
+	case "cosmos.simpleswap.v1.Pool.decimals":
+		panic(fmt.Errorf("field decimals of message cosmos.simpleswap.v1.Pool is not mutable"))
+	case "cosmos.simpleswap.v1.Pool.swapFeePercentage":
+		panic(fmt.Errorf("field swapFeePercentage of message cosmos.simpleswap.v1.Pool is not mutable"))
+	case "cosmos.simpleswap.v1.Pool.id":
+		panic(fmt.Errorf("field id of message cosmos.simpleswap.v1.Pool is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.Pool"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.Pool does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Pool) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.Pool.totalAccruedFees":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.simpleswap.v1.Pool.totalLiquidity":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.simpleswap.v1.Pool.decimals":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.simpleswap.v1.Pool.shareToken":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.simpleswap.v1.Pool.swapFeePercentage":
+		return protoreflect.ValueOfInt32(int32(0))
+	case "cosmos.simpleswap.v1.Pool.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.Pool"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.Pool does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Pool) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.simpleswap.v1.Pool", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Pool) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Pool) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Pool) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Pool)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TotalAccruedFees != 0 {
+			n += 1 + runtime.Sov(uint64(x.TotalAccruedFees))
+		}
+		if x.TotalLiquidity != 0 {
+			n += 1 + runtime.Sov(uint64(x.TotalLiquidity))
+		}
+		if x.Decimals != 0 {
+			n += 1 + runtime.Sov(uint64(x.Decimals))
+		}
+		if x.ShareToken != nil {
+			l = options.Size(x.ShareToken)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SwapFeePercentage != 0 {
+			n += 1 + runtime.Sov(uint64(x.SwapFeePercentage))
+		}
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Pool)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.SwapFeePercentage != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SwapFeePercentage))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.ShareToken != nil {
+			encoded, err := options.Marshal(x.ShareToken)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Decimals != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Decimals))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.TotalLiquidity != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalLiquidity))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.TotalAccruedFees != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalAccruedFees))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Pool)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Pool: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalAccruedFees", wireType)
+				}
+				x.TotalAccruedFees = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TotalAccruedFees |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidity", wireType)
+				}
+				x.TotalLiquidity = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TotalLiquidity |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+				}
+				x.Decimals = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Decimals |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ShareToken", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ShareToken == nil {
+					x.ShareToken = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ShareToken); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SwapFeePercentage", wireType)
+				}
+				x.SwapFeePercentage = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SwapFeePercentage |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Gauge_4_list)(nil)
+
+type _Gauge_4_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Gauge_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Gauge_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Gauge_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Gauge_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Gauge_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Gauge_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Gauge_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Gauge_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Gauge_5_list)(nil)
+
+type _Gauge_5_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Gauge_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Gauge_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Gauge_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Gauge_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Gauge_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Gauge_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Gauge_5_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Gauge_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Gauge                  protoreflect.MessageDescriptor
+	fd_Gauge_id               protoreflect.FieldDescriptor
+	fd_Gauge_poolId           protoreflect.FieldDescriptor
+	fd_Gauge_owner            protoreflect.FieldDescriptor
+	fd_Gauge_coins            protoreflect.FieldDescriptor
+	fd_Gauge_distributedCoins protoreflect.FieldDescriptor
+	fd_Gauge_numEpochs        protoreflect.FieldDescriptor
+	fd_Gauge_filledEpochs     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_simpleswap_v1_types_proto_init()
+	md_Gauge = File_cosmos_simpleswap_v1_types_proto.Messages().ByName("Gauge")
+	fd_Gauge_id = md_Gauge.Fields().ByName("id")
+	fd_Gauge_poolId = md_Gauge.Fields().ByName("poolId")
+	fd_Gauge_owner = md_Gauge.Fields().ByName("owner")
+	fd_Gauge_coins = md_Gauge.Fields().ByName("coins")
+	fd_Gauge_distributedCoins = md_Gauge.Fields().ByName("distributedCoins")
+	fd_Gauge_numEpochs = md_Gauge.Fields().ByName("numEpochs")
+	fd_Gauge_filledEpochs = md_Gauge.Fields().ByName("filledEpochs")
+}
+
+var _ protoreflect.Message = (*fastReflection_Gauge)(nil)
+
+type fastReflection_Gauge Gauge
+
+func (x *Gauge) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Gauge)(x)
+}
+
+func (x *Gauge) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Gauge_messageType fastReflection_Gauge_messageType
+var _ protoreflect.MessageType = fastReflection_Gauge_messageType{}
+
+type fastReflection_Gauge_messageType struct{}
+
+func (x fastReflection_Gauge_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Gauge)(nil)
+}
+func (x fastReflection_Gauge_messageType) New() protoreflect.Message {
+	return new(fastReflection_Gauge)
+}
+func (x fastReflection_Gauge_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Gauge
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Gauge) Descriptor() protoreflect.MessageDescriptor {
+	return md_Gauge
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Gauge) Type() protoreflect.MessageType {
+	return _fastReflection_Gauge_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Gauge) New() protoreflect.Message {
+	return new(fastReflection_Gauge)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Gauge) Interface() protoreflect.ProtoMessage {
+	return (*Gauge)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Gauge) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_Gauge_id, value) {
+			return
+		}
+	}
+	if x.PoolId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PoolId)
+		if !f(fd_Gauge_poolId, value) {
+			return
+		}
+	}
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_Gauge_owner, value) {
+			return
+		}
+	}
+	if len(x.Coins) != 0 {
+		value := protoreflect.ValueOfList(&_Gauge_4_list{list: &x.Coins})
+		if !f(fd_Gauge_coins, value) {
+			return
+		}
+	}
+	if len(x.DistributedCoins) != 0 {
+		value := protoreflect.ValueOfList(&_Gauge_5_list{list: &x.DistributedCoins})
+		if !f(fd_Gauge_distributedCoins, value) {
+			return
+		}
+	}
+	if x.NumEpochs != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NumEpochs)
+		if !f(fd_Gauge_numEpochs, value) {
+			return
+		}
+	}
+	if x.FilledEpochs != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.FilledEpochs)
+		if !f(fd_Gauge_filledEpochs, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Gauge) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.Gauge.id":
+		return x.Id != uint64(0)
+	case "cosmos.simpleswap.v1.Gauge.poolId":
+		return x.PoolId != uint64(0)
+	case "cosmos.simpleswap.v1.Gauge.owner":
+		return x.Owner != ""
+	case "cosmos.simpleswap.v1.Gauge.coins":
+		return len(x.Coins) != 0
+	case "cosmos.simpleswap.v1.Gauge.distributedCoins":
+		return len(x.DistributedCoins) != 0
+	case "cosmos.simpleswap.v1.Gauge.numEpochs":
+		return x.NumEpochs != uint64(0)
+	case "cosmos.simpleswap.v1.Gauge.filledEpochs":
+		return x.FilledEpochs != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.Gauge"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.Gauge does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Gauge) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.Gauge.id":
+		x.Id = uint64(0)
+	case "cosmos.simpleswap.v1.Gauge.poolId":
+		x.PoolId = uint64(0)
+	case "cosmos.simpleswap.v1.Gauge.owner":
+		x.Owner = ""
+	case "cosmos.simpleswap.v1.Gauge.coins":
+		x.Coins = nil
+	case "cosmos.simpleswap.v1.Gauge.distributedCoins":
+		x.DistributedCoins = nil
+	case "cosmos.simpleswap.v1.Gauge.numEpochs":
+		x.NumEpochs = uint64(0)
+	case "cosmos.simpleswap.v1.Gauge.filledEpochs":
+		x.FilledEpochs = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.Gauge"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.Gauge does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Gauge) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.simpleswap.v1.Gauge.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.simpleswap.v1.Gauge.poolId":
+		value := x.PoolId
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.simpleswap.v1.Gauge.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "cosmos.simpleswap.v1.Gauge.coins":
+		if len(x.Coins) == 0 {
+			return protoreflect.ValueOfList(&_Gauge_4_list{})
+		}
+		listValue := &_Gauge_4_list{list: &x.Coins}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.simpleswap.v1.Gauge.distributedCoins":
+		if len(x.DistributedCoins) == 0 {
+			return protoreflect.ValueOfList(&_Gauge_5_list{})
+		}
+		listValue := &_Gauge_5_list{list: &x.DistributedCoins}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.simpleswap.v1.Gauge.numEpochs":
+		value := x.NumEpochs
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.simpleswap.v1.Gauge.filledEpochs":
+		value := x.FilledEpochs
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.Gauge"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.Gauge does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Gauge) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.Gauge.id":
+		x.Id = value.Uint()
+	case "cosmos.simpleswap.v1.Gauge.poolId":
+		x.PoolId = value.Uint()
+	case "cosmos.simpleswap.v1.Gauge.owner":
+		x.Owner = value.Interface().(string)
+	case "cosmos.simpleswap.v1.Gauge.coins":
+		lv := value.List()
+		clv := lv.(*_Gauge_4_list)
+		x.Coins = *clv.list
+	case "cosmos.simpleswap.v1.Gauge.distributedCoins":
+		lv := value.List()
+		clv := lv.(*_Gauge_5_list)
+		x.DistributedCoins = *clv.list
+	case "cosmos.simpleswap.v1.Gauge.numEpochs":
+		x.NumEpochs = value.Uint()
+	case "cosmos.simpleswap.v1.Gauge.filledEpochs":
+		x.FilledEpochs = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.Gauge"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.Gauge does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Gauge) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.Gauge.coins":
+		if x.Coins == nil {
+			x.Coins = []*v1beta1.Coin{}
+		}
+		value := &_Gauge_4_list{list: &x.Coins}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.simpleswap.v1.Gauge.distributedCoins":
+		if x.DistributedCoins == nil {
+			x.DistributedCoins = []*v1beta1.Coin{}
+		}
+		value := &_Gauge_5_list{list: &x.DistributedCoins}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.simpleswap.v1.Gauge.id":
+		panic(fmt.Errorf("field id of message cosmos.simpleswap.v1.Gauge is not mutable"))
+	case "cosmos.simpleswap.v1.Gauge.poolId":
+		panic(fmt.Errorf("field poolId of message cosmos.simpleswap.v1.Gauge is not mutable"))
+	case "cosmos.simpleswap.v1.Gauge.owner":
+		panic(fmt.Errorf("field owner of message cosmos.simpleswap.v1.Gauge is not mutable"))
+	case "cosmos.simpleswap.v1.Gauge.numEpochs":
+		panic(fmt.Errorf("field numEpochs of message cosmos.simpleswap.v1.Gauge is not mutable"))
+	case "cosmos.simpleswap.v1.Gauge.filledEpochs":
+		panic(fmt.Errorf("field filledEpochs of message cosmos.simpleswap.v1.Gauge is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.Gauge"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.Gauge does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Gauge) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.Gauge.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.simpleswap.v1.Gauge.poolId":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.simpleswap.v1.Gauge.owner":
+		return protoreflect.ValueOfString("")
+	case "cosmos.simpleswap.v1.Gauge.coins":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Gauge_4_list{list: &list})
+	case "cosmos.simpleswap.v1.Gauge.distributedCoins":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Gauge_5_list{list: &list})
+	case "cosmos.simpleswap.v1.Gauge.numEpochs":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.simpleswap.v1.Gauge.filledEpochs":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.Gauge"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.Gauge does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Gauge) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.simpleswap.v1.Gauge", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Gauge) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Gauge) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Gauge) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Gauge) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Gauge)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.PoolId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PoolId))
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Coins) > 0 {
+			for _, e := range x.Coins {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DistributedCoins) > 0 {
+			for _, e := range x.DistributedCoins {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NumEpochs != 0 {
+			n += 1 + runtime.Sov(uint64(x.NumEpochs))
+		}
+		if x.FilledEpochs != 0 {
+			n += 1 + runtime.Sov(uint64(x.FilledEpochs))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Gauge)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.FilledEpochs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FilledEpochs))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.NumEpochs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NumEpochs))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.DistributedCoins) > 0 {
+			for iNdEx := len(x.DistributedCoins) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DistributedCoins[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.Coins) > 0 {
+			for iNdEx := len(x.Coins) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Coins[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.PoolId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PoolId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Gauge)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Gauge: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				}
+				x.PoolId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PoolId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Coins = append(x.Coins, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Coins[len(x.Coins)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DistributedCoins", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DistributedCoins = append(x.DistributedCoins, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DistributedCoins[len(x.DistributedCoins)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumEpochs", wireType)
+				}
+				x.NumEpochs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NumEpochs |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FilledEpochs", wireType)
+				}
+				x.FilledEpochs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FilledEpochs |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Rewards_1_list)(nil)
+
+type _Rewards_1_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Rewards_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Rewards_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Rewards_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Rewards_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Rewards_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Rewards_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Rewards_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Rewards_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Rewards_3_list)(nil)
+
+type _Rewards_3_list struct {
+	list *[]*v1beta1.DecCoin
+}
+
+func (x *_Rewards_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Rewards_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Rewards_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Rewards_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Rewards_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Rewards_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Rewards_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Rewards_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Rewards                  protoreflect.MessageDescriptor
+	fd_Rewards_coins            protoreflect.FieldDescriptor
+	fd_Rewards_weight           protoreflect.FieldDescriptor
+	fd_Rewards_rewardsPerWeight protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_simpleswap_v1_types_proto_init()
+	md_Rewards = File_cosmos_simpleswap_v1_types_proto.Messages().ByName("Rewards")
+	fd_Rewards_coins = md_Rewards.Fields().ByName("coins")
+	fd_Rewards_weight = md_Rewards.Fields().ByName("weight")
+	fd_Rewards_rewardsPerWeight = md_Rewards.Fields().ByName("rewardsPerWeight")
+}
+
+var _ protoreflect.Message = (*fastReflection_Rewards)(nil)
+
+type fastReflection_Rewards Rewards
+
+func (x *Rewards) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Rewards)(x)
+}
+
+func (x *Rewards) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Rewards_messageType fastReflection_Rewards_messageType
+var _ protoreflect.MessageType = fastReflection_Rewards_messageType{}
+
+type fastReflection_Rewards_messageType struct{}
+
+func (x fastReflection_Rewards_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Rewards)(nil)
+}
+func (x fastReflection_Rewards_messageType) New() protoreflect.Message {
+	return new(fastReflection_Rewards)
+}
+func (x fastReflection_Rewards_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Rewards
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Rewards) Descriptor() protoreflect.MessageDescriptor {
+	return md_Rewards
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Rewards) Type() protoreflect.MessageType {
+	return _fastReflection_Rewards_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Rewards) New() protoreflect.Message {
+	return new(fastReflection_Rewards)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Rewards) Interface() protoreflect.ProtoMessage {
+	return (*Rewards)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Rewards) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Coins) != 0 {
+		value := protoreflect.ValueOfList(&_Rewards_1_list{list: &x.Coins})
+		if !f(fd_Rewards_coins, value) {
+			return
+		}
+	}
+	if x.Weight != "" {
+		value := protoreflect.ValueOfString(x.Weight)
+		if !f(fd_Rewards_weight, value) {
+			return
+		}
+	}
+	if len(x.RewardsPerWeight) != 0 {
+		value := protoreflect.ValueOfList(&_Rewards_3_list{list: &x.RewardsPerWeight})
+		if !f(fd_Rewards_rewardsPerWeight, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Rewards) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.Rewards.coins":
+		return len(x.Coins) != 0
+	case "cosmos.simpleswap.v1.Rewards.weight":
+		return x.Weight != ""
+	case "cosmos.simpleswap.v1.Rewards.rewardsPerWeight":
+		return len(x.RewardsPerWeight) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.Rewards"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.Rewards does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Rewards) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.Rewards.coins":
+		x.Coins = nil
+	case "cosmos.simpleswap.v1.Rewards.weight":
+		x.Weight = ""
+	case "cosmos.simpleswap.v1.Rewards.rewardsPerWeight":
+		x.RewardsPerWeight = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.Rewards"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.Rewards does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Rewards) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.simpleswap.v1.Rewards.coins":
+		if len(x.Coins) == 0 {
+			return protoreflect.ValueOfList(&_Rewards_1_list{})
+		}
+		listValue := &_Rewards_1_list{list: &x.Coins}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.simpleswap.v1.Rewards.weight":
+		value := x.Weight
+		return protoreflect.ValueOfString(value)
+	case "cosmos.simpleswap.v1.Rewards.rewardsPerWeight":
+		if len(x.RewardsPerWeight) == 0 {
+			return protoreflect.ValueOfList(&_Rewards_3_list{})
+		}
+		listValue := &_Rewards_3_list{list: &x.RewardsPerWeight}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.Rewards"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.Rewards does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Rewards) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.Rewards.coins":
+		lv := value.List()
+		clv := lv.(*_Rewards_1_list)
+		x.Coins = *clv.list
+	case "cosmos.simpleswap.v1.Rewards.weight":
+		x.Weight = value.Interface().(string)
+	case "cosmos.simpleswap.v1.Rewards.rewardsPerWeight":
+		lv := value.List()
+		clv := lv.(*_Rewards_3_list)
+		x.RewardsPerWeight = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.Rewards"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.Rewards does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Rewards) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.Rewards.coins":
+		if x.Coins == nil {
+			x.Coins = []*v1beta1.Coin{}
+		}
+		value := &_Rewards_1_list{list: &x.Coins}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.simpleswap.v1.Rewards.rewardsPerWeight":
+		if x.RewardsPerWeight == nil {
+			x.RewardsPerWeight = []*v1beta1.DecCoin{}
+		}
+		value := &_Rewards_3_list{list: &x.RewardsPerWeight}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.simpleswap.v1.Rewards.weight":
+		panic(fmt.Errorf("field weight of message cosmos.simpleswap.v1.Rewards is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.Rewards"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.Rewards does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Rewards) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.Rewards.coins":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Rewards_1_list{list: &list})
+	case "cosmos.simpleswap.v1.Rewards.weight":
+		return protoreflect.ValueOfString("")
+	case "cosmos.simpleswap.v1.Rewards.rewardsPerWeight":
+		list := []*v1beta1.DecCoin{}
+		return protoreflect.ValueOfList(&_Rewards_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.Rewards"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.Rewards does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Rewards) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.simpleswap.v1.Rewards", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Rewards) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Rewards) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Rewards) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Rewards) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Rewards)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Coins) > 0 {
+			for _, e := range x.Coins {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Weight)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.RewardsPerWeight) > 0 {
+			for _, e := range x.RewardsPerWeight {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Rewards)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RewardsPerWeight) > 0 {
+			for iNdEx := len(x.RewardsPerWeight) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RewardsPerWeight[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Weight) > 0 {
+			i -= len(x.Weight)
+			copy(dAtA[i:], x.Weight)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Weight)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Coins) > 0 {
+			for iNdEx := len(x.Coins) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Coins[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Rewards)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Rewards: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Rewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Coins = append(x.Coins, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Coins[len(x.Coins)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Weight = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardsPerWeight", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RewardsPerWeight = append(x.RewardsPerWeight, &v1beta1.DecCoin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RewardsPerWeight[len(x.RewardsPerWeight)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_RewardAccumulator_2_list)(nil)
+
+type _RewardAccumulator_2_list struct {
+	list *[]*v1beta1.DecCoin
+}
+
+func (x *_RewardAccumulator_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_RewardAccumulator_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_RewardAccumulator_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_RewardAccumulator_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_RewardAccumulator_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RewardAccumulator_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_RewardAccumulator_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RewardAccumulator_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_RewardAccumulator                  protoreflect.MessageDescriptor
+	fd_RewardAccumulator_totalWeight      protoreflect.FieldDescriptor
+	fd_RewardAccumulator_rewardsPerWeight protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_simpleswap_v1_types_proto_init()
+	md_RewardAccumulator = File_cosmos_simpleswap_v1_types_proto.Messages().ByName("RewardAccumulator")
+	fd_RewardAccumulator_totalWeight = md_RewardAccumulator.Fields().ByName("totalWeight")
+	fd_RewardAccumulator_rewardsPerWeight = md_RewardAccumulator.Fields().ByName("rewardsPerWeight")
+}
+
+var _ protoreflect.Message = (*fastReflection_RewardAccumulator)(nil)
+
+type fastReflection_RewardAccumulator RewardAccumulator
+
+func (x *RewardAccumulator) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RewardAccumulator)(x)
+}
+
+func (x *RewardAccumulator) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RewardAccumulator_messageType fastReflection_RewardAccumulator_messageType
+var _ protoreflect.MessageType = fastReflection_RewardAccumulator_messageType{}
+
+type fastReflection_RewardAccumulator_messageType struct{}
+
+func (x fastReflection_RewardAccumulator_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RewardAccumulator)(nil)
+}
+func (x fastReflection_RewardAccumulator_messageType) New() protoreflect.Message {
+	return new(fastReflection_RewardAccumulator)
+}
+func (x fastReflection_RewardAccumulator_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RewardAccumulator
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RewardAccumulator) Descriptor() protoreflect.MessageDescriptor {
+	return md_RewardAccumulator
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RewardAccumulator) Type() protoreflect.MessageType {
+	return _fastReflection_RewardAccumulator_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RewardAccumulator) New() protoreflect.Message {
+	return new(fastReflection_RewardAccumulator)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RewardAccumulator) Interface() protoreflect.ProtoMessage {
+	return (*RewardAccumulator)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RewardAccumulator) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TotalWeight != "" {
+		value := protoreflect.ValueOfString(x.TotalWeight)
+		if !f(fd_RewardAccumulator_totalWeight, value) {
+			return
+		}
+	}
+	if len(x.RewardsPerWeight) != 0 {
+		value := protoreflect.ValueOfList(&_RewardAccumulator_2_list{list: &x.RewardsPerWeight})
+		if !f(fd_RewardAccumulator_rewardsPerWeight, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RewardAccumulator) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.RewardAccumulator.totalWeight":
+		return x.TotalWeight != ""
+	case "cosmos.simpleswap.v1.RewardAccumulator.rewardsPerWeight":
+		return len(x.RewardsPerWeight) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.RewardAccumulator"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.RewardAccumulator does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RewardAccumulator) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.RewardAccumulator.totalWeight":
+		x.TotalWeight = ""
+	case "cosmos.simpleswap.v1.RewardAccumulator.rewardsPerWeight":
+		x.RewardsPerWeight = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.RewardAccumulator"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.RewardAccumulator does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RewardAccumulator) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.simpleswap.v1.RewardAccumulator.totalWeight":
+		value := x.TotalWeight
+		return protoreflect.ValueOfString(value)
+	case "cosmos.simpleswap.v1.RewardAccumulator.rewardsPerWeight":
+		if len(x.RewardsPerWeight) == 0 {
+			return protoreflect.ValueOfList(&_RewardAccumulator_2_list{})
+		}
+		listValue := &_RewardAccumulator_2_list{list: &x.RewardsPerWeight}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.RewardAccumulator"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.RewardAccumulator does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RewardAccumulator) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.RewardAccumulator.totalWeight":
+		x.TotalWeight = value.Interface().(string)
+	case "cosmos.simpleswap.v1.RewardAccumulator.rewardsPerWeight":
+		lv := value.List()
+		clv := lv.(*_RewardAccumulator_2_list)
+		x.RewardsPerWeight = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.RewardAccumulator"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.RewardAccumulator does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RewardAccumulator) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.RewardAccumulator.rewardsPerWeight":
+		if x.RewardsPerWeight == nil {
+			x.RewardsPerWeight = []*v1beta1.DecCoin{}
+		}
+		value := &_RewardAccumulator_2_list{list: &x.RewardsPerWeight}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.simpleswap.v1.RewardAccumulator.totalWeight":
+		panic(fmt.Errorf("field totalWeight of message cosmos.simpleswap.v1.RewardAccumulator is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.RewardAccumulator"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.RewardAccumulator does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RewardAccumulator) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.RewardAccumulator.totalWeight":
+		return protoreflect.ValueOfString("")
+	case "cosmos.simpleswap.v1.RewardAccumulator.rewardsPerWeight":
+		list := []*v1beta1.DecCoin{}
+		return protoreflect.ValueOfList(&_RewardAccumulator_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.RewardAccumulator"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.RewardAccumulator does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RewardAccumulator) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.simpleswap.v1.RewardAccumulator", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RewardAccumulator) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RewardAccumulator) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RewardAccumulator) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RewardAccumulator) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RewardAccumulator)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.TotalWeight)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.RewardsPerWeight) > 0 {
+			for _, e := range x.RewardsPerWeight {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RewardAccumulator)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RewardsPerWeight) > 0 {
+			for iNdEx := len(x.RewardsPerWeight) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RewardsPerWeight[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.TotalWeight) > 0 {
+			i -= len(x.TotalWeight)
+			copy(dAtA[i:], x.TotalWeight)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalWeight)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RewardAccumulator)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RewardAccumulator: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RewardAccumulator: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalWeight", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalWeight = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardsPerWeight", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RewardsPerWeight = append(x.RewardsPerWeight, &v1beta1.DecCoin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RewardsPerWeight[len(x.RewardsPerWeight)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_DenomOrigin        protoreflect.MessageDescriptor
+	fd_DenomOrigin_denom  protoreflect.FieldDescriptor
+	fd_DenomOrigin_origin protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_simpleswap_v1_types_proto_init()
+	md_DenomOrigin = File_cosmos_simpleswap_v1_types_proto.Messages().ByName("DenomOrigin")
+	fd_DenomOrigin_denom = md_DenomOrigin.Fields().ByName("denom")
+	fd_DenomOrigin_origin = md_DenomOrigin.Fields().ByName("origin")
+}
+
+var _ protoreflect.Message = (*fastReflection_DenomOrigin)(nil)
+
+type fastReflection_DenomOrigin DenomOrigin
+
+func (x *DenomOrigin) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DenomOrigin)(x)
+}
+
+func (x *DenomOrigin) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DenomOrigin_messageType fastReflection_DenomOrigin_messageType
+var _ protoreflect.MessageType = fastReflection_DenomOrigin_messageType{}
+
+type fastReflection_DenomOrigin_messageType struct{}
+
+func (x fastReflection_DenomOrigin_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DenomOrigin)(nil)
+}
+func (x fastReflection_DenomOrigin_messageType) New() protoreflect.Message {
+	return new(fastReflection_DenomOrigin)
+}
+func (x fastReflection_DenomOrigin_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DenomOrigin
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DenomOrigin) Descriptor() protoreflect.MessageDescriptor {
+	return md_DenomOrigin
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DenomOrigin) Type() protoreflect.MessageType {
+	return _fastReflection_DenomOrigin_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DenomOrigin) New() protoreflect.Message {
+	return new(fastReflection_DenomOrigin)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DenomOrigin) Interface() protoreflect.ProtoMessage {
+	return (*DenomOrigin)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DenomOrigin) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_DenomOrigin_denom, value) {
+			return
+		}
+	}
+	if x.Origin != "" {
+		value := protoreflect.ValueOfString(x.Origin)
+		if !f(fd_DenomOrigin_origin, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DenomOrigin) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.DenomOrigin.denom":
+		return x.Denom != ""
+	case "cosmos.simpleswap.v1.DenomOrigin.origin":
+		return x.Origin != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.DenomOrigin"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.DenomOrigin does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenomOrigin) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.DenomOrigin.denom":
+		x.Denom = ""
+	case "cosmos.simpleswap.v1.DenomOrigin.origin":
+		x.Origin = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.DenomOrigin"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.DenomOrigin does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DenomOrigin) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.simpleswap.v1.DenomOrigin.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "cosmos.simpleswap.v1.DenomOrigin.origin":
+		value := x.Origin
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.DenomOrigin"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.DenomOrigin does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenomOrigin) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.DenomOrigin.denom":
+		x.Denom = value.Interface().(string)
+	case "cosmos.simpleswap.v1.DenomOrigin.origin":
+		x.Origin = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.DenomOrigin"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.DenomOrigin does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenomOrigin) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.DenomOrigin.denom":
+		panic(fmt.Errorf("field denom of message cosmos.simpleswap.v1.DenomOrigin is not mutable"))
+	case "cosmos.simpleswap.v1.DenomOrigin.origin":
+		panic(fmt.Errorf("field origin of message cosmos.simpleswap.v1.DenomOrigin is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.DenomOrigin"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.DenomOrigin does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DenomOrigin) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.DenomOrigin.denom":
+		return protoreflect.ValueOfString("")
+	case "cosmos.simpleswap.v1.DenomOrigin.origin":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.DenomOrigin"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.DenomOrigin does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DenomOrigin) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.simpleswap.v1.DenomOrigin", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DenomOrigin) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenomOrigin) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DenomOrigin) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DenomOrigin) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DenomOrigin)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Origin)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DenomOrigin)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Origin) > 0 {
+			i -= len(x.Origin)
+			copy(dAtA[i:], x.Origin)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Origin)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DenomOrigin)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DenomOrigin: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DenomOrigin: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Origin = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_AssetRate       protoreflect.MessageDescriptor
+	fd_AssetRate_denom protoreflect.FieldDescriptor
+	fd_AssetRate_rate  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_simpleswap_v1_types_proto_init()
+	md_AssetRate = File_cosmos_simpleswap_v1_types_proto.Messages().ByName("AssetRate")
+	fd_AssetRate_denom = md_AssetRate.Fields().ByName("denom")
+	fd_AssetRate_rate = md_AssetRate.Fields().ByName("rate")
+}
+
+var _ protoreflect.Message = (*fastReflection_AssetRate)(nil)
+
+type fastReflection_AssetRate AssetRate
+
+func (x *AssetRate) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AssetRate)(x)
+}
+
+func (x *AssetRate) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AssetRate_messageType fastReflection_AssetRate_messageType
+var _ protoreflect.MessageType = fastReflection_AssetRate_messageType{}
+
+type fastReflection_AssetRate_messageType struct{}
+
+func (x fastReflection_AssetRate_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AssetRate)(nil)
+}
+func (x fastReflection_AssetRate_messageType) New() protoreflect.Message {
+	return new(fastReflection_AssetRate)
+}
+func (x fastReflection_AssetRate_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AssetRate
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AssetRate) Descriptor() protoreflect.MessageDescriptor {
+	return md_AssetRate
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AssetRate) Type() protoreflect.MessageType {
+	return _fastReflection_AssetRate_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AssetRate) New() protoreflect.Message {
+	return new(fastReflection_AssetRate)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AssetRate) Interface() protoreflect.ProtoMessage {
+	return (*AssetRate)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AssetRate) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_AssetRate_denom, value) {
+			return
+		}
+	}
+	if x.Rate != "" {
+		value := protoreflect.ValueOfString(x.Rate)
+		if !f(fd_AssetRate_rate, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AssetRate) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.AssetRate.denom":
+		return x.Denom != ""
+	case "cosmos.simpleswap.v1.AssetRate.rate":
+		return x.Rate != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.AssetRate"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.AssetRate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AssetRate) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.AssetRate.denom":
+		x.Denom = ""
+	case "cosmos.simpleswap.v1.AssetRate.rate":
+		x.Rate = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.AssetRate"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.AssetRate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AssetRate) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.simpleswap.v1.AssetRate.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "cosmos.simpleswap.v1.AssetRate.rate":
+		value := x.Rate
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.AssetRate"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.AssetRate does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AssetRate) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.AssetRate.denom":
+		x.Denom = value.Interface().(string)
+	case "cosmos.simpleswap.v1.AssetRate.rate":
+		x.Rate = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.AssetRate"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.AssetRate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AssetRate) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.AssetRate.denom":
+		panic(fmt.Errorf("field denom of message cosmos.simpleswap.v1.AssetRate is not mutable"))
+	case "cosmos.simpleswap.v1.AssetRate.rate":
+		panic(fmt.Errorf("field rate of message cosmos.simpleswap.v1.AssetRate is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.AssetRate"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.AssetRate does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AssetRate) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.AssetRate.denom":
+		return protoreflect.ValueOfString("")
+	case "cosmos.simpleswap.v1.AssetRate.rate":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.AssetRate"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.AssetRate does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AssetRate) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.simpleswap.v1.AssetRate", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AssetRate) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AssetRate) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AssetRate) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AssetRate) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AssetRate)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Rate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AssetRate)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Rate) > 0 {
+			i -= len(x.Rate)
+			copy(dAtA[i:], x.Rate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Rate)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AssetRate)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AssetRate: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AssetRate: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Rate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PeriodLock              protoreflect.MessageDescriptor
+	fd_PeriodLock_id           protoreflect.FieldDescriptor
+	fd_PeriodLock_owner        protoreflect.FieldDescriptor
+	fd_PeriodLock_shares       protoreflect.FieldDescriptor
+	fd_PeriodLock_duration     protoreflect.FieldDescriptor
+	fd_PeriodLock_endTime      protoreflect.FieldDescriptor
+	fd_PeriodLock_rewardWeight protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_simpleswap_v1_types_proto_init()
+	md_PeriodLock = File_cosmos_simpleswap_v1_types_proto.Messages().ByName("PeriodLock")
+	fd_PeriodLock_id = md_PeriodLock.Fields().ByName("id")
+	fd_PeriodLock_owner = md_PeriodLock.Fields().ByName("owner")
+	fd_PeriodLock_shares = md_PeriodLock.Fields().ByName("shares")
+	fd_PeriodLock_duration = md_PeriodLock.Fields().ByName("duration")
+	fd_PeriodLock_endTime = md_PeriodLock.Fields().ByName("endTime")
+	fd_PeriodLock_rewardWeight = md_PeriodLock.Fields().ByName("rewardWeight")
+}
+
+var _ protoreflect.Message = (*fastReflection_PeriodLock)(nil)
+
+type fastReflection_PeriodLock PeriodLock
+
+func (x *PeriodLock) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PeriodLock)(x)
+}
+
+func (x *PeriodLock) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PeriodLock_messageType fastReflection_PeriodLock_messageType
+var _ protoreflect.MessageType = fastReflection_PeriodLock_messageType{}
+
+type fastReflection_PeriodLock_messageType struct{}
+
+func (x fastReflection_PeriodLock_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PeriodLock)(nil)
+}
+func (x fastReflection_PeriodLock_messageType) New() protoreflect.Message {
+	return new(fastReflection_PeriodLock)
+}
+func (x fastReflection_PeriodLock_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PeriodLock
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PeriodLock) Descriptor() protoreflect.MessageDescriptor {
+	return md_PeriodLock
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PeriodLock) Type() protoreflect.MessageType {
+	return _fastReflection_PeriodLock_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PeriodLock) New() protoreflect.Message {
+	return new(fastReflection_PeriodLock)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PeriodLock) Interface() protoreflect.ProtoMessage {
+	return (*PeriodLock)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PeriodLock) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_PeriodLock_id, value) {
+			return
+		}
+	}
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_PeriodLock_owner, value) {
+			return
+		}
+	}
+	if x.Shares != nil {
+		value := protoreflect.ValueOfMessage(x.Shares.ProtoReflect())
+		if !f(fd_PeriodLock_shares, value) {
+			return
+		}
+	}
+	if x.Duration != nil {
+		value := protoreflect.ValueOfMessage(x.Duration.ProtoReflect())
+		if !f(fd_PeriodLock_duration, value) {
+			return
+		}
+	}
+	if x.EndTime != nil {
+		value := protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
+		if !f(fd_PeriodLock_endTime, value) {
+			return
+		}
+	}
+	if x.RewardWeight != "" {
+		value := protoreflect.ValueOfString(x.RewardWeight)
+		if !f(fd_PeriodLock_rewardWeight, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PeriodLock) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.PeriodLock.id":
+		return x.Id != uint64(0)
+	case "cosmos.simpleswap.v1.PeriodLock.owner":
+		return x.Owner != ""
+	case "cosmos.simpleswap.v1.PeriodLock.shares":
+		return x.Shares != nil
+	case "cosmos.simpleswap.v1.PeriodLock.duration":
+		return x.Duration != nil
+	case "cosmos.simpleswap.v1.PeriodLock.endTime":
+		return x.EndTime != nil
+	case "cosmos.simpleswap.v1.PeriodLock.rewardWeight":
+		return x.RewardWeight != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.PeriodLock"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.PeriodLock does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PeriodLock) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.PeriodLock.id":
+		x.Id = uint64(0)
+	case "cosmos.simpleswap.v1.PeriodLock.owner":
+		x.Owner = ""
+	case "cosmos.simpleswap.v1.PeriodLock.shares":
+		x.Shares = nil
+	case "cosmos.simpleswap.v1.PeriodLock.duration":
+		x.Duration = nil
+	case "cosmos.simpleswap.v1.PeriodLock.endTime":
+		x.EndTime = nil
+	case "cosmos.simpleswap.v1.PeriodLock.rewardWeight":
+		x.RewardWeight = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.PeriodLock"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.PeriodLock does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PeriodLock) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.simpleswap.v1.PeriodLock.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.simpleswap.v1.PeriodLock.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "cosmos.simpleswap.v1.PeriodLock.shares":
+		value := x.Shares
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.simpleswap.v1.PeriodLock.duration":
+		value := x.Duration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.simpleswap.v1.PeriodLock.endTime":
+		value := x.EndTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.simpleswap.v1.PeriodLock.rewardWeight":
+		value := x.RewardWeight
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.PeriodLock"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.PeriodLock does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PeriodLock) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.PeriodLock.id":
+		x.Id = value.Uint()
+	case "cosmos.simpleswap.v1.PeriodLock.owner":
+		x.Owner = value.Interface().(string)
+	case "cosmos.simpleswap.v1.PeriodLock.shares":
+		x.Shares = value.Message().Interface().(*v1beta1.Coin)
+	case "cosmos.simpleswap.v1.PeriodLock.duration":
+		x.Duration = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.simpleswap.v1.PeriodLock.endTime":
+		x.EndTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.simpleswap.v1.PeriodLock.rewardWeight":
+		x.RewardWeight = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.PeriodLock"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.PeriodLock does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PeriodLock) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.PeriodLock.shares":
+		if x.Shares == nil {
+			x.Shares = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Shares.ProtoReflect())
+	case "cosmos.simpleswap.v1.PeriodLock.duration":
+		if x.Duration == nil {
+			x.Duration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.Duration.ProtoReflect())
+	case "cosmos.simpleswap.v1.PeriodLock.endTime":
+		if x.EndTime == nil {
+			x.EndTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
+	case "cosmos.simpleswap.v1.PeriodLock.id":
+		panic(fmt.Errorf("field id of message cosmos.simpleswap.v1.PeriodLock is not mutable"))
+	case "cosmos.simpleswap.v1.PeriodLock.owner":
+		panic(fmt.Errorf("field owner of message cosmos.simpleswap.v1.PeriodLock is not mutable"))
+	case "cosmos.simpleswap.v1.PeriodLock.rewardWeight":
+		panic(fmt.Errorf("field rewardWeight of message cosmos.simpleswap.v1.PeriodLock is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.PeriodLock"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.PeriodLock does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PeriodLock) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.PeriodLock.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.simpleswap.v1.PeriodLock.owner":
+		return protoreflect.ValueOfString("")
+	case "cosmos.simpleswap.v1.PeriodLock.shares":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.simpleswap.v1.PeriodLock.duration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.simpleswap.v1.PeriodLock.endTime":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.simpleswap.v1.PeriodLock.rewardWeight":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.PeriodLock"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.PeriodLock does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PeriodLock) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.simpleswap.v1.PeriodLock", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PeriodLock) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PeriodLock) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PeriodLock) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PeriodLock) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PeriodLock)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Shares != nil {
+			l = options.Size(x.Shares)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Duration != nil {
+			l = options.Size(x.Duration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EndTime != nil {
+			l = options.Size(x.EndTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RewardWeight)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PeriodLock)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RewardWeight) > 0 {
+			i -= len(x.RewardWeight)
+			copy(dAtA[i:], x.RewardWeight)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RewardWeight)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.EndTime != nil {
+			encoded, err := options.Marshal(x.EndTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Duration != nil {
+			encoded, err := options.Marshal(x.Duration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Shares != nil {
+			encoded, err := options.Marshal(x.Shares)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PeriodLock)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PeriodLock: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PeriodLock: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Shares == nil {
+					x.Shares = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Shares); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Duration == nil {
+					x.Duration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Duration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EndTime == nil {
+					x.EndTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EndTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardWeight", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RewardWeight = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_LimitOrder             protoreflect.MessageDescriptor
+	fd_LimitOrder_id          protoreflect.FieldDescriptor
+	fd_LimitOrder_owner       protoreflect.FieldDescriptor
+	fd_LimitOrder_input       protoreflect.FieldDescriptor
+	fd_LimitOrder_outputDenom protoreflect.FieldDescriptor
+	fd_LimitOrder_limitPrice  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_simpleswap_v1_types_proto_init()
+	md_LimitOrder = File_cosmos_simpleswap_v1_types_proto.Messages().ByName("LimitOrder")
+	fd_LimitOrder_id = md_LimitOrder.Fields().ByName("id")
+	fd_LimitOrder_owner = md_LimitOrder.Fields().ByName("owner")
+	fd_LimitOrder_input = md_LimitOrder.Fields().ByName("input")
+	fd_LimitOrder_outputDenom = md_LimitOrder.Fields().ByName("outputDenom")
+	fd_LimitOrder_limitPrice = md_LimitOrder.Fields().ByName("limitPrice")
+}
+
+var _ protoreflect.Message = (*fastReflection_LimitOrder)(nil)
+
+type fastReflection_LimitOrder LimitOrder
+
+func (x *LimitOrder) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LimitOrder)(x)
+}
+
+func (x *LimitOrder) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_LimitOrder_messageType fastReflection_LimitOrder_messageType
+var _ protoreflect.MessageType = fastReflection_LimitOrder_messageType{}
+
+type fastReflection_LimitOrder_messageType struct{}
+
+func (x fastReflection_LimitOrder_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LimitOrder)(nil)
+}
+func (x fastReflection_LimitOrder_messageType) New() protoreflect.Message {
+	return new(fastReflection_LimitOrder)
+}
+func (x fastReflection_LimitOrder_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LimitOrder
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_LimitOrder) Descriptor() protoreflect.MessageDescriptor {
+	return md_LimitOrder
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_LimitOrder) Type() protoreflect.MessageType {
+	return _fastReflection_LimitOrder_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_LimitOrder) New() protoreflect.Message {
+	return new(fastReflection_LimitOrder)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_LimitOrder) Interface() protoreflect.ProtoMessage {
+	return (*LimitOrder)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_LimitOrder) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_LimitOrder_id, value) {
+			return
+		}
+	}
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_LimitOrder_owner, value) {
+			return
+		}
+	}
+	if x.Input != nil {
+		value := protoreflect.ValueOfMessage(x.Input.ProtoReflect())
+		if !f(fd_LimitOrder_input, value) {
+			return
+		}
+	}
+	if x.OutputDenom != "" {
+		value := protoreflect.ValueOfString(x.OutputDenom)
+		if !f(fd_LimitOrder_outputDenom, value) {
+			return
+		}
+	}
+	if x.LimitPrice != "" {
+		value := protoreflect.ValueOfString(x.LimitPrice)
+		if !f(fd_LimitOrder_limitPrice, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_LimitOrder) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.LimitOrder.id":
+		return x.Id != uint64(0)
+	case "cosmos.simpleswap.v1.LimitOrder.owner":
+		return x.Owner != ""
+	case "cosmos.simpleswap.v1.LimitOrder.input":
+		return x.Input != nil
+	case "cosmos.simpleswap.v1.LimitOrder.outputDenom":
+		return x.OutputDenom != ""
+	case "cosmos.simpleswap.v1.LimitOrder.limitPrice":
+		return x.LimitPrice != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.LimitOrder"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.LimitOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LimitOrder) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.LimitOrder.id":
+		x.Id = uint64(0)
+	case "cosmos.simpleswap.v1.LimitOrder.owner":
+		x.Owner = ""
+	case "cosmos.simpleswap.v1.LimitOrder.input":
+		x.Input = nil
+	case "cosmos.simpleswap.v1.LimitOrder.outputDenom":
+		x.OutputDenom = ""
+	case "cosmos.simpleswap.v1.LimitOrder.limitPrice":
+		x.LimitPrice = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.LimitOrder"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.LimitOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_LimitOrder) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.simpleswap.v1.LimitOrder.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.simpleswap.v1.LimitOrder.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "cosmos.simpleswap.v1.LimitOrder.input":
+		value := x.Input
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.simpleswap.v1.LimitOrder.outputDenom":
+		value := x.OutputDenom
+		return protoreflect.ValueOfString(value)
+	case "cosmos.simpleswap.v1.LimitOrder.limitPrice":
+		value := x.LimitPrice
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.LimitOrder"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.LimitOrder does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LimitOrder) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.LimitOrder.id":
+		x.Id = value.Uint()
+	case "cosmos.simpleswap.v1.LimitOrder.owner":
+		x.Owner = value.Interface().(string)
+	case "cosmos.simpleswap.v1.LimitOrder.input":
+		x.Input = value.Message().Interface().(*v1beta1.Coin)
+	case "cosmos.simpleswap.v1.LimitOrder.outputDenom":
+		x.OutputDenom = value.Interface().(string)
+	case "cosmos.simpleswap.v1.LimitOrder.limitPrice":
+		x.LimitPrice = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.LimitOrder"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.LimitOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LimitOrder) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.LimitOrder.input":
+		if x.Input == nil {
+			x.Input = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Input.ProtoReflect())
+	case "cosmos.simpleswap.v1.LimitOrder.id":
+		panic(fmt.Errorf("field id of message cosmos.simpleswap.v1.LimitOrder is not mutable"))
+	case "cosmos.simpleswap.v1.LimitOrder.owner":
+		panic(fmt.Errorf("field owner of message cosmos.simpleswap.v1.LimitOrder is not mutable"))
+	case "cosmos.simpleswap.v1.LimitOrder.outputDenom":
+		panic(fmt.Errorf("field outputDenom of message cosmos.simpleswap.v1.LimitOrder is not mutable"))
+	case "cosmos.simpleswap.v1.LimitOrder.limitPrice":
+		panic(fmt.Errorf("field limitPrice of message cosmos.simpleswap.v1.LimitOrder is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.LimitOrder"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.LimitOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_LimitOrder) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.LimitOrder.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.simpleswap.v1.LimitOrder.owner":
+		return protoreflect.ValueOfString("")
+	case "cosmos.simpleswap.v1.LimitOrder.input":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.simpleswap.v1.LimitOrder.outputDenom":
+		return protoreflect.ValueOfString("")
+	case "cosmos.simpleswap.v1.LimitOrder.limitPrice":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.LimitOrder"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.LimitOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_LimitOrder) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.simpleswap.v1.LimitOrder", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_LimitOrder) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LimitOrder) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_LimitOrder) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_LimitOrder) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*LimitOrder)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Input != nil {
+			l = options.Size(x.Input)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.OutputDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LimitPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*LimitOrder)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.LimitPrice) > 0 {
+			i -= len(x.LimitPrice)
+			copy(dAtA[i:], x.LimitPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LimitPrice)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.OutputDenom) > 0 {
+			i -= len(x.OutputDenom)
+			copy(dAtA[i:], x.OutputDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OutputDenom)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Input != nil {
+			encoded, err := options.Marshal(x.Input)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*LimitOrder)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LimitOrder: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Input == nil {
+					x.Input = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Input); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutputDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OutputDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LimitPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LimitPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_DCAPlan                     protoreflect.MessageDescriptor
+	fd_DCAPlan_id                  protoreflect.FieldDescriptor
+	fd_DCAPlan_owner               protoreflect.FieldDescriptor
+	fd_DCAPlan_total               protoreflect.FieldDescriptor
+	fd_DCAPlan_remaining           protoreflect.FieldDescriptor
+	fd_DCAPlan_sliceAmount         protoreflect.FieldDescriptor
+	fd_DCAPlan_outputDenom         protoreflect.FieldDescriptor
+	fd_DCAPlan_minOutputPerSlice   protoreflect.FieldDescriptor
+	fd_DCAPlan_intervalBlocks      protoreflect.FieldDescriptor
+	fd_DCAPlan_nextExecutionHeight protoreflect.FieldDescriptor
+	fd_DCAPlan_consecutiveSkips    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_simpleswap_v1_types_proto_init()
+	md_DCAPlan = File_cosmos_simpleswap_v1_types_proto.Messages().ByName("DCAPlan")
+	fd_DCAPlan_id = md_DCAPlan.Fields().ByName("id")
+	fd_DCAPlan_owner = md_DCAPlan.Fields().ByName("owner")
+	fd_DCAPlan_total = md_DCAPlan.Fields().ByName("total")
+	fd_DCAPlan_remaining = md_DCAPlan.Fields().ByName("remaining")
+	fd_DCAPlan_sliceAmount = md_DCAPlan.Fields().ByName("sliceAmount")
+	fd_DCAPlan_outputDenom = md_DCAPlan.Fields().ByName("outputDenom")
+	fd_DCAPlan_minOutputPerSlice = md_DCAPlan.Fields().ByName("minOutputPerSlice")
+	fd_DCAPlan_intervalBlocks = md_DCAPlan.Fields().ByName("intervalBlocks")
+	fd_DCAPlan_nextExecutionHeight = md_DCAPlan.Fields().ByName("nextExecutionHeight")
+	fd_DCAPlan_consecutiveSkips = md_DCAPlan.Fields().ByName("consecutiveSkips")
+}
+
+var _ protoreflect.Message = (*fastReflection_DCAPlan)(nil)
+
+type fastReflection_DCAPlan DCAPlan
+
+func (x *DCAPlan) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DCAPlan)(x)
+}
+
+func (x *DCAPlan) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DCAPlan_messageType fastReflection_DCAPlan_messageType
+var _ protoreflect.MessageType = fastReflection_DCAPlan_messageType{}
+
+type fastReflection_DCAPlan_messageType struct{}
+
+func (x fastReflection_DCAPlan_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DCAPlan)(nil)
+}
+func (x fastReflection_DCAPlan_messageType) New() protoreflect.Message {
+	return new(fastReflection_DCAPlan)
+}
+func (x fastReflection_DCAPlan_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DCAPlan
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DCAPlan) Descriptor() protoreflect.MessageDescriptor {
+	return md_DCAPlan
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DCAPlan) Type() protoreflect.MessageType {
+	return _fastReflection_DCAPlan_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DCAPlan) New() protoreflect.Message {
+	return new(fastReflection_DCAPlan)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DCAPlan) Interface() protoreflect.ProtoMessage {
+	return (*DCAPlan)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DCAPlan) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_DCAPlan_id, value) {
+			return
+		}
+	}
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_DCAPlan_owner, value) {
+			return
+		}
+	}
+	if x.Total != nil {
+		value := protoreflect.ValueOfMessage(x.Total.ProtoReflect())
+		if !f(fd_DCAPlan_total, value) {
+			return
+		}
+	}
+	if x.Remaining != nil {
+		value := protoreflect.ValueOfMessage(x.Remaining.ProtoReflect())
+		if !f(fd_DCAPlan_remaining, value) {
+			return
+		}
+	}
+	if x.SliceAmount != "" {
+		value := protoreflect.ValueOfString(x.SliceAmount)
+		if !f(fd_DCAPlan_sliceAmount, value) {
+			return
+		}
+	}
+	if x.OutputDenom != "" {
+		value := protoreflect.ValueOfString(x.OutputDenom)
+		if !f(fd_DCAPlan_outputDenom, value) {
+			return
+		}
+	}
+	if x.MinOutputPerSlice != "" {
+		value := protoreflect.ValueOfString(x.MinOutputPerSlice)
+		if !f(fd_DCAPlan_minOutputPerSlice, value) {
+			return
+		}
+	}
+	if x.IntervalBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.IntervalBlocks)
+		if !f(fd_DCAPlan_intervalBlocks, value) {
+			return
+		}
+	}
+	if x.NextExecutionHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.NextExecutionHeight)
+		if !f(fd_DCAPlan_nextExecutionHeight, value) {
+			return
+		}
+	}
+	if x.ConsecutiveSkips != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ConsecutiveSkips)
+		if !f(fd_DCAPlan_consecutiveSkips, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DCAPlan) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.DCAPlan.id":
+		return x.Id != uint64(0)
+	case "cosmos.simpleswap.v1.DCAPlan.owner":
+		return x.Owner != ""
+	case "cosmos.simpleswap.v1.DCAPlan.total":
+		return x.Total != nil
+	case "cosmos.simpleswap.v1.DCAPlan.remaining":
+		return x.Remaining != nil
+	case "cosmos.simpleswap.v1.DCAPlan.sliceAmount":
+		return x.SliceAmount != ""
+	case "cosmos.simpleswap.v1.DCAPlan.outputDenom":
+		return x.OutputDenom != ""
+	case "cosmos.simpleswap.v1.DCAPlan.minOutputPerSlice":
+		return x.MinOutputPerSlice != ""
+	case "cosmos.simpleswap.v1.DCAPlan.intervalBlocks":
+		return x.IntervalBlocks != uint64(0)
+	case "cosmos.simpleswap.v1.DCAPlan.nextExecutionHeight":
+		return x.NextExecutionHeight != int64(0)
+	case "cosmos.simpleswap.v1.DCAPlan.consecutiveSkips":
+		return x.ConsecutiveSkips != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.DCAPlan"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.DCAPlan does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DCAPlan) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.DCAPlan.id":
+		x.Id = uint64(0)
+	case "cosmos.simpleswap.v1.DCAPlan.owner":
+		x.Owner = ""
+	case "cosmos.simpleswap.v1.DCAPlan.total":
+		x.Total = nil
+	case "cosmos.simpleswap.v1.DCAPlan.remaining":
+		x.Remaining = nil
+	case "cosmos.simpleswap.v1.DCAPlan.sliceAmount":
+		x.SliceAmount = ""
+	case "cosmos.simpleswap.v1.DCAPlan.outputDenom":
+		x.OutputDenom = ""
+	case "cosmos.simpleswap.v1.DCAPlan.minOutputPerSlice":
+		x.MinOutputPerSlice = ""
+	case "cosmos.simpleswap.v1.DCAPlan.intervalBlocks":
+		x.IntervalBlocks = uint64(0)
+	case "cosmos.simpleswap.v1.DCAPlan.nextExecutionHeight":
+		x.NextExecutionHeight = int64(0)
+	case "cosmos.simpleswap.v1.DCAPlan.consecutiveSkips":
+		x.ConsecutiveSkips = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.DCAPlan"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.DCAPlan does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DCAPlan) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.simpleswap.v1.DCAPlan.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.simpleswap.v1.DCAPlan.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "cosmos.simpleswap.v1.DCAPlan.total":
+		value := x.Total
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.simpleswap.v1.DCAPlan.remaining":
+		value := x.Remaining
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.simpleswap.v1.DCAPlan.sliceAmount":
+		value := x.SliceAmount
+		return protoreflect.ValueOfString(value)
+	case "cosmos.simpleswap.v1.DCAPlan.outputDenom":
+		value := x.OutputDenom
+		return protoreflect.ValueOfString(value)
+	case "cosmos.simpleswap.v1.DCAPlan.minOutputPerSlice":
+		value := x.MinOutputPerSlice
+		return protoreflect.ValueOfString(value)
+	case "cosmos.simpleswap.v1.DCAPlan.intervalBlocks":
+		value := x.IntervalBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.simpleswap.v1.DCAPlan.nextExecutionHeight":
+		value := x.NextExecutionHeight
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.simpleswap.v1.DCAPlan.consecutiveSkips":
+		value := x.ConsecutiveSkips
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.DCAPlan"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.DCAPlan does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DCAPlan) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.DCAPlan.id":
+		x.Id = value.Uint()
+	case "cosmos.simpleswap.v1.DCAPlan.owner":
+		x.Owner = value.Interface().(string)
+	case "cosmos.simpleswap.v1.DCAPlan.total":
+		x.Total = value.Message().Interface().(*v1beta1.Coin)
+	case "cosmos.simpleswap.v1.DCAPlan.remaining":
+		x.Remaining = value.Message().Interface().(*v1beta1.Coin)
+	case "cosmos.simpleswap.v1.DCAPlan.sliceAmount":
+		x.SliceAmount = value.Interface().(string)
+	case "cosmos.simpleswap.v1.DCAPlan.outputDenom":
+		x.OutputDenom = value.Interface().(string)
+	case "cosmos.simpleswap.v1.DCAPlan.minOutputPerSlice":
+		x.MinOutputPerSlice = value.Interface().(string)
+	case "cosmos.simpleswap.v1.DCAPlan.intervalBlocks":
+		x.IntervalBlocks = value.Uint()
+	case "cosmos.simpleswap.v1.DCAPlan.nextExecutionHeight":
+		x.NextExecutionHeight = value.Int()
+	case "cosmos.simpleswap.v1.DCAPlan.consecutiveSkips":
+		x.ConsecutiveSkips = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.DCAPlan"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.DCAPlan does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DCAPlan) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.DCAPlan.total":
+		if x.Total == nil {
+			x.Total = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Total.ProtoReflect())
+	case "cosmos.simpleswap.v1.DCAPlan.remaining":
+		if x.Remaining == nil {
+			x.Remaining = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Remaining.ProtoReflect())
+	case "cosmos.simpleswap.v1.DCAPlan.id":
+		panic(fmt.Errorf("field id of message cosmos.simpleswap.v1.DCAPlan is not mutable"))
+	case "cosmos.simpleswap.v1.DCAPlan.owner":
+		panic(fmt.Errorf("field owner of message cosmos.simpleswap.v1.DCAPlan is not mutable"))
+	case "cosmos.simpleswap.v1.DCAPlan.sliceAmount":
+		panic(fmt.Errorf("field sliceAmount of message cosmos.simpleswap.v1.DCAPlan is not mutable"))
+	case "cosmos.simpleswap.v1.DCAPlan.outputDenom":
+		panic(fmt.Errorf("field outputDenom of message cosmos.simpleswap.v1.DCAPlan is not mutable"))
+	case "cosmos.simpleswap.v1.DCAPlan.minOutputPerSlice":
+		panic(fmt.Errorf("field minOutputPerSlice of message cosmos.simpleswap.v1.DCAPlan is not mutable"))
+	case "cosmos.simpleswap.v1.DCAPlan.intervalBlocks":
+		panic(fmt.Errorf("field intervalBlocks of message cosmos.simpleswap.v1.DCAPlan is not mutable"))
+	case "cosmos.simpleswap.v1.DCAPlan.nextExecutionHeight":
+		panic(fmt.Errorf("field nextExecutionHeight of message cosmos.simpleswap.v1.DCAPlan is not mutable"))
+	case "cosmos.simpleswap.v1.DCAPlan.consecutiveSkips":
+		panic(fmt.Errorf("field consecutiveSkips of message cosmos.simpleswap.v1.DCAPlan is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.DCAPlan"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.DCAPlan does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DCAPlan) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.DCAPlan.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.simpleswap.v1.DCAPlan.owner":
+		return protoreflect.ValueOfString("")
+	case "cosmos.simpleswap.v1.DCAPlan.total":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.simpleswap.v1.DCAPlan.remaining":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.simpleswap.v1.DCAPlan.sliceAmount":
+		return protoreflect.ValueOfString("")
+	case "cosmos.simpleswap.v1.DCAPlan.outputDenom":
+		return protoreflect.ValueOfString("")
+	case "cosmos.simpleswap.v1.DCAPlan.minOutputPerSlice":
+		return protoreflect.ValueOfString("")
+	case "cosmos.simpleswap.v1.DCAPlan.intervalBlocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.simpleswap.v1.DCAPlan.nextExecutionHeight":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.simpleswap.v1.DCAPlan.consecutiveSkips":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.DCAPlan"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.DCAPlan does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DCAPlan) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.simpleswap.v1.DCAPlan", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DCAPlan) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DCAPlan) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DCAPlan) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DCAPlan) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DCAPlan)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Total != nil {
+			l = options.Size(x.Total)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Remaining != nil {
+			l = options.Size(x.Remaining)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SliceAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.OutputDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MinOutputPerSlice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.IntervalBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.IntervalBlocks))
+		}
+		if x.NextExecutionHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextExecutionHeight))
+		}
+		if x.ConsecutiveSkips != 0 {
+			n += 1 + runtime.Sov(uint64(x.ConsecutiveSkips))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DCAPlan)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ConsecutiveSkips != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ConsecutiveSkips))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.NextExecutionHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextExecutionHeight))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.IntervalBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IntervalBlocks))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.MinOutputPerSlice) > 0 {
+			i -= len(x.MinOutputPerSlice)
+			copy(dAtA[i:], x.MinOutputPerSlice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinOutputPerSlice)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.OutputDenom) > 0 {
+			i -= len(x.OutputDenom)
+			copy(dAtA[i:], x.OutputDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OutputDenom)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.SliceAmount) > 0 {
+			i -= len(x.SliceAmount)
+			copy(dAtA[i:], x.SliceAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SliceAmount)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Remaining != nil {
+			encoded, err := options.Marshal(x.Remaining)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Total != nil {
+			encoded, err := options.Marshal(x.Total)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DCAPlan)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DCAPlan: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DCAPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Total == nil {
+					x.Total = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Total); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Remaining == nil {
+					x.Remaining = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Remaining); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SliceAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SliceAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutputDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OutputDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinOutputPerSlice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinOutputPerSlice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IntervalBlocks", wireType)
+				}
+				x.IntervalBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IntervalBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextExecutionHeight", wireType)
+				}
+				x.NextExecutionHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextExecutionHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveSkips", wireType)
+				}
+				x.ConsecutiveSkips = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ConsecutiveSkips |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_BatchSwap                      protoreflect.MessageDescriptor
+	fd_BatchSwap_id                   protoreflect.FieldDescriptor
+	fd_BatchSwap_trader               protoreflect.FieldDescriptor
+	fd_BatchSwap_input                protoreflect.FieldDescriptor
+	fd_BatchSwap_outputDenom          protoreflect.FieldDescriptor
+	fd_BatchSwap_maxSwapFeePercentage protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_simpleswap_v1_types_proto_init()
+	md_BatchSwap = File_cosmos_simpleswap_v1_types_proto.Messages().ByName("BatchSwap")
+	fd_BatchSwap_id = md_BatchSwap.Fields().ByName("id")
+	fd_BatchSwap_trader = md_BatchSwap.Fields().ByName("trader")
+	fd_BatchSwap_input = md_BatchSwap.Fields().ByName("input")
+	fd_BatchSwap_outputDenom = md_BatchSwap.Fields().ByName("outputDenom")
+	fd_BatchSwap_maxSwapFeePercentage = md_BatchSwap.Fields().ByName("maxSwapFeePercentage")
+}
+
+var _ protoreflect.Message = (*fastReflection_BatchSwap)(nil)
+
+type fastReflection_BatchSwap BatchSwap
+
+func (x *BatchSwap) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BatchSwap)(x)
+}
+
+func (x *BatchSwap) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BatchSwap_messageType fastReflection_BatchSwap_messageType
+var _ protoreflect.MessageType = fastReflection_BatchSwap_messageType{}
+
+type fastReflection_BatchSwap_messageType struct{}
+
+func (x fastReflection_BatchSwap_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BatchSwap)(nil)
+}
+func (x fastReflection_BatchSwap_messageType) New() protoreflect.Message {
+	return new(fastReflection_BatchSwap)
+}
+func (x fastReflection_BatchSwap_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BatchSwap
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BatchSwap) Descriptor() protoreflect.MessageDescriptor {
+	return md_BatchSwap
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BatchSwap) Type() protoreflect.MessageType {
+	return _fastReflection_BatchSwap_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BatchSwap) New() protoreflect.Message {
+	return new(fastReflection_BatchSwap)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BatchSwap) Interface() protoreflect.ProtoMessage {
+	return (*BatchSwap)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BatchSwap) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_BatchSwap_id, value) {
+			return
+		}
+	}
+	if x.Trader != "" {
+		value := protoreflect.ValueOfString(x.Trader)
+		if !f(fd_BatchSwap_trader, value) {
+			return
+		}
+	}
+	if x.Input != nil {
+		value := protoreflect.ValueOfMessage(x.Input.ProtoReflect())
+		if !f(fd_BatchSwap_input, value) {
+			return
+		}
+	}
+	if x.OutputDenom != "" {
+		value := protoreflect.ValueOfString(x.OutputDenom)
+		if !f(fd_BatchSwap_outputDenom, value) {
+			return
+		}
+	}
+	if x.MaxSwapFeePercentage != int32(0) {
+		value := protoreflect.ValueOfInt32(x.MaxSwapFeePercentage)
+		if !f(fd_BatchSwap_maxSwapFeePercentage, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BatchSwap) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.BatchSwap.id":
+		return x.Id != uint64(0)
+	case "cosmos.simpleswap.v1.BatchSwap.trader":
+		return x.Trader != ""
+	case "cosmos.simpleswap.v1.BatchSwap.input":
+		return x.Input != nil
+	case "cosmos.simpleswap.v1.BatchSwap.outputDenom":
+		return x.OutputDenom != ""
+	case "cosmos.simpleswap.v1.BatchSwap.maxSwapFeePercentage":
+		return x.MaxSwapFeePercentage != int32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.BatchSwap"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.BatchSwap does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BatchSwap) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.BatchSwap.id":
+		x.Id = uint64(0)
+	case "cosmos.simpleswap.v1.BatchSwap.trader":
+		x.Trader = ""
+	case "cosmos.simpleswap.v1.BatchSwap.input":
+		x.Input = nil
+	case "cosmos.simpleswap.v1.BatchSwap.outputDenom":
+		x.OutputDenom = ""
+	case "cosmos.simpleswap.v1.BatchSwap.maxSwapFeePercentage":
+		x.MaxSwapFeePercentage = int32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.BatchSwap"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.BatchSwap does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BatchSwap) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.simpleswap.v1.BatchSwap.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.simpleswap.v1.BatchSwap.trader":
+		value := x.Trader
+		return protoreflect.ValueOfString(value)
+	case "cosmos.simpleswap.v1.BatchSwap.input":
+		value := x.Input
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.simpleswap.v1.BatchSwap.outputDenom":
+		value := x.OutputDenom
+		return protoreflect.ValueOfString(value)
+	case "cosmos.simpleswap.v1.BatchSwap.maxSwapFeePercentage":
+		value := x.MaxSwapFeePercentage
+		return protoreflect.ValueOfInt32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.BatchSwap"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.BatchSwap does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BatchSwap) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.BatchSwap.id":
+		x.Id = value.Uint()
+	case "cosmos.simpleswap.v1.BatchSwap.trader":
+		x.Trader = value.Interface().(string)
+	case "cosmos.simpleswap.v1.BatchSwap.input":
+		x.Input = value.Message().Interface().(*v1beta1.Coin)
+	case "cosmos.simpleswap.v1.BatchSwap.outputDenom":
+		x.OutputDenom = value.Interface().(string)
+	case "cosmos.simpleswap.v1.BatchSwap.maxSwapFeePercentage":
+		x.MaxSwapFeePercentage = int32(value.Int())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.BatchSwap"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.BatchSwap does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BatchSwap) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.BatchSwap.input":
+		if x.Input == nil {
+			x.Input = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Input.ProtoReflect())
+	case "cosmos.simpleswap.v1.BatchSwap.id":
+		panic(fmt.Errorf("field id of message cosmos.simpleswap.v1.BatchSwap is not mutable"))
+	case "cosmos.simpleswap.v1.BatchSwap.trader":
+		panic(fmt.Errorf("field trader of message cosmos.simpleswap.v1.BatchSwap is not mutable"))
+	case "cosmos.simpleswap.v1.BatchSwap.outputDenom":
+		panic(fmt.Errorf("field outputDenom of message cosmos.simpleswap.v1.BatchSwap is not mutable"))
+	case "cosmos.simpleswap.v1.BatchSwap.maxSwapFeePercentage":
+		panic(fmt.Errorf("field maxSwapFeePercentage of message cosmos.simpleswap.v1.BatchSwap is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.BatchSwap"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.BatchSwap does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BatchSwap) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.BatchSwap.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.simpleswap.v1.BatchSwap.trader":
+		return protoreflect.ValueOfString("")
+	case "cosmos.simpleswap.v1.BatchSwap.input":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.simpleswap.v1.BatchSwap.outputDenom":
+		return protoreflect.ValueOfString("")
+	case "cosmos.simpleswap.v1.BatchSwap.maxSwapFeePercentage":
+		return protoreflect.ValueOfInt32(int32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.BatchSwap"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.BatchSwap does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BatchSwap) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.simpleswap.v1.BatchSwap", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BatchSwap) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BatchSwap) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BatchSwap) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BatchSwap) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BatchSwap)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Trader)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Input != nil {
+			l = options.Size(x.Input)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.OutputDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxSwapFeePercentage != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxSwapFeePercentage))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BatchSwap)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MaxSwapFeePercentage != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxSwapFeePercentage))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.OutputDenom) > 0 {
+			i -= len(x.OutputDenom)
+			copy(dAtA[i:], x.OutputDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OutputDenom)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Input != nil {
+			encoded, err := options.Marshal(x.Input)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Trader) > 0 {
+			i -= len(x.Trader)
+			copy(dAtA[i:], x.Trader)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Trader)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BatchSwap)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BatchSwap: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BatchSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Trader = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Input == nil {
+					x.Input = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Input); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutputDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OutputDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSwapFeePercentage", wireType)
+				}
+				x.MaxSwapFeePercentage = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxSwapFeePercentage |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PoolStatsBucket             protoreflect.MessageDescriptor
+	fd_PoolStatsBucket_startTime   protoreflect.FieldDescriptor
+	fd_PoolStatsBucket_inputDenom  protoreflect.FieldDescriptor
+	fd_PoolStatsBucket_outputDenom protoreflect.FieldDescriptor
+	fd_PoolStatsBucket_volume      protoreflect.FieldDescriptor
+	fd_PoolStatsBucket_fees        protoreflect.FieldDescriptor
+	fd_PoolStatsBucket_swapCount   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_simpleswap_v1_types_proto_init()
+	md_PoolStatsBucket = File_cosmos_simpleswap_v1_types_proto.Messages().ByName("PoolStatsBucket")
+	fd_PoolStatsBucket_startTime = md_PoolStatsBucket.Fields().ByName("startTime")
+	fd_PoolStatsBucket_inputDenom = md_PoolStatsBucket.Fields().ByName("inputDenom")
+	fd_PoolStatsBucket_outputDenom = md_PoolStatsBucket.Fields().ByName("outputDenom")
+	fd_PoolStatsBucket_volume = md_PoolStatsBucket.Fields().ByName("volume")
+	fd_PoolStatsBucket_fees = md_PoolStatsBucket.Fields().ByName("fees")
+	fd_PoolStatsBucket_swapCount = md_PoolStatsBucket.Fields().ByName("swapCount")
+}
+
+var _ protoreflect.Message = (*fastReflection_PoolStatsBucket)(nil)
+
+type fastReflection_PoolStatsBucket PoolStatsBucket
+
+func (x *PoolStatsBucket) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PoolStatsBucket)(x)
+}
+
+func (x *PoolStatsBucket) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PoolStatsBucket_messageType fastReflection_PoolStatsBucket_messageType
+var _ protoreflect.MessageType = fastReflection_PoolStatsBucket_messageType{}
+
+type fastReflection_PoolStatsBucket_messageType struct{}
+
+func (x fastReflection_PoolStatsBucket_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PoolStatsBucket)(nil)
+}
+func (x fastReflection_PoolStatsBucket_messageType) New() protoreflect.Message {
+	return new(fastReflection_PoolStatsBucket)
+}
+func (x fastReflection_PoolStatsBucket_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PoolStatsBucket
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PoolStatsBucket) Descriptor() protoreflect.MessageDescriptor {
+	return md_PoolStatsBucket
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PoolStatsBucket) Type() protoreflect.MessageType {
+	return _fastReflection_PoolStatsBucket_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PoolStatsBucket) New() protoreflect.Message {
+	return new(fastReflection_PoolStatsBucket)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PoolStatsBucket) Interface() protoreflect.ProtoMessage {
+	return (*PoolStatsBucket)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PoolStatsBucket) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.StartTime != nil {
+		value := protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+		if !f(fd_PoolStatsBucket_startTime, value) {
+			return
+		}
+	}
+	if x.InputDenom != "" {
+		value := protoreflect.ValueOfString(x.InputDenom)
+		if !f(fd_PoolStatsBucket_inputDenom, value) {
+			return
+		}
+	}
+	if x.OutputDenom != "" {
+		value := protoreflect.ValueOfString(x.OutputDenom)
+		if !f(fd_PoolStatsBucket_outputDenom, value) {
+			return
+		}
+	}
+	if x.Volume != "" {
+		value := protoreflect.ValueOfString(x.Volume)
+		if !f(fd_PoolStatsBucket_volume, value) {
+			return
+		}
+	}
+	if x.Fees != "" {
+		value := protoreflect.ValueOfString(x.Fees)
+		if !f(fd_PoolStatsBucket_fees, value) {
+			return
+		}
+	}
+	if x.SwapCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SwapCount)
+		if !f(fd_PoolStatsBucket_swapCount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PoolStatsBucket) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.PoolStatsBucket.startTime":
+		return x.StartTime != nil
+	case "cosmos.simpleswap.v1.PoolStatsBucket.inputDenom":
+		return x.InputDenom != ""
+	case "cosmos.simpleswap.v1.PoolStatsBucket.outputDenom":
+		return x.OutputDenom != ""
+	case "cosmos.simpleswap.v1.PoolStatsBucket.volume":
+		return x.Volume != ""
+	case "cosmos.simpleswap.v1.PoolStatsBucket.fees":
+		return x.Fees != ""
+	case "cosmos.simpleswap.v1.PoolStatsBucket.swapCount":
+		return x.SwapCount != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.PoolStatsBucket"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.PoolStatsBucket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PoolStatsBucket) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.PoolStatsBucket.startTime":
+		x.StartTime = nil
+	case "cosmos.simpleswap.v1.PoolStatsBucket.inputDenom":
+		x.InputDenom = ""
+	case "cosmos.simpleswap.v1.PoolStatsBucket.outputDenom":
+		x.OutputDenom = ""
+	case "cosmos.simpleswap.v1.PoolStatsBucket.volume":
+		x.Volume = ""
+	case "cosmos.simpleswap.v1.PoolStatsBucket.fees":
+		x.Fees = ""
+	case "cosmos.simpleswap.v1.PoolStatsBucket.swapCount":
+		x.SwapCount = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.PoolStatsBucket"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.PoolStatsBucket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PoolStatsBucket) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.simpleswap.v1.PoolStatsBucket.startTime":
+		value := x.StartTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.simpleswap.v1.PoolStatsBucket.inputDenom":
+		value := x.InputDenom
+		return protoreflect.ValueOfString(value)
+	case "cosmos.simpleswap.v1.PoolStatsBucket.outputDenom":
+		value := x.OutputDenom
+		return protoreflect.ValueOfString(value)
+	case "cosmos.simpleswap.v1.PoolStatsBucket.volume":
+		value := x.Volume
+		return protoreflect.ValueOfString(value)
+	case "cosmos.simpleswap.v1.PoolStatsBucket.fees":
+		value := x.Fees
+		return protoreflect.ValueOfString(value)
+	case "cosmos.simpleswap.v1.PoolStatsBucket.swapCount":
+		value := x.SwapCount
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.PoolStatsBucket"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.PoolStatsBucket does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PoolStatsBucket) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.PoolStatsBucket.startTime":
+		x.StartTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.simpleswap.v1.PoolStatsBucket.inputDenom":
+		x.InputDenom = value.Interface().(string)
+	case "cosmos.simpleswap.v1.PoolStatsBucket.outputDenom":
+		x.OutputDenom = value.Interface().(string)
+	case "cosmos.simpleswap.v1.PoolStatsBucket.volume":
+		x.Volume = value.Interface().(string)
+	case "cosmos.simpleswap.v1.PoolStatsBucket.fees":
+		x.Fees = value.Interface().(string)
+	case "cosmos.simpleswap.v1.PoolStatsBucket.swapCount":
+		x.SwapCount = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.PoolStatsBucket"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.PoolStatsBucket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PoolStatsBucket) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.PoolStatsBucket.startTime":
+		if x.StartTime == nil {
+			x.StartTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+	case "cosmos.simpleswap.v1.PoolStatsBucket.inputDenom":
+		panic(fmt.Errorf("field inputDenom of message cosmos.simpleswap.v1.PoolStatsBucket is not mutable"))
+	case "cosmos.simpleswap.v1.PoolStatsBucket.outputDenom":
+		panic(fmt.Errorf("field outputDenom of message cosmos.simpleswap.v1.PoolStatsBucket is not mutable"))
+	case "cosmos.simpleswap.v1.PoolStatsBucket.volume":
+		panic(fmt.Errorf("field volume of message cosmos.simpleswap.v1.PoolStatsBucket is not mutable"))
+	case "cosmos.simpleswap.v1.PoolStatsBucket.fees":
+		panic(fmt.Errorf("field fees of message cosmos.simpleswap.v1.PoolStatsBucket is not mutable"))
+	case "cosmos.simpleswap.v1.PoolStatsBucket.swapCount":
+		panic(fmt.Errorf("field swapCount of message cosmos.simpleswap.v1.PoolStatsBucket is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.PoolStatsBucket"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.PoolStatsBucket does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PoolStatsBucket) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.PoolStatsBucket.startTime":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.simpleswap.v1.PoolStatsBucket.inputDenom":
+		return protoreflect.ValueOfString("")
+	case "cosmos.simpleswap.v1.PoolStatsBucket.outputDenom":
+		return protoreflect.ValueOfString("")
+	case "cosmos.simpleswap.v1.PoolStatsBucket.volume":
+		return protoreflect.ValueOfString("")
+	case "cosmos.simpleswap.v1.PoolStatsBucket.fees":
+		return protoreflect.ValueOfString("")
+	case "cosmos.simpleswap.v1.PoolStatsBucket.swapCount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.PoolStatsBucket"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.PoolStatsBucket does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PoolStatsBucket) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.simpleswap.v1.PoolStatsBucket", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PoolStatsBucket) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PoolStatsBucket) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PoolStatsBucket) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PoolStatsBucket) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PoolStatsBucket)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.StartTime != nil {
+			l = options.Size(x.StartTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.InputDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.OutputDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Volume)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Fees)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SwapCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.SwapCount))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PoolStatsBucket)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.SwapCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SwapCount))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Fees) > 0 {
+			i -= len(x.Fees)
+			copy(dAtA[i:], x.Fees)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Fees)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Volume) > 0 {
+			i -= len(x.Volume)
+			copy(dAtA[i:], x.Volume)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Volume)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.OutputDenom) > 0 {
+			i -= len(x.OutputDenom)
+			copy(dAtA[i:], x.OutputDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OutputDenom)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.InputDenom) > 0 {
+			i -= len(x.InputDenom)
+			copy(dAtA[i:], x.InputDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InputDenom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.StartTime != nil {
+			encoded, err := options.Marshal(x.StartTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PoolStatsBucket)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PoolStatsBucket: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PoolStatsBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.StartTime == nil {
+					x.StartTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StartTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InputDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InputDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutputDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OutputDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Volume = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Fees = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SwapCount", wireType)
+				}
+				x.SwapCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SwapCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_GenesisState_3_list)(nil)
+
+type _GenesisState_3_list struct {
+	list *[]*GenesisLiquidityProvider
+}
+
+func (x *_GenesisState_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*GenesisLiquidityProvider)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*GenesisLiquidityProvider)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_3_list) AppendMutable() protoreflect.Value {
+	v := new(GenesisLiquidityProvider)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_3_list) NewElement() protoreflect.Value {
+	v := new(GenesisLiquidityProvider)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_4_list)(nil)
+
+type _GenesisState_4_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_GenesisState_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*Gauge
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Gauge)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Gauge)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(Gauge)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(Gauge)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*GenesisRewards
+}
+
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*GenesisRewards)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*GenesisRewards)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(GenesisRewards)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(GenesisRewards)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_8_list)(nil)
+
+type _GenesisState_8_list struct {
+	list *[]*PeriodLock
+}
+
+func (x *_GenesisState_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PeriodLock)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PeriodLock)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_8_list) AppendMutable() protoreflect.Value {
+	v := new(PeriodLock)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_8_list) NewElement() protoreflect.Value {
+	v := new(PeriodLock)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*AssetRate
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AssetRate)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AssetRate)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(AssetRate)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(AssetRate)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_11_list)(nil)
+
+type _GenesisState_11_list struct {
+	list *[]*LimitOrder
+}
+
+func (x *_GenesisState_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LimitOrder)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LimitOrder)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_11_list) AppendMutable() protoreflect.Value {
+	v := new(LimitOrder)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_11_list) NewElement() protoreflect.Value {
+	v := new(LimitOrder)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_13_list)(nil)
+
+type _GenesisState_13_list struct {
+	list *[]*DCAPlan
+}
+
+func (x *_GenesisState_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DCAPlan)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DCAPlan)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_13_list) AppendMutable() protoreflect.Value {
+	v := new(DCAPlan)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_13_list) NewElement() protoreflect.Value {
+	v := new(DCAPlan)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_16_list)(nil)
+
+type _GenesisState_16_list struct {
+	list *[]*PoolStatsBucket
+}
+
+func (x *_GenesisState_16_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PoolStatsBucket)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PoolStatsBucket)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_16_list) AppendMutable() protoreflect.Value {
+	v := new(PoolStatsBucket)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_16_list) NewElement() protoreflect.Value {
+	v := new(PoolStatsBucket)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_GenesisState                    protoreflect.MessageDescriptor
+	fd_GenesisState_pool               protoreflect.FieldDescriptor
+	fd_GenesisState_params             protoreflect.FieldDescriptor
+	fd_GenesisState_liquidityProviders protoreflect.FieldDescriptor
+	fd_GenesisState_coinsReserve       protoreflect.FieldDescriptor
+	fd_GenesisState_gauges             protoreflect.FieldDescriptor
+	fd_GenesisState_gaugeSequence      protoreflect.FieldDescriptor
+	fd_GenesisState_rewards            protoreflect.FieldDescriptor
+	fd_GenesisState_locks              protoreflect.FieldDescriptor
+	fd_GenesisState_lockSequence       protoreflect.FieldDescriptor
+	fd_GenesisState_assetRates         protoreflect.FieldDescriptor
+	fd_GenesisState_limitOrders        protoreflect.FieldDescriptor
+	fd_GenesisState_limitOrderSequence protoreflect.FieldDescriptor
+	fd_GenesisState_dcaPlans           protoreflect.FieldDescriptor
+	fd_GenesisState_dcaPlanSequence    protoreflect.FieldDescriptor
+	fd_GenesisState_batchSwapSequence  protoreflect.FieldDescriptor
+	fd_GenesisState_poolStats          protoreflect.FieldDescriptor
+	fd_GenesisState_rewardAccumulator  protoreflect.FieldDescriptor
+	fd_GenesisState_limitOrderCursor   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_simpleswap_v1_types_proto_init()
+	md_GenesisState = File_cosmos_simpleswap_v1_types_proto.Messages().ByName("GenesisState")
+	fd_GenesisState_pool = md_GenesisState.Fields().ByName("pool")
+	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_liquidityProviders = md_GenesisState.Fields().ByName("liquidityProviders")
+	fd_GenesisState_coinsReserve = md_GenesisState.Fields().ByName("coinsReserve")
+	fd_GenesisState_gauges = md_GenesisState.Fields().ByName("gauges")
+	fd_GenesisState_gaugeSequence = md_GenesisState.Fields().ByName("gaugeSequence")
+	fd_GenesisState_rewards = md_GenesisState.Fields().ByName("rewards")
+	fd_GenesisState_locks = md_GenesisState.Fields().ByName("locks")
+	fd_GenesisState_lockSequence = md_GenesisState.Fields().ByName("lockSequence")
+	fd_GenesisState_assetRates = md_GenesisState.Fields().ByName("assetRates")
+	fd_GenesisState_limitOrders = md_GenesisState.Fields().ByName("limitOrders")
+	fd_GenesisState_limitOrderSequence = md_GenesisState.Fields().ByName("limitOrderSequence")
+	fd_GenesisState_dcaPlans = md_GenesisState.Fields().ByName("dcaPlans")
+	fd_GenesisState_dcaPlanSequence = md_GenesisState.Fields().ByName("dcaPlanSequence")
+	fd_GenesisState_batchSwapSequence = md_GenesisState.Fields().ByName("batchSwapSequence")
+	fd_GenesisState_poolStats = md_GenesisState.Fields().ByName("poolStats")
+	fd_GenesisState_rewardAccumulator = md_GenesisState.Fields().ByName("rewardAccumulator")
+	fd_GenesisState_limitOrderCursor = md_GenesisState.Fields().ByName("limitOrderCursor")
+}
+
+var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+
+type fastReflection_GenesisState GenesisState
+
+func (x *GenesisState) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GenesisState)(x)
+}
+
+func (x *GenesisState) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
+var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+
+type fastReflection_GenesisState_messageType struct{}
+
+func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GenesisState)(nil)
+}
+func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
+	return _fastReflection_GenesisState_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GenesisState) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
+	return (*GenesisState)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pool != nil {
+		value := protoreflect.ValueOfMessage(x.Pool.ProtoReflect())
+		if !f(fd_GenesisState_pool, value) {
+			return
+		}
+	}
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_GenesisState_params, value) {
+			return
+		}
+	}
+	if len(x.LiquidityProviders) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.LiquidityProviders})
+		if !f(fd_GenesisState_liquidityProviders, value) {
+			return
+		}
+	}
+	if len(x.CoinsReserve) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.CoinsReserve})
+		if !f(fd_GenesisState_coinsReserve, value) {
+			return
+		}
+	}
+	if len(x.Gauges) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.Gauges})
+		if !f(fd_GenesisState_gauges, value) {
+			return
+		}
+	}
+	if x.GaugeSequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GaugeSequence)
+		if !f(fd_GenesisState_gaugeSequence, value) {
+			return
+		}
+	}
+	if len(x.Rewards) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.Rewards})
+		if !f(fd_GenesisState_rewards, value) {
+			return
+		}
+	}
+	if len(x.Locks) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.Locks})
+		if !f(fd_GenesisState_locks, value) {
+			return
+		}
+	}
+	if x.LockSequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LockSequence)
+		if !f(fd_GenesisState_lockSequence, value) {
+			return
+		}
+	}
+	if len(x.AssetRates) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.AssetRates})
+		if !f(fd_GenesisState_assetRates, value) {
+			return
+		}
+	}
+	if len(x.LimitOrders) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_11_list{list: &x.LimitOrders})
+		if !f(fd_GenesisState_limitOrders, value) {
+			return
+		}
+	}
+	if x.LimitOrderSequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LimitOrderSequence)
+		if !f(fd_GenesisState_limitOrderSequence, value) {
+			return
+		}
+	}
+	if len(x.DcaPlans) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_13_list{list: &x.DcaPlans})
+		if !f(fd_GenesisState_dcaPlans, value) {
+			return
+		}
+	}
+	if x.DcaPlanSequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DcaPlanSequence)
+		if !f(fd_GenesisState_dcaPlanSequence, value) {
+			return
+		}
+	}
+	if x.BatchSwapSequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BatchSwapSequence)
+		if !f(fd_GenesisState_batchSwapSequence, value) {
+			return
+		}
+	}
+	if len(x.PoolStats) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_16_list{list: &x.PoolStats})
+		if !f(fd_GenesisState_poolStats, value) {
+			return
+		}
+	}
+	if x.RewardAccumulator != nil {
+		value := protoreflect.ValueOfMessage(x.RewardAccumulator.ProtoReflect())
+		if !f(fd_GenesisState_rewardAccumulator, value) {
+			return
+		}
+	}
+	if x.LimitOrderCursor != nil {
+		value := protoreflect.ValueOfMessage(x.LimitOrderCursor.ProtoReflect())
+		if !f(fd_GenesisState_limitOrderCursor, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.GenesisState.pool":
+		return x.Pool != nil
+	case "cosmos.simpleswap.v1.GenesisState.params":
+		return x.Params != nil
+	case "cosmos.simpleswap.v1.GenesisState.liquidityProviders":
+		return len(x.LiquidityProviders) != 0
+	case "cosmos.simpleswap.v1.GenesisState.coinsReserve":
+		return len(x.CoinsReserve) != 0
+	case "cosmos.simpleswap.v1.GenesisState.gauges":
+		return len(x.Gauges) != 0
+	case "cosmos.simpleswap.v1.GenesisState.gaugeSequence":
+		return x.GaugeSequence != uint64(0)
+	case "cosmos.simpleswap.v1.GenesisState.rewards":
+		return len(x.Rewards) != 0
+	case "cosmos.simpleswap.v1.GenesisState.locks":
+		return len(x.Locks) != 0
+	case "cosmos.simpleswap.v1.GenesisState.lockSequence":
+		return x.LockSequence != uint64(0)
+	case "cosmos.simpleswap.v1.GenesisState.assetRates":
+		return len(x.AssetRates) != 0
+	case "cosmos.simpleswap.v1.GenesisState.limitOrders":
+		return len(x.LimitOrders) != 0
+	case "cosmos.simpleswap.v1.GenesisState.limitOrderSequence":
+		return x.LimitOrderSequence != uint64(0)
+	case "cosmos.simpleswap.v1.GenesisState.dcaPlans":
+		return len(x.DcaPlans) != 0
+	case "cosmos.simpleswap.v1.GenesisState.dcaPlanSequence":
+		return x.DcaPlanSequence != uint64(0)
+	case "cosmos.simpleswap.v1.GenesisState.batchSwapSequence":
+		return x.BatchSwapSequence != uint64(0)
+	case "cosmos.simpleswap.v1.GenesisState.poolStats":
+		return len(x.PoolStats) != 0
+	case "cosmos.simpleswap.v1.GenesisState.rewardAccumulator":
+		return x.RewardAccumulator != nil
+	case "cosmos.simpleswap.v1.GenesisState.limitOrderCursor":
+		return x.LimitOrderCursor != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.GenesisState.pool":
+		x.Pool = nil
+	case "cosmos.simpleswap.v1.GenesisState.params":
+		x.Params = nil
+	case "cosmos.simpleswap.v1.GenesisState.liquidityProviders":
+		x.LiquidityProviders = nil
+	case "cosmos.simpleswap.v1.GenesisState.coinsReserve":
+		x.CoinsReserve = nil
+	case "cosmos.simpleswap.v1.GenesisState.gauges":
+		x.Gauges = nil
+	case "cosmos.simpleswap.v1.GenesisState.gaugeSequence":
+		x.GaugeSequence = uint64(0)
+	case "cosmos.simpleswap.v1.GenesisState.rewards":
+		x.Rewards = nil
+	case "cosmos.simpleswap.v1.GenesisState.locks":
+		x.Locks = nil
+	case "cosmos.simpleswap.v1.GenesisState.lockSequence":
+		x.LockSequence = uint64(0)
+	case "cosmos.simpleswap.v1.GenesisState.assetRates":
+		x.AssetRates = nil
+	case "cosmos.simpleswap.v1.GenesisState.limitOrders":
+		x.LimitOrders = nil
+	case "cosmos.simpleswap.v1.GenesisState.limitOrderSequence":
+		x.LimitOrderSequence = uint64(0)
+	case "cosmos.simpleswap.v1.GenesisState.dcaPlans":
+		x.DcaPlans = nil
+	case "cosmos.simpleswap.v1.GenesisState.dcaPlanSequence":
+		x.DcaPlanSequence = uint64(0)
+	case "cosmos.simpleswap.v1.GenesisState.batchSwapSequence":
+		x.BatchSwapSequence = uint64(0)
+	case "cosmos.simpleswap.v1.GenesisState.poolStats":
+		x.PoolStats = nil
+	case "cosmos.simpleswap.v1.GenesisState.rewardAccumulator":
+		x.RewardAccumulator = nil
+	case "cosmos.simpleswap.v1.GenesisState.limitOrderCursor":
+		x.LimitOrderCursor = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.simpleswap.v1.GenesisState.pool":
+		value := x.Pool
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.simpleswap.v1.GenesisState.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.simpleswap.v1.GenesisState.liquidityProviders":
+		if len(x.LiquidityProviders) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_3_list{})
+		}
+		listValue := &_GenesisState_3_list{list: &x.LiquidityProviders}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.simpleswap.v1.GenesisState.coinsReserve":
+		if len(x.CoinsReserve) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.CoinsReserve}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.simpleswap.v1.GenesisState.gauges":
+		if len(x.Gauges) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.Gauges}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.simpleswap.v1.GenesisState.gaugeSequence":
+		value := x.GaugeSequence
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.simpleswap.v1.GenesisState.rewards":
+		if len(x.Rewards) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.Rewards}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.simpleswap.v1.GenesisState.locks":
+		if len(x.Locks) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.Locks}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.simpleswap.v1.GenesisState.lockSequence":
+		value := x.LockSequence
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.simpleswap.v1.GenesisState.assetRates":
+		if len(x.AssetRates) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.AssetRates}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.simpleswap.v1.GenesisState.limitOrders":
+		if len(x.LimitOrders) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_11_list{})
+		}
+		listValue := &_GenesisState_11_list{list: &x.LimitOrders}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.simpleswap.v1.GenesisState.limitOrderSequence":
+		value := x.LimitOrderSequence
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.simpleswap.v1.GenesisState.dcaPlans":
+		if len(x.DcaPlans) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_13_list{})
+		}
+		listValue := &_GenesisState_13_list{list: &x.DcaPlans}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.simpleswap.v1.GenesisState.dcaPlanSequence":
+		value := x.DcaPlanSequence
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.simpleswap.v1.GenesisState.batchSwapSequence":
+		value := x.BatchSwapSequence
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.simpleswap.v1.GenesisState.poolStats":
+		if len(x.PoolStats) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_16_list{})
+		}
+		listValue := &_GenesisState_16_list{list: &x.PoolStats}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.simpleswap.v1.GenesisState.rewardAccumulator":
+		value := x.RewardAccumulator
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.simpleswap.v1.GenesisState.limitOrderCursor":
+		value := x.LimitOrderCursor
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.GenesisState does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.GenesisState.pool":
+		x.Pool = value.Message().Interface().(*Pool)
+	case "cosmos.simpleswap.v1.GenesisState.params":
+		x.Params = value.Message().Interface().(*Params)
+	case "cosmos.simpleswap.v1.GenesisState.liquidityProviders":
+		lv := value.List()
+		clv := lv.(*_GenesisState_3_list)
+		x.LiquidityProviders = *clv.list
+	case "cosmos.simpleswap.v1.GenesisState.coinsReserve":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.CoinsReserve = *clv.list
+	case "cosmos.simpleswap.v1.GenesisState.gauges":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.Gauges = *clv.list
+	case "cosmos.simpleswap.v1.GenesisState.gaugeSequence":
+		x.GaugeSequence = value.Uint()
+	case "cosmos.simpleswap.v1.GenesisState.rewards":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.Rewards = *clv.list
+	case "cosmos.simpleswap.v1.GenesisState.locks":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.Locks = *clv.list
+	case "cosmos.simpleswap.v1.GenesisState.lockSequence":
+		x.LockSequence = value.Uint()
+	case "cosmos.simpleswap.v1.GenesisState.assetRates":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.AssetRates = *clv.list
+	case "cosmos.simpleswap.v1.GenesisState.limitOrders":
+		lv := value.List()
+		clv := lv.(*_GenesisState_11_list)
+		x.LimitOrders = *clv.list
+	case "cosmos.simpleswap.v1.GenesisState.limitOrderSequence":
+		x.LimitOrderSequence = value.Uint()
+	case "cosmos.simpleswap.v1.GenesisState.dcaPlans":
+		lv := value.List()
+		clv := lv.(*_GenesisState_13_list)
+		x.DcaPlans = *clv.list
+	case "cosmos.simpleswap.v1.GenesisState.dcaPlanSequence":
+		x.DcaPlanSequence = value.Uint()
+	case "cosmos.simpleswap.v1.GenesisState.batchSwapSequence":
+		x.BatchSwapSequence = value.Uint()
+	case "cosmos.simpleswap.v1.GenesisState.poolStats":
+		lv := value.List()
+		clv := lv.(*_GenesisState_16_list)
+		x.PoolStats = *clv.list
+	case "cosmos.simpleswap.v1.GenesisState.rewardAccumulator":
+		x.RewardAccumulator = value.Message().Interface().(*RewardAccumulator)
+	case "cosmos.simpleswap.v1.GenesisState.limitOrderCursor":
+		x.LimitOrderCursor = value.Message().Interface().(*LimitOrderCursor)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.GenesisState.pool":
+		if x.Pool == nil {
+			x.Pool = new(Pool)
+		}
+		return protoreflect.ValueOfMessage(x.Pool.ProtoReflect())
+	case "cosmos.simpleswap.v1.GenesisState.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "cosmos.simpleswap.v1.GenesisState.liquidityProviders":
+		if x.LiquidityProviders == nil {
+			x.LiquidityProviders = []*GenesisLiquidityProvider{}
+		}
+		value := &_GenesisState_3_list{list: &x.LiquidityProviders}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.simpleswap.v1.GenesisState.coinsReserve":
+		if x.CoinsReserve == nil {
+			x.CoinsReserve = []*v1beta1.Coin{}
+		}
+		value := &_GenesisState_4_list{list: &x.CoinsReserve}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.simpleswap.v1.GenesisState.gauges":
+		if x.Gauges == nil {
+			x.Gauges = []*Gauge{}
+		}
+		value := &_GenesisState_5_list{list: &x.Gauges}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.simpleswap.v1.GenesisState.rewards":
+		if x.Rewards == nil {
+			x.Rewards = []*GenesisRewards{}
+		}
+		value := &_GenesisState_7_list{list: &x.Rewards}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.simpleswap.v1.GenesisState.locks":
+		if x.Locks == nil {
+			x.Locks = []*PeriodLock{}
+		}
+		value := &_GenesisState_8_list{list: &x.Locks}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.simpleswap.v1.GenesisState.assetRates":
+		if x.AssetRates == nil {
+			x.AssetRates = []*AssetRate{}
+		}
+		value := &_GenesisState_10_list{list: &x.AssetRates}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.simpleswap.v1.GenesisState.limitOrders":
+		if x.LimitOrders == nil {
+			x.LimitOrders = []*LimitOrder{}
+		}
+		value := &_GenesisState_11_list{list: &x.LimitOrders}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.simpleswap.v1.GenesisState.dcaPlans":
+		if x.DcaPlans == nil {
+			x.DcaPlans = []*DCAPlan{}
+		}
+		value := &_GenesisState_13_list{list: &x.DcaPlans}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.simpleswap.v1.GenesisState.poolStats":
+		if x.PoolStats == nil {
+			x.PoolStats = []*PoolStatsBucket{}
+		}
+		value := &_GenesisState_16_list{list: &x.PoolStats}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.simpleswap.v1.GenesisState.rewardAccumulator":
+		if x.RewardAccumulator == nil {
+			x.RewardAccumulator = new(RewardAccumulator)
+		}
+		return protoreflect.ValueOfMessage(x.RewardAccumulator.ProtoReflect())
+	case "cosmos.simpleswap.v1.GenesisState.limitOrderCursor":
+		if x.LimitOrderCursor == nil {
+			x.LimitOrderCursor = new(LimitOrderCursor)
+		}
+		return protoreflect.ValueOfMessage(x.LimitOrderCursor.ProtoReflect())
+	case "cosmos.simpleswap.v1.GenesisState.gaugeSequence":
+		panic(fmt.Errorf("field gaugeSequence of message cosmos.simpleswap.v1.GenesisState is not mutable"))
+	case "cosmos.simpleswap.v1.GenesisState.lockSequence":
+		panic(fmt.Errorf("field lockSequence of message cosmos.simpleswap.v1.GenesisState is not mutable"))
+	case "cosmos.simpleswap.v1.GenesisState.limitOrderSequence":
+		panic(fmt.Errorf("field limitOrderSequence of message cosmos.simpleswap.v1.GenesisState is not mutable"))
+	case "cosmos.simpleswap.v1.GenesisState.dcaPlanSequence":
+		panic(fmt.Errorf("field dcaPlanSequence of message cosmos.simpleswap.v1.GenesisState is not mutable"))
+	case "cosmos.simpleswap.v1.GenesisState.batchSwapSequence":
+		panic(fmt.Errorf("field batchSwapSequence of message cosmos.simpleswap.v1.GenesisState is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.GenesisState.pool":
+		m := new(Pool)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.simpleswap.v1.GenesisState.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.simpleswap.v1.GenesisState.liquidityProviders":
+		list := []*GenesisLiquidityProvider{}
+		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
+	case "cosmos.simpleswap.v1.GenesisState.coinsReserve":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "cosmos.simpleswap.v1.GenesisState.gauges":
+		list := []*Gauge{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "cosmos.simpleswap.v1.GenesisState.gaugeSequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.simpleswap.v1.GenesisState.rewards":
+		list := []*GenesisRewards{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "cosmos.simpleswap.v1.GenesisState.locks":
+		list := []*PeriodLock{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+	case "cosmos.simpleswap.v1.GenesisState.lockSequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.simpleswap.v1.GenesisState.assetRates":
+		list := []*AssetRate{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "cosmos.simpleswap.v1.GenesisState.limitOrders":
+		list := []*LimitOrder{}
+		return protoreflect.ValueOfList(&_GenesisState_11_list{list: &list})
+	case "cosmos.simpleswap.v1.GenesisState.limitOrderSequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.simpleswap.v1.GenesisState.dcaPlans":
+		list := []*DCAPlan{}
+		return protoreflect.ValueOfList(&_GenesisState_13_list{list: &list})
+	case "cosmos.simpleswap.v1.GenesisState.dcaPlanSequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.simpleswap.v1.GenesisState.batchSwapSequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.simpleswap.v1.GenesisState.poolStats":
+		list := []*PoolStatsBucket{}
+		return protoreflect.ValueOfList(&_GenesisState_16_list{list: &list})
+	case "cosmos.simpleswap.v1.GenesisState.rewardAccumulator":
+		m := new(RewardAccumulator)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.simpleswap.v1.GenesisState.limitOrderCursor":
+		m := new(LimitOrderCursor)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.simpleswap.v1.GenesisState", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GenesisState) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Pool != nil {
+			l = options.Size(x.Pool)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.LiquidityProviders) > 0 {
+			for _, e := range x.LiquidityProviders {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.CoinsReserve) > 0 {
+			for _, e := range x.CoinsReserve {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Gauges) > 0 {
+			for _, e := range x.Gauges {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.GaugeSequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.GaugeSequence))
+		}
+		if len(x.Rewards) > 0 {
+			for _, e := range x.Rewards {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Locks) > 0 {
+			for _, e := range x.Locks {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.LockSequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.LockSequence))
+		}
+		if len(x.AssetRates) > 0 {
+			for _, e := range x.AssetRates {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.LimitOrders) > 0 {
+			for _, e := range x.LimitOrders {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.LimitOrderSequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.LimitOrderSequence))
+		}
+		if len(x.DcaPlans) > 0 {
+			for _, e := range x.DcaPlans {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.DcaPlanSequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.DcaPlanSequence))
+		}
+		if x.BatchSwapSequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.BatchSwapSequence))
+		}
+		if len(x.PoolStats) > 0 {
+			for _, e := range x.PoolStats {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.RewardAccumulator != nil {
+			l = options.Size(x.RewardAccumulator)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.LimitOrderCursor != nil {
+			l = options.Size(x.LimitOrderCursor)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.LimitOrderCursor != nil {
+			encoded, err := options.Marshal(x.LimitOrderCursor)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+		if x.RewardAccumulator != nil {
+			encoded, err := options.Marshal(x.RewardAccumulator)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if len(x.PoolStats) > 0 {
+			for iNdEx := len(x.PoolStats) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PoolStats[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if x.BatchSwapSequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BatchSwapSequence))
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.DcaPlanSequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DcaPlanSequence))
+			i--
+			dAtA[i] = 0x70
+		}
+		if len(x.DcaPlans) > 0 {
+			for iNdEx := len(x.DcaPlans) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DcaPlans[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if x.LimitOrderSequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LimitOrderSequence))
+			i--
+			dAtA[i] = 0x60
+		}
+		if len(x.LimitOrders) > 0 {
+			for iNdEx := len(x.LimitOrders) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LimitOrders[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if len(x.AssetRates) > 0 {
+			for iNdEx := len(x.AssetRates) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AssetRates[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if x.LockSequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LockSequence))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.Locks) > 0 {
+			for iNdEx := len(x.Locks) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Locks[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.Rewards) > 0 {
+			for iNdEx := len(x.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Rewards[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if x.GaugeSequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GaugeSequence))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Gauges) > 0 {
+			for iNdEx := len(x.Gauges) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Gauges[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.CoinsReserve) > 0 {
+			for iNdEx := len(x.CoinsReserve) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CoinsReserve[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.LiquidityProviders) > 0 {
+			for iNdEx := len(x.LiquidityProviders) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LiquidityProviders[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Pool != nil {
+			encoded, err := options.Marshal(x.Pool)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pool == nil {
+					x.Pool = &Pool{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pool); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LiquidityProviders", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LiquidityProviders = append(x.LiquidityProviders, &GenesisLiquidityProvider{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LiquidityProviders[len(x.LiquidityProviders)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CoinsReserve", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CoinsReserve = append(x.CoinsReserve, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CoinsReserve[len(x.CoinsReserve)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Gauges", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Gauges = append(x.Gauges, &Gauge{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Gauges[len(x.Gauges)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GaugeSequence", wireType)
+				}
+				x.GaugeSequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GaugeSequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Rewards = append(x.Rewards, &GenesisRewards{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Rewards[len(x.Rewards)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Locks = append(x.Locks, &PeriodLock{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Locks[len(x.Locks)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockSequence", wireType)
+				}
+				x.LockSequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LockSequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AssetRates", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AssetRates = append(x.AssetRates, &AssetRate{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AssetRates[len(x.AssetRates)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LimitOrders", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LimitOrders = append(x.LimitOrders, &LimitOrder{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LimitOrders[len(x.LimitOrders)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LimitOrderSequence", wireType)
+				}
+				x.LimitOrderSequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LimitOrderSequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DcaPlans", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DcaPlans = append(x.DcaPlans, &DCAPlan{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DcaPlans[len(x.DcaPlans)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DcaPlanSequence", wireType)
+				}
+				x.DcaPlanSequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DcaPlanSequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchSwapSequence", wireType)
+				}
+				x.BatchSwapSequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BatchSwapSequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolStats", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PoolStats = append(x.PoolStats, &PoolStatsBucket{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PoolStats[len(x.PoolStats)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardAccumulator", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RewardAccumulator == nil {
+					x.RewardAccumulator = &RewardAccumulator{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RewardAccumulator); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LimitOrderCursor", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.LimitOrderCursor == nil {
+					x.LimitOrderCursor = &LimitOrderCursor{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LimitOrderCursor); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_LimitOrderCursor             protoreflect.MessageDescriptor
+	fd_LimitOrderCursor_lastOrderId protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_simpleswap_v1_types_proto_init()
+	md_LimitOrderCursor = File_cosmos_simpleswap_v1_types_proto.Messages().ByName("LimitOrderCursor")
+	fd_LimitOrderCursor_lastOrderId = md_LimitOrderCursor.Fields().ByName("lastOrderId")
+}
+
+var _ protoreflect.Message = (*fastReflection_LimitOrderCursor)(nil)
+
+type fastReflection_LimitOrderCursor LimitOrderCursor
+
+func (x *LimitOrderCursor) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LimitOrderCursor)(x)
+}
+
+func (x *LimitOrderCursor) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_LimitOrderCursor_messageType fastReflection_LimitOrderCursor_messageType
+var _ protoreflect.MessageType = fastReflection_LimitOrderCursor_messageType{}
+
+type fastReflection_LimitOrderCursor_messageType struct{}
+
+func (x fastReflection_LimitOrderCursor_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LimitOrderCursor)(nil)
+}
+func (x fastReflection_LimitOrderCursor_messageType) New() protoreflect.Message {
+	return new(fastReflection_LimitOrderCursor)
+}
+func (x fastReflection_LimitOrderCursor_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LimitOrderCursor
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_LimitOrderCursor) Descriptor() protoreflect.MessageDescriptor {
+	return md_LimitOrderCursor
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_LimitOrderCursor) Type() protoreflect.MessageType {
+	return _fastReflection_LimitOrderCursor_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_LimitOrderCursor) New() protoreflect.Message {
+	return new(fastReflection_LimitOrderCursor)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_LimitOrderCursor) Interface() protoreflect.ProtoMessage {
+	return (*LimitOrderCursor)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_LimitOrderCursor) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LastOrderId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LastOrderId)
+		if !f(fd_LimitOrderCursor_lastOrderId, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_LimitOrderCursor) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.LimitOrderCursor.lastOrderId":
+		return x.LastOrderId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.LimitOrderCursor"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.LimitOrderCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LimitOrderCursor) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.LimitOrderCursor.lastOrderId":
+		x.LastOrderId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.LimitOrderCursor"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.LimitOrderCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_LimitOrderCursor) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.simpleswap.v1.LimitOrderCursor.lastOrderId":
+		value := x.LastOrderId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.LimitOrderCursor"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.LimitOrderCursor does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LimitOrderCursor) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.LimitOrderCursor.lastOrderId":
+		x.LastOrderId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.LimitOrderCursor"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.LimitOrderCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LimitOrderCursor) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.LimitOrderCursor.lastOrderId":
+		panic(fmt.Errorf("field lastOrderId of message cosmos.simpleswap.v1.LimitOrderCursor is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.LimitOrderCursor"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.LimitOrderCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_LimitOrderCursor) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.LimitOrderCursor.lastOrderId":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.LimitOrderCursor"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.LimitOrderCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_LimitOrderCursor) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.simpleswap.v1.LimitOrderCursor", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_LimitOrderCursor) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LimitOrderCursor) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_LimitOrderCursor) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_LimitOrderCursor) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*LimitOrderCursor)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.LastOrderId != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastOrderId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*LimitOrderCursor)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.LastOrderId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastOrderId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*LimitOrderCursor)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LimitOrderCursor: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LimitOrderCursor: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastOrderId", wireType)
+				}
+				x.LastOrderId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastOrderId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_GenesisLiquidityProvider                   protoreflect.MessageDescriptor
+	fd_GenesisLiquidityProvider_address           protoreflect.FieldDescriptor
+	fd_GenesisLiquidityProvider_liquidityProvider protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_simpleswap_v1_types_proto_init()
+	md_GenesisLiquidityProvider = File_cosmos_simpleswap_v1_types_proto.Messages().ByName("GenesisLiquidityProvider")
+	fd_GenesisLiquidityProvider_address = md_GenesisLiquidityProvider.Fields().ByName("address")
+	fd_GenesisLiquidityProvider_liquidityProvider = md_GenesisLiquidityProvider.Fields().ByName("liquidityProvider")
+}
+
+var _ protoreflect.Message = (*fastReflection_GenesisLiquidityProvider)(nil)
+
+type fastReflection_GenesisLiquidityProvider GenesisLiquidityProvider
+
+func (x *GenesisLiquidityProvider) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GenesisLiquidityProvider)(x)
+}
+
+func (x *GenesisLiquidityProvider) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GenesisLiquidityProvider_messageType fastReflection_GenesisLiquidityProvider_messageType
+var _ protoreflect.MessageType = fastReflection_GenesisLiquidityProvider_messageType{}
+
+type fastReflection_GenesisLiquidityProvider_messageType struct{}
+
+func (x fastReflection_GenesisLiquidityProvider_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GenesisLiquidityProvider)(nil)
+}
+func (x fastReflection_GenesisLiquidityProvider_messageType) New() protoreflect.Message {
+	return new(fastReflection_GenesisLiquidityProvider)
+}
+func (x fastReflection_GenesisLiquidityProvider_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisLiquidityProvider
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GenesisLiquidityProvider) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisLiquidityProvider
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GenesisLiquidityProvider) Type() protoreflect.MessageType {
+	return _fastReflection_GenesisLiquidityProvider_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GenesisLiquidityProvider) New() protoreflect.Message {
+	return new(fastReflection_GenesisLiquidityProvider)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GenesisLiquidityProvider) Interface() protoreflect.ProtoMessage {
+	return (*GenesisLiquidityProvider)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GenesisLiquidityProvider) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_GenesisLiquidityProvider_address, value) {
+			return
+		}
+	}
+	if x.LiquidityProvider != nil {
+		value := protoreflect.ValueOfMessage(x.LiquidityProvider.ProtoReflect())
+		if !f(fd_GenesisLiquidityProvider_liquidityProvider, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GenesisLiquidityProvider) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.GenesisLiquidityProvider.address":
+		return x.Address != ""
+	case "cosmos.simpleswap.v1.GenesisLiquidityProvider.liquidityProvider":
+		return x.LiquidityProvider != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.GenesisLiquidityProvider"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.GenesisLiquidityProvider does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisLiquidityProvider) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.GenesisLiquidityProvider.address":
+		x.Address = ""
+	case "cosmos.simpleswap.v1.GenesisLiquidityProvider.liquidityProvider":
+		x.LiquidityProvider = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.GenesisLiquidityProvider"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.GenesisLiquidityProvider does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GenesisLiquidityProvider) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.simpleswap.v1.GenesisLiquidityProvider.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "cosmos.simpleswap.v1.GenesisLiquidityProvider.liquidityProvider":
+		value := x.LiquidityProvider
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.GenesisLiquidityProvider"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.GenesisLiquidityProvider does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisLiquidityProvider) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.GenesisLiquidityProvider.address":
+		x.Address = value.Interface().(string)
+	case "cosmos.simpleswap.v1.GenesisLiquidityProvider.liquidityProvider":
+		x.LiquidityProvider = value.Message().Interface().(*LiquidityProvider)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.GenesisLiquidityProvider"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.GenesisLiquidityProvider does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisLiquidityProvider) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.GenesisLiquidityProvider.liquidityProvider":
+		if x.LiquidityProvider == nil {
+			x.LiquidityProvider = new(LiquidityProvider)
+		}
+		return protoreflect.ValueOfMessage(x.LiquidityProvider.ProtoReflect())
+	case "cosmos.simpleswap.v1.GenesisLiquidityProvider.address":
+		panic(fmt.Errorf("field address of message cosmos.simpleswap.v1.GenesisLiquidityProvider is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.GenesisLiquidityProvider"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.GenesisLiquidityProvider does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GenesisLiquidityProvider) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.GenesisLiquidityProvider.address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.simpleswap.v1.GenesisLiquidityProvider.liquidityProvider":
+		m := new(LiquidityProvider)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.GenesisLiquidityProvider"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.GenesisLiquidityProvider does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GenesisLiquidityProvider) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.simpleswap.v1.GenesisLiquidityProvider", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GenesisLiquidityProvider) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisLiquidityProvider) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GenesisLiquidityProvider) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GenesisLiquidityProvider) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GenesisLiquidityProvider)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LiquidityProvider != nil {
+			l = options.Size(x.LiquidityProvider)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisLiquidityProvider)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.LiquidityProvider != nil {
+			encoded, err := options.Marshal(x.LiquidityProvider)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisLiquidityProvider)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisLiquidityProvider: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisLiquidityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LiquidityProvider", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.LiquidityProvider == nil {
+					x.LiquidityProvider = &LiquidityProvider{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LiquidityProvider); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_GenesisRewards         protoreflect.MessageDescriptor
+	fd_GenesisRewards_address protoreflect.FieldDescriptor
+	fd_GenesisRewards_rewards protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_simpleswap_v1_types_proto_init()
+	md_GenesisRewards = File_cosmos_simpleswap_v1_types_proto.Messages().ByName("GenesisRewards")
+	fd_GenesisRewards_address = md_GenesisRewards.Fields().ByName("address")
+	fd_GenesisRewards_rewards = md_GenesisRewards.Fields().ByName("rewards")
+}
+
+var _ protoreflect.Message = (*fastReflection_GenesisRewards)(nil)
+
+type fastReflection_GenesisRewards GenesisRewards
+
+func (x *GenesisRewards) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GenesisRewards)(x)
+}
+
+func (x *GenesisRewards) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GenesisRewards_messageType fastReflection_GenesisRewards_messageType
+var _ protoreflect.MessageType = fastReflection_GenesisRewards_messageType{}
+
+type fastReflection_GenesisRewards_messageType struct{}
+
+func (x fastReflection_GenesisRewards_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GenesisRewards)(nil)
+}
+func (x fastReflection_GenesisRewards_messageType) New() protoreflect.Message {
+	return new(fastReflection_GenesisRewards)
+}
+func (x fastReflection_GenesisRewards_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisRewards
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GenesisRewards) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisRewards
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GenesisRewards) Type() protoreflect.MessageType {
+	return _fastReflection_GenesisRewards_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GenesisRewards) New() protoreflect.Message {
+	return new(fastReflection_GenesisRewards)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GenesisRewards) Interface() protoreflect.ProtoMessage {
+	return (*GenesisRewards)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GenesisRewards) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_GenesisRewards_address, value) {
+			return
+		}
+	}
+	if x.Rewards != nil {
+		value := protoreflect.ValueOfMessage(x.Rewards.ProtoReflect())
+		if !f(fd_GenesisRewards_rewards, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GenesisRewards) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.GenesisRewards.address":
+		return x.Address != ""
+	case "cosmos.simpleswap.v1.GenesisRewards.rewards":
+		return x.Rewards != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.GenesisRewards"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.GenesisRewards does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisRewards) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.GenesisRewards.address":
+		x.Address = ""
+	case "cosmos.simpleswap.v1.GenesisRewards.rewards":
+		x.Rewards = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.GenesisRewards"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.GenesisRewards does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GenesisRewards) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.simpleswap.v1.GenesisRewards.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "cosmos.simpleswap.v1.GenesisRewards.rewards":
+		value := x.Rewards
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.GenesisRewards"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.GenesisRewards does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisRewards) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.GenesisRewards.address":
+		x.Address = value.Interface().(string)
+	case "cosmos.simpleswap.v1.GenesisRewards.rewards":
+		x.Rewards = value.Message().Interface().(*Rewards)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.GenesisRewards"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.GenesisRewards does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisRewards) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.GenesisRewards.rewards":
+		if x.Rewards == nil {
+			x.Rewards = new(Rewards)
+		}
+		return protoreflect.ValueOfMessage(x.Rewards.ProtoReflect())
+	case "cosmos.simpleswap.v1.GenesisRewards.address":
+		panic(fmt.Errorf("field address of message cosmos.simpleswap.v1.GenesisRewards is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.GenesisRewards"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.GenesisRewards does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GenesisRewards) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.simpleswap.v1.GenesisRewards.address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.simpleswap.v1.GenesisRewards.rewards":
+		m := new(Rewards)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.simpleswap.v1.GenesisRewards"))
+		}
+		panic(fmt.Errorf("message cosmos.simpleswap.v1.GenesisRewards does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GenesisRewards) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.simpleswap.v1.GenesisRewards", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GenesisRewards) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisRewards) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GenesisRewards) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GenesisRewards) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GenesisRewards)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Rewards != nil {
+			l = options.Size(x.Rewards)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisRewards)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Rewards != nil {
+			encoded, err := options.Marshal(x.Rewards)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisRewards)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisRewards: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Rewards == nil {
+					x.Rewards = &Rewards{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Rewards); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: cosmos/simpleswap/v1/types.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// OracleGuardMode defines the action taken by the oracle price guard on a depegged swap.
+type OracleGuardMode int32
+
+const (
+	// ORACLE_GUARD_MODE_DISABLED disables the oracle price guard.
+	OracleGuardMode_ORACLE_GUARD_MODE_DISABLED OracleGuardMode = 0
+	// ORACLE_GUARD_MODE_REJECT rejects swaps exceeding the maximum deviation.
+	OracleGuardMode_ORACLE_GUARD_MODE_REJECT OracleGuardMode = 1
+	// ORACLE_GUARD_MODE_SURCHARGE charges the deviation as an additional fee on the output.
+	OracleGuardMode_ORACLE_GUARD_MODE_SURCHARGE OracleGuardMode = 2
+)
+
+// Enum value maps for OracleGuardMode.
+var (
+	OracleGuardMode_name = map[int32]string{
+		0: "ORACLE_GUARD_MODE_DISABLED",
+		1: "ORACLE_GUARD_MODE_REJECT",
+		2: "ORACLE_GUARD_MODE_SURCHARGE",
+	}
+	OracleGuardMode_value = map[string]int32{
+		"ORACLE_GUARD_MODE_DISABLED":  0,
+		"ORACLE_GUARD_MODE_REJECT":    1,
+		"ORACLE_GUARD_MODE_SURCHARGE": 2,
+	}
+)
+
+func (x OracleGuardMode) Enum() *OracleGuardMode {
+	p := new(OracleGuardMode)
+	*p = x
+	return p
+}
+
+func (x OracleGuardMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OracleGuardMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_cosmos_simpleswap_v1_types_proto_enumTypes[0].Descriptor()
+}
+
+func (OracleGuardMode) Type() protoreflect.EnumType {
+	return &file_cosmos_simpleswap_v1_types_proto_enumTypes[0]
+}
+
+func (x OracleGuardMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OracleGuardMode.Descriptor instead.
+func (OracleGuardMode) EnumDescriptor() ([]byte, []int) {
+	return file_cosmos_simpleswap_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+// Params defines the parameters of the module.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// coins that are listed by the module, by native denom or by the transfer path trace of a bridged
+	// coin, e.g. transfer/channel-0/weth, which resolves to the ibc/<hash> denom of the coin.
+	WhitelistedCoins  []*v1beta1.Coin `protobuf:"bytes,1,rep,name=whitelistedCoins,proto3" json:"whitelistedCoins,omitempty"`
+	SwapFeePercentage int32           `protobuf:"varint,2,opt,name=swapFeePercentage,proto3" json:"swapFeePercentage,omitempty"` // fee percentage charged at swap
+	Decimals          int64           `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`                   // number of decimals for the stablecoin
+	// oracleGuardMode defines how swaps are treated when the oracle price ratio
+	// between the input and output coins deviates from 1 by more than oracleMaxDeviation,
+	// in favour of the trader against the pool.
+	OracleGuardMode OracleGuardMode `protobuf:"varint,5,opt,name=oracleGuardMode,proto3,enum=cosmos.simpleswap.v1.OracleGuardMode" json:"oracleGuardMode,omitempty"`
+	// oracleMaxDeviation is the maximum tolerated deviation of the oracle price
+	// ratio between the input and output coins from 1 in favour of the trader, e.g.
+	// 0.02 for 2%.
+	OracleMaxDeviation string `protobuf:"bytes,6,opt,name=oracleMaxDeviation,proto3" json:"oracleMaxDeviation,omitempty"`
+	// incentivesEpochBlocks is the number of blocks between two gauge reward distributions, 0 disables them.
+	IncentivesEpochBlocks int64 `protobuf:"varint,7,opt,name=incentivesEpochBlocks,proto3" json:"incentivesEpochBlocks,omitempty"`
+	// lockDurations are the allowed share lock durations and their reward-weight multipliers.
+	LockDurations []*LockDuration `protobuf:"bytes,8,rep,name=lockDurations,proto3" json:"lockDurations,omitempty"`
+	// assetExponents are the decimal exponents of the whitelisted coins. A whitelisted coin without
+	// an entry uses the display exponent of its bank denom metadata, or the pool decimals.
+	AssetExponents []*AssetExponent `protobuf:"bytes,9,rep,name=assetExponents,proto3" json:"assetExponents,omitempty"`
+	// feeSwapDenoms are the whitelisted coins accepted to pay the transaction fees. The fees are
+	// swapped through the pool into the native fee denom, which must be whitelisted too.
+	FeeSwapDenoms []string `protobuf:"bytes,10,rep,name=feeSwapDenoms,proto3" json:"feeSwapDenoms,omitempty"`
+	// feeSwapMaxSlippage is the highest share, e.g. 0.01 for 1%, a fee swap output may fall below the
+	// value of the fee at a price independent of the pool: the oracle prices, or without an oracle
+	// the ratio of the validator minimum gas prices in CheckTx. Without either, the output is bounded
+	// against the pool price, which only caps the swap fee and the oracle surcharge.
+	FeeSwapMaxSlippage string `protobuf:"bytes,11,opt,name=feeSwapMaxSlippage,proto3" json:"feeSwapMaxSlippage,omitempty"`
+	// batchAuction enables the batch auction mode of the pool: the swap messages are queued during
+	// the block and cleared at its end at a uniform price per pair, netting the opposite swaps.
+	BatchAuction bool `protobuf:"varint,12,opt,name=batchAuction,proto3" json:"batchAuction,omitempty"`
+	// flashSwapFeePercentage is the fee charged on the output of a flash swap, in the units of
+	// swapFeePercentage, 0 to charge the swap fee percentage of the pool.
+	FlashSwapFeePercentage int32 `protobuf:"varint,13,opt,name=flashSwapFeePercentage,proto3" json:"flashSwapFeePercentage,omitempty"`
+	// statsRetention is how long the hourly pool stats buckets are kept before they are pruned, at
+	// least 7 days for the 7 days stats, 0 to keep them 7 days.
+	StatsRetention *durationpb.Duration `protobuf:"bytes,14,opt,name=statsRetention,proto3" json:"statsRetention,omitempty"`
+	// limitOrderMatchesPerBlock is the highest number of open limit orders tried at the end of a
+	// block, resuming after the last order tried in the previous block, 0 to try 100 orders.
+	LimitOrderMatchesPerBlock uint32 `protobuf:"varint,15,opt,name=limitOrderMatchesPerBlock,proto3" json:"limitOrderMatchesPerBlock,omitempty"`
+	// dcaPlanMaxSkips is the number of slices of a DCA plan skipped in a row closing the plan and
+	// refunding its escrow, 0 to close it after 10 skips.
+	DcaPlanMaxSkips uint32 `protobuf:"varint,16,opt,name=dcaPlanMaxSkips,proto3" json:"dcaPlanMaxSkips,omitempty"`
+	// dcaSlicesPerBlock is the highest number of due DCA slices executed at the end of a block, the
+	// slices not executed stay due and go first in the next block, 0 to execute 100 slices.
+	DcaSlicesPerBlock uint32 `protobuf:"varint,17,opt,name=dcaSlicesPerBlock,proto3" json:"dcaSlicesPerBlock,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_cosmos_simpleswap_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetWhitelistedCoins() []*v1beta1.Coin {
+	if x != nil {
+		return x.WhitelistedCoins
+	}
+	return nil
+}
+
+func (x *Params) GetSwapFeePercentage() int32 {
+	if x != nil {
+		return x.SwapFeePercentage
+	}
+	return 0
+}
+
+func (x *Params) GetDecimals() int64 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *Params) GetOracleGuardMode() OracleGuardMode {
+	if x != nil {
+		return x.OracleGuardMode
+	}
+	return OracleGuardMode_ORACLE_GUARD_MODE_DISABLED
+}
+
+func (x *Params) GetOracleMaxDeviation() string {
+	if x != nil {
+		return x.OracleMaxDeviation
+	}
+	return ""
+}
+
+func (x *Params) GetIncentivesEpochBlocks() int64 {
+	if x != nil {
+		return x.IncentivesEpochBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetLockDurations() []*LockDuration {
+	if x != nil {
+		return x.LockDurations
+	}
+	return nil
+}
+
+func (x *Params) GetAssetExponents() []*AssetExponent {
+	if x != nil {
+		return x.AssetExponents
+	}
+	return nil
+}
+
+func (x *Params) GetFeeSwapDenoms() []string {
+	if x != nil {
+		return x.FeeSwapDenoms
+	}
+	return nil
+}
+
+func (x *Params) GetFeeSwapMaxSlippage() string {
+	if x != nil {
+		return x.FeeSwapMaxSlippage
+	}
+	return ""
+}
+
+func (x *Params) GetBatchAuction() bool {
+	if x != nil {
+		return x.BatchAuction
+	}
+	return false
+}
+
+func (x *Params) GetFlashSwapFeePercentage() int32 {
+	if x != nil {
+		return x.FlashSwapFeePercentage
+	}
+	return 0
+}
+
+func (x *Params) GetStatsRetention() *durationpb.Duration {
+	if x != nil {
+		return x.StatsRetention
+	}
+	return nil
+}
+
+func (x *Params) GetLimitOrderMatchesPerBlock() uint32 {
+	if x != nil {
+		return x.LimitOrderMatchesPerBlock
+	}
+	return 0
+}
+
+func (x *Params) GetDcaPlanMaxSkips() uint32 {
+	if x != nil {
+		return x.DcaPlanMaxSkips
+	}
+	return 0
+}
+
+func (x *Params) GetDcaSlicesPerBlock() uint32 {
+	if x != nil {
+		return x.DcaSlicesPerBlock
+	}
+	return 0
+}
+
+// AssetExponent declares the decimal exponent of a whitelisted coin, e.g. 18 for ETH in wei.
+type AssetExponent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Denom    string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Exponent uint32 `protobuf:"varint,2,opt,name=exponent,proto3" json:"exponent,omitempty"`
+}
+
+func (x *AssetExponent) Reset() {
+	*x = AssetExponent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetExponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetExponent) ProtoMessage() {}
+
+// Deprecated: Use AssetExponent.ProtoReflect.Descriptor instead.
+func (*AssetExponent) Descriptor() ([]byte, []int) {
+	return file_cosmos_simpleswap_v1_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AssetExponent) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *AssetExponent) GetExponent() uint32 {
+	if x != nil {
+		return x.Exponent
+	}
+	return 0
+}
+
+// LockDuration is an allowed share lock duration with its reward-weight multiplier.
+type LockDuration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// duration is the unbonding duration of the lock.
+	Duration *durationpb.Duration `protobuf:"bytes,1,opt,name=duration,proto3" json:"duration,omitempty"`
+	// multiplier is applied to the locked shares when weighting the gauge rewards, e.g. 2 for twice the rewards.
+	Multiplier string `protobuf:"bytes,2,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+}
+
+func (x *LockDuration) Reset() {
+	*x = LockDuration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LockDuration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockDuration) ProtoMessage() {}
+
+// Deprecated: Use LockDuration.ProtoReflect.Descriptor instead.
+func (*LockDuration) Descriptor() ([]byte, []int) {
+	return file_cosmos_simpleswap_v1_types_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LockDuration) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *LockDuration) GetMultiplier() string {
+	if x != nil {
+		return x.Multiplier
+	}
+	return ""
+}
+
+type LiquidityProvider struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StableCoin          *v1beta1.Coin `protobuf:"bytes,1,opt,name=stableCoin,proto3" json:"stableCoin,omitempty"`                    // coin that the LP is providing
+	PoolShare           *v1beta1.Coin `protobuf:"bytes,2,opt,name=poolShare,proto3" json:"poolShare,omitempty"`                      // pool share that the LP is providing
+	AccruedFees         int64         `protobuf:"varint,3,opt,name=accruedFees,proto3" json:"accruedFees,omitempty"`                 // amount of fees accrued
+	GloballyAccruedFees int64         `protobuf:"varint,4,opt,name=globallyAccruedFees,proto3" json:"globallyAccruedFees,omitempty"` // amount of fees accrued globally
+}
+
+func (x *LiquidityProvider) Reset() {
+	*x = LiquidityProvider{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LiquidityProvider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiquidityProvider) ProtoMessage() {}
+
+// Deprecated: Use LiquidityProvider.ProtoReflect.Descriptor instead.
+func (*LiquidityProvider) Descriptor() ([]byte, []int) {
+	return file_cosmos_simpleswap_v1_types_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LiquidityProvider) GetStableCoin() *v1beta1.Coin {
+	if x != nil {
+		return x.StableCoin
+	}
+	return nil
+}
+
+func (x *LiquidityProvider) GetPoolShare() *v1beta1.Coin {
+	if x != nil {
+		return x.PoolShare
+	}
+	return nil
+}
+
+func (x *LiquidityProvider) GetAccruedFees() int64 {
+	if x != nil {
+		return x.AccruedFees
+	}
+	return 0
+}
+
+func (x *LiquidityProvider) GetGloballyAccruedFees() int64 {
+	if x != nil {
+		return x.GloballyAccruedFees
+	}
+	return 0
+}
+
+type Pool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalAccruedFees  int64         `protobuf:"varint,1,opt,name=totalAccruedFees,proto3" json:"totalAccruedFees,omitempty"`
+	TotalLiquidity    int64         `protobuf:"varint,2,opt,name=totalLiquidity,proto3" json:"totalLiquidity,omitempty"`
+	Decimals          int64         `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	ShareToken        *v1beta1.Coin `protobuf:"bytes,4,opt,name=shareToken,proto3" json:"shareToken,omitempty"` // pool share denom and total shares outstanding
+	SwapFeePercentage int32         `protobuf:"varint,5,opt,name=swapFeePercentage,proto3" json:"swapFeePercentage,omitempty"`
+	Id                uint64        `protobuf:"varint,6,opt,name=id,proto3" json:"id,omitempty"` // identifier of the pool
+}
+
+func (x *Pool) Reset() {
+	*x = Pool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pool) ProtoMessage() {}
+
+// Deprecated: Use Pool.ProtoReflect.Descriptor instead.
+func (*Pool) Descriptor() ([]byte, []int) {
+	return file_cosmos_simpleswap_v1_types_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Pool) GetTotalAccruedFees() int64 {
+	if x != nil {
+		return x.TotalAccruedFees
+	}
+	return 0
+}
+
+func (x *Pool) GetTotalLiquidity() int64 {
+	if x != nil {
+		return x.TotalLiquidity
+	}
+	return 0
+}
+
+func (x *Pool) GetDecimals() int64 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *Pool) GetShareToken() *v1beta1.Coin {
+	if x != nil {
+		return x.ShareToken
+	}
+	return nil
+}
+
+func (x *Pool) GetSwapFeePercentage() int32 {
+	if x != nil {
+		return x.SwapFeePercentage
+	}
+	return 0
+}
+
+func (x *Pool) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Gauge distributes reward coins to the share locks of a pool over a number of epochs.
+type Gauge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique identifier of the gauge.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// poolId is the pool whose share locks are rewarded.
+	PoolId uint64 `protobuf:"varint,2,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	// owner is the address that created the gauge.
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	// coins are the total rewards funded to the gauge.
+	Coins []*v1beta1.Coin `protobuf:"bytes,4,rep,name=coins,proto3" json:"coins,omitempty"`
+	// distributedCoins are the rewards already distributed to the lock owners.
+	DistributedCoins []*v1beta1.Coin `protobuf:"bytes,5,rep,name=distributedCoins,proto3" json:"distributedCoins,omitempty"`
+	// numEpochs is the number of epochs the rewards are distributed over.
+	NumEpochs uint64 `protobuf:"varint,6,opt,name=numEpochs,proto3" json:"numEpochs,omitempty"`
+	// filledEpochs is the number of epochs already distributed.
+	FilledEpochs uint64 `protobuf:"varint,7,opt,name=filledEpochs,proto3" json:"filledEpochs,omitempty"`
+}
+
+func (x *Gauge) Reset() {
+	*x = Gauge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gauge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gauge) ProtoMessage() {}
+
+// Deprecated: Use Gauge.ProtoReflect.Descriptor instead.
+func (*Gauge) Descriptor() ([]byte, []int) {
+	return file_cosmos_simpleswap_v1_types_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Gauge) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Gauge) GetPoolId() uint64 {
+	if x != nil {
+		return x.PoolId
+	}
+	return 0
+}
+
+func (x *Gauge) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Gauge) GetCoins() []*v1beta1.Coin {
+	if x != nil {
+		return x.Coins
+	}
+	return nil
+}
+
+func (x *Gauge) GetDistributedCoins() []*v1beta1.Coin {
+	if x != nil {
+		return x.DistributedCoins
+	}
+	return nil
+}
+
+func (x *Gauge) GetNumEpochs() uint64 {
+	if x != nil {
+		return x.NumEpochs
+	}
+	return 0
+}
+
+func (x *Gauge) GetFilledEpochs() uint64 {
+	if x != nil {
+		return x.FilledEpochs
+	}
+	return 0
+}
+
+// Rewards are the gauge rewards credited to a lock owner and not yet claimed, with the reward
+// weight of its locks.
+type Rewards struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// coins are the rewards settled and not yet claimed.
+	Coins []*v1beta1.Coin `protobuf:"bytes,1,rep,name=coins,proto3" json:"coins,omitempty"`
+	// weight is the sum of the reward weights of the locks of the owner.
+	Weight string `protobuf:"bytes,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	// rewardsPerWeight is the rewards per unit of weight of the accumulator when the rewards were
+	// last settled, the weight earns the increase since then.
+	RewardsPerWeight []*v1beta1.DecCoin `protobuf:"bytes,3,rep,name=rewardsPerWeight,proto3" json:"rewardsPerWeight,omitempty"`
+}
+
+func (x *Rewards) Reset() {
+	*x = Rewards{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rewards) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rewards) ProtoMessage() {}
+
+// Deprecated: Use Rewards.ProtoReflect.Descriptor instead.
+func (*Rewards) Descriptor() ([]byte, []int) {
+	return file_cosmos_simpleswap_v1_types_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Rewards) GetCoins() []*v1beta1.Coin {
+	if x != nil {
+		return x.Coins
+	}
+	return nil
+}
+
+func (x *Rewards) GetWeight() string {
+	if x != nil {
+		return x.Weight
+	}
+	return ""
+}
+
+func (x *Rewards) GetRewardsPerWeight() []*v1beta1.DecCoin {
+	if x != nil {
+		return x.RewardsPerWeight
+	}
+	return nil
+}
+
+// RewardAccumulator is the running total of the gauge rewards distributed per unit of reward
+// weight, with the total weight of the locks.
+type RewardAccumulator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// totalWeight is the sum of the reward weights of all the locks.
+	TotalWeight string `protobuf:"bytes,1,opt,name=totalWeight,proto3" json:"totalWeight,omitempty"`
+	// rewardsPerWeight are the rewards distributed per unit of weight since the genesis.
+	RewardsPerWeight []*v1beta1.DecCoin `protobuf:"bytes,2,rep,name=rewardsPerWeight,proto3" json:"rewardsPerWeight,omitempty"`
+}
+
+func (x *RewardAccumulator) Reset() {
+	*x = RewardAccumulator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RewardAccumulator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardAccumulator) ProtoMessage() {}
+
+// Deprecated: Use RewardAccumulator.ProtoReflect.Descriptor instead.
+func (*RewardAccumulator) Descriptor() ([]byte, []int) {
+	return file_cosmos_simpleswap_v1_types_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RewardAccumulator) GetTotalWeight() string {
+	if x != nil {
+		return x.TotalWeight
+	}
+	return ""
+}
+
+func (x *RewardAccumulator) GetRewardsPerWeight() []*v1beta1.DecCoin {
+	if x != nil {
+		return x.RewardsPerWeight
+	}
+	return nil
+}
+
+// DenomOrigin is the human-readable origin of a denom of the pool.
+type DenomOrigin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// denom is the denom of the coins on this chain, e.g. ibc/<hash> for a bridged coin.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// origin is the transfer path trace of a bridged coin, e.g. transfer/channel-0/weth, or the denom
+	// itself for a native coin.
+	Origin string `protobuf:"bytes,2,opt,name=origin,proto3" json:"origin,omitempty"`
+}
+
+func (x *DenomOrigin) Reset() {
+	*x = DenomOrigin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DenomOrigin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DenomOrigin) ProtoMessage() {}
+
+// Deprecated: Use DenomOrigin.ProtoReflect.Descriptor instead.
+func (*DenomOrigin) Descriptor() ([]byte, []int) {
+	return file_cosmos_simpleswap_v1_types_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DenomOrigin) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *DenomOrigin) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+// AssetRate is the governance-set redemption rate of a whitelisted coin, i.e. the value of one
+// unit of the coin in the pool reference asset, e.g. 1.05 for a stkETH worth 1.05 ETH.
+type AssetRate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Rate  string `protobuf:"bytes,2,opt,name=rate,proto3" json:"rate,omitempty"`
+}
+
+func (x *AssetRate) Reset() {
+	*x = AssetRate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetRate) ProtoMessage() {}
+
+// Deprecated: Use AssetRate.ProtoReflect.Descriptor instead.
+func (*AssetRate) Descriptor() ([]byte, []int) {
+	return file_cosmos_simpleswap_v1_types_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AssetRate) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *AssetRate) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+// PeriodLock holds pool shares locked by their owner for a duration.
+type PeriodLock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique identifier of the lock.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address that locked the shares.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// shares are the locked pool shares.
+	Shares *v1beta1.Coin `protobuf:"bytes,3,opt,name=shares,proto3" json:"shares,omitempty"`
+	// duration is the unbonding duration of the lock.
+	Duration *durationpb.Duration `protobuf:"bytes,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	// endTime is the time the shares are released, zero until the unlock begins.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	// rewardWeight is the weight of the lock in the gauge distribution, its shares times the
+	// multiplier of its duration when it was created.
+	RewardWeight string `protobuf:"bytes,6,opt,name=rewardWeight,proto3" json:"rewardWeight,omitempty"`
+}
+
+func (x *PeriodLock) Reset() {
+	*x = PeriodLock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeriodLock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeriodLock) ProtoMessage() {}
+
+// Deprecated: Use PeriodLock.ProtoReflect.Descriptor instead.
+func (*PeriodLock) Descriptor() ([]byte, []int) {
+	return file_cosmos_simpleswap_v1_types_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PeriodLock) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PeriodLock) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *PeriodLock) GetShares() *v1beta1.Coin {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+func (x *PeriodLock) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *PeriodLock) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *PeriodLock) GetRewardWeight() string {
+	if x != nil {
+		return x.RewardWeight
+	}
+	return ""
+}
+
+// LimitOrder is an order of its owner to swap the escrowed input coin for the output denom once
+// the pool pays at least the limit price, swap fee included.
+type LimitOrder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique identifier of the order, the orders are filled in the order of their ids.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address that placed the order and receives the output.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// input is the escrowed coin to swap.
+	Input *v1beta1.Coin `protobuf:"bytes,3,opt,name=input,proto3" json:"input,omitempty"`
+	// outputDenom is the denom of the coin to receive.
+	OutputDenom string `protobuf:"bytes,4,opt,name=outputDenom,proto3" json:"outputDenom,omitempty"`
+	// limitPrice is the lowest output paid per unit of input, net of the swap fee, in the base
+	// units of the coins, e.g. 0.999 to sell 10 WETH for at least 9.99 ETH.
+	LimitPrice string `protobuf:"bytes,5,opt,name=limitPrice,proto3" json:"limitPrice,omitempty"`
+}
+
+func (x *LimitOrder) Reset() {
+	*x = LimitOrder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LimitOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LimitOrder) ProtoMessage() {}
+
+// Deprecated: Use LimitOrder.ProtoReflect.Descriptor instead.
+func (*LimitOrder) Descriptor() ([]byte, []int) {
+	return file_cosmos_simpleswap_v1_types_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *LimitOrder) GetId() uint64 {
+	if x != nil {
+		return x.Id
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	return 0
+}
+
+func (x *LimitOrder) GetOwner() string {
+	if x != nil {
+		return x.Owner
 	}
+	return ""
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: cosmos/simpleswap/v1/types.proto
+func (x *LimitOrder) GetInput() *v1beta1.Coin {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+func (x *LimitOrder) GetOutputDenom() string {
+	if x != nil {
+		return x.OutputDenom
+	}
+	return ""
+}
 
-// Params defines the parameters of the module.
-type Params struct {
+func (x *LimitOrder) GetLimitPrice() string {
+	if x != nil {
+		return x.LimitPrice
+	}
+	return ""
+}
+
+// DCAPlan is a plan of its owner to swap an escrowed amount of coins for the output denom in
+// slices, one every interval of blocks, to average the price of a large conversion.
+type DCAPlan struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WhitelistedCoins  []*v1beta1.Coin `protobuf:"bytes,1,rep,name=whitelistedCoins,proto3" json:"whitelistedCoins,omitempty"`    // coins that are listed by the module
-	SwapFeePercentage int32           `protobuf:"varint,2,opt,name=swapFeePercentage,proto3" json:"swapFeePercentage,omitempty"` // fee percentage charged at swap
-	Decimals          int64           `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`                   // number of decimals for the stablecoin
-	ShareToken        *v1beta1.Coin   `protobuf:"bytes,4,opt,name=shareToken,proto3" json:"shareToken,omitempty"`                // coin that represents the pool share
+	// id is the unique identifier of the plan, the slices due at the same height are executed in
+	// the order of the ids.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address that created the plan and receives the output.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// total is the input coin escrowed when the plan was created.
+	Total *v1beta1.Coin `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+	// remaining is the escrowed input not yet swapped.
+	Remaining *v1beta1.Coin `protobuf:"bytes,4,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	// sliceAmount is the input amount swapped by each execution, the last slice swaps the remainder.
+	SliceAmount string `protobuf:"bytes,5,opt,name=sliceAmount,proto3" json:"sliceAmount,omitempty"`
+	// outputDenom is the denom of the coin to receive.
+	OutputDenom string `protobuf:"bytes,6,opt,name=outputDenom,proto3" json:"outputDenom,omitempty"`
+	// minOutputPerSlice is the lowest output of a full slice, net of the swap fee. A slice paying
+	// less is skipped and retried at the next execution.
+	MinOutputPerSlice string `protobuf:"bytes,7,opt,name=minOutputPerSlice,proto3" json:"minOutputPerSlice,omitempty"`
+	// intervalBlocks is the number of blocks between two executions.
+	IntervalBlocks uint64 `protobuf:"varint,8,opt,name=intervalBlocks,proto3" json:"intervalBlocks,omitempty"`
+	// nextExecutionHeight is the height at the end of which the next slice is due.
+	NextExecutionHeight int64 `protobuf:"varint,9,opt,name=nextExecutionHeight,proto3" json:"nextExecutionHeight,omitempty"`
+	// consecutiveSkips is the number of slices skipped in a row since the last executed slice. The
+	// plan is closed and its escrow refunded once it reaches dcaPlanMaxSkips.
+	ConsecutiveSkips uint32 `protobuf:"varint,10,opt,name=consecutiveSkips,proto3" json:"consecutiveSkips,omitempty"`
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
+func (x *DCAPlan) Reset() {
+	*x = DCAPlan{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[0]
+		mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Params) String() string {
+func (x *DCAPlan) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Params) ProtoMessage() {}
+func (*DCAPlan) ProtoMessage() {}
 
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_cosmos_simpleswap_v1_types_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use DCAPlan.ProtoReflect.Descriptor instead.
+func (*DCAPlan) Descriptor() ([]byte, []int) {
+	return file_cosmos_simpleswap_v1_types_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *Params) GetWhitelistedCoins() []*v1beta1.Coin {
+func (x *DCAPlan) GetId() uint64 {
 	if x != nil {
-		return x.WhitelistedCoins
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DCAPlan) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *DCAPlan) GetTotal() *v1beta1.Coin {
+	if x != nil {
+		return x.Total
 	}
 	return nil
 }
 
-func (x *Params) GetSwapFeePercentage() int32 {
+func (x *DCAPlan) GetRemaining() *v1beta1.Coin {
 	if x != nil {
-		return x.SwapFeePercentage
+		return x.Remaining
+	}
+	return nil
+}
+
+func (x *DCAPlan) GetSliceAmount() string {
+	if x != nil {
+		return x.SliceAmount
+	}
+	return ""
+}
+
+func (x *DCAPlan) GetOutputDenom() string {
+	if x != nil {
+		return x.OutputDenom
+	}
+	return ""
+}
+
+func (x *DCAPlan) GetMinOutputPerSlice() string {
+	if x != nil {
+		return x.MinOutputPerSlice
+	}
+	return ""
+}
+
+func (x *DCAPlan) GetIntervalBlocks() uint64 {
+	if x != nil {
+		return x.IntervalBlocks
 	}
 	return 0
 }
 
-func (x *Params) GetDecimals() int64 {
+func (x *DCAPlan) GetNextExecutionHeight() int64 {
 	if x != nil {
-		return x.Decimals
+		return x.NextExecutionHeight
 	}
 	return 0
 }
 
-func (x *Params) GetShareToken() *v1beta1.Coin {
+func (x *DCAPlan) GetConsecutiveSkips() uint32 {
 	if x != nil {
-		return x.ShareToken
+		return x.ConsecutiveSkips
+	}
+	return 0
+}
+
+// BatchSwap is a swap message queued in the batch auction mode, cleared at the end of the block.
+type BatchSwap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique identifier of the queued swap.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// trader is the address that swaps and receives the output.
+	Trader string `protobuf:"bytes,2,opt,name=trader,proto3" json:"trader,omitempty"`
+	// input is the escrowed coin to swap.
+	Input *v1beta1.Coin `protobuf:"bytes,3,opt,name=input,proto3" json:"input,omitempty"`
+	// outputDenom is the denom of the coin to receive.
+	OutputDenom string `protobuf:"bytes,4,opt,name=outputDenom,proto3" json:"outputDenom,omitempty"`
+	// maxSwapFeePercentage is the highest swap fee the trader accepts, the oracle surcharge
+	// included, 0 for no bound. The swap is refunded if the fee at clearing exceeds it.
+	MaxSwapFeePercentage int32 `protobuf:"varint,5,opt,name=maxSwapFeePercentage,proto3" json:"maxSwapFeePercentage,omitempty"`
+}
+
+func (x *BatchSwap) Reset() {
+	*x = BatchSwap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchSwap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchSwap) ProtoMessage() {}
+
+// Deprecated: Use BatchSwap.ProtoReflect.Descriptor instead.
+func (*BatchSwap) Descriptor() ([]byte, []int) {
+	return file_cosmos_simpleswap_v1_types_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BatchSwap) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BatchSwap) GetTrader() string {
+	if x != nil {
+		return x.Trader
+	}
+	return ""
+}
+
+func (x *BatchSwap) GetInput() *v1beta1.Coin {
+	if x != nil {
+		return x.Input
 	}
 	return nil
 }
 
-type LiquidityProvider struct {
+func (x *BatchSwap) GetOutputDenom() string {
+	if x != nil {
+		return x.OutputDenom
+	}
+	return ""
+}
+
+func (x *BatchSwap) GetMaxSwapFeePercentage() int32 {
+	if x != nil {
+		return x.MaxSwapFeePercentage
+	}
+	return 0
+}
+
+// PoolStatsBucket accumulates the swaps of a pair of coins during an hour, in the pool decimals.
+type PoolStatsBucket struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StableCoin          *v1beta1.Coin `protobuf:"bytes,1,opt,name=stableCoin,proto3" json:"stableCoin,omitempty"`                    // coin that the LP is providing
-	PoolShare           *v1beta1.Coin `protobuf:"bytes,2,opt,name=poolShare,proto3" json:"poolShare,omitempty"`                      // pool share that the LP is providing
-	AccruedFees         int64         `protobuf:"varint,3,opt,name=accruedFees,proto3" json:"accruedFees,omitempty"`                 // amount of fees accrued
-	GloballyAccruedFees int64         `protobuf:"varint,4,opt,name=globallyAccruedFees,proto3" json:"globallyAccruedFees,omitempty"` // amount of fees accrued globally
+	// startTime is the block time the hour of the bucket starts at.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	// inputDenom is the denom of the coin swapped.
+	InputDenom string `protobuf:"bytes,2,opt,name=inputDenom,proto3" json:"inputDenom,omitempty"`
+	// outputDenom is the denom of the coin received.
+	OutputDenom string `protobuf:"bytes,3,opt,name=outputDenom,proto3" json:"outputDenom,omitempty"`
+	// volume is the value of the outputs of the swaps, before the swap fee.
+	Volume string `protobuf:"bytes,4,opt,name=volume,proto3" json:"volume,omitempty"`
+	// fees are the swap fees accrued by the liquidity providers.
+	Fees string `protobuf:"bytes,5,opt,name=fees,proto3" json:"fees,omitempty"`
+	// swapCount is the number of swaps.
+	SwapCount uint64 `protobuf:"varint,6,opt,name=swapCount,proto3" json:"swapCount,omitempty"`
 }
 
-func (x *LiquidityProvider) Reset() {
-	*x = LiquidityProvider{}
+func (x *PoolStatsBucket) Reset() {
+	*x = PoolStatsBucket{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[1]
+		mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolStatsBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolStatsBucket) ProtoMessage() {}
+
+// Deprecated: Use PoolStatsBucket.ProtoReflect.Descriptor instead.
+func (*PoolStatsBucket) Descriptor() ([]byte, []int) {
+	return file_cosmos_simpleswap_v1_types_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PoolStatsBucket) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *PoolStatsBucket) GetInputDenom() string {
+	if x != nil {
+		return x.InputDenom
+	}
+	return ""
+}
+
+func (x *PoolStatsBucket) GetOutputDenom() string {
+	if x != nil {
+		return x.OutputDenom
+	}
+	return ""
+}
+
+func (x *PoolStatsBucket) GetVolume() string {
+	if x != nil {
+		return x.Volume
+	}
+	return ""
+}
+
+func (x *PoolStatsBucket) GetFees() string {
+	if x != nil {
+		return x.Fees
+	}
+	return ""
+}
+
+func (x *PoolStatsBucket) GetSwapCount() uint64 {
+	if x != nil {
+		return x.SwapCount
+	}
+	return 0
+}
+
+// GenesisState is the state that must be provided at genesis.
+type GenesisState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Pool is the liquidity pool invovlved in our simpleswap module.
+	Pool *Pool `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	// params defines all the parameters of the module.
+	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+	// liquidityProviders are the liquidity providers of the pool by address.
+	LiquidityProviders []*GenesisLiquidityProvider `protobuf:"bytes,3,rep,name=liquidityProviders,proto3" json:"liquidityProviders,omitempty"`
+	// coinsReserve are the reserves of the pool.
+	CoinsReserve []*v1beta1.Coin `protobuf:"bytes,4,rep,name=coinsReserve,proto3" json:"coinsReserve,omitempty"`
+	// gauges are the incentive gauges and gaugeSequence the next gauge id.
+	Gauges        []*Gauge `protobuf:"bytes,5,rep,name=gauges,proto3" json:"gauges,omitempty"`
+	GaugeSequence uint64   `protobuf:"varint,6,opt,name=gaugeSequence,proto3" json:"gaugeSequence,omitempty"`
+	// rewards are the unclaimed gauge rewards by address.
+	Rewards []*GenesisRewards `protobuf:"bytes,7,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	// locks are the share locks and lockSequence the next lock id.
+	Locks        []*PeriodLock `protobuf:"bytes,8,rep,name=locks,proto3" json:"locks,omitempty"`
+	LockSequence uint64        `protobuf:"varint,9,opt,name=lockSequence,proto3" json:"lockSequence,omitempty"`
+	// assetRates are the governance set rates of the whitelisted coins.
+	AssetRates []*AssetRate `protobuf:"bytes,10,rep,name=assetRates,proto3" json:"assetRates,omitempty"`
+	// limitOrders are the open limit orders and limitOrderSequence the next order id.
+	LimitOrders        []*LimitOrder `protobuf:"bytes,11,rep,name=limitOrders,proto3" json:"limitOrders,omitempty"`
+	LimitOrderSequence uint64        `protobuf:"varint,12,opt,name=limitOrderSequence,proto3" json:"limitOrderSequence,omitempty"`
+	// dcaPlans are the DCA plans and dcaPlanSequence the next plan id.
+	DcaPlans        []*DCAPlan `protobuf:"bytes,13,rep,name=dcaPlans,proto3" json:"dcaPlans,omitempty"`
+	DcaPlanSequence uint64     `protobuf:"varint,14,opt,name=dcaPlanSequence,proto3" json:"dcaPlanSequence,omitempty"`
+	// batchSwapSequence is the next batch swap id, the batch swaps are cleared at the end of every block.
+	BatchSwapSequence uint64 `protobuf:"varint,15,opt,name=batchSwapSequence,proto3" json:"batchSwapSequence,omitempty"`
+	// poolStats are the hourly pool stats buckets kept for the retention of the params.
+	PoolStats []*PoolStatsBucket `protobuf:"bytes,16,rep,name=poolStats,proto3" json:"poolStats,omitempty"`
+	// rewardAccumulator is the gauge rewards distributed per unit of weight and the total weight of
+	// the locks.
+	RewardAccumulator *RewardAccumulator `protobuf:"bytes,17,opt,name=rewardAccumulator,proto3" json:"rewardAccumulator,omitempty"`
+	// limitOrderCursor is the position of the EndBlocker among the limit orders, unset until the
+	// first orders are tried.
+	LimitOrderCursor *LimitOrderCursor `protobuf:"bytes,18,opt,name=limitOrderCursor,proto3" json:"limitOrderCursor,omitempty"`
+}
+
+func (x *GenesisState) Reset() {
+	*x = GenesisState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LiquidityProvider) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GenesisState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenesisState) ProtoMessage() {}
+
+// Deprecated: Use GenesisState.ProtoReflect.Descriptor instead.
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return file_cosmos_simpleswap_v1_types_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GenesisState) GetPool() *Pool {
+	if x != nil {
+		return x.Pool
+	}
+	return nil
+}
+
+func (x *GenesisState) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *GenesisState) GetLiquidityProviders() []*GenesisLiquidityProvider {
+	if x != nil {
+		return x.LiquidityProviders
+	}
+	return nil
+}
+
+func (x *GenesisState) GetCoinsReserve() []*v1beta1.Coin {
+	if x != nil {
+		return x.CoinsReserve
+	}
+	return nil
+}
+
+func (x *GenesisState) GetGauges() []*Gauge {
+	if x != nil {
+		return x.Gauges
+	}
+	return nil
+}
+
+func (x *GenesisState) GetGaugeSequence() uint64 {
+	if x != nil {
+		return x.GaugeSequence
+	}
+	return 0
+}
+
+func (x *GenesisState) GetRewards() []*GenesisRewards {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+func (x *GenesisState) GetLocks() []*PeriodLock {
+	if x != nil {
+		return x.Locks
+	}
+	return nil
+}
+
+func (x *GenesisState) GetLockSequence() uint64 {
+	if x != nil {
+		return x.LockSequence
+	}
+	return 0
+}
+
+func (x *GenesisState) GetAssetRates() []*AssetRate {
+	if x != nil {
+		return x.AssetRates
+	}
+	return nil
+}
+
+func (x *GenesisState) GetLimitOrders() []*LimitOrder {
+	if x != nil {
+		return x.LimitOrders
+	}
+	return nil
+}
+
+func (x *GenesisState) GetLimitOrderSequence() uint64 {
+	if x != nil {
+		return x.LimitOrderSequence
+	}
+	return 0
 }
 
-func (*LiquidityProvider) ProtoMessage() {}
+func (x *GenesisState) GetDcaPlans() []*DCAPlan {
+	if x != nil {
+		return x.DcaPlans
+	}
+	return nil
+}
 
-// Deprecated: Use LiquidityProvider.ProtoReflect.Descriptor instead.
-func (*LiquidityProvider) Descriptor() ([]byte, []int) {
-	return file_cosmos_simpleswap_v1_types_proto_rawDescGZIP(), []int{1}
+func (x *GenesisState) GetDcaPlanSequence() uint64 {
+	if x != nil {
+		return x.DcaPlanSequence
+	}
+	return 0
 }
 
-func (x *LiquidityProvider) GetStableCoin() *v1beta1.Coin {
+func (x *GenesisState) GetBatchSwapSequence() uint64 {
 	if x != nil {
-		return x.StableCoin
+		return x.BatchSwapSequence
 	}
-	return nil
+	return 0
 }
 
-func (x *LiquidityProvider) GetPoolShare() *v1beta1.Coin {
+func (x *GenesisState) GetPoolStats() []*PoolStatsBucket {
 	if x != nil {
-		return x.PoolShare
+		return x.PoolStats
 	}
 	return nil
 }
 
-func (x *LiquidityProvider) GetAccruedFees() int64 {
+func (x *GenesisState) GetRewardAccumulator() *RewardAccumulator {
 	if x != nil {
-		return x.AccruedFees
+		return x.RewardAccumulator
 	}
-	return 0
+	return nil
 }
 
-func (x *LiquidityProvider) GetGloballyAccruedFees() int64 {
+func (x *GenesisState) GetLimitOrderCursor() *LimitOrderCursor {
 	if x != nil {
-		return x.GloballyAccruedFees
+		return x.LimitOrderCursor
 	}
-	return 0
+	return nil
 }
 
-type Pool struct {
+// LimitOrderCursor is the position of the EndBlocker among the limit orders, in the genesis.
+type LimitOrderCursor struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TotalAccruedFees  int64         `protobuf:"varint,1,opt,name=totalAccruedFees,proto3" json:"totalAccruedFees,omitempty"`
-	TotalLiquidity    int64         `protobuf:"varint,2,opt,name=totalLiquidity,proto3" json:"totalLiquidity,omitempty"`
-	Decimals          int64         `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
-	ShareToken        *v1beta1.Coin `protobuf:"bytes,4,opt,name=shareToken,proto3" json:"shareToken,omitempty"`
-	SwapFeePercentage int32         `protobuf:"varint,5,opt,name=swapFeePercentage,proto3" json:"swapFeePercentage,omitempty"`
+	// lastOrderId is the id of the last limit order tried, the next block resumes after it.
+	LastOrderId uint64 `protobuf:"varint,1,opt,name=lastOrderId,proto3" json:"lastOrderId,omitempty"`
 }
 
-func (x *Pool) Reset() {
-	*x = Pool{}
+func (x *LimitOrderCursor) Reset() {
+	*x = LimitOrderCursor{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[2]
+		mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Pool) String() string {
+func (x *LimitOrderCursor) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pool) ProtoMessage() {}
+func (*LimitOrderCursor) ProtoMessage() {}
 
-// Deprecated: Use Pool.ProtoReflect.Descriptor instead.
-func (*Pool) Descriptor() ([]byte, []int) {
-	return file_cosmos_simpleswap_v1_types_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use LimitOrderCursor.ProtoReflect.Descriptor instead.
+func (*LimitOrderCursor) Descriptor() ([]byte, []int) {
+	return file_cosmos_simpleswap_v1_types_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *Pool) GetTotalAccruedFees() int64 {
+func (x *LimitOrderCursor) GetLastOrderId() uint64 {
 	if x != nil {
-		return x.TotalAccruedFees
+		return x.LastOrderId
 	}
 	return 0
 }
 
-func (x *Pool) GetTotalLiquidity() int64 {
-	if x != nil {
-		return x.TotalLiquidity
-	}
-	return 0
+// GenesisLiquidityProvider is a liquidity provider of the pool with its address, in the genesis.
+type GenesisLiquidityProvider struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address           string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	LiquidityProvider *LiquidityProvider `protobuf:"bytes,2,opt,name=liquidityProvider,proto3" json:"liquidityProvider,omitempty"`
 }
 
-func (x *Pool) GetDecimals() int64 {
-	if x != nil {
-		return x.Decimals
+func (x *GenesisLiquidityProvider) Reset() {
+	*x = GenesisLiquidityProvider{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Pool) GetShareToken() *v1beta1.Coin {
+func (x *GenesisLiquidityProvider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenesisLiquidityProvider) ProtoMessage() {}
+
+// Deprecated: Use GenesisLiquidityProvider.ProtoReflect.Descriptor instead.
+func (*GenesisLiquidityProvider) Descriptor() ([]byte, []int) {
+	return file_cosmos_simpleswap_v1_types_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GenesisLiquidityProvider) GetAddress() string {
 	if x != nil {
-		return x.ShareToken
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-func (x *Pool) GetSwapFeePercentage() int32 {
+func (x *GenesisLiquidityProvider) GetLiquidityProvider() *LiquidityProvider {
 	if x != nil {
-		return x.SwapFeePercentage
+		return x.LiquidityProvider
 	}
-	return 0
+	return nil
 }
 
-// GenesisState is the state that must be provided at genesis.
-type GenesisState struct {
+// GenesisRewards are the unclaimed gauge rewards of an address, in the genesis.
+type GenesisRewards struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Pool is the liquidity pool invovlved in our simpleswap module.
-	Pool *Pool `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
-	// params defines all the parameters of the module.
-	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+	Address string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Rewards *Rewards `protobuf:"bytes,2,opt,name=rewards,proto3" json:"rewards,omitempty"`
 }
 
-func (x *GenesisState) Reset() {
-	*x = GenesisState{}
+func (x *GenesisRewards) Reset() {
+	*x = GenesisRewards{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[3]
+		mi := &file_cosmos_simpleswap_v1_types_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GenesisState) String() string {
+func (x *GenesisRewards) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenesisState) ProtoMessage() {}
+func (*GenesisRewards) ProtoMessage() {}
 
-// Deprecated: Use GenesisState.ProtoReflect.Descriptor instead.
-func (*GenesisState) Descriptor() ([]byte, []int) {
-	return file_cosmos_simpleswap_v1_types_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use GenesisRewards.ProtoReflect.Descriptor instead.
+func (*GenesisRewards) Descriptor() ([]byte, []int) {
+	return file_cosmos_simpleswap_v1_types_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GenesisState) GetPool() *Pool {
+func (x *GenesisRewards) GetAddress() string {
 	if x != nil {
-		return x.Pool
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-func (x *GenesisState) GetParams() *Params {
+func (x *GenesisRewards) GetRewards() *Rewards {
 	if x != nil {
-		return x.Params
+		return x.Rewards
 	}
 	return nil
 }
@@ -2693,73 +15813,423 @@ var file_cosmos_simpleswap_v1_types_proto_rawDesc = []byte{
 	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67,
 	0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f,
-	0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf3, 0x01, 0x0a,
-	0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x45, 0x0a, 0x10, 0x77, 0x68, 0x69, 0x74, 0x65,
-	0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x10, 0x77, 0x68,
-	0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x12, 0x2c,
-	0x0a, 0x11, 0x73, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
-	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x73, 0x77, 0x61, 0x70, 0x46,
-	0x65, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
-	0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x68, 0x61, 0x72,
-	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x3a, 0x1d, 0x8a, 0xe7, 0xb0, 0x2a, 0x18, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2f, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x22, 0xdb, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x43, 0x6f, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x43,
-	0x6f, 0x69, 0x6e, 0x12, 0x37, 0x0a, 0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x53, 0x68, 0x61, 0x72, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
-	0x6e, 0x52, 0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x20, 0x0a, 0x0b,
-	0x61, 0x63, 0x63, 0x72, 0x75, 0x65, 0x64, 0x46, 0x65, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x72, 0x75, 0x65, 0x64, 0x46, 0x65, 0x65, 0x73, 0x12, 0x30,
-	0x0a, 0x13, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x6c, 0x79, 0x41, 0x63, 0x63, 0x72, 0x75, 0x65,
-	0x64, 0x46, 0x65, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x67, 0x6c, 0x6f,
-	0x62, 0x61, 0x6c, 0x6c, 0x79, 0x41, 0x63, 0x63, 0x72, 0x75, 0x65, 0x64, 0x46, 0x65, 0x65, 0x73,
-	0x22, 0xdf, 0x01, 0x0a, 0x04, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x2a, 0x0a, 0x10, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x41, 0x63, 0x63, 0x72, 0x75, 0x65, 0x64, 0x46, 0x65, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x72, 0x75, 0x65,
-	0x64, 0x46, 0x65, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x69,
-	0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a,
+	0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb8, 0x08,
+	0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x45, 0x0a, 0x10, 0x77, 0x68, 0x69, 0x74,
+	0x65, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x10, 0x77,
+	0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x12,
+	0x2c, 0x0a, 0x11, 0x73, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x73, 0x77, 0x61, 0x70,
+	0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a,
 	0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x68, 0x61,
-	0x72, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x50,
-	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x11, 0x73, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
-	0x67, 0x65, 0x22, 0x7f, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65,
-	0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x04, 0x70, 0x6f,
-	0x6f, 0x6c, 0x12, 0x3f, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70,
-	0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x42, 0xdc, 0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31,
-	0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x42,
+	0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x12, 0x4f, 0x0a, 0x0f, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x47, 0x75, 0x61, 0x72, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70,
+	0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x47, 0x75, 0x61, 0x72, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0f, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x47, 0x75, 0x61, 0x72, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x66, 0x0a, 0x12, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x4d, 0x61, 0x78, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x12,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x4d, 0x61, 0x78, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x15, 0x69, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x76, 0x65, 0x73,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x15, 0x69, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x76, 0x65, 0x73, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x53, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x6b,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x73,
+	0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d,
+	0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x56, 0x0a,
+	0x0e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73,
+	0x69, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x45, 0x78, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x65, 0x65, 0x53, 0x77, 0x61, 0x70,
+	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x65,
+	0x65, 0x53, 0x77, 0x61, 0x70, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x12, 0x66, 0x0a, 0x12, 0x66,
+	0x65, 0x65, 0x53, 0x77, 0x61, 0x70, 0x4d, 0x61, 0x78, 0x53, 0x6c, 0x69, 0x70, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x12, 0x66, 0x65, 0x65, 0x53, 0x77, 0x61, 0x70, 0x4d, 0x61, 0x78, 0x53, 0x6c, 0x69, 0x70, 0x70,
+	0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x61, 0x74, 0x63, 0x68, 0x41, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x16, 0x66, 0x6c, 0x61, 0x73, 0x68,
+	0x53, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x16, 0x66, 0x6c, 0x61, 0x73, 0x68, 0x53, 0x77,
+	0x61, 0x70, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12,
+	0x50, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x3c, 0x0a, 0x19, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x28, 0x0a, 0x0f, 0x64, 0x63, 0x61, 0x50, 0x6c, 0x61, 0x6e, 0x4d, 0x61, 0x78, 0x53, 0x6b, 0x69,
+	0x70, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64, 0x63, 0x61, 0x50, 0x6c, 0x61,
+	0x6e, 0x4d, 0x61, 0x78, 0x53, 0x6b, 0x69, 0x70, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x64, 0x63, 0x61,
+	0x53, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x64, 0x63, 0x61, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x50,
+	0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x1d, 0x8a, 0xe7, 0xb0, 0x2a, 0x18, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2f,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x52, 0x0a, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x41, 0x0a, 0x0d, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x45, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12,
+	0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x22, 0xac, 0x01, 0x0a, 0x0c,
+	0x4c, 0x6f, 0x63, 0x6b, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x98,
+	0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x56, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a,
+	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x22, 0xdb, 0x01, 0x0a, 0x11, 0x4c,
+	0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x69, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x52,
+	0x0a, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x69, 0x6e, 0x12, 0x37, 0x0a, 0x09, 0x70,
+	0x6f, 0x6f, 0x6c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x72, 0x75, 0x65, 0x64, 0x46,
+	0x65, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x72, 0x75,
+	0x65, 0x64, 0x46, 0x65, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
+	0x6c, 0x79, 0x41, 0x63, 0x63, 0x72, 0x75, 0x65, 0x64, 0x46, 0x65, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x13, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x6c, 0x79, 0x41, 0x63, 0x63,
+	0x72, 0x75, 0x65, 0x64, 0x46, 0x65, 0x65, 0x73, 0x22, 0xef, 0x01, 0x0a, 0x04, 0x50, 0x6f, 0x6f,
+	0x6c, 0x12, 0x2a, 0x0a, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x72, 0x75, 0x65,
+	0x64, 0x46, 0x65, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x41, 0x63, 0x63, 0x72, 0x75, 0x65, 0x64, 0x46, 0x65, 0x65, 0x73, 0x12, 0x26, 0x0a,
+	0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x69, 0x71, 0x75,
+	0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c,
+	0x73, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x52, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2c, 0x0a, 0x11,
+	0x73, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x73, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x87, 0x03, 0x0a, 0x05, 0x47,
+	0x61, 0x75, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x66, 0x0a, 0x05,
+	0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28,
 	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2f, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x77,
-	0x61, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70,
-	0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x53, 0x58, 0xaa, 0x02, 0x14, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x56, 0x31, 0xca,
-	0x02, 0x14, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x73,
-	0x77, 0x61, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x20, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c,
-	0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x43, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x3a, 0x3a, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x3a, 0x3a,
-	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x63,
+	0x6f, 0x69, 0x6e, 0x73, 0x12, 0x7c, 0x0a, 0x10, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x64, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa,
+	0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x10, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x69,
+	0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73,
+	0x12, 0x22, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x73, 0x22, 0xc0, 0x02, 0x0a, 0x07, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x12, 0x66, 0x0a, 0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00,
+	0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x12, 0x48, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x82, 0x01, 0x0a, 0x10, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x50, 0x65,
+	0x72, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x38, 0xc8, 0xde, 0x1f,
+	0x00, 0xaa, 0xdf, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
+	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x50, 0x65,
+	0x72, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xec, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x41, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x52, 0x0a,
+	0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e,
+	0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x57, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x82, 0x01, 0x0a, 0x10, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x50, 0x65, 0x72,
+	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x38, 0xc8, 0xde, 0x1f, 0x00,
+	0xaa, 0xdf, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x50, 0x65, 0x72,
+	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x3b, 0x0a, 0x0b, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x4f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x22, 0x6d, 0x0a, 0x09, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x61, 0x74, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x4a, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x04, 0x72, 0x61,
+	0x74, 0x65, 0x22, 0xeb, 0x02, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4c, 0x6f, 0x63,
+	0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x2e, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x12, 0x3c, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde,
+	0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12,
+	0x44, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0xc8, 0xde,
+	0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x54, 0x0a, 0x0c, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x22, 0x82, 0x02, 0x0a, 0x0a, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x2e, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12,
+	0x3a, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x56, 0x0a,
+	0x0a, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0xa5, 0x04, 0x0a, 0x07, 0x44, 0x43, 0x41, 0x50, 0x6c, 0x61,
+	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x2e, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x12, 0x3a, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f,
+	0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x42, 0x0a,
+	0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f,
+	0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x12, 0x52, 0x0a, 0x0b, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x44,
+	0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x5e, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x50, 0x65, 0x72, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e,
+	0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x50,
+	0x65, 0x72, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12,
+	0x30, 0x0a, 0x13, 0x6e, 0x65, 0x78, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6e, 0x65,
+	0x78, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65,
+	0x53, 0x6b, 0x69, 0x70, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x63, 0x6f, 0x6e,
+	0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x53, 0x6b, 0x69, 0x70, 0x73, 0x22, 0xdf, 0x01,
+	0x0a, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x77, 0x61, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x12, 0x3a, 0x0a,
+	0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x32, 0x0a, 0x14, 0x6d,
+	0x61, 0x78, 0x53, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x53, 0x77,
+	0x61, 0x70, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22,
+	0xca, 0x02, 0x0a, 0x0f, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x12, 0x47, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x69, 0x6e, 0x70, 0x75, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x20, 0x0a, 0x0b,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x48,
+	0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x04, 0x66, 0x65, 0x65, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x77, 0x61, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x73, 0x77, 0x61, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xbb, 0x09, 0x0a,
+	0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2e, 0x0a,
+	0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x3f, 0x0a,
+	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x77, 0x61,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f,
+	0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x69,
+	0x0a, 0x12, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69,
+	0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x12, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x48, 0x0a, 0x0c, 0x63, 0x6f, 0x69,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x12, 0x3e, 0x0a, 0x06, 0x67, 0x61, 0x75, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x69, 0x6d,
+	0x70, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x75, 0x67, 0x65,
+	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x67, 0x61, 0x75,
+	0x67, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x67, 0x61, 0x75, 0x67, 0x65, 0x53, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x67, 0x61, 0x75, 0x67,
+	0x65, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x49, 0x0a, 0x07, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x12, 0x41, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x69, 0x6d,
+	0x70, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x4c, 0x6f, 0x63, 0x6b, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x05, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x6b, 0x53,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6c,
+	0x6f, 0x63, 0x6b, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x0a, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x52, 0x61, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x73,
+	0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x61, 0x74, 0x65,
+	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x4d, 0x0a, 0x0b, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x09,
+	0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x12, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x08, 0x64, 0x63, 0x61, 0x50, 0x6c, 0x61,
+	0x6e, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x43, 0x41, 0x50, 0x6c, 0x61, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x08, 0x64, 0x63, 0x61, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x0f,
+	0x64, 0x63, 0x61, 0x50, 0x6c, 0x61, 0x6e, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x64, 0x63, 0x61, 0x50, 0x6c, 0x61, 0x6e, 0x53, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53,
+	0x77, 0x61, 0x70, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x11, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x77, 0x61, 0x70, 0x53, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x4e, 0x0a, 0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x09,
+	0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x12, 0x60, 0x0a, 0x11, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x41, 0x63,
+	0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x73,
+	0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x41, 0x63, 0x63,
+	0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x11, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x41, 0x63, 0x63, 0x75, 0x6d,
+	0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x52, 0x0a, 0x10, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65,
+	0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x10, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x34, 0x0a, 0x10, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x20,
+	0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0xb0, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x4c, 0x69, 0x71, 0x75,
+	0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x32, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x60, 0x0a, 0x11, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x11, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x22, 0x88, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x42, 0x0a, 0x07, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2a, 0x76,
+	0x0a, 0x0f, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x47, 0x75, 0x61, 0x72, 0x64, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x4f, 0x52, 0x41, 0x43, 0x4c, 0x45, 0x5f, 0x47, 0x55, 0x41, 0x52,
+	0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x1c, 0x0a, 0x18, 0x4f, 0x52, 0x41, 0x43, 0x4c, 0x45, 0x5f, 0x47, 0x55, 0x41, 0x52,
+	0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x01, 0x12,
+	0x1f, 0x0a, 0x1b, 0x4f, 0x52, 0x41, 0x43, 0x4c, 0x45, 0x5f, 0x47, 0x55, 0x41, 0x52, 0x44, 0x5f,
+	0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x55, 0x52, 0x43, 0x48, 0x41, 0x52, 0x47, 0x45, 0x10, 0x02,
+	0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xdc, 0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70,
+	0x2e, 0x76, 0x31, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x42, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x73, 0x69, 0x6d, 0x70, 0x6c,
+	0x65, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x73,
+	0x77, 0x61, 0x70, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x53, 0x58, 0xaa, 0x02, 0x14, 0x43, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e,
+	0x56, 0x31, 0xca, 0x02, 0x14, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x69, 0x6d, 0x70,
+	0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x20, 0x43, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x5c, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x5c, 0x56, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x43,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x77, 0x61,
+	0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2774,27 +16244,77 @@ func file_cosmos_simpleswap_v1_types_proto_rawDescGZIP() []byte {
 	return file_cosmos_simpleswap_v1_types_proto_rawDescData
 }
 
-var file_cosmos_simpleswap_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_cosmos_simpleswap_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cosmos_simpleswap_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_cosmos_simpleswap_v1_types_proto_goTypes = []interface{}{
-	(*Params)(nil),            // 0: cosmos.simpleswap.v1.Params
-	(*LiquidityProvider)(nil), // 1: cosmos.simpleswap.v1.LiquidityProvider
-	(*Pool)(nil),              // 2: cosmos.simpleswap.v1.Pool
-	(*GenesisState)(nil),      // 3: cosmos.simpleswap.v1.GenesisState
-	(*v1beta1.Coin)(nil),      // 4: cosmos.base.v1beta1.Coin
+	(OracleGuardMode)(0),             // 0: cosmos.simpleswap.v1.OracleGuardMode
+	(*Params)(nil),                   // 1: cosmos.simpleswap.v1.Params
+	(*AssetExponent)(nil),            // 2: cosmos.simpleswap.v1.AssetExponent
+	(*LockDuration)(nil),             // 3: cosmos.simpleswap.v1.LockDuration
+	(*LiquidityProvider)(nil),        // 4: cosmos.simpleswap.v1.LiquidityProvider
+	(*Pool)(nil),                     // 5: cosmos.simpleswap.v1.Pool
+	(*Gauge)(nil),                    // 6: cosmos.simpleswap.v1.Gauge
+	(*Rewards)(nil),                  // 7: cosmos.simpleswap.v1.Rewards
+	(*RewardAccumulator)(nil),        // 8: cosmos.simpleswap.v1.RewardAccumulator
+	(*DenomOrigin)(nil),              // 9: cosmos.simpleswap.v1.DenomOrigin
+	(*AssetRate)(nil),                // 10: cosmos.simpleswap.v1.AssetRate
+	(*PeriodLock)(nil),               // 11: cosmos.simpleswap.v1.PeriodLock
+	(*LimitOrder)(nil),               // 12: cosmos.simpleswap.v1.LimitOrder
+	(*DCAPlan)(nil),                  // 13: cosmos.simpleswap.v1.DCAPlan
+	(*BatchSwap)(nil),                // 14: cosmos.simpleswap.v1.BatchSwap
+	(*PoolStatsBucket)(nil),          // 15: cosmos.simpleswap.v1.PoolStatsBucket
+	(*GenesisState)(nil),             // 16: cosmos.simpleswap.v1.GenesisState
+	(*LimitOrderCursor)(nil),         // 17: cosmos.simpleswap.v1.LimitOrderCursor
+	(*GenesisLiquidityProvider)(nil), // 18: cosmos.simpleswap.v1.GenesisLiquidityProvider
+	(*GenesisRewards)(nil),           // 19: cosmos.simpleswap.v1.GenesisRewards
+	(*v1beta1.Coin)(nil),             // 20: cosmos.base.v1beta1.Coin
+	(*durationpb.Duration)(nil),      // 21: google.protobuf.Duration
+	(*v1beta1.DecCoin)(nil),          // 22: cosmos.base.v1beta1.DecCoin
+	(*timestamppb.Timestamp)(nil),    // 23: google.protobuf.Timestamp
 }
 var file_cosmos_simpleswap_v1_types_proto_depIdxs = []int32{
-	4, // 0: cosmos.simpleswap.v1.Params.whitelistedCoins:type_name -> cosmos.base.v1beta1.Coin
-	4, // 1: cosmos.simpleswap.v1.Params.shareToken:type_name -> cosmos.base.v1beta1.Coin
-	4, // 2: cosmos.simpleswap.v1.LiquidityProvider.stableCoin:type_name -> cosmos.base.v1beta1.Coin
-	4, // 3: cosmos.simpleswap.v1.LiquidityProvider.poolShare:type_name -> cosmos.base.v1beta1.Coin
-	4, // 4: cosmos.simpleswap.v1.Pool.shareToken:type_name -> cosmos.base.v1beta1.Coin
-	2, // 5: cosmos.simpleswap.v1.GenesisState.pool:type_name -> cosmos.simpleswap.v1.Pool
-	0, // 6: cosmos.simpleswap.v1.GenesisState.params:type_name -> cosmos.simpleswap.v1.Params
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	20, // 0: cosmos.simpleswap.v1.Params.whitelistedCoins:type_name -> cosmos.base.v1beta1.Coin
+	0,  // 1: cosmos.simpleswap.v1.Params.oracleGuardMode:type_name -> cosmos.simpleswap.v1.OracleGuardMode
+	3,  // 2: cosmos.simpleswap.v1.Params.lockDurations:type_name -> cosmos.simpleswap.v1.LockDuration
+	2,  // 3: cosmos.simpleswap.v1.Params.assetExponents:type_name -> cosmos.simpleswap.v1.AssetExponent
+	21, // 4: cosmos.simpleswap.v1.Params.statsRetention:type_name -> google.protobuf.Duration
+	21, // 5: cosmos.simpleswap.v1.LockDuration.duration:type_name -> google.protobuf.Duration
+	20, // 6: cosmos.simpleswap.v1.LiquidityProvider.stableCoin:type_name -> cosmos.base.v1beta1.Coin
+	20, // 7: cosmos.simpleswap.v1.LiquidityProvider.poolShare:type_name -> cosmos.base.v1beta1.Coin
+	20, // 8: cosmos.simpleswap.v1.Pool.shareToken:type_name -> cosmos.base.v1beta1.Coin
+	20, // 9: cosmos.simpleswap.v1.Gauge.coins:type_name -> cosmos.base.v1beta1.Coin
+	20, // 10: cosmos.simpleswap.v1.Gauge.distributedCoins:type_name -> cosmos.base.v1beta1.Coin
+	20, // 11: cosmos.simpleswap.v1.Rewards.coins:type_name -> cosmos.base.v1beta1.Coin
+	22, // 12: cosmos.simpleswap.v1.Rewards.rewardsPerWeight:type_name -> cosmos.base.v1beta1.DecCoin
+	22, // 13: cosmos.simpleswap.v1.RewardAccumulator.rewardsPerWeight:type_name -> cosmos.base.v1beta1.DecCoin
+	20, // 14: cosmos.simpleswap.v1.PeriodLock.shares:type_name -> cosmos.base.v1beta1.Coin
+	21, // 15: cosmos.simpleswap.v1.PeriodLock.duration:type_name -> google.protobuf.Duration
+	23, // 16: cosmos.simpleswap.v1.PeriodLock.endTime:type_name -> google.protobuf.Timestamp
+	20, // 17: cosmos.simpleswap.v1.LimitOrder.input:type_name -> cosmos.base.v1beta1.Coin
+	20, // 18: cosmos.simpleswap.v1.DCAPlan.total:type_name -> cosmos.base.v1beta1.Coin
+	20, // 19: cosmos.simpleswap.v1.DCAPlan.remaining:type_name -> cosmos.base.v1beta1.Coin
+	20, // 20: cosmos.simpleswap.v1.BatchSwap.input:type_name -> cosmos.base.v1beta1.Coin
+	23, // 21: cosmos.simpleswap.v1.PoolStatsBucket.startTime:type_name -> google.protobuf.Timestamp
+	5,  // 22: cosmos.simpleswap.v1.GenesisState.pool:type_name -> cosmos.simpleswap.v1.Pool
+	1,  // 23: cosmos.simpleswap.v1.GenesisState.params:type_name -> cosmos.simpleswap.v1.Params
+	18, // 24: cosmos.simpleswap.v1.GenesisState.liquidityProviders:type_name -> cosmos.simpleswap.v1.GenesisLiquidityProvider
+	20, // 25: cosmos.simpleswap.v1.GenesisState.coinsReserve:type_name -> cosmos.base.v1beta1.Coin
+	6,  // 26: cosmos.simpleswap.v1.GenesisState.gauges:type_name -> cosmos.simpleswap.v1.Gauge
+	19, // 27: cosmos.simpleswap.v1.GenesisState.rewards:type_name -> cosmos.simpleswap.v1.GenesisRewards
+	11, // 28: cosmos.simpleswap.v1.GenesisState.locks:type_name -> cosmos.simpleswap.v1.PeriodLock
+	10, // 29: cosmos.simpleswap.v1.GenesisState.assetRates:type_name -> cosmos.simpleswap.v1.AssetRate
+	12, // 30: cosmos.simpleswap.v1.GenesisState.limitOrders:type_name -> cosmos.simpleswap.v1.LimitOrder
+	13, // 31: cosmos.simpleswap.v1.GenesisState.dcaPlans:type_name -> cosmos.simpleswap.v1.DCAPlan
+	15, // 32: cosmos.simpleswap.v1.GenesisState.poolStats:type_name -> cosmos.simpleswap.v1.PoolStatsBucket
+	8,  // 33: cosmos.simpleswap.v1.GenesisState.rewardAccumulator:type_name -> cosmos.simpleswap.v1.RewardAccumulator
+	17, // 34: cosmos.simpleswap.v1.GenesisState.limitOrderCursor:type_name -> cosmos.simpleswap.v1.LimitOrderCursor
+	4,  // 35: cosmos.simpleswap.v1.GenesisLiquidityProvider.liquidityProvider:type_name -> cosmos.simpleswap.v1.LiquidityProvider
+	7,  // 36: cosmos.simpleswap.v1.GenesisRewards.rewards:type_name -> cosmos.simpleswap.v1.Rewards
+	37, // [37:37] is the sub-list for method output_type
+	37, // [37:37] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_simpleswap_v1_types_proto_init() }
@@ -2816,7 +16336,7 @@ func file_cosmos_simpleswap_v1_types_proto_init() {
 			}
 		}
 		file_cosmos_simpleswap_v1_types_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LiquidityProvider); i {
+			switch v := v.(*AssetExponent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2828,7 +16348,7 @@ func file_cosmos_simpleswap_v1_types_proto_init() {
 			}
 		}
 		file_cosmos_simpleswap_v1_types_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pool); i {
+			switch v := v.(*LockDuration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2840,6 +16360,150 @@ func file_cosmos_simpleswap_v1_types_proto_init() {
 			}
 		}
 		file_cosmos_simpleswap_v1_types_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LiquidityProvider); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_simpleswap_v1_types_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pool); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_simpleswap_v1_types_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gauge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_simpleswap_v1_types_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rewards); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_simpleswap_v1_types_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardAccumulator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_simpleswap_v1_types_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DenomOrigin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_simpleswap_v1_types_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetRate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_simpleswap_v1_types_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeriodLock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_simpleswap_v1_types_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LimitOrder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_simpleswap_v1_types_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DCAPlan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_simpleswap_v1_types_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchSwap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_simpleswap_v1_types_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolStatsBucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_simpleswap_v1_types_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
 			case 0:
 				return &v.state
@@ -2851,19 +16515,56 @@ func file_cosmos_simpleswap_v1_types_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_simpleswap_v1_types_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LimitOrderCursor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_simpleswap_v1_types_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenesisLiquidityProvider); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_simpleswap_v1_types_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenesisRewards); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_simpleswap_v1_types_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      1,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_cosmos_simpleswap_v1_types_proto_goTypes,
 		DependencyIndexes: file_cosmos_simpleswap_v1_types_proto_depIdxs,
+		EnumInfos:         file_cosmos_simpleswap_v1_types_proto_enumTypes,
 		MessageInfos:      file_cosmos_simpleswap_v1_types_proto_msgTypes,
 	}.Build()
 	File_cosmos_simpleswap_v1_types_proto = out.File
@@ -0,0 +1,119 @@
+package simpleswap
+
+import (
+	"context"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// gasCostPerIteration is the gas consumed per allowed pair checked by a swap authorization.
+const gasCostPerIteration = uint64(10)
+
+var _ authz.Authorization = &SwapAuthorization{}
+
+// NewSwapAuthorization creates a new SwapAuthorization object.
+func NewSwapAuthorization(allowedPairs []DenomPair, spendLimit sdk.Coins, maxSwapFeePercentage int32, expiration time.Time) *SwapAuthorization {
+	return &SwapAuthorization{
+		AllowedPairs:         allowedPairs,
+		SpendLimit:           spendLimit,
+		MaxSwapFeePercentage: maxSwapFeePercentage,
+		Expiration:           expiration,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a SwapAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgSwapLiquidity{})
+}
+
+// Accept implements Authorization.Accept. The swap must be of an allowed pair, bound the swap
+// fee within the authorization and fit in the spend limit, which is decremented by its input.
+func (a SwapAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	swapMsg, ok := msg.(*MsgSwapLiquidity)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !sdkCtx.BlockTime().Before(a.Expiration) {
+		return authz.AcceptResponse{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "the swap authorization expired at %s", a.Expiration)
+	}
+
+	isPairAllowed := false
+	for _, pair := range a.AllowedPairs {
+		sdkCtx.GasMeter().ConsumeGas(gasCostPerIteration, "swap authorization")
+		if ResolveDenom(pair.Input) == swapMsg.Input.Denom && ResolveDenom(pair.Output) == swapMsg.Output.Denom {
+			isPairAllowed = true
+			break
+		}
+	}
+
+	if !isPairAllowed {
+		return authz.AcceptResponse{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "cannot swap %s for %s", swapMsg.Input.Denom, swapMsg.Output.Denom)
+	}
+
+	// The swap must bound the swap fee, so that neither a fee raise nor an oracle surcharge, which
+	// the bound includes, can be charged to the granter
+	if swapMsg.MaxSwapFeePercentage == 0 || swapMsg.MaxSwapFeePercentage > a.MaxSwapFeePercentage {
+		return authz.AcceptResponse{}, errorsmod.Wrapf(ErrSwapFeeTooHigh, "the swap must accept a swap fee of at most %d", a.MaxSwapFeePercentage)
+	}
+
+	limitLeft, isNegative := a.SpendLimit.SafeSub(swapMsg.Input)
+	if isNegative {
+		return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("requested amount is more than spend limit")
+	}
+
+	if limitLeft.IsZero() {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{
+		Accept:  true,
+		Updated: NewSwapAuthorization(a.AllowedPairs, limitLeft, a.MaxSwapFeePercentage, a.Expiration),
+	}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a SwapAuthorization) ValidateBasic() error {
+	if len(a.AllowedPairs) == 0 {
+		return errorsmod.Wrap(ErrInvalidSwapAuthorization, "allowed pairs cannot be empty")
+	}
+
+	seenPairs := make(map[DenomPair]bool)
+	for _, pair := range a.AllowedPairs {
+		if err := sdk.ValidateDenom(pair.Input); err != nil {
+			return errorsmod.Wrapf(ErrInvalidSwapAuthorization, "for the input denom: %s", err)
+		}
+
+		if err := sdk.ValidateDenom(pair.Output); err != nil {
+			return errorsmod.Wrapf(ErrInvalidSwapAuthorization, "for the output denom: %s", err)
+		}
+
+		if pair.Input == pair.Output || seenPairs[pair] {
+			return errorsmod.Wrapf(ErrInvalidSwapAuthorization, "for the pair: %s/%s", pair.Input, pair.Output)
+		}
+		seenPairs[pair] = true
+	}
+
+	if len(a.SpendLimit) == 0 {
+		return sdkerrors.ErrInvalidCoins.Wrap("spend limit cannot be nil")
+	}
+
+	if !a.SpendLimit.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("spend limit must be positive")
+	}
+
+	if a.MaxSwapFeePercentage <= 0 {
+		return errorsmod.Wrap(ErrInvalidSwapAuthorization, "the max swap fee percentage must be positive")
+	}
+
+	if a.Expiration.IsZero() {
+		return errorsmod.Wrap(ErrInvalidSwapAuthorization, "the expiration cannot be empty")
+	}
+
+	return nil
+}
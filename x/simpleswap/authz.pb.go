@@ -0,0 +1,732 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/simpleswap/v1/authz.proto
+
+package simpleswap
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SwapAuthorization allows the grantee to swap the coins of the granter with MsgSwapLiquidity,
+// within bounds.
+type SwapAuthorization struct {
+	// allowedPairs are the input and output denoms the grantee may swap.
+	AllowedPairs []DenomPair `protobuf:"bytes,1,rep,name=allowedPairs,proto3" json:"allowedPairs"`
+	// spendLimit is the amount of input coins the grantee may still swap, it is decremented by the
+	// input of each swap.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spendLimit"`
+	// maxSwapFeePercentage is the highest swap fee the grantee may accept on behalf of the granter,
+	// the oracle surcharge included, in the units of the swapFeePercentage param. The swaps must
+	// declare a bound within it.
+	MaxSwapFeePercentage int32 `protobuf:"varint,3,opt,name=maxSwapFeePercentage,proto3" json:"maxSwapFeePercentage,omitempty"`
+	// expiration is the time after which the authorization can no longer be used.
+	Expiration time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration"`
+}
+
+func (m *SwapAuthorization) Reset()         { *m = SwapAuthorization{} }
+func (m *SwapAuthorization) String() string { return proto.CompactTextString(m) }
+func (*SwapAuthorization) ProtoMessage()    {}
+func (*SwapAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7875af36f1abfe2, []int{0}
+}
+func (m *SwapAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapAuthorization.Merge(m, src)
+}
+func (m *SwapAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapAuthorization proto.InternalMessageInfo
+
+func (m *SwapAuthorization) GetAllowedPairs() []DenomPair {
+	if m != nil {
+		return m.AllowedPairs
+	}
+	return nil
+}
+
+func (m *SwapAuthorization) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *SwapAuthorization) GetMaxSwapFeePercentage() int32 {
+	if m != nil {
+		return m.MaxSwapFeePercentage
+	}
+	return 0
+}
+
+func (m *SwapAuthorization) GetExpiration() time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return time.Time{}
+}
+
+// DenomPair is the input and output denoms of a swap.
+type DenomPair struct {
+	Input  string `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	Output string `protobuf:"bytes,2,opt,name=output,proto3" json:"output,omitempty"`
+}
+
+func (m *DenomPair) Reset()         { *m = DenomPair{} }
+func (m *DenomPair) String() string { return proto.CompactTextString(m) }
+func (*DenomPair) ProtoMessage()    {}
+func (*DenomPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7875af36f1abfe2, []int{1}
+}
+func (m *DenomPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomPair.Merge(m, src)
+}
+func (m *DenomPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomPair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomPair proto.InternalMessageInfo
+
+func (m *DenomPair) GetInput() string {
+	if m != nil {
+		return m.Input
+	}
+	return ""
+}
+
+func (m *DenomPair) GetOutput() string {
+	if m != nil {
+		return m.Output
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*SwapAuthorization)(nil), "cosmos.simpleswap.v1.SwapAuthorization")
+	proto.RegisterType((*DenomPair)(nil), "cosmos.simpleswap.v1.DenomPair")
+}
+
+func init() { proto.RegisterFile("cosmos/simpleswap/v1/authz.proto", fileDescriptor_d7875af36f1abfe2) }
+
+var fileDescriptor_d7875af36f1abfe2 = []byte{
+	// 453 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xcf, 0x6f, 0xd3, 0x30,
+	0x14, 0xae, 0x57, 0x36, 0x51, 0x8f, 0xcb, 0xa2, 0x0a, 0x65, 0x15, 0x4a, 0xa2, 0x72, 0x89, 0x26,
+	0xcd, 0x56, 0x8b, 0x40, 0x82, 0x1b, 0x65, 0xe2, 0x84, 0xa6, 0xa9, 0x70, 0xe2, 0x82, 0x9c, 0xd6,
+	0xa4, 0x16, 0x75, 0x9e, 0x15, 0x3b, 0xed, 0xd8, 0x9f, 0xc0, 0x69, 0xff, 0x01, 0x57, 0xc4, 0x69,
+	0x07, 0xfe, 0x88, 0x89, 0xd3, 0x8e, 0x9c, 0x18, 0x6a, 0x0f, 0xfb, 0x37, 0x50, 0x6c, 0x77, 0x3f,
+	0xd4, 0x5d, 0x92, 0xbc, 0xef, 0xbd, 0xef, 0xb3, 0xbf, 0x2f, 0x0f, 0x27, 0x23, 0xd0, 0x12, 0x34,
+	0xd5, 0x42, 0xaa, 0x29, 0xd7, 0x73, 0xa6, 0xe8, 0xac, 0x47, 0x59, 0x65, 0x26, 0x27, 0x44, 0x95,
+	0x60, 0x20, 0x68, 0xbb, 0x09, 0x72, 0x33, 0x41, 0x66, 0xbd, 0x4e, 0xe4, 0x79, 0x19, 0xd3, 0x9c,
+	0xce, 0x7a, 0x19, 0x37, 0xac, 0x47, 0x47, 0x20, 0x0a, 0xc7, 0xea, 0xec, 0xba, 0xfe, 0x27, 0x5b,
+	0x51, 0x2f, 0xe1, 0x5a, 0xed, 0x1c, 0x72, 0x70, 0x78, 0xfd, 0xe5, 0xd1, 0x1d, 0x26, 0x45, 0x01,
+	0xd4, 0x3e, 0x3d, 0x14, 0xe7, 0x00, 0xf9, 0x94, 0x53, 0x5b, 0x65, 0xd5, 0x67, 0x6a, 0x84, 0xe4,
+	0xda, 0x30, 0xa9, 0xdc, 0x40, 0xf7, 0x7b, 0x13, 0xef, 0xbc, 0x9f, 0x33, 0xf5, 0xba, 0x32, 0x13,
+	0x28, 0xc5, 0x09, 0x33, 0x02, 0x8a, 0xe0, 0x10, 0x3f, 0x62, 0xd3, 0x29, 0xcc, 0xf9, 0xf8, 0x88,
+	0x89, 0x52, 0x87, 0x28, 0x69, 0xa6, 0xdb, 0xfd, 0x98, 0xdc, 0xe7, 0x83, 0x1c, 0xf0, 0x02, 0x64,
+	0x3d, 0x37, 0x68, 0x9d, 0xff, 0x8d, 0x1b, 0x3f, 0xae, 0xce, 0xf6, 0xd0, 0xf0, 0x0e, 0x3f, 0x50,
+	0x18, 0x6b, 0xc5, 0x8b, 0xf1, 0x3b, 0x21, 0x85, 0x09, 0x37, 0xac, 0xda, 0xee, 0x4a, 0xad, 0xf6,
+	0x4f, 0xbc, 0x7f, 0xf2, 0x06, 0x44, 0x31, 0x78, 0x5e, 0xeb, 0xfc, 0xbc, 0x8c, 0xd3, 0x5c, 0x98,
+	0x49, 0x95, 0x91, 0x11, 0x48, 0xef, 0xdf, 0xbf, 0xf6, 0xf5, 0xf8, 0x0b, 0x35, 0x5f, 0x15, 0xd7,
+	0x96, 0xa0, 0xdd, 0x99, 0xb7, 0xce, 0x08, 0xfa, 0xb8, 0x2d, 0xd9, 0x71, 0xed, 0xec, 0x2d, 0xe7,
+	0x47, 0xbc, 0x1c, 0xf1, 0xc2, 0xb0, 0x9c, 0x87, 0xcd, 0x04, 0xa5, 0x9b, 0xc3, 0x7b, 0x7b, 0xc1,
+	0x01, 0xc6, 0xfc, 0x58, 0x89, 0xd2, 0x66, 0x10, 0x3e, 0x48, 0x50, 0xba, 0xdd, 0xef, 0x10, 0x97,
+	0x20, 0x59, 0x25, 0x48, 0x3e, 0xac, 0x12, 0x1c, 0x3c, 0xac, 0xaf, 0x79, 0x7a, 0x19, 0xa3, 0xe1,
+	0x2d, 0xde, 0xab, 0xc3, 0xdf, 0xbf, 0xf6, 0xbb, 0xde, 0x9a, 0x5b, 0x82, 0x95, 0xb7, 0x3b, 0x19,
+	0x7f, 0xbb, 0x3a, 0xdb, 0x7b, 0xba, 0xbe, 0x39, 0x6b, 0xff, 0xa2, 0xfb, 0x12, 0xb7, 0xae, 0x13,
+	0x0e, 0xda, 0x78, 0x53, 0x14, 0xaa, 0x32, 0x21, 0x4a, 0x50, 0xda, 0x1a, 0xba, 0x22, 0x78, 0x8c,
+	0xb7, 0xa0, 0x32, 0x35, 0xbc, 0x61, 0x61, 0x5f, 0x0d, 0x5e, 0x9c, 0x2f, 0x22, 0x74, 0xb1, 0x88,
+	0xd0, 0xbf, 0x45, 0x84, 0x4e, 0x97, 0x51, 0xe3, 0x62, 0x19, 0x35, 0xfe, 0x2c, 0xa3, 0xc6, 0xc7,
+	0x27, 0xeb, 0xc9, 0xde, 0x5c, 0x22, 0xdb, 0xb2, 0x66, 0x9f, 0xfd, 0x1f, 0x00, 0x6e, 0x2b, 0x4c,
+	0xf2, 0xda, 0x02, 0x00, 0x00,
+}
+
+func (m *SwapAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAuthz(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if m.MaxSwapFeePercentage != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.MaxSwapFeePercentage))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AllowedPairs) > 0 {
+		for iNdEx := len(m.AllowedPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Output) > 0 {
+		i -= len(m.Output)
+		copy(dAtA[i:], m.Output)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Output)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Input) > 0 {
+		i -= len(m.Input)
+		copy(dAtA[i:], m.Input)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Input)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SwapAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedPairs) > 0 {
+		for _, e := range m.AllowedPairs {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.MaxSwapFeePercentage != 0 {
+		n += 1 + sovAuthz(uint64(m.MaxSwapFeePercentage))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovAuthz(uint64(l))
+	return n
+}
+
+func (m *DenomPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Input)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	l = len(m.Output)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SwapAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedPairs = append(m.AllowedPairs, DenomPair{})
+			if err := m.AllowedPairs[len(m.AllowedPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapFeePercentage", wireType)
+			}
+			m.MaxSwapFeePercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSwapFeePercentage |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Input = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Output = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
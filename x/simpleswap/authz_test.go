@@ -0,0 +1,186 @@
+package simpleswap_test
+
+import (
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/simpleswap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSwapAuthorizationValidateBasic(t *testing.T) {
+	expiration := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	pairs := []simpleswap.DenomPair{{Input: "WETH", Output: "ETH"}}
+	limit := sdk.NewCoins(sdk.NewInt64Coin("WETH", 1000))
+
+	testCases := []struct {
+		name          string
+		authorization *simpleswap.SwapAuthorization
+		expectErr     bool
+	}{
+		{
+			name:          "valid",
+			authorization: simpleswap.NewSwapAuthorization(pairs, limit, 30000, expiration),
+		},
+		{
+			name:          "no allowed pair",
+			authorization: simpleswap.NewSwapAuthorization(nil, limit, 30000, expiration),
+			expectErr:     true,
+		},
+		{
+			name:          "pair of the same denom",
+			authorization: simpleswap.NewSwapAuthorization([]simpleswap.DenomPair{{Input: "ETH", Output: "ETH"}}, limit, 30000, expiration),
+			expectErr:     true,
+		},
+		{
+			name:          "duplicate pair",
+			authorization: simpleswap.NewSwapAuthorization(append(pairs, pairs...), limit, 30000, expiration),
+			expectErr:     true,
+		},
+		{
+			name:          "invalid denom",
+			authorization: simpleswap.NewSwapAuthorization([]simpleswap.DenomPair{{Input: "1WETH", Output: "ETH"}}, limit, 30000, expiration),
+			expectErr:     true,
+		},
+		{
+			name:          "no spend limit",
+			authorization: simpleswap.NewSwapAuthorization(pairs, nil, 30000, expiration),
+			expectErr:     true,
+		},
+		{
+			name:          "zero spend limit",
+			authorization: simpleswap.NewSwapAuthorization(pairs, sdk.Coins{sdk.NewInt64Coin("WETH", 0)}, 30000, expiration),
+			expectErr:     true,
+		},
+		{
+			name:          "no max swap fee",
+			authorization: simpleswap.NewSwapAuthorization(pairs, limit, 0, expiration),
+			expectErr:     true,
+		},
+		{
+			name:          "no expiration",
+			authorization: simpleswap.NewSwapAuthorization(pairs, limit, 30000, time.Time{}),
+			expectErr:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.authorization.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSwapAuthorizationAccept(t *testing.T) {
+	key := storetypes.NewKVStoreKey(simpleswap.ModuleName)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx.WithBlockTime(now)
+
+	wethTrace := "transfer/channel-0/weth"
+	authorization := simpleswap.NewSwapAuthorization(
+		[]simpleswap.DenomPair{{Input: "WETH", Output: "ETH"}, {Input: wethTrace, Output: "ETH"}},
+		sdk.NewCoins(sdk.NewInt64Coin("WETH", 1000)),
+		30000,
+		now.Add(time.Hour),
+	)
+
+	swap := func(input, output string, amount int64, maxSwapFeePercentage int32) sdk.Msg {
+		return &simpleswap.MsgSwapLiquidity{
+			Input:                sdk.NewInt64Coin(input, amount),
+			Output:               sdk.NewInt64Coin(output, amount),
+			MaxSwapFeePercentage: maxSwapFeePercentage,
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		ctx           sdk.Context
+		msg           sdk.Msg
+		expectErr     error
+		expectDelete  bool
+		expectedLimit sdk.Coins
+	}{
+		{
+			name:          "swap within the limit",
+			ctx:           ctx,
+			msg:           swap("WETH", "ETH", 400, 30000),
+			expectedLimit: sdk.NewCoins(sdk.NewInt64Coin("WETH", 600)),
+		},
+		{
+			name:         "swap of the whole limit",
+			ctx:          ctx,
+			msg:          swap("WETH", "ETH", 1000, 20000),
+			expectDelete: true,
+		},
+		{
+			name:      "swap above the limit",
+			ctx:       ctx,
+			msg:       swap("WETH", "ETH", 1001, 30000),
+			expectErr: sdkerrors.ErrInsufficientFunds,
+		},
+		{
+			name:      "swap of a denom without limit",
+			ctx:       ctx,
+			msg:       swap(simpleswap.ResolveDenom(wethTrace), "ETH", 1, 30000),
+			expectErr: sdkerrors.ErrInsufficientFunds,
+		},
+		{
+			name:      "pair not allowed",
+			ctx:       ctx,
+			msg:       swap("ETH", "WETH", 400, 30000),
+			expectErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name:      "swap fee not bounded",
+			ctx:       ctx,
+			msg:       swap("WETH", "ETH", 400, 0),
+			expectErr: simpleswap.ErrSwapFeeTooHigh,
+		},
+		{
+			name:      "swap fee above the max",
+			ctx:       ctx,
+			msg:       swap("WETH", "ETH", 400, 30001),
+			expectErr: simpleswap.ErrSwapFeeTooHigh,
+		},
+		{
+			name:      "expired",
+			ctx:       ctx.WithBlockTime(now.Add(time.Hour)),
+			msg:       swap("WETH", "ETH", 400, 30000),
+			expectErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name:      "other message",
+			ctx:       ctx,
+			msg:       &banktypes.MsgSend{},
+			expectErr: sdkerrors.ErrInvalidType,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := authorization.Accept(tc.ctx, tc.msg)
+			if tc.expectErr != nil {
+				require.ErrorIs(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, resp.Accept)
+			require.Equal(t, tc.expectDelete, resp.Delete)
+			if !tc.expectDelete {
+				require.Equal(t, tc.expectedLimit, resp.Updated.(*simpleswap.SwapAuthorization).SpendLimit)
+			}
+		})
+	}
+}
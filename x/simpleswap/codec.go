@@ -6,6 +6,7 @@ import (
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
@@ -13,6 +14,18 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgAddLiquidity{}, "simpleswap/MsgAddLiquidity")
 	legacy.RegisterAminoMsg(cdc, &MsgSwapLiquidity{}, "simpleswap/MsgSwapLiquidity")
 	legacy.RegisterAminoMsg(cdc, &MsgRemoveLiquidity{}, "simpleswap/MsgRemoveLiquidity")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateGauge{}, "simpleswap/MsgCreateGauge")
+	legacy.RegisterAminoMsg(cdc, &MsgAddToGauge{}, "simpleswap/MsgAddToGauge")
+	legacy.RegisterAminoMsg(cdc, &MsgClaimRewards{}, "simpleswap/MsgClaimRewards")
+	legacy.RegisterAminoMsg(cdc, &MsgLockShares{}, "simpleswap/MsgLockShares")
+	legacy.RegisterAminoMsg(cdc, &MsgBeginUnlock{}, "simpleswap/MsgBeginUnlock")
+	legacy.RegisterAminoMsg(cdc, &MsgSetAssetRate{}, "simpleswap/MsgSetAssetRate")
+	legacy.RegisterAminoMsg(cdc, &MsgPlaceLimitOrder{}, "simpleswap/MsgPlaceLimitOrder")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelLimitOrder{}, "simpleswap/MsgCancelLimitOrder")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateDCAPlan{}, "simpleswap/MsgCreateDCAPlan")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelDCAPlan{}, "simpleswap/MsgCancelDCAPlan")
+	legacy.RegisterAminoMsg(cdc, &MsgFlashSwap{}, "simpleswap/MsgFlashSwap")
+	cdc.RegisterConcrete(&SwapAuthorization{}, "cosmos/simpleswap/SwapAuthorization", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -21,6 +34,20 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgAddLiquidity{},
 		&MsgSwapLiquidity{},
 		&MsgRemoveLiquidity{},
+		&MsgCreateGauge{},
+		&MsgAddToGauge{},
+		&MsgClaimRewards{},
+		&MsgLockShares{},
+		&MsgBeginUnlock{},
+		&MsgSetAssetRate{},
+		&MsgPlaceLimitOrder{},
+		&MsgCancelLimitOrder{},
+		&MsgCreateDCAPlan{},
+		&MsgCancelDCAPlan{},
+		&MsgFlashSwap{},
+	)
+	registry.RegisterImplementations((*authz.Authorization)(nil),
+		&SwapAuthorization{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -0,0 +1,43 @@
+package simpleswap
+
+import (
+	"fmt"
+	"strings"
+
+	types "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+// IsDenomTrace returns true if a whitelist entry is the transfer path trace of a bridged
+// coin, e.g. transfer/channel-0/weth, rather than the denom of a coin on this chain.
+func IsDenomTrace(entry string) bool {
+	return transfertypes.ParseDenomTrace(entry).Path != ""
+}
+
+// ResolveDenom returns the denom on this chain of a whitelist entry. A transfer path trace
+// resolves to the ibc/<hash> denom the transfer application mints for it, any other entry
+// is the denom itself.
+func ResolveDenom(entry string) string {
+	if !IsDenomTrace(entry) {
+		return entry
+	}
+
+	return transfertypes.ParseDenomTrace(entry).IBCDenom()
+}
+
+// validateWhitelistEntry checks a whitelist entry is a valid denom, IBC denom or transfer path trace.
+func validateWhitelistEntry(entry string) error {
+	if err := types.ValidateDenom(entry); err != nil {
+		return err
+	}
+
+	if IsDenomTrace(entry) {
+		return transfertypes.ParseDenomTrace(entry).Validate()
+	}
+
+	if strings.HasPrefix(entry, fmt.Sprintf("%s/", transfertypes.DenomPrefix)) {
+		return transfertypes.ValidateIBCDenom(entry)
+	}
+
+	return nil
+}
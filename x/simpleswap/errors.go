@@ -15,6 +15,52 @@ var (
 	ErrZeroDecimals = errors.Register(ModuleName, 10, "decimals cannot be zero")
 	ErrZeroDecimalCoefficient = errors.Register(ModuleName, 11, "decimal coefficient cannot be zero")
 	ErrZeroSwapFeeDecimals = errors.Register(ModuleName, 12, "swap fee decimals cannot be zero")
+	// Deprecated: the share token is no longer a param, its denom is derived from the pool id. The
+	// error is kept registered so that code 13 is not reused.
 	ErrShareTokenInvalid = errors.Register(ModuleName, 13, "share token is invalid")
 	ErrAmountNotEqual = errors.Register(ModuleName, 14, "amounts are not equal")
+	ErrInvalidOracleGuardMode = errors.Register(ModuleName, 15, "invalid oracle guard mode")
+	ErrInvalidOracleDeviation = errors.Register(ModuleName, 16, "oracle max deviation must be in [0, 1)")
+	ErrInvalidOraclePrice = errors.Register(ModuleName, 17, "invalid oracle price")
+	ErrOraclePriceDeviation = errors.Register(ModuleName, 18, "oracle price deviation exceeds the allowed bound")
+	ErrInvalidIncentivesEpoch = errors.Register(ModuleName, 19, "incentives epoch blocks must not be negative")
+	ErrInvalidPoolId = errors.Register(ModuleName, 20, "pool not found")
+	ErrGaugeNotFound = errors.Register(ModuleName, 21, "gauge not found")
+	ErrInvalidNumEpochs = errors.Register(ModuleName, 22, "number of epochs must be positive")
+	ErrGaugeFinished = errors.Register(ModuleName, 23, "gauge has finished distributing")
+	ErrNoRewards = errors.Register(ModuleName, 24, "no rewards to claim")
+	ErrInvalidLockDuration = errors.Register(ModuleName, 25, "invalid lock duration")
+	ErrInvalidLockMultiplier = errors.Register(ModuleName, 26, "lock multiplier must be at least 1")
+	ErrLockNotFound = errors.Register(ModuleName, 27, "lock not found")
+	ErrLockNotOwned = errors.Register(ModuleName, 28, "lock is not owned by the address")
+	ErrLockUnlocking = errors.Register(ModuleName, 29, "lock is already unlocking")
+	ErrInvalidAssetExponent = errors.Register(ModuleName, 30, "invalid asset exponent")
+	ErrInvalidAssetRate = errors.Register(ModuleName, 31, "asset rate must be positive")
+	ErrMinOutput = errors.Register(ModuleName, 32, "swap output is below the minimum")
+	ErrInvalidSwapMemo = errors.Register(ModuleName, 33, "invalid swap memo")
+	ErrDuplicateCoin = errors.Register(ModuleName, 34, "coin is whitelisted more than once")
+	ErrSwapFeeTooHigh = errors.Register(ModuleName, 35, "swap fee is above the accepted maximum")
+	ErrInvalidSwapAuthorization = errors.Register(ModuleName, 36, "invalid swap authorization")
+	ErrInvalidFeeSwapDenom = errors.Register(ModuleName, 37, "invalid fee swap denom")
+	ErrInvalidFeeSwapSlippage = errors.Register(ModuleName, 38, "fee swap max slippage must be in [0, 1)")
+	ErrInvalidLimitPrice = errors.Register(ModuleName, 39, "limit price must be positive")
+	ErrLimitOrderNotFound = errors.Register(ModuleName, 40, "limit order not found")
+	ErrLimitOrderNotOwned = errors.Register(ModuleName, 41, "limit order is not owned by the address")
+	ErrInvalidDCAPlan = errors.Register(ModuleName, 42, "invalid DCA plan")
+	ErrDCAPlanNotFound = errors.Register(ModuleName, 43, "DCA plan not found")
+	ErrDCAPlanNotOwned = errors.Register(ModuleName, 44, "DCA plan is not owned by the address")
+	ErrInvalidFlashSwap = errors.Register(ModuleName, 45, "invalid flash swap")
+	ErrFlashSwapReentrant = errors.Register(ModuleName, 46, "flash swaps cannot be nested")
+	ErrFlashSwapUnauthorized = errors.Register(ModuleName, 47, "nested message is not signed by the flash swap trader")
+	ErrFlashSwapNotRepaid = errors.Register(ModuleName, 48, "flash swap is not repaid")
+	ErrInvalidStatsRetention = errors.Register(ModuleName, 49, "pool stats retention is below the minimum")
+	ErrInvalidAddress = errors.Register(ModuleName, 50, "invalid address")
+	ErrUnauthorized = errors.Register(ModuleName, 51, "unauthorized, the signer is not the module authority")
+	ErrInsufficientBalance = errors.Register(ModuleName, 52, "account balance is insufficient")
+	ErrInsufficientReserve = errors.Register(ModuleName, 53, "pool reserve is insufficient")
+	ErrLiquidityProviderNotFound = errors.Register(ModuleName, 54, "liquidity provider not found")
+	ErrMintShares = errors.Register(ModuleName, 55, "failed to mint the pool shares")
+	ErrBurnShares = errors.Register(ModuleName, 56, "failed to burn the pool shares")
+	ErrPayout = errors.Register(ModuleName, 57, "failed to pay out of the module account")
+	ErrDenomMismatch = errors.Register(ModuleName, 58, "denom does not match the liquidity position")
 )
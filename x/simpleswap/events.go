@@ -0,0 +1,29 @@
+package simpleswap
+
+// Event types and attributes emitted by the module.
+const (
+	EventTypeLimitOrderFilled   = "limit_order_filled"
+	EventTypeDCASliceExecuted   = "dca_slice_executed"
+	EventTypeDCASliceSkipped    = "dca_slice_skipped"
+	EventTypeDCAPlanClosed      = "dca_plan_closed"
+	EventTypeBatchCleared       = "batch_auction_cleared"
+	EventTypeBatchSwapSettled   = "batch_swap_settled"
+	EventTypeFlashSwap          = "flash_swap"
+	EventTypeEndBlockStepFailed = "end_block_step_failed"
+
+	AttributeKeyOrderId   = "order_id"
+	AttributeKeyPlanId    = "plan_id"
+	AttributeKeyOwner     = "owner"
+	AttributeKeyInput     = "input"
+	AttributeKeyOutput    = "output"
+	AttributeKeyRemaining = "remaining"
+	AttributeKeyReason    = "reason"
+	AttributeKeySwapId    = "swap_id"
+	AttributeKeyTrader    = "trader"
+	AttributeKeyRefund    = "refund"
+	AttributeKeyPair      = "pair"
+	AttributeKeyPrice     = "clearing_price"
+	AttributeKeyMatched   = "matched_value"
+	AttributeKeyFee       = "fee"
+	AttributeKeyStep      = "step"
+)
@@ -3,7 +3,11 @@ package expectedkeepers
 import (
 	"context"
 
+	"cosmossdk.io/math"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 )
 
 type BankKeeper interface {
@@ -12,4 +16,34 @@ type BankKeeper interface {
 	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 	SpendableCoin(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	SetDenomMetaData(ctx context.Context, denomMetaData banktypes.Metadata)
+	GetDenomMetaData(ctx context.Context, denom string) (banktypes.Metadata, bool)
+}
+
+// AccountKeeper defines the expected interface of the account keeper.
+// The simulation looks up the accounts signing the simulated transactions.
+type AccountKeeper interface {
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+	GetModuleAddress(moduleName string) sdk.AccAddress
+}
+
+// OracleKeeper defines the expected interface of an external price oracle.
+// It is optional, swaps are not price checked when no oracle is set.
+type OracleKeeper interface {
+	// GetPrice returns the price of the given denom in a common quote asset.
+	GetPrice(ctx context.Context, denom string) (math.LegacyDec, error)
+}
+
+// TransferKeeper defines the expected interface of the IBC transfer keeper.
+// It is optional, it resolves the IBC denoms received by transfers to their origin.
+type TransferKeeper interface {
+	// GetDenomTrace returns the transfer path trace of the hash of an IBC denom.
+	GetDenomTrace(ctx sdk.Context, denomTraceHash cmtbytes.HexBytes) (transfertypes.DenomTrace, bool)
+}
+
+// StakingKeeper defines the expected interface of the staking keeper.
+// The fees swapped by the fee swap ante decorator are paid in its bond denom.
+type StakingKeeper interface {
+	BondDenom(ctx context.Context) (string, error)
 }
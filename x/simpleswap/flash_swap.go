@@ -0,0 +1,44 @@
+package simpleswap
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var _ codectypes.UnpackInterfacesMessage = &MsgFlashSwap{}
+
+// NewMsgFlashSwap creates a new MsgFlashSwap executing the nested messages.
+func NewMsgFlashSwap(trader string, output sdk.Coin, inputDenom string, msgs []sdk.Msg) (*MsgFlashSwap, error) {
+	anys, err := tx.SetMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgFlashSwap{
+		Trader:     trader,
+		Output:     output,
+		InputDenom: inputDenom,
+		Msgs:       anys,
+	}, nil
+}
+
+// GetMessages returns the nested messages of the flash swap.
+func (msg MsgFlashSwap) GetMessages() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(msg.Msgs))
+	for i, msgAny := range msg.Msgs {
+		nested, ok := msgAny.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, errorsmod.Wrapf(ErrInvalidFlashSwap, "message %d is not a sdk.Msg: %s", i, msgAny.TypeUrl)
+		}
+		msgs[i] = nested
+	}
+
+	return msgs, nil
+}
+
+// UnpackInterfaces implements codectypes.UnpackInterfacesMessage.
+func (msg MsgFlashSwap) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return tx.UnpackInterfaces(unpacker, msg.Msgs)
+}
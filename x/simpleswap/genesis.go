@@ -1,9 +1,16 @@
 package simpleswap
 
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
 // NewGenesisState creates a new genesis state with default values.
 func NewGenesisState() *GenesisState {
 	return &GenesisState{
-		Params: DefaultParams(),
+		Params:            DefaultParams(),
+		RewardAccumulator: RewardAccumulator{TotalWeight: math.ZeroInt()},
 	}
 }
 
@@ -13,5 +20,154 @@ func (gs *GenesisState) Validate() error {
 		return err
 	}
 
+	// Check the liquidity providers, reserves and rewards are unique
+	seenProviders := make(map[string]bool)
+	for _, provider := range gs.LiquidityProviders {
+		if provider.Address == "" || seenProviders[provider.Address] {
+			return fmt.Errorf("invalid or duplicate liquidity provider: %q", provider.Address)
+		}
+		seenProviders[provider.Address] = true
+	}
+
+	seenReserves := make(map[string]bool)
+	for _, reserve := range gs.CoinsReserve {
+		if err := reserve.Validate(); err != nil || seenReserves[reserve.Denom] {
+			return fmt.Errorf("invalid or duplicate coins reserve: %s", reserve)
+		}
+		seenReserves[reserve.Denom] = true
+	}
+
+	seenRewards := make(map[string]bool)
+	for _, rewards := range gs.Rewards {
+		if rewards.Address == "" || seenRewards[rewards.Address] || !rewards.Rewards.Coins.IsValid() {
+			return fmt.Errorf("invalid or duplicate rewards: %q", rewards.Address)
+		}
+		seenRewards[rewards.Address] = true
+	}
+
+	if err := gs.validateRewardWeights(); err != nil {
+		return err
+	}
+
+	// Check the ids are unique and below the next id of their sequence
+	gaugeIds := make([]uint64, len(gs.Gauges))
+	for i, gauge := range gs.Gauges {
+		gaugeIds[i] = gauge.Id
+	}
+	if err := validateGenesisIds("gauge", gaugeIds, gs.GaugeSequence); err != nil {
+		return err
+	}
+
+	lockIds := make([]uint64, len(gs.Locks))
+	for i, lock := range gs.Locks {
+		lockIds[i] = lock.Id
+	}
+	if err := validateGenesisIds("lock", lockIds, gs.LockSequence); err != nil {
+		return err
+	}
+
+	orderIds := make([]uint64, len(gs.LimitOrders))
+	for i, order := range gs.LimitOrders {
+		orderIds[i] = order.Id
+	}
+	if err := validateGenesisIds("limit order", orderIds, gs.LimitOrderSequence); err != nil {
+		return err
+	}
+
+	if gs.LimitOrderCursor != nil && gs.LimitOrderCursor.LastOrderId >= gs.LimitOrderSequence {
+		return fmt.Errorf("invalid limit order cursor %d, the next id is %d", gs.LimitOrderCursor.LastOrderId, gs.LimitOrderSequence)
+	}
+
+	// Check the pool stats buckets are unique by hour and pair, and their totals are not negative
+	seenBuckets := make(map[string]bool)
+	for _, bucket := range gs.PoolStats {
+		key := fmt.Sprintf("%d/%s/%s", bucket.StartTime.Unix(), bucket.InputDenom, bucket.OutputDenom)
+		if seenBuckets[key] || !bucket.StartTime.Equal(bucket.StartTime.Truncate(StatsBucketDuration)) ||
+			bucket.Volume.IsNil() || bucket.Volume.IsNegative() || bucket.Fees.IsNil() || bucket.Fees.IsNegative() {
+			return fmt.Errorf("invalid or duplicate pool stats bucket: %s", key)
+		}
+		seenBuckets[key] = true
+	}
+
+	planIds := make([]uint64, len(gs.DcaPlans))
+	for i, plan := range gs.DcaPlans {
+		planIds[i] = plan.Id
+	}
+
+	return validateGenesisIds("DCA plan", planIds, gs.DcaPlanSequence)
+}
+
+// validateRewardWeights checks the reward weight of every owner is the sum of the weights of its
+// locks, the total weight of the accumulator the sum of the weights of all the locks, and the
+// rewards of the owners were settled up to at most the accumulator.
+func (gs *GenesisState) validateRewardWeights() error {
+	acc := gs.RewardAccumulator
+	if !acc.RewardsPerWeight.IsValid() {
+		return fmt.Errorf("invalid rewards per weight: %s", acc.RewardsPerWeight)
+	}
+
+	lockWeights := make(map[string]math.Int)
+	totalWeight := math.ZeroInt()
+	for _, lock := range gs.Locks {
+		weight := weightOrZero(lock.RewardWeight)
+		if weight.IsNegative() {
+			return fmt.Errorf("negative reward weight of the lock %d: %s", lock.Id, weight)
+		}
+
+		if _, ok := lockWeights[lock.Owner]; !ok {
+			lockWeights[lock.Owner] = math.ZeroInt()
+		}
+		lockWeights[lock.Owner] = lockWeights[lock.Owner].Add(weight)
+		totalWeight = totalWeight.Add(weight)
+	}
+
+	if !weightOrZero(acc.TotalWeight).Equal(totalWeight) {
+		return fmt.Errorf("the total reward weight %s is not the weight of the locks %s", weightOrZero(acc.TotalWeight), totalWeight)
+	}
+
+	for _, rewards := range gs.Rewards {
+		lockWeight, ok := lockWeights[rewards.Address]
+		if !ok {
+			lockWeight = math.ZeroInt()
+		}
+		delete(lockWeights, rewards.Address)
+
+		if !weightOrZero(rewards.Rewards.Weight).Equal(lockWeight) {
+			return fmt.Errorf("the reward weight of %s is not the weight of its locks %s", rewards.Address, lockWeight)
+		}
+
+		if _, negative := acc.RewardsPerWeight.SafeSub(rewards.Rewards.RewardsPerWeight); negative {
+			return fmt.Errorf("the rewards of %s are settled past the accumulator", rewards.Address)
+		}
+	}
+
+	for owner, weight := range lockWeights {
+		if weight.IsPositive() {
+			return fmt.Errorf("the locks of %s have no rewards for their weight %s", owner, weight)
+		}
+	}
+
+	return nil
+}
+
+// weightOrZero returns the weight, or zero if it is not set.
+func weightOrZero(weight math.Int) math.Int {
+	if weight.IsNil() {
+		return math.ZeroInt()
+	}
+
+	return weight
+}
+
+// validateGenesisIds checks the ids of the objects are unique and below the next id of their sequence.
+func validateGenesisIds(object string, ids []uint64, sequence uint64) error {
+	seenIds := make(map[uint64]bool)
+	for _, id := range ids {
+		if seenIds[id] || id >= sequence {
+			return fmt.Errorf("invalid or duplicate %s id %d, the next id is %d", object, id, sequence)
+		}
+		seenIds[id] = true
+	}
+
 	return nil
 }
@@ -0,0 +1,76 @@
+package simpleswap
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SimpleSwapHooks defines the hooks other modules can register to react to
+// pool creation, liquidity changes and swaps.
+type SimpleSwapHooks interface {
+	// AfterPoolCreated is called after the pool is initialized.
+	AfterPoolCreated(ctx context.Context, poolId uint64) error
+	// AfterJoinPool is called after liquidity is added and the share tokens are minted.
+	AfterJoinPool(ctx context.Context, liquidityProvider sdk.AccAddress, poolId uint64, token, shares sdk.Coin) error
+	// AfterExitPool is called after liquidity is removed and the share tokens are burned.
+	AfterExitPool(ctx context.Context, liquidityProvider sdk.AccAddress, poolId uint64, token, shares sdk.Coin) error
+	// AfterSwap is called after a swap is executed, output is the amount received by the trader.
+	AfterSwap(ctx context.Context, trader sdk.AccAddress, poolId uint64, input, output sdk.Coin) error
+}
+
+// SimpleSwapHooksWrapper is a wrapper for modules to inject SimpleSwapHooks using depinject.
+type SimpleSwapHooksWrapper struct{ SimpleSwapHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (SimpleSwapHooksWrapper) IsOnePerModuleType() {}
+
+var _ SimpleSwapHooks = MultiSimpleSwapHooks{}
+
+// MultiSimpleSwapHooks combines multiple simpleswap hooks, all hook functions are run in array sequence.
+type MultiSimpleSwapHooks []SimpleSwapHooks
+
+// NewMultiSimpleSwapHooks returns a MultiSimpleSwapHooks running the given hooks in order.
+func NewMultiSimpleSwapHooks(hooks ...SimpleSwapHooks) MultiSimpleSwapHooks {
+	return hooks
+}
+
+func (h MultiSimpleSwapHooks) AfterPoolCreated(ctx context.Context, poolId uint64) error {
+	for i := range h {
+		if err := h[i].AfterPoolCreated(ctx, poolId); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h MultiSimpleSwapHooks) AfterJoinPool(ctx context.Context, liquidityProvider sdk.AccAddress, poolId uint64, token, shares sdk.Coin) error {
+	for i := range h {
+		if err := h[i].AfterJoinPool(ctx, liquidityProvider, poolId, token, shares); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h MultiSimpleSwapHooks) AfterExitPool(ctx context.Context, liquidityProvider sdk.AccAddress, poolId uint64, token, shares sdk.Coin) error {
+	for i := range h {
+		if err := h[i].AfterExitPool(ctx, liquidityProvider, poolId, token, shares); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h MultiSimpleSwapHooks) AfterSwap(ctx context.Context, trader sdk.AccAddress, poolId uint64, input, output sdk.Coin) error {
+	for i := range h {
+		if err := h[i].AfterSwap(ctx, trader, poolId, input, output); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package ibcswap
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/simpleswap"
+)
+
+// MemoKey is the key of the swap instructions in the memo of an ICS-20 transfer.
+const MemoKey = simpleswap.ModuleName
+
+// SwapMemo is the swap to execute on the receipt of an ICS-20 transfer, e.g.
+//
+//	{"simpleswap":{"out_denom":"ETH","min_out":"1000","receiver":"mini1..."}}
+//
+// The received coins are swapped for at least MinOut of OutDenom, paid to the Receiver or,
+// when empty, to the receiver of the transfer.
+type SwapMemo struct {
+	OutDenom string   `json:"out_denom"`
+	MinOut   math.Int `json:"min_out"`
+	Receiver string   `json:"receiver,omitempty"`
+}
+
+// ParseMemo returns the swap instructions of a transfer memo, and false when the memo has
+// none, so that the transfer is handled as a plain transfer.
+func ParseMemo(memo string) (SwapMemo, bool, error) {
+	var entries map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &entries); err != nil {
+		return SwapMemo{}, false, nil
+	}
+
+	raw, ok := entries[MemoKey]
+	if !ok {
+		return SwapMemo{}, false, nil
+	}
+
+	var swap SwapMemo
+	if err := json.Unmarshal(raw, &swap); err != nil {
+		return SwapMemo{}, true, errorsmod.Wrapf(simpleswap.ErrInvalidSwapMemo, "%s", err)
+	}
+
+	return swap, true, swap.Validate()
+}
+
+// Validate checks the swap instructions of a memo.
+func (m SwapMemo) Validate() error {
+	if err := sdk.ValidateDenom(m.OutDenom); err != nil {
+		return errorsmod.Wrapf(simpleswap.ErrInvalidSwapMemo, "for the out_denom: %s", err)
+	}
+
+	if m.MinOut.IsNil() || m.MinOut.IsNegative() {
+		return errorsmod.Wrap(simpleswap.ErrInvalidSwapMemo, "min_out must not be negative")
+	}
+
+	if m.Receiver != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Receiver); err != nil {
+			return errorsmod.Wrapf(simpleswap.ErrInvalidSwapMemo, "for the receiver: %s", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package ibcswap_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/simpleswap"
+	"github.com/cosmos/simpleswap/ibcswap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMemo(t *testing.T) {
+	testCases := []struct {
+		name      string
+		memo      string
+		expectOk  bool
+		expectErr error
+		expected  ibcswap.SwapMemo
+	}{
+		{name: "empty memo", memo: ""},
+		{name: "text memo", memo: "hello"},
+		{name: "memo of another middleware", memo: `{"forward":{"receiver":"mini1"}}`},
+		{
+			name:     "swap memo",
+			memo:     `{"simpleswap":{"out_denom":"ETH","min_out":"100"}}`,
+			expectOk: true,
+			expected: ibcswap.SwapMemo{OutDenom: "ETH", MinOut: math.NewInt(100)},
+		},
+		{
+			name:      "malformed swap memo",
+			memo:      `{"simpleswap":"ETH"}`,
+			expectOk:  true,
+			expectErr: simpleswap.ErrInvalidSwapMemo,
+		},
+		{
+			name:      "invalid out denom",
+			memo:      `{"simpleswap":{"out_denom":"1","min_out":"100"}}`,
+			expectOk:  true,
+			expectErr: simpleswap.ErrInvalidSwapMemo,
+		},
+		{
+			name:      "missing min out",
+			memo:      `{"simpleswap":{"out_denom":"ETH"}}`,
+			expectOk:  true,
+			expectErr: simpleswap.ErrInvalidSwapMemo,
+		},
+		{
+			name:      "invalid receiver",
+			memo:      `{"simpleswap":{"out_denom":"ETH","min_out":"100","receiver":"mini"}}`,
+			expectOk:  true,
+			expectErr: simpleswap.ErrInvalidSwapMemo,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			memo, ok, err := ibcswap.ParseMemo(tc.memo)
+			require.Equal(t, tc.expectOk, ok)
+			if tc.expectErr != nil {
+				require.ErrorIs(t, err, tc.expectErr)
+				return
+			}
+
+			require.NoError(t, err)
+			if tc.expectOk {
+				require.Equal(t, tc.expected, memo)
+			}
+		})
+	}
+}
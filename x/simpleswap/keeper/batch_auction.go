@@ -0,0 +1,387 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/simpleswap"
+)
+
+// batchPair holds the swaps queued for a pair of denoms, sorted by denom. The swaps of the first
+// side sell the first denom for the second one, the swaps of the second side the other way round.
+type batchPair struct {
+	denoms [2]string
+	sides  [2][]simpleswap.BatchSwap
+}
+
+// batchFill is the settlement of a queued swap: the part of its input filled, the coin debited
+// from the output reserve before the swap fee, the fee and the payout.
+type batchFill struct {
+	swap   simpleswap.BatchSwap
+	filled sdk.Coin
+	debit  sdk.Coin
+	value  math.Int
+	fee    math.Int
+	output sdk.Coin
+	refund sdk.Coin
+}
+
+// queueBatchSwap escrows the input of the swap and queues it for the batch auction of the block.
+func (k Keeper) queueBatchSwap(ctx context.Context, msg *simpleswap.MsgSwapLiquidity) error {
+	trader, err := k.addressCodec.StringToBytes(msg.Trader)
+	if err != nil {
+		return errorsmod.Wrapf(simpleswap.ErrInvalidAddress, "invalid trader address: %s", err)
+	}
+
+	if err := k.BankKeeper.SendCoinsFromAccountToModule(ctx, trader, simpleswap.ModuleName, sdk.NewCoins(msg.Input)); err != nil {
+		return errorsmod.Wrapf(simpleswap.ErrInsufficientBalance, "cannot escrow %s: %s", msg.Input, err)
+	}
+
+	id, err := k.BatchSwapSequence.Next(ctx)
+	if err != nil {
+		return err
+	}
+
+	return k.BatchSwaps.Set(ctx, id, simpleswap.BatchSwap{
+		Id:                   id,
+		Trader:               msg.Trader,
+		Input:                msg.Input,
+		OutputDenom:          msg.Output.Denom,
+		MaxSwapFeePercentage: msg.MaxSwapFeePercentage,
+	})
+}
+
+// ClearBatchAuction clears the swaps queued during the block. The swaps of a pair are cleared at
+// a uniform price, the pool price at the end of the block: the opposite swaps are netted against
+// each other and only the imbalance is swapped against the reserves. The part of a side the
+// reserves cannot pay is refunded pro rata. A pair that fails to clear, e.g. because of the
+// oracle guard, is refunded as a whole.
+func (k Keeper) ClearBatchAuction(ctx context.Context) error {
+	pairs := map[string]*batchPair{}
+	err := k.BatchSwaps.Walk(ctx, nil, func(_ uint64, swap simpleswap.BatchSwap) (bool, error) {
+		denoms, side := [2]string{swap.Input.Denom, swap.OutputDenom}, 0
+		if denoms[0] > denoms[1] {
+			denoms, side = [2]string{denoms[1], denoms[0]}, 1
+		}
+
+		key := denoms[0] + "/" + denoms[1]
+		if _, ok := pairs[key]; !ok {
+			pairs[key] = &batchPair{denoms: denoms}
+		}
+		pairs[key].sides[side] = append(pairs[key].sides[side], swap)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(pairs) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(pairs))
+	for key := range pairs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, key := range keys {
+		if err := k.clearBatchPair(sdkCtx, *pairs[key]); err != nil {
+			return err
+		}
+	}
+
+	return k.BatchSwaps.Clear(ctx, nil)
+}
+
+// clearBatchPair settles the swaps of a pair in a cached context, written only when the whole
+// pair clears. Otherwise the swaps are refunded. The swaps bounding the swap fee below the fee
+// charged at clearing, the oracle surcharge included, are refunded before the pair is settled.
+func (k Keeper) clearBatchPair(ctx sdk.Context, pair batchPair) error {
+	pair, err := k.refundSwapsAboveMaxFee(ctx, pair)
+	if err != nil {
+		return err
+	}
+
+	if len(pair.sides[0]) == 0 && len(pair.sides[1]) == 0 {
+		return nil
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	fills, price, matched, clearErr := k.settleBatchPair(cacheCtx, pair)
+	if clearErr != nil {
+		for _, side := range pair.sides {
+			for _, swap := range side {
+				if err := k.refundBatchSwap(ctx, swap, clearErr); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	write()
+
+	for _, fill := range fills {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			simpleswap.EventTypeBatchSwapSettled,
+			sdk.NewAttribute(simpleswap.AttributeKeySwapId, strconv.FormatUint(fill.swap.Id, 10)),
+			sdk.NewAttribute(simpleswap.AttributeKeyTrader, fill.swap.Trader),
+			sdk.NewAttribute(simpleswap.AttributeKeyInput, fill.filled.String()),
+			sdk.NewAttribute(simpleswap.AttributeKeyOutput, fill.output.String()),
+			sdk.NewAttribute(simpleswap.AttributeKeyRefund, fill.refund.String()),
+		))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		simpleswap.EventTypeBatchCleared,
+		sdk.NewAttribute(simpleswap.AttributeKeyPair, pair.denoms[0]+"/"+pair.denoms[1]),
+		sdk.NewAttribute(simpleswap.AttributeKeyPrice, price.String()),
+		sdk.NewAttribute(simpleswap.AttributeKeyMatched, matched.String()),
+	))
+
+	return nil
+}
+
+// settleBatchPair fills the swaps of the pair, updates the reserves and the accrued fees, and
+// pays the traders. It returns the fills, the clearing price of the first denom in the second
+// one and the value netted between the two sides.
+func (k Keeper) settleBatchPair(ctx sdk.Context, pair batchPair) ([]batchFill, math.LegacyDec, math.Int, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, math.LegacyDec{}, math.Int{}, err
+	}
+
+	pool, err := k.Pool.Get(ctx)
+	if err != nil {
+		return nil, math.LegacyDec{}, math.Int{}, err
+	}
+
+	price, err := k.poolPrice(ctx, params, pool, pair.denoms[0], pair.denoms[1])
+	if err != nil {
+		return nil, math.LegacyDec{}, math.Int{}, err
+	}
+
+	// Value each swap and each side in the pool reference asset, rounding in favour of the pool
+	var values [2][]math.Int
+	var totals, reserveValues, fills [2]math.Int
+	var reserves [2]sdk.Coin
+	var surcharges [2]math.LegacyDec
+	for i, denom := range pair.denoms {
+		totals[i] = math.ZeroInt()
+		for _, swap := range pair.sides[i] {
+			value, err := k.liquidityValue(ctx, params, pool, swap.Input, false)
+			if err != nil {
+				return nil, math.LegacyDec{}, math.Int{}, err
+			}
+			values[i] = append(values[i], value)
+			totals[i] = totals[i].Add(value)
+		}
+
+		reserves[i], err = k.CoinsReserve.Get(ctx, denom)
+		if errors.Is(err, collections.ErrNotFound) {
+			reserves[i] = sdk.NewCoin(denom, math.ZeroInt())
+		} else if err != nil {
+			return nil, math.LegacyDec{}, math.Int{}, err
+		}
+
+		reserveValues[i], err = k.liquidityValue(ctx, params, pool, reserves[i], false)
+		if err != nil {
+			return nil, math.LegacyDec{}, math.Int{}, err
+		}
+
+		surcharges[i], err = k.oracleSurcharge(ctx, params, denom, pair.denoms[1-i])
+		if err != nil {
+			return nil, math.LegacyDec{}, math.Int{}, err
+		}
+	}
+
+	// A side is filled by the other side first, then by the reserves of the denom it buys
+	for i := range pair.denoms {
+		fills[i] = math.MinInt(totals[i], totals[1-i].Add(reserveValues[1-i]))
+	}
+	matched := math.MinInt(totals[0], totals[1])
+
+	var result []batchFill
+	for i, denom := range pair.denoms {
+		outputDenom := pair.denoms[1-i]
+		for j, swap := range pair.sides[i] {
+			fill := batchFill{
+				swap:   swap,
+				filled: swap.Input,
+				debit:  sdk.NewCoin(outputDenom, math.ZeroInt()),
+				value:  math.ZeroInt(),
+				fee:    math.ZeroInt(),
+				output: sdk.NewCoin(outputDenom, math.ZeroInt()),
+			}
+
+			// The filled part of a partially filled side is prorated and rounded down
+			value := values[i][j]
+			if fills[i].LT(totals[i]) {
+				fill.filled = sdk.NewCoin(denom, swap.Input.Amount.Mul(fills[i]).Quo(totals[i]))
+				value, err = k.liquidityValue(ctx, params, pool, fill.filled, false)
+				if err != nil {
+					return nil, math.LegacyDec{}, math.Int{}, err
+				}
+			}
+			fill.refund = swap.Input.Sub(fill.filled)
+
+			if value.IsPositive() {
+				fill.value = value
+				fill.debit, err = k.coinForValue(ctx, params, pool, outputDenom, value, false)
+				if err != nil {
+					return nil, math.LegacyDec{}, math.Int{}, err
+				}
+
+				fill.fee = computeSwapFee(pool, value, surcharges[i])
+				fill.output, err = k.coinForValue(ctx, params, pool, outputDenom, value.Sub(fill.fee), false)
+				if err != nil {
+					return nil, math.LegacyDec{}, math.Int{}, err
+				}
+			}
+
+			reserves[i] = reserves[i].Add(fill.filled)
+			result = append(result, fill)
+		}
+	}
+
+	// The output reserves are debited before the swap fee once both sides are credited
+	for _, fill := range result {
+		i := 0
+		if fill.debit.Denom == pair.denoms[1] {
+			i = 1
+		}
+
+		if reserves[i].Amount.LT(fill.debit.Amount) {
+			return nil, math.LegacyDec{}, math.Int{}, errorsmod.Wrapf(simpleswap.ErrInsufficientReserve, "for the denom: %s", fill.debit.Denom)
+		}
+		reserves[i] = reserves[i].Sub(fill.debit)
+		pool.TotalAccruedFees += fill.fee.Int64()
+
+		if fill.value.IsPositive() {
+			if err := k.recordSwapStats(ctx, fill.filled.Denom, fill.debit.Denom, fill.value, fill.fee); err != nil {
+				return nil, math.LegacyDec{}, math.Int{}, err
+			}
+		}
+	}
+
+	for _, reserve := range reserves {
+		if err := k.CoinsReserve.Set(ctx, reserve.Denom, reserve); err != nil {
+			return nil, math.LegacyDec{}, math.Int{}, err
+		}
+	}
+
+	if err := k.Pool.Set(ctx, pool); err != nil {
+		return nil, math.LegacyDec{}, math.Int{}, err
+	}
+
+	for _, fill := range result {
+		trader, err := k.addressCodec.StringToBytes(fill.swap.Trader)
+		if err != nil {
+			return nil, math.LegacyDec{}, math.Int{}, err
+		}
+
+		if payout := sdk.NewCoins(fill.output, fill.refund); !payout.IsZero() {
+			if err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, simpleswap.ModuleName, trader, payout); err != nil {
+				return nil, math.LegacyDec{}, math.Int{}, err
+			}
+		}
+
+		if fill.filled.IsPositive() {
+			if err := k.Hooks().AfterSwap(ctx, trader, pool.Id, fill.filled, fill.output); err != nil {
+				return nil, math.LegacyDec{}, math.Int{}, err
+			}
+		}
+	}
+
+	return result, price, matched, nil
+}
+
+// refundSwapsAboveMaxFee refunds the swaps of the pair whose swap fee bound is below the swap fee
+// with the oracle surcharge of their side, and returns the pair of the other swaps. The pair is
+// returned as is if the surcharge cannot be computed, the settlement then fails for the whole pair.
+func (k Keeper) refundSwapsAboveMaxFee(ctx sdk.Context, pair batchPair) (batchPair, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return batchPair{}, err
+	}
+
+	pool, err := k.Pool.Get(ctx)
+	if err != nil {
+		return batchPair{}, err
+	}
+
+	kept := batchPair{denoms: pair.denoms}
+	for i, denom := range pair.denoms {
+		surchargeRate, err := k.oracleSurcharge(ctx, params, denom, pair.denoms[1-i])
+		if err != nil {
+			return pair, nil
+		}
+
+		for _, swap := range pair.sides[i] {
+			if feeErr := checkMaxSwapFee(pool, params.SwapFeePercentage, surchargeRate, swap.MaxSwapFeePercentage); feeErr != nil {
+				if err := k.refundBatchSwap(ctx, swap, feeErr); err != nil {
+					return batchPair{}, err
+				}
+				continue
+			}
+
+			kept.sides[i] = append(kept.sides[i], swap)
+		}
+	}
+
+	return kept, nil
+}
+
+// refundBatchSwap returns the escrowed input of a swap which failed to clear.
+func (k Keeper) refundBatchSwap(ctx sdk.Context, swap simpleswap.BatchSwap, reason error) error {
+	trader, err := k.addressCodec.StringToBytes(swap.Trader)
+	if err != nil {
+		return err
+	}
+
+	if err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, simpleswap.ModuleName, trader, sdk.NewCoins(swap.Input)); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		simpleswap.EventTypeBatchSwapSettled,
+		sdk.NewAttribute(simpleswap.AttributeKeySwapId, strconv.FormatUint(swap.Id, 10)),
+		sdk.NewAttribute(simpleswap.AttributeKeyTrader, swap.Trader),
+		sdk.NewAttribute(simpleswap.AttributeKeyInput, sdk.NewCoin(swap.Input.Denom, math.ZeroInt()).String()),
+		sdk.NewAttribute(simpleswap.AttributeKeyOutput, sdk.NewCoin(swap.OutputDenom, math.ZeroInt()).String()),
+		sdk.NewAttribute(simpleswap.AttributeKeyRefund, swap.Input.String()),
+		sdk.NewAttribute(simpleswap.AttributeKeyReason, reason.Error()),
+	))
+
+	return nil
+}
+
+// poolPrice returns the price of the base denom in the quote denom in the pool, i.e. the amount
+// of the quote denom worth one unit of the base denom, before the swap fee.
+func (k Keeper) poolPrice(ctx context.Context, params simpleswap.Params, pool simpleswap.Pool, base, quote string) (math.LegacyDec, error) {
+	baseRate, err := k.AssetRate(ctx, base)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	quoteRate, err := k.AssetRate(ctx, quote)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	price := baseRate.Quo(quoteRate)
+	baseExponent, quoteExponent := int64(k.assetExponent(ctx, params, pool, base)), int64(k.assetExponent(ctx, params, pool, quote))
+	if quoteExponent >= baseExponent {
+		return price.MulInt(pow10(quoteExponent - baseExponent)), nil
+	}
+
+	return price.QuoInt(pow10(baseExponent - quoteExponent)), nil
+}
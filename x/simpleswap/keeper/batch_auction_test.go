@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	types "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/simpleswap"
+	"github.com/golang/mock/gomock"
+)
+
+func (s *KeeperTestSuite) TestClearBatchAuction() {
+	require := s.Require()
+	seller, buyer := s.addrs[1], s.addrs[2]
+
+	params := simpleswap.DefaultParams()
+	params.BatchAuction = true
+	s.initGenesis(params)
+	require.NoError(s.simpleSwapKeeper.CoinsReserve.Set(s.ctx, "ETH", types.NewInt64Coin("ETH", 10_000)))
+	require.NoError(s.simpleSwapKeeper.CoinsReserve.Set(s.ctx, "WETH", types.NewInt64Coin("WETH", 10_000)))
+
+	// The swaps are escrowed and queued until the end of the block
+	weth, eth := types.NewInt64Coin("WETH", 1000), types.NewInt64Coin("ETH", 400)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, seller, simpleswap.ModuleName, types.NewCoins(weth)).Return(nil).Times(1)
+	resp, err := s.msgServer.SwapLiquidity(s.ctx, &simpleswap.MsgSwapLiquidity{
+		Trader: seller.String(),
+		Input:  weth,
+		Output: types.NewInt64Coin("ETH", 1000),
+	})
+	require.NoError(err)
+	require.True(resp.Queued)
+	require.True(resp.Output.IsZero())
+
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, buyer, simpleswap.ModuleName, types.NewCoins(eth)).Return(nil).Times(1)
+	_, err = s.msgServer.SwapLiquidity(s.ctx, &simpleswap.MsgSwapLiquidity{
+		Trader: buyer.String(),
+		Input:  eth,
+		Output: types.NewInt64Coin("WETH", 400),
+	})
+	require.NoError(err)
+
+	// Both swaps are filled at the pool price net of the swap fee, only the imbalance of 600 is
+	// swapped against the reserves
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), simpleswap.ModuleName, seller, types.NewCoins(types.NewInt64Coin("ETH", 999))).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), simpleswap.ModuleName, buyer, types.NewCoins(types.NewInt64Coin("WETH", 399))).Return(nil).Times(1)
+
+	ctx := s.ctx.WithEventManager(types.NewEventManager())
+	require.NoError(s.simpleSwapKeeper.EndBlocker(ctx))
+
+	ethReserve, err := s.simpleSwapKeeper.CoinsReserve.Get(ctx, "ETH")
+	require.NoError(err)
+	require.Equal(types.NewInt64Coin("ETH", 9400), ethReserve)
+	wethReserve, err := s.simpleSwapKeeper.CoinsReserve.Get(ctx, "WETH")
+	require.NoError(err)
+	require.Equal(types.NewInt64Coin("WETH", 10_600), wethReserve)
+
+	pool, err := s.simpleSwapKeeper.Pool.Get(ctx)
+	require.NoError(err)
+	require.Equal(int64(2), pool.TotalAccruedFees)
+
+	events := ctx.EventManager().Events()
+	require.Len(events, 3)
+	require.Equal(simpleswap.EventTypeBatchCleared, events[2].Type)
+	matched, ok := events[2].GetAttribute(simpleswap.AttributeKeyMatched)
+	require.True(ok)
+	require.Equal("400", matched.Value)
+	price, ok := events[2].GetAttribute(simpleswap.AttributeKeyPrice)
+	require.True(ok)
+	require.Equal(math.LegacyOneDec().String(), price.Value)
+
+	// The queue is cleared with the block
+	iter, err := s.simpleSwapKeeper.BatchSwaps.Iterate(ctx, nil)
+	require.NoError(err)
+	defer iter.Close()
+	require.False(iter.Valid())
+}
+
+func (s *KeeperTestSuite) TestClearBatchAuctionPartialFill() {
+	require := s.Require()
+	seller := s.addrs[1]
+
+	params := simpleswap.DefaultParams()
+	params.BatchAuction = true
+	s.initGenesis(params)
+	require.NoError(s.simpleSwapKeeper.CoinsReserve.Set(s.ctx, "ETH", types.NewInt64Coin("ETH", 500)))
+
+	weth := types.NewInt64Coin("WETH", 1000)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, seller, simpleswap.ModuleName, types.NewCoins(weth)).Return(nil).Times(1)
+	_, err := s.msgServer.SwapLiquidity(s.ctx, &simpleswap.MsgSwapLiquidity{
+		Trader: seller.String(),
+		Input:  weth,
+		Output: types.NewInt64Coin("ETH", 1000),
+	})
+	require.NoError(err)
+
+	// The reserves only pay half of the swap, the unfilled input is refunded
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), simpleswap.ModuleName, seller, types.NewCoins(types.NewInt64Coin("ETH", 499), types.NewInt64Coin("WETH", 500))).Return(nil).Times(1)
+
+	ctx := s.ctx.WithEventManager(types.NewEventManager())
+	require.NoError(s.simpleSwapKeeper.EndBlocker(ctx))
+
+	ethReserve, err := s.simpleSwapKeeper.CoinsReserve.Get(ctx, "ETH")
+	require.NoError(err)
+	require.True(ethReserve.IsZero())
+	wethReserve, err := s.simpleSwapKeeper.CoinsReserve.Get(ctx, "WETH")
+	require.NoError(err)
+	require.Equal(types.NewInt64Coin("WETH", 500), wethReserve)
+
+	refund, ok := ctx.EventManager().Events()[0].GetAttribute(simpleswap.AttributeKeyRefund)
+	require.True(ok)
+	require.Equal("500WETH", refund.Value)
+}
+
+func (s *KeeperTestSuite) TestClearBatchAuctionMaxSwapFee() {
+	require := s.Require()
+	seller := s.addrs[1]
+
+	params := simpleswap.DefaultParams()
+	params.BatchAuction = true
+	params.OracleGuardMode = simpleswap.ORACLE_GUARD_MODE_SURCHARGE
+	s.initGenesis(params)
+	require.NoError(s.simpleSwapKeeper.CoinsReserve.Set(s.ctx, "WETH", types.NewInt64Coin("WETH", 1000)))
+
+	eth := types.NewInt64Coin("ETH", 1000)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, seller, simpleswap.ModuleName, types.NewCoins(eth)).Return(nil).Times(1)
+	_, err := s.msgServer.SwapLiquidity(s.ctx, &simpleswap.MsgSwapLiquidity{
+		Trader:               seller.String(),
+		Input:                eth,
+		Output:               types.NewInt64Coin("WETH", 1000),
+		MaxSwapFeePercentage: 30000,
+	})
+	require.NoError(err)
+
+	// ETH depegs before the clearing, the surcharge exceeds the bound and the swap is refunded
+	s.oracleKeeper.EXPECT().GetPrice(gomock.Any(), "ETH").Return(math.LegacyMustNewDecFromStr("0.9"), nil).AnyTimes()
+	s.oracleKeeper.EXPECT().GetPrice(gomock.Any(), "WETH").Return(math.LegacyOneDec(), nil).AnyTimes()
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), simpleswap.ModuleName, seller, types.NewCoins(eth)).Return(nil).Times(1)
+
+	ctx := s.ctx.WithEventManager(types.NewEventManager())
+	require.NoError(s.simpleSwapKeeper.EndBlocker(ctx))
+
+	wethReserve, err := s.simpleSwapKeeper.CoinsReserve.Get(ctx, "WETH")
+	require.NoError(err)
+	require.Equal(types.NewInt64Coin("WETH", 1000), wethReserve)
+
+	reason, ok := ctx.EventManager().Events()[0].GetAttribute(simpleswap.AttributeKeyReason)
+	require.True(ok)
+	require.Contains(reason.Value, simpleswap.ErrSwapFeeTooHigh.Error())
+}
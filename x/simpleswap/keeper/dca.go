@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"context"
+	stdmath "math"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/simpleswap"
+)
+
+// DCAPlansIndexes are the indexes of the DCA plans.
+type DCAPlansIndexes struct {
+	// NextExecution indexes the ids of the plans by the height their next slice is due at.
+	NextExecution *indexes.Multi[int64, uint64, simpleswap.DCAPlan]
+
+	// Owner indexes the ids of the plans by their owner.
+	Owner *PaginatedMulti[string, uint64, simpleswap.DCAPlan]
+}
+
+// NewDCAPlansIndexes creates the indexes of the DCA plans.
+func NewDCAPlansIndexes(sb *collections.SchemaBuilder) DCAPlansIndexes {
+	return DCAPlansIndexes{
+		NextExecution: indexes.NewMulti(sb, simpleswap.DCAPlansByNextExecutionKey, "dca_plans_by_next_execution", collections.Int64Key, collections.Uint64Key, DCAPlanNextExecution),
+		Owner:         NewPaginatedMulti(sb, simpleswap.DCAPlansByOwnerKey, "dca_plans_by_owner", collections.StringKey, collections.Uint64Key, DCAPlanOwner),
+	}
+}
+
+// IndexesList implements collections.Indexes.
+func (i DCAPlansIndexes) IndexesList() []collections.Index[uint64, simpleswap.DCAPlan] {
+	return []collections.Index[uint64, simpleswap.DCAPlan]{i.NextExecution, i.Owner}
+}
+
+// DCAPlanNextExecution returns the height the next slice of the plan is due at, by which it is indexed.
+func DCAPlanNextExecution(_ uint64, plan simpleswap.DCAPlan) (int64, error) {
+	return plan.NextExecutionHeight, nil
+}
+
+// DCAPlanOwner returns the owner of the plan, by which it is indexed.
+func DCAPlanOwner(_ uint64, plan simpleswap.DCAPlan) (string, error) {
+	return plan.Owner, nil
+}
+
+// ExecuteDCAPlans executes, in the order of their due heights then of their ids, the slice of
+// every DCA plan due at the current height. A slice is swapped out of the escrow for the owner,
+// or skipped if the pool does not pay its minimum output, and the next slice is due after the
+// interval of the plan. A plan is deleted once its escrow is swapped, or closed and refunded once
+// DcaPlanMaxSkips slices are skipped in a row. At most DcaSlicesPerBlock slices are executed per
+// block, the plans not reached keep their due height and so go first in the next block.
+func (k Keeper) ExecuteDCAPlans(ctx context.Context) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Only the plans due up to the current height are visited
+	due := new(collections.Range[collections.Pair[int64, uint64]]).
+		EndInclusive(collections.Join(height, uint64(stdmath.MaxUint64)))
+
+	limit := int(params.DCASlices())
+	var ids []uint64
+	err = k.DCAPlans.Indexes.NextExecution.Walk(ctx, due, func(_ int64, id uint64) (bool, error) {
+		if len(ids) == limit {
+			return true, nil
+		}
+
+		ids = append(ids, id)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		plan, err := k.DCAPlans.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := k.executeDCASlice(sdk.UnwrapSDKContext(ctx), params, plan); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// executeDCASlice swaps the next slice of the plan and schedules the following one.
+func (k Keeper) executeDCASlice(ctx sdk.Context, params simpleswap.Params, plan simpleswap.DCAPlan) error {
+	slice := sdk.NewCoin(plan.Remaining.Denom, math.MinInt(plan.SliceAmount, plan.Remaining.Amount))
+	plan.NextExecutionHeight = ctx.BlockHeight() + int64(plan.IntervalBlocks)
+
+	output, err := k.swapEscrowed(ctx, plan.Owner, slice, plan.OutputDenom, DCASliceMinOutput(plan, slice.Amount))
+	if err != nil {
+		// The slice stays in the escrow and is retried at the next execution
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			simpleswap.EventTypeDCASliceSkipped,
+			sdk.NewAttribute(simpleswap.AttributeKeyPlanId, strconv.FormatUint(plan.Id, 10)),
+			sdk.NewAttribute(simpleswap.AttributeKeyOwner, plan.Owner),
+			sdk.NewAttribute(simpleswap.AttributeKeyInput, slice.String()),
+			sdk.NewAttribute(simpleswap.AttributeKeyReason, err.Error()),
+		))
+
+		plan.ConsecutiveSkips++
+		if plan.ConsecutiveSkips >= params.DCAMaxSkips() {
+			return k.closeDCAPlan(ctx, plan)
+		}
+
+		return k.DCAPlans.Set(ctx, plan.Id, plan)
+	}
+
+	plan.Remaining = plan.Remaining.Sub(slice)
+	plan.ConsecutiveSkips = 0
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		simpleswap.EventTypeDCASliceExecuted,
+		sdk.NewAttribute(simpleswap.AttributeKeyPlanId, strconv.FormatUint(plan.Id, 10)),
+		sdk.NewAttribute(simpleswap.AttributeKeyOwner, plan.Owner),
+		sdk.NewAttribute(simpleswap.AttributeKeyInput, slice.String()),
+		sdk.NewAttribute(simpleswap.AttributeKeyOutput, output.String()),
+		sdk.NewAttribute(simpleswap.AttributeKeyRemaining, plan.Remaining.String()),
+	))
+
+	if plan.Remaining.IsZero() {
+		return k.DCAPlans.Remove(ctx, plan.Id)
+	}
+
+	return k.DCAPlans.Set(ctx, plan.Id, plan)
+}
+
+// closeDCAPlan refunds the escrow not yet swapped of a plan whose slices keep being skipped and
+// deletes the plan.
+func (k Keeper) closeDCAPlan(ctx sdk.Context, plan simpleswap.DCAPlan) error {
+	owner, err := k.addressCodec.StringToBytes(plan.Owner)
+	if err != nil {
+		return err
+	}
+
+	if err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, simpleswap.ModuleName, owner, sdk.NewCoins(plan.Remaining)); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		simpleswap.EventTypeDCAPlanClosed,
+		sdk.NewAttribute(simpleswap.AttributeKeyPlanId, strconv.FormatUint(plan.Id, 10)),
+		sdk.NewAttribute(simpleswap.AttributeKeyOwner, plan.Owner),
+		sdk.NewAttribute(simpleswap.AttributeKeyRefund, plan.Remaining.String()),
+	))
+
+	return k.DCAPlans.Remove(ctx, plan.Id)
+}
+
+// DCASliceMinOutput returns the lowest output of a slice of the plan, i.e. the minimum output per
+// slice prorated to a last slice smaller than the slice amount, rounded up.
+func DCASliceMinOutput(plan simpleswap.DCAPlan, amount math.Int) math.Int {
+	if amount.Equal(plan.SliceAmount) {
+		return plan.MinOutputPerSlice
+	}
+
+	return math.LegacyNewDecFromInt(plan.MinOutputPerSlice).MulInt(amount).QuoInt(plan.SliceAmount).Ceil().TruncateInt()
+}
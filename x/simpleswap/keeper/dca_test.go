@@ -0,0 +1,369 @@
+package keeper_test
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/math"
+	types "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/simpleswap"
+	simpleswapKeeper "github.com/cosmos/simpleswap/keeper"
+	"github.com/golang/mock/gomock"
+)
+
+func (s *KeeperTestSuite) TestCreateDCAPlan() {
+	owner := s.addrs[1]
+
+	testCases := []struct {
+		name              string
+		total             types.Coin
+		sliceAmount       math.Int
+		intervalBlocks    uint64
+		outputDenom       string
+		minOutputPerSlice math.Int
+		expectErr         error
+	}{
+		{
+			name:              "total not whitelisted",
+			total:             types.NewInt64Coin("BTC", 10_000),
+			sliceAmount:       math.NewInt(1000),
+			intervalBlocks:    10,
+			outputDenom:       "ETH",
+			minOutputPerSlice: math.NewInt(990),
+			expectErr:         simpleswap.ErrCoinInvalid,
+		},
+		{
+			name:              "output is the input",
+			total:             types.NewInt64Coin("stkETH", 10_000),
+			sliceAmount:       math.NewInt(1000),
+			intervalBlocks:    10,
+			outputDenom:       "stkETH",
+			minOutputPerSlice: math.NewInt(990),
+			expectErr:         simpleswap.ErrCoinInvalid,
+		},
+		{
+			name:              "slice above the total",
+			total:             types.NewInt64Coin("stkETH", 10_000),
+			sliceAmount:       math.NewInt(10_001),
+			intervalBlocks:    10,
+			outputDenom:       "ETH",
+			minOutputPerSlice: math.NewInt(990),
+			expectErr:         simpleswap.ErrInvalidDCAPlan,
+		},
+		{
+			name:              "zero slice",
+			total:             types.NewInt64Coin("stkETH", 10_000),
+			sliceAmount:       math.ZeroInt(),
+			intervalBlocks:    10,
+			outputDenom:       "ETH",
+			minOutputPerSlice: math.NewInt(990),
+			expectErr:         simpleswap.ErrInvalidDCAPlan,
+		},
+		{
+			name:              "zero interval",
+			total:             types.NewInt64Coin("stkETH", 10_000),
+			sliceAmount:       math.NewInt(1000),
+			outputDenom:       "ETH",
+			minOutputPerSlice: math.NewInt(990),
+			expectErr:         simpleswap.ErrInvalidDCAPlan,
+		},
+		{
+			name:              "negative minimum output",
+			total:             types.NewInt64Coin("stkETH", 10_000),
+			sliceAmount:       math.NewInt(1000),
+			intervalBlocks:    10,
+			outputDenom:       "ETH",
+			minOutputPerSlice: math.NewInt(-1),
+			expectErr:         simpleswap.ErrInvalidDCAPlan,
+		},
+		{
+			name:              "valid plan",
+			total:             types.NewInt64Coin("stkETH", 10_000),
+			sliceAmount:       math.NewInt(1000),
+			intervalBlocks:    10,
+			outputDenom:       "ETH",
+			minOutputPerSlice: math.NewInt(990),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			require := s.Require()
+			s.initGenesis(simpleswap.DefaultParams())
+			ctx := s.ctx.WithBlockHeight(5)
+
+			if tc.expectErr == nil {
+				s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(ctx, owner, simpleswap.ModuleName, types.NewCoins(tc.total)).Return(nil).Times(1)
+			}
+
+			resp, err := s.msgServer.CreateDCAPlan(ctx, &simpleswap.MsgCreateDCAPlan{
+				Owner:             owner.String(),
+				Total:             tc.total,
+				SliceAmount:       tc.sliceAmount,
+				IntervalBlocks:    tc.intervalBlocks,
+				OutputDenom:       tc.outputDenom,
+				MinOutputPerSlice: tc.minOutputPerSlice,
+			})
+			if tc.expectErr != nil {
+				require.ErrorIs(err, tc.expectErr)
+				return
+			}
+			require.NoError(err)
+
+			plans, err := s.queryClient.DCAPlansByOwner(ctx, &simpleswap.QueryDCAPlansByOwnerRequest{Owner: owner.String()})
+			require.NoError(err)
+			require.Len(plans.Plans, 1)
+			require.Equal(resp.PlanId, plans.Plans[0].Id)
+			require.Equal(tc.total, plans.Plans[0].Remaining)
+			require.Equal(int64(5), plans.Plans[0].NextExecutionHeight)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestExecuteDCAPlans() {
+	require := s.Require()
+	owner, other := s.addrs[1], s.addrs[2]
+
+	s.initGenesis(simpleswap.DefaultParams())
+	require.NoError(s.simpleSwapKeeper.CoinsReserve.Set(s.ctx, "ETH", types.NewInt64Coin("ETH", 10_000)))
+
+	// The bank keeper tracks the ETH balance of the owner, credited by the swaps
+	ethBalance := types.NewInt64Coin("ETH", 0)
+	s.bankKeeper.EXPECT().SpendableCoin(gomock.Any(), owner, "ETH").DoAndReturn(func(context.Context, types.AccAddress, string) types.Coin {
+		return ethBalance
+	}).AnyTimes()
+	s.bankKeeper.EXPECT().SpendableCoin(gomock.Any(), owner, "WETH").Return(types.NewInt64Coin("WETH", 2500)).AnyTimes()
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), owner, simpleswap.ModuleName, gomock.Any()).Return(nil).AnyTimes()
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), simpleswap.ModuleName, owner, gomock.Any()).DoAndReturn(func(_ context.Context, _ string, _ types.AccAddress, coins types.Coins) error {
+		ethBalance = ethBalance.AddAmount(coins.AmountOf("ETH"))
+		return nil
+	}).AnyTimes()
+
+	ctx := s.ctx.WithBlockHeight(5)
+	resp, err := s.msgServer.CreateDCAPlan(ctx, &simpleswap.MsgCreateDCAPlan{
+		Owner:             owner.String(),
+		Total:             types.NewInt64Coin("WETH", 2500),
+		SliceAmount:       math.NewInt(1000),
+		IntervalBlocks:    10,
+		OutputDenom:       "ETH",
+		MinOutputPerSlice: math.NewInt(990),
+	})
+	require.NoError(err)
+
+	endBlock := func(height int64) (simpleswap.DCAPlan, types.Events) {
+		ctx := s.ctx.WithBlockHeight(height).WithEventManager(types.NewEventManager())
+		require.NoError(s.simpleSwapKeeper.EndBlocker(ctx))
+
+		plan, _ := s.simpleSwapKeeper.DCAPlans.Get(ctx, resp.PlanId)
+		return plan, ctx.EventManager().Events()
+	}
+
+	// The first slice is executed at the end of the block of the creation, net of the swap fee
+	plan, events := endBlock(5)
+	require.Equal(types.NewInt64Coin("WETH", 1500), plan.Remaining)
+	require.Equal(int64(15), plan.NextExecutionHeight)
+	require.Equal(types.NewInt64Coin("ETH", 999), ethBalance)
+	require.Len(events, 1)
+	require.Equal(simpleswap.EventTypeDCASliceExecuted, events[0].Type)
+
+	// No slice is due before the interval has elapsed
+	plan, events = endBlock(10)
+	require.Equal(types.NewInt64Coin("WETH", 1500), plan.Remaining)
+	require.Empty(events)
+
+	// A slice the pool cannot pay is skipped and retried after the interval
+	require.NoError(s.simpleSwapKeeper.CoinsReserve.Set(s.ctx, "ETH", types.NewInt64Coin("ETH", 500)))
+	plan, events = endBlock(15)
+	require.Equal(types.NewInt64Coin("WETH", 1500), plan.Remaining)
+	require.Equal(int64(25), plan.NextExecutionHeight)
+	require.Len(events, 1)
+	require.Equal(simpleswap.EventTypeDCASliceSkipped, events[0].Type)
+
+	// Only the owner can cancel the plan
+	_, err = s.msgServer.CancelDCAPlan(s.ctx, &simpleswap.MsgCancelDCAPlan{Owner: other.String(), PlanId: resp.PlanId})
+	require.ErrorIs(err, simpleswap.ErrDCAPlanNotOwned)
+
+	require.NoError(s.simpleSwapKeeper.CoinsReserve.Set(s.ctx, "ETH", types.NewInt64Coin("ETH", 10_000)))
+	plan, _ = endBlock(25)
+	require.Equal(types.NewInt64Coin("WETH", 500), plan.Remaining)
+	require.Equal(types.NewInt64Coin("ETH", 1998), ethBalance)
+
+	// The last slice swaps the remainder at the prorated minimum output and deletes the plan
+	require.Equal(math.NewInt(495), simpleswapKeeper.DCASliceMinOutput(plan, math.NewInt(500)))
+	_, events = endBlock(35)
+	require.Len(events, 1)
+	remaining, ok := events[0].GetAttribute(simpleswap.AttributeKeyRemaining)
+	require.True(ok)
+	require.Equal("0WETH", remaining.Value)
+	require.Equal(types.NewInt64Coin("ETH", 2497), ethBalance)
+
+	_, err = s.msgServer.CancelDCAPlan(s.ctx, &simpleswap.MsgCancelDCAPlan{Owner: owner.String(), PlanId: resp.PlanId})
+	require.ErrorIs(err, simpleswap.ErrDCAPlanNotFound)
+}
+
+func (s *KeeperTestSuite) TestCancelDCAPlan() {
+	require := s.Require()
+	owner := s.addrs[1]
+	total := types.NewInt64Coin("stkETH", 10_000)
+
+	s.initGenesis(simpleswap.DefaultParams())
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, owner, simpleswap.ModuleName, types.NewCoins(total)).Return(nil).Times(1)
+	resp, err := s.msgServer.CreateDCAPlan(s.ctx, &simpleswap.MsgCreateDCAPlan{
+		Owner:             owner.String(),
+		Total:             total,
+		SliceAmount:       math.NewInt(1000),
+		IntervalBlocks:    10,
+		OutputDenom:       "ETH",
+		MinOutputPerSlice: math.NewInt(990),
+	})
+	require.NoError(err)
+
+	// The escrow not yet swapped is refunded
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(s.ctx, simpleswap.ModuleName, owner, types.NewCoins(total)).Return(nil).Times(1)
+	cancelResp, err := s.msgServer.CancelDCAPlan(s.ctx, &simpleswap.MsgCancelDCAPlan{Owner: owner.String(), PlanId: resp.PlanId})
+	require.NoError(err)
+	require.Equal(total, cancelResp.Refund)
+
+	plans, err := s.queryClient.DCAPlansByOwner(s.ctx, &simpleswap.QueryDCAPlansByOwnerRequest{Owner: owner.String()})
+	require.NoError(err)
+	require.Empty(plans.Plans)
+}
+
+func (s *KeeperTestSuite) TestExecuteDCAPlansMaxSkips() {
+	require := s.Require()
+	owner := s.addrs[1]
+	total := types.NewInt64Coin("WETH", 2500)
+
+	params := simpleswap.DefaultParams()
+	params.DcaPlanMaxSkips = 2
+	s.initGenesis(params)
+
+	ctx := s.ctx.WithBlockHeight(5)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(ctx, owner, simpleswap.ModuleName, types.NewCoins(total)).Return(nil).Times(1)
+	resp, err := s.msgServer.CreateDCAPlan(ctx, &simpleswap.MsgCreateDCAPlan{
+		Owner:             owner.String(),
+		Total:             total,
+		SliceAmount:       math.NewInt(1000),
+		IntervalBlocks:    10,
+		OutputDenom:       "ETH",
+		MinOutputPerSlice: math.NewInt(990),
+	})
+	require.NoError(err)
+
+	// The pool has no ETH reserve, every slice is skipped
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), simpleswap.ModuleName, owner, types.NewCoins(types.NewInt64Coin("WETH", 1000))).Return(nil).Times(2)
+	s.bankKeeper.EXPECT().SpendableCoin(gomock.Any(), owner, "WETH").Return(total).Times(2)
+
+	endBlock := func(height int64) types.Events {
+		ctx := s.ctx.WithBlockHeight(height).WithEventManager(types.NewEventManager())
+		require.NoError(s.simpleSwapKeeper.EndBlocker(ctx))
+		return ctx.EventManager().Events()
+	}
+
+	events := endBlock(5)
+	require.Len(events, 1)
+	require.Equal(simpleswap.EventTypeDCASliceSkipped, events[0].Type)
+	plan, err := s.simpleSwapKeeper.DCAPlans.Get(s.ctx, resp.PlanId)
+	require.NoError(err)
+	require.Equal(uint32(1), plan.ConsecutiveSkips)
+
+	// The plan is not visited before its next execution height
+	require.Empty(endBlock(10))
+
+	// The second skip in a row closes the plan and refunds the escrow
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), simpleswap.ModuleName, owner, types.NewCoins(total)).Return(nil).Times(1)
+	events = endBlock(15)
+	require.Len(events, 2)
+	require.Equal(simpleswap.EventTypeDCAPlanClosed, events[1].Type)
+	refund, ok := events[1].GetAttribute(simpleswap.AttributeKeyRefund)
+	require.True(ok)
+	require.Equal(total.String(), refund.Value)
+
+	_, err = s.simpleSwapKeeper.DCAPlans.Get(s.ctx, resp.PlanId)
+	require.Error(err)
+	iter, err := s.simpleSwapKeeper.DCAPlans.Indexes.NextExecution.Iterate(s.ctx, nil)
+	require.NoError(err)
+	defer iter.Close()
+	require.False(iter.Valid())
+}
+
+func (s *KeeperTestSuite) TestExecuteDCAPlansPerBlock() {
+	require := s.Require()
+	owner, other := s.addrs[1], s.addrs[2]
+
+	params := simpleswap.DefaultParams()
+	params.DcaSlicesPerBlock = 1
+	s.initGenesis(params)
+
+	// The pool has no ETH reserve, every slice is skipped and its escrow kept
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), simpleswap.ModuleName, gomock.Any()).Return(nil).AnyTimes()
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), simpleswap.ModuleName, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	s.bankKeeper.EXPECT().SpendableCoin(gomock.Any(), gomock.Any(), "WETH").Return(types.NewInt64Coin("WETH", 2500)).AnyTimes()
+
+	ctx := s.ctx.WithBlockHeight(5)
+	create := func(owner types.AccAddress) uint64 {
+		resp, err := s.msgServer.CreateDCAPlan(ctx, &simpleswap.MsgCreateDCAPlan{
+			Owner:             owner.String(),
+			Total:             types.NewInt64Coin("WETH", 2500),
+			SliceAmount:       math.NewInt(1000),
+			IntervalBlocks:    10,
+			OutputDenom:       "ETH",
+			MinOutputPerSlice: math.NewInt(990),
+		})
+		require.NoError(err)
+		return resp.PlanId
+	}
+
+	first := create(owner)
+	second := create(owner)
+	otherPlan := create(other)
+
+	// The plans of the owner are paged over the owner index
+	page, err := s.queryClient.DCAPlansByOwner(ctx, &simpleswap.QueryDCAPlansByOwnerRequest{
+		Owner:      owner.String(),
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Len(page.Plans, 1)
+	require.Equal(first, page.Plans[0].Id)
+	require.Equal(uint64(2), page.Pagination.Total)
+
+	page, err = s.queryClient.DCAPlansByOwner(ctx, &simpleswap.QueryDCAPlansByOwnerRequest{
+		Owner:      owner.String(),
+		Pagination: &query.PageRequest{Key: page.Pagination.NextKey},
+	})
+	require.NoError(err)
+	require.Len(page.Plans, 1)
+	require.Equal(second, page.Plans[0].Id)
+	require.Nil(page.Pagination.NextKey)
+
+	endBlock := func(height int64) []string {
+		ctx := s.ctx.WithBlockHeight(height).WithEventManager(types.NewEventManager())
+		require.NoError(s.simpleSwapKeeper.EndBlocker(ctx))
+
+		ids := []string{}
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == simpleswap.EventTypeDCASliceSkipped {
+				id, ok := event.GetAttribute(simpleswap.AttributeKeyPlanId)
+				require.True(ok)
+				ids = append(ids, id.Value)
+			}
+		}
+		return ids
+	}
+
+	// A single slice is executed per block, the plans not reached stay due and go first in the
+	// next blocks, before the plans due later
+	require.Equal([]string{strconv.FormatUint(first, 10)}, endBlock(5))
+	require.Equal([]string{strconv.FormatUint(second, 10)}, endBlock(6))
+	require.Equal([]string{strconv.FormatUint(otherPlan, 10)}, endBlock(7))
+	require.Empty(endBlock(8))
+
+	for id, height := range map[uint64]int64{first: 15, second: 16, otherPlan: 17} {
+		plan, err := s.simpleSwapKeeper.DCAPlans.Get(s.ctx, id)
+		require.NoError(err)
+		require.Equal(height, plan.NextExecutionHeight)
+	}
+}
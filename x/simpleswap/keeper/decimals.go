@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/simpleswap"
+)
+
+// assetExponent returns the decimal exponent of a whitelisted denom. The exponent declared in
+// the params takes precedence over the display exponent of the bank denom metadata, a denom
+// without either is assumed to have the pool decimals.
+func (k Keeper) assetExponent(ctx context.Context, params simpleswap.Params, pool simpleswap.Pool, denom string) uint32 {
+	if exponent, ok := params.AssetExponent(denom); ok {
+		return exponent
+	}
+
+	if metadata, ok := k.BankKeeper.GetDenomMetaData(ctx, denom); ok {
+		for _, unit := range metadata.DenomUnits {
+			if unit.Denom == metadata.Display {
+				return unit.Exponent
+			}
+		}
+	}
+
+	return uint32(pool.Decimals)
+}
+
+// normalizeAmount converts a coin amount to the pool decimals. Callers round the amounts
+// owed to the pool up and the amounts owed by the pool down, so that the rounding always
+// favors the pool.
+func (k Keeper) normalizeAmount(ctx context.Context, params simpleswap.Params, pool simpleswap.Pool, coin sdk.Coin, roundUp bool) math.Int {
+	return scaleAmount(coin.Amount, int64(k.assetExponent(ctx, params, pool, coin.Denom)), pool.Decimals, roundUp)
+}
+
+// denormalizeAmount converts an amount in the pool decimals to the denom, with the same
+// rounding rules as normalizeAmount.
+func (k Keeper) denormalizeAmount(ctx context.Context, params simpleswap.Params, pool simpleswap.Pool, denom string, amount math.Int, roundUp bool) sdk.Coin {
+	return sdk.NewCoin(denom, scaleAmount(amount, pool.Decimals, int64(k.assetExponent(ctx, params, pool, denom)), roundUp))
+}
+
+// scaleAmount converts an amount from one decimal exponent to another.
+func scaleAmount(amount math.Int, fromExponent, toExponent int64, roundUp bool) math.Int {
+	if fromExponent <= toExponent {
+		return amount.Mul(pow10(toExponent - fromExponent))
+	}
+
+	divisor := pow10(fromExponent - toExponent)
+	if roundUp {
+		return amount.Add(divisor).SubRaw(1).Quo(divisor)
+	}
+
+	return amount.Quo(divisor)
+}
+
+func pow10(exponent int64) math.Int {
+	return math.NewIntWithDecimal(1, int(exponent))
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/cosmos/simpleswap"
+)
+
+// DenomOrigin returns the human-readable origin of a denom. An IBC denom resolves to the
+// transfer path trace recorded by the transfer keeper, the IBC denoms it has no trace of
+// have never been received and are their own origin. Without a transfer keeper, an IBC
+// denom resolves to the whitelist entry it is derived from. Any other denom is its own origin.
+func (k Keeper) DenomOrigin(ctx context.Context, params simpleswap.Params, denom string) string {
+	ibcPrefix := fmt.Sprintf("%s/", transfertypes.DenomPrefix)
+	if !strings.HasPrefix(denom, ibcPrefix) {
+		return denom
+	}
+
+	if k.transferKeeper != nil {
+		hash, err := transfertypes.ParseHexHash(strings.TrimPrefix(denom, ibcPrefix))
+		if err != nil {
+			return denom
+		}
+
+		if denomTrace, found := k.transferKeeper.GetDenomTrace(sdk.UnwrapSDKContext(ctx), hash); found {
+			return denomTrace.GetFullDenomPath()
+		}
+
+		return denom
+	}
+
+	for _, coin := range params.WhitelistedCoins {
+		if simpleswap.IsDenomTrace(coin.Denom) && simpleswap.ResolveDenom(coin.Denom) == denom {
+			return coin.Denom
+		}
+	}
+
+	return denom
+}
+
+// WhitelistOrigins returns the origins of the denoms of the whitelisted coins.
+func (k Keeper) WhitelistOrigins(ctx context.Context, params simpleswap.Params) []simpleswap.DenomOrigin {
+	origins := make([]simpleswap.DenomOrigin, 0, len(params.WhitelistedCoins))
+	for _, denom := range params.WhitelistedDenoms() {
+		origins = append(origins, simpleswap.DenomOrigin{Denom: denom, Origin: k.DenomOrigin(ctx, params, denom)})
+	}
+
+	return origins
+}
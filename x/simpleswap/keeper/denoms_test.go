@@ -0,0 +1,163 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/cosmos/simpleswap"
+	expectedkeepers "github.com/cosmos/simpleswap/expected_keepers"
+	simpleswapKeeper "github.com/cosmos/simpleswap/keeper"
+	"github.com/golang/mock/gomock"
+)
+
+const wethTrace = "transfer/channel-0/weth"
+
+func (s *KeeperTestSuite) TestWhitelistEntries() {
+	wethDenom := transfertypes.ParseDenomTrace(wethTrace).IBCDenom()
+
+	testCases := []struct {
+		name      string
+		entries   []string
+		expectErr error
+	}{
+		{
+			name:    "native denoms",
+			entries: []string{"ETH", "WETH"},
+		},
+		{
+			name:    "transfer path trace",
+			entries: []string{"ETH", wethTrace},
+		},
+		{
+			name:    "multi-hop transfer path trace",
+			entries: []string{"ETH", "transfer/channel-1/transfer/channel-0/weth"},
+		},
+		{
+			name:    "ibc denom",
+			entries: []string{"ETH", wethDenom},
+		},
+		{
+			name:      "invalid ibc denom",
+			entries:   []string{"ETH", "ibc/weth"},
+			expectErr: simpleswap.ErrCoinInvalid,
+		},
+		{
+			name:      "invalid denom",
+			entries:   []string{"ETH", "1weth"},
+			expectErr: simpleswap.ErrCoinInvalid,
+		},
+		{
+			name:      "duplicate denom",
+			entries:   []string{"ETH", "ETH"},
+			expectErr: simpleswap.ErrDuplicateCoin,
+		},
+		{
+			name:      "trace of a whitelisted ibc denom",
+			entries:   []string{wethDenom, wethTrace},
+			expectErr: simpleswap.ErrDuplicateCoin,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			params := simpleswap.DefaultParams()
+			params.WhitelistedCoins = nil
+			for _, entry := range tc.entries {
+				params.WhitelistedCoins = append(params.WhitelistedCoins, &sdk.Coin{Denom: entry, Amount: math.ZeroInt()})
+			}
+
+			err := params.Validate()
+			if tc.expectErr != nil {
+				s.Require().ErrorIs(err, tc.expectErr)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestWhitelistTrace() {
+	require := s.Require()
+	trader := s.addrs[1]
+	wethDenom := transfertypes.ParseDenomTrace(wethTrace).IBCDenom()
+
+	params := simpleswap.DefaultParams()
+	params.WhitelistedCoins = append(params.WhitelistedCoins, &sdk.Coin{Denom: wethTrace, Amount: math.ZeroInt()})
+	params.AssetExponents = []simpleswap.AssetExponent{{Denom: wethTrace, Exponent: 18}}
+	require.NoError(params.Validate())
+	s.initGenesis(params)
+
+	// The trace resolves to the ibc denom of the bridged coin
+	require.True(params.IsWhitelisted(wethDenom))
+	require.True(params.IsWhitelisted(wethTrace))
+	exponent, ok := params.AssetExponent(wethDenom)
+	require.True(ok)
+	require.Equal(uint32(18), exponent)
+
+	// The bridged coins are added to the reserve of their ibc denom
+	input := sdk.NewCoin(wethDenom, math.NewIntWithDecimal(1, 18))
+	s.bankKeeper.EXPECT().SpendableCoin(s.ctx, trader, wethDenom).Return(input).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, trader, simpleswap.ModuleName, sdk.NewCoins(input)).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().MintCoins(s.ctx, simpleswap.ModuleName, gomock.Any()).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(s.ctx, simpleswap.ModuleName, trader, gomock.Any()).Return(nil).Times(1)
+	_, err := s.msgServer.AddLiquidity(s.ctx, &simpleswap.MsgAddLiquidity{
+		LiquidityProvider: trader.String(),
+		Token:             input,
+	})
+	require.NoError(err)
+
+	// The reserve is queried by trace or by ibc denom, and shows the trace of the coin
+	for _, denom := range []string{wethTrace, wethDenom} {
+		resp, err := s.queryClient.CoinReserve(s.ctx, &simpleswap.QueryCoinReserveRequest{CoinDenom: denom})
+		require.NoError(err)
+		require.Equal(input, resp.CoinReserve)
+		require.Equal(wethTrace, resp.Origin)
+	}
+
+	reservesResp, err := s.queryClient.CoinReserves(s.ctx, &simpleswap.QueryCoinReservesRequest{})
+	require.NoError(err)
+	require.Equal(wethDenom, reservesResp.CoinReserves[3].Denom)
+	require.Equal([]simpleswap.DenomOrigin{
+		{Denom: "ETH", Origin: "ETH"},
+		{Denom: "WETH", Origin: "WETH"},
+		{Denom: "stkETH", Origin: "stkETH"},
+		{Denom: wethDenom, Origin: wethTrace},
+	}, reservesResp.Origins)
+}
+
+func (s *KeeperTestSuite) TestDenomOrigin() {
+	require := s.Require()
+
+	wethDenom := transfertypes.ParseDenomTrace(wethTrace).IBCDenom()
+	atomTrace := transfertypes.ParseDenomTrace("transfer/channel-7/uatom")
+
+	// A coin whitelisted by its ibc denom has no origin until the transfer keeper knows its trace
+	params := simpleswap.DefaultParams()
+	params.WhitelistedCoins = append(params.WhitelistedCoins, &sdk.Coin{Denom: atomTrace.IBCDenom(), Amount: math.ZeroInt()})
+	s.initGenesis(params)
+
+	resp, err := s.queryClient.Pool(s.ctx, &simpleswap.QueryPoolRequest{})
+	require.NoError(err)
+	require.Equal(simpleswap.DenomOrigin{Denom: atomTrace.IBCDenom(), Origin: atomTrace.IBCDenom()}, resp.Assets[3])
+
+	// The transfer keeper is shared with the query server created before it was set
+	transferKeeper := expectedkeepers.NewMockTransferKeeper(gomock.NewController(s.T()))
+	transferKeeper.EXPECT().GetDenomTrace(gomock.Any(), atomTrace.Hash()).Return(atomTrace, true).AnyTimes()
+	transferKeeper.EXPECT().GetDenomTrace(gomock.Any(), gomock.Any()).Return(transfertypes.DenomTrace{}, false).AnyTimes()
+	s.simpleSwapKeeper.SetTransferKeeper(transferKeeper)
+
+	resp, err = s.queryClient.Pool(s.ctx, &simpleswap.QueryPoolRequest{})
+	require.NoError(err)
+	require.Equal(simpleswap.DenomOrigin{Denom: atomTrace.IBCDenom(), Origin: "transfer/channel-7/uatom"}, resp.Assets[3])
+
+	// The ibc denoms the transfer keeper has no trace of are their own origin, even when a
+	// whitelisted trace hashes to them, native denoms are their own origin
+	params.WhitelistedCoins = append(params.WhitelistedCoins, &sdk.Coin{Denom: wethTrace, Amount: math.ZeroInt()})
+	require.Equal(wethDenom, s.simpleSwapKeeper.DenomOrigin(s.ctx, params, wethDenom))
+	require.Equal("ibc/unknown", s.simpleSwapKeeper.DenomOrigin(s.ctx, params, "ibc/unknown"))
+	require.Equal("ETH", s.simpleSwapKeeper.DenomOrigin(s.ctx, params, "ETH"))
+
+	// A zero keeper has no transfer keeper, the ibc denoms fall back to the whitelisted trace
+	require.Equal(wethTrace, simpleswapKeeper.Keeper{}.DenomOrigin(s.ctx, params, wethDenom))
+}
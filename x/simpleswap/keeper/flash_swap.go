@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/simpleswap"
+)
+
+// flashSwapContextKey marks the context of the messages nested in a flash swap, so that flash
+// swaps cannot be nested.
+type flashSwapContextKey struct{}
+
+// flashSwapRepayment returns the coins of the input denom owed for an output of the value: the
+// principal worth the value and the flash fee on top, both rounded up in favour of the pool.
+func (k Keeper) flashSwapRepayment(ctx context.Context, params simpleswap.Params, pool simpleswap.Pool, inputDenom string, value math.Int, surchargeRate math.LegacyDec) (sdk.Coin, sdk.Coin, error) {
+	percentage := params.FlashSwapFeePercentage
+	if percentage == 0 {
+		percentage = pool.SwapFeePercentage
+	}
+
+	principal, err := k.coinForValue(ctx, params, pool, inputDenom, value, true)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	fee, err := k.coinForValue(ctx, params, pool, inputDenom, surchargedFee(pool, value, percentage, surchargeRate), true)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	return principal, fee, nil
+}
+
+// executeFlashSwapMsgs executes the messages nested in a flash swap, which must all be signed by
+// the trader alone, and returns their responses.
+func (k Keeper) executeFlashSwapMsgs(ctx sdk.Context, trader sdk.AccAddress, msgs []sdk.Msg) ([]*codectypes.Any, error) {
+	if len(msgs) > 0 && k.router == nil {
+		return nil, errorsmod.Wrap(simpleswap.ErrInvalidFlashSwap, "no message router is set")
+	}
+
+	ctx = ctx.WithValue(flashSwapContextKey{}, true)
+	responses := make([]*codectypes.Any, 0, len(msgs))
+	for i, msg := range msgs {
+		signers, _, err := k.cdc.GetMsgV1Signers(msg)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(signers) != 1 || !bytes.Equal(signers[0], trader) {
+			return nil, errorsmod.Wrapf(simpleswap.ErrFlashSwapUnauthorized, "for the message %d: %s", i, sdk.MsgTypeURL(msg))
+		}
+
+		handler := k.router.Handler(msg)
+		if handler == nil {
+			return nil, sdkerrors.ErrUnknownRequest.Wrapf("unrecognized message route: %s", sdk.MsgTypeURL(msg))
+		}
+
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "for the message %d: %s", i, sdk.MsgTypeURL(msg))
+		}
+
+		// Emit the events of the nested message in the flash swap
+		ctx.EventManager().EmitEvents(res.GetEvents())
+
+		if len(res.MsgResponses) > 0 {
+			responses = append(responses, res.MsgResponses[0])
+		}
+	}
+
+	return responses, nil
+}
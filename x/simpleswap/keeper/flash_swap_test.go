@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/simpleswap"
+	simpleswapKeeper "github.com/cosmos/simpleswap/keeper"
+	"github.com/golang/mock/gomock"
+)
+
+// flashSwapMsgServer returns a msg server whose keeper routes the messages nested in a flash swap
+// to the module msg server.
+func (s *KeeperTestSuite) flashSwapMsgServer() simpleswap.MsgServer {
+	registry := codectypes.NewInterfaceRegistry()
+	simpleswap.RegisterInterfaces(registry)
+	router := baseapp.NewMsgServiceRouter()
+	router.SetInterfaceRegistry(registry)
+
+	k := s.simpleSwapKeeper
+	k.SetRouter(router)
+	msgServer := simpleswapKeeper.NewMsgServerImpl(&k)
+	simpleswap.RegisterMsgServer(router, msgServer)
+
+	return msgServer
+}
+
+func (s *KeeperTestSuite) TestFlashSwap() {
+	require := s.Require()
+	trader := s.addrs[1]
+
+	s.initGenesis(simpleswap.DefaultParams())
+	require.NoError(s.simpleSwapKeeper.CoinsReserve.Set(s.ctx, "ETH", types.NewInt64Coin("ETH", 10_000)))
+	require.NoError(s.simpleSwapKeeper.CoinsReserve.Set(s.ctx, "WETH", types.NewInt64Coin("WETH", 10_000)))
+	msgServer := s.flashSwapMsgServer()
+
+	// The trader borrows 1000 ETH, swaps them for 999 WETH and repays 1000 WETH plus the flash fee
+	eth := types.NewInt64Coin("ETH", 1000)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), simpleswap.ModuleName, trader, types.NewCoins(eth)).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().SpendableCoin(gomock.Any(), trader, "ETH").Return(eth).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), trader, simpleswap.ModuleName, types.NewCoins(eth)).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), simpleswap.ModuleName, trader, types.NewCoins(types.NewInt64Coin("WETH", 999))).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), trader, simpleswap.ModuleName, types.NewCoins(types.NewInt64Coin("WETH", 1001))).Return(nil).Times(1)
+
+	msg, err := simpleswap.NewMsgFlashSwap(trader.String(), eth, "WETH", []types.Msg{
+		&simpleswap.MsgSwapLiquidity{
+			Trader: trader.String(),
+			Input:  eth,
+			Output: types.NewInt64Coin("WETH", 1000),
+		},
+	})
+	require.NoError(err)
+
+	resp, err := msgServer.FlashSwap(s.ctx, msg)
+	require.NoError(err)
+	require.Equal(types.NewInt64Coin("WETH", 1001), resp.Repaid)
+	require.Len(resp.MsgResponses, 1)
+
+	// The reserves are whole again, the swap fee and the flash fee accrue to the liquidity providers
+	ethReserve, err := s.simpleSwapKeeper.CoinsReserve.Get(s.ctx, "ETH")
+	require.NoError(err)
+	require.Equal(types.NewInt64Coin("ETH", 10_000), ethReserve)
+	wethReserve, err := s.simpleSwapKeeper.CoinsReserve.Get(s.ctx, "WETH")
+	require.NoError(err)
+	require.Equal(types.NewInt64Coin("WETH", 10_000), wethReserve)
+
+	pool, err := s.simpleSwapKeeper.Pool.Get(s.ctx)
+	require.NoError(err)
+	require.Equal(int64(2), pool.TotalAccruedFees)
+}
+
+func (s *KeeperTestSuite) TestFlashSwapFailures() {
+	trader, other := s.addrs[1], s.addrs[2]
+	eth := types.NewInt64Coin("ETH", 1000)
+
+	testCases := []struct {
+		name      string
+		output    types.Coin
+		msgs      func() []types.Msg
+		repayErr  error
+		expectErr error
+	}{
+		{
+			name:      "output above the reserve",
+			output:    types.NewInt64Coin("ETH", 10_001),
+			msgs:      func() []types.Msg { return nil },
+			expectErr: simpleswap.ErrInsufficientReserve,
+		},
+		{
+			name:   "nested message of another signer",
+			output: eth,
+			msgs: func() []types.Msg {
+				return []types.Msg{&simpleswap.MsgCancelDCAPlan{Owner: other.String(), PlanId: 1}}
+			},
+			expectErr: simpleswap.ErrFlashSwapUnauthorized,
+		},
+		{
+			name:   "nested flash swap",
+			output: eth,
+			msgs: func() []types.Msg {
+				nested, err := simpleswap.NewMsgFlashSwap(trader.String(), eth, "WETH", nil)
+				s.Require().NoError(err)
+				return []types.Msg{nested}
+			},
+			expectErr: simpleswap.ErrFlashSwapReentrant,
+		},
+		{
+			name:      "not repaid",
+			output:    eth,
+			msgs:      func() []types.Msg { return nil },
+			repayErr:  errors.New("insufficient funds"),
+			expectErr: simpleswap.ErrFlashSwapNotRepaid,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			require := s.Require()
+			s.initGenesis(simpleswap.DefaultParams())
+			require.NoError(s.simpleSwapKeeper.CoinsReserve.Set(s.ctx, "ETH", types.NewInt64Coin("ETH", 10_000)))
+			msgServer := s.flashSwapMsgServer()
+
+			// The output is sent before the nested messages, the repayment is collected after them
+			if tc.expectErr != simpleswap.ErrInsufficientReserve {
+				s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), simpleswap.ModuleName, trader, types.NewCoins(tc.output)).Return(nil).Times(1)
+			}
+			if tc.repayErr != nil {
+				s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), trader, simpleswap.ModuleName, gomock.Any()).Return(tc.repayErr).Times(1)
+			}
+
+			msg, err := simpleswap.NewMsgFlashSwap(trader.String(), tc.output, "WETH", tc.msgs())
+			require.NoError(err)
+
+			_, err = msgServer.FlashSwap(s.ctx, msg)
+			require.ErrorIs(err, tc.expectErr)
+		})
+	}
+}
@@ -2,7 +2,11 @@ package keeper
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	types "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/simpleswap"
 )
@@ -19,22 +23,137 @@ func (k *Keeper) InitGenesis(ctx context.Context, data *simpleswap.GenesisState)
 		return err
 	}
 
-	shareToken := &types.Coin{
-		Denom:  params.ShareToken.Denom,
-		Amount: params.ShareToken.Amount,
+	// The pool of an exported genesis is restored, otherwise it is created with an empty share
+	// token supply, which follows the minted and burned shares
+	pool := simpleswap.Pool{
+		Decimals: params.Decimals,
+		ShareToken: &types.Coin{
+			Denom:  simpleswap.PoolShareDenom(simpleswap.DefaultPoolId),
+			Amount: math.ZeroInt(),
+		},
+		SwapFeePercentage: params.SwapFeePercentage,
+		Id:                simpleswap.DefaultPoolId,
+	}
+	if data.Pool != nil {
+		pool = *data.Pool
 	}
+
 	// Set the pool
-	if err := k.Pool.Set(ctx, simpleswap.Pool{
-		Decimals:          params.Decimals,
-		ShareToken:        shareToken,
-		SwapFeePercentage: params.SwapFeePercentage,
-	}); err != nil {
+	if err := k.Pool.Set(ctx, pool); err != nil {
 		return err
 	}
 
-	// Set the whitelisted coins
+	// Register the share token metadata so that wallets can display it
+	k.BankKeeper.SetDenomMetaData(ctx, simpleswap.PoolShareDenomMetadata(simpleswap.DefaultPoolId, uint32(params.Decimals)))
+
+	// Set the whitelisted coins, by their denom on this chain
 	for _, coin := range params.WhitelistedCoins {
-		if err := k.CoinsReserve.Set(ctx, coin.Denom, *coin); err != nil {
+		denom := simpleswap.ResolveDenom(coin.Denom)
+		if err := k.CoinsReserve.Set(ctx, denom, types.Coin{Denom: denom, Amount: coin.Amount}); err != nil {
+			return err
+		}
+	}
+
+	if err := k.initGenesisState(ctx, data); err != nil {
+		return err
+	}
+
+	// The hooks are only called for a new pool, not for the pool of an exported genesis
+	if data.Pool != nil {
+		return nil
+	}
+
+	return k.Hooks().AfterPoolCreated(ctx, simpleswap.DefaultPoolId)
+}
+
+// initGenesisState restores the state of an exported genesis.
+func (k *Keeper) initGenesisState(ctx context.Context, data *simpleswap.GenesisState) error {
+	for _, provider := range data.LiquidityProviders {
+		if err := k.LiquidityProviders.Set(ctx, provider.Address, provider.LiquidityProvider); err != nil {
+			return err
+		}
+	}
+
+	for _, reserve := range data.CoinsReserve {
+		if err := k.CoinsReserve.Set(ctx, reserve.Denom, reserve); err != nil {
+			return err
+		}
+	}
+
+	for _, gauge := range data.Gauges {
+		if err := k.Gauges.Set(ctx, gauge.Id, gauge); err != nil {
+			return err
+		}
+	}
+
+	for _, rewards := range data.Rewards {
+		if err := k.Rewards.Set(ctx, rewards.Address, rewards.Rewards); err != nil {
+			return err
+		}
+	}
+
+	for _, lock := range data.Locks {
+		if err := k.Locks.Set(ctx, lock.Id, lock); err != nil {
+			return err
+		}
+	}
+
+	// A genesis without locks may leave the total weight unset
+	acc := data.RewardAccumulator
+	if acc.TotalWeight.IsNil() {
+		acc.TotalWeight = math.ZeroInt()
+	}
+	if err := k.RewardAccumulator.Set(ctx, acc); err != nil {
+		return err
+	}
+
+	for _, assetRate := range data.AssetRates {
+		if err := k.AssetRates.Set(ctx, assetRate.Denom, assetRate); err != nil {
+			return err
+		}
+	}
+
+	for _, order := range data.LimitOrders {
+		if err := k.LimitOrders.Set(ctx, order.Id, order); err != nil {
+			return err
+		}
+	}
+
+	// The EndBlocker resumes after the last limit order it tried
+	if data.LimitOrderCursor != nil {
+		if err := k.LimitOrderCursor.Set(ctx, data.LimitOrderCursor.LastOrderId); err != nil {
+			return err
+		}
+	}
+
+	for _, plan := range data.DcaPlans {
+		if err := k.DCAPlans.Set(ctx, plan.Id, plan); err != nil {
+			return err
+		}
+	}
+
+	for _, bucket := range data.PoolStats {
+		if err := k.PoolStats.Set(ctx, collections.Join3(bucket.StartTime, bucket.InputDenom, bucket.OutputDenom), bucket); err != nil {
+			return err
+		}
+	}
+
+	// The sequences are only set when they were used, so that a new genesis starts them at zero
+	for _, sequence := range []struct {
+		sequence collections.Sequence
+		value    uint64
+	}{
+		{k.GaugeSequence, data.GaugeSequence},
+		{k.LockSequence, data.LockSequence},
+		{k.LimitOrderSequence, data.LimitOrderSequence},
+		{k.DCAPlanSequence, data.DcaPlanSequence},
+		{k.BatchSwapSequence, data.BatchSwapSequence},
+	} {
+		if sequence.value == 0 {
+			continue
+		}
+
+		if err := sequence.sequence.Set(ctx, sequence.value); err != nil {
 			return err
 		}
 	}
@@ -49,7 +168,118 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (*simpleswap.GenesisState, e
 		return nil, err
 	}
 
-	return &simpleswap.GenesisState{
+	pool, err := k.Pool.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genesis := &simpleswap.GenesisState{
+		Pool:   &pool,
 		Params: params,
-	}, nil
+	}
+
+	err = k.LiquidityProviders.Walk(ctx, nil, func(address string, provider simpleswap.LiquidityProvider) (bool, error) {
+		genesis.LiquidityProviders = append(genesis.LiquidityProviders, simpleswap.GenesisLiquidityProvider{Address: address, LiquidityProvider: provider})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.CoinsReserve.Walk(ctx, nil, func(_ string, reserve types.Coin) (bool, error) {
+		genesis.CoinsReserve = append(genesis.CoinsReserve, reserve)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Gauges.Walk(ctx, nil, func(_ uint64, gauge simpleswap.Gauge) (bool, error) {
+		genesis.Gauges = append(genesis.Gauges, gauge)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Rewards.Walk(ctx, nil, func(address string, rewards simpleswap.Rewards) (bool, error) {
+		genesis.Rewards = append(genesis.Rewards, simpleswap.GenesisRewards{Address: address, Rewards: rewards})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Locks.Walk(ctx, nil, func(_ uint64, lock simpleswap.PeriodLock) (bool, error) {
+		genesis.Locks = append(genesis.Locks, lock)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if genesis.RewardAccumulator, err = k.rewardAccumulator(ctx); err != nil {
+		return nil, err
+	}
+
+	err = k.AssetRates.Walk(ctx, nil, func(_ string, assetRate simpleswap.AssetRate) (bool, error) {
+		genesis.AssetRates = append(genesis.AssetRates, assetRate)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.LimitOrders.Walk(ctx, nil, func(_ uint64, order simpleswap.LimitOrder) (bool, error) {
+		genesis.LimitOrders = append(genesis.LimitOrders, order)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := k.LimitOrderCursor.Get(ctx)
+	if err == nil {
+		genesis.LimitOrderCursor = &simpleswap.LimitOrderCursor{LastOrderId: cursor}
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	err = k.DCAPlans.Walk(ctx, nil, func(_ uint64, plan simpleswap.DCAPlan) (bool, error) {
+		genesis.DcaPlans = append(genesis.DcaPlans, plan)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.PoolStats.Walk(ctx, nil, func(_ collections.Triple[time.Time, string, string], bucket simpleswap.PoolStatsBucket) (bool, error) {
+		genesis.PoolStats = append(genesis.PoolStats, bucket)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if genesis.GaugeSequence, err = k.GaugeSequence.Peek(ctx); err != nil {
+		return nil, err
+	}
+
+	if genesis.LockSequence, err = k.LockSequence.Peek(ctx); err != nil {
+		return nil, err
+	}
+
+	if genesis.LimitOrderSequence, err = k.LimitOrderSequence.Peek(ctx); err != nil {
+		return nil, err
+	}
+
+	if genesis.DcaPlanSequence, err = k.DCAPlanSequence.Peek(ctx); err != nil {
+		return nil, err
+	}
+
+	if genesis.BatchSwapSequence, err = k.BatchSwapSequence.Peek(ctx); err != nil {
+		return nil, err
+	}
+
+	return genesis, nil
 }
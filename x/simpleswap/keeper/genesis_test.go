@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	types "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/simpleswap"
+)
+
+func (s *KeeperTestSuite) TestGenesisLimitOrders() {
+	require := s.Require()
+	owner := s.addrs[1]
+
+	s.initGenesis(simpleswap.DefaultParams())
+
+	// The cursor is not exported before the EndBlocker tried any order
+	genesis, err := s.simpleSwapKeeper.ExportGenesis(s.ctx)
+	require.NoError(err)
+	require.Nil(genesis.LimitOrderCursor)
+
+	// Three open orders, the EndBlocker stopped after the second one
+	input := types.NewInt64Coin("WETH", 1000)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, owner, simpleswap.ModuleName, types.NewCoins(input)).Return(nil).Times(3)
+	for i := 0; i < 3; i++ {
+		_, err := s.msgServer.PlaceLimitOrder(s.ctx, &simpleswap.MsgPlaceLimitOrder{
+			Owner:       owner.String(),
+			Input:       input,
+			OutputDenom: "ETH",
+			LimitPrice:  math.LegacyOneDec(),
+		})
+		require.NoError(err)
+	}
+	require.NoError(s.simpleSwapKeeper.LimitOrderCursor.Set(s.ctx, 1))
+
+	genesis, err = s.simpleSwapKeeper.ExportGenesis(s.ctx)
+	require.NoError(err)
+	require.NoError(genesis.Validate())
+	require.Len(genesis.LimitOrders, 3)
+	require.Equal(uint64(3), genesis.LimitOrderSequence)
+	require.Equal(&simpleswap.LimitOrderCursor{LastOrderId: 1}, genesis.LimitOrderCursor)
+
+	// A new chain resumes after the same order
+	s.SetupTest()
+	s.bankKeeper.EXPECT().SetDenomMetaData(s.ctx, simpleswap.PoolShareDenomMetadata(simpleswap.DefaultPoolId, uint32(genesis.Params.Decimals))).Times(1)
+	require.NoError(s.simpleSwapKeeper.InitGenesis(s.ctx, genesis))
+
+	cursor, err := s.simpleSwapKeeper.LimitOrderCursor.Get(s.ctx)
+	require.NoError(err)
+	require.Equal(uint64(1), cursor)
+
+	imported, err := s.simpleSwapKeeper.ExportGenesis(s.ctx)
+	require.NoError(err)
+	require.Equal(genesis.LimitOrders, imported.LimitOrders)
+	require.Equal(genesis.LimitOrderSequence, imported.LimitOrderSequence)
+	require.Equal(genesis.LimitOrderCursor, imported.LimitOrderCursor)
+
+	// The cursor cannot point past the sequence
+	genesis.LimitOrderCursor.LastOrderId = 3
+	require.Error(genesis.Validate())
+}
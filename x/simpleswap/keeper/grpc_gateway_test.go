@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gateway "github.com/cosmos/gogogateway"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+
+	"github.com/cosmos/simpleswap"
+)
+
+// queryGateway serves a GET request on the gRPC-gateway routes of the module, set up as in the API
+// server, and returns the HTTP status and the gRPC status code of the response.
+func (s *KeeperTestSuite) queryGateway(path string) (int, codes.Code) {
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &gateway.JSONPb{EmitDefaults: true, OrigName: true}),
+		runtime.WithProtoErrorHandler(runtime.DefaultHTTPProtoErrorHandler),
+	)
+	s.Require().NoError(simpleswap.RegisterQueryHandlerClient(context.Background(), mux, s.queryClient))
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+
+	// The errors are returned as a status, with the gRPC code
+	var body struct {
+		Code codes.Code `json:"code"`
+	}
+	s.Require().NoError(json.Unmarshal(recorder.Body.Bytes(), &body), recorder.Body.String())
+	return recorder.Code, body.Code
+}
+
+func (s *KeeperTestSuite) TestQueryGatewayRoutes() {
+	shareDenom := simpleswap.PoolShareDenom(simpleswap.DefaultPoolId)
+	s.Require().NoError(s.simpleSwapKeeper.Pool.Set(s.ctx, simpleswap.Pool{
+		TotalLiquidity: 1_000_000,
+		Decimals:       6,
+		ShareToken:     &sdk.Coin{Denom: shareDenom, Amount: math.NewInt(1_000_000)},
+		Id:             simpleswap.DefaultPoolId,
+	}))
+	s.Require().NoError(s.simpleSwapKeeper.CoinsReserve.Set(s.ctx, "ETH", sdk.NewInt64Coin("ETH", 1_000_000)))
+	s.Require().NoError(s.simpleSwapKeeper.LiquidityProviders.Set(s.ctx, s.addrs[0].String(), simpleswap.LiquidityProvider{
+		StableCoin: &sdk.Coin{Denom: "ETH", Amount: math.NewInt(1_000_000)},
+		PoolShare:  &sdk.Coin{Denom: shareDenom, Amount: math.NewInt(1_000_000)},
+	}))
+	s.Require().NoError(s.simpleSwapKeeper.Gauges.Set(s.ctx, 1, simpleswap.Gauge{Id: 1, Owner: s.addrs[0].String()}))
+
+	provider, other := s.addrs[0].String(), s.addrs[1].String()
+	bothKeyAndOffset := "pagination.key=AA%3D%3D&pagination.offset=1"
+	testCases := []struct {
+		name string
+		path string
+		code codes.Code
+	}{
+		{"params", "/cosmos/simpleswap/v1/params", codes.OK},
+		{"pool", "/cosmos/simpleswap/v1/pool", codes.OK},
+		{"pool stats", "/cosmos/simpleswap/v1/pool/stats", codes.OK},
+		{"liquidity provider", "/cosmos/simpleswap/v1/liquidity_provider/" + provider, codes.OK},
+		{"liquidity provider not found", "/cosmos/simpleswap/v1/liquidity_provider/" + other, codes.NotFound},
+		{"liquidity provider with an invalid address", "/cosmos/simpleswap/v1/liquidity_provider/invalid", codes.InvalidArgument},
+		{"position", "/cosmos/simpleswap/v1/position/" + provider, codes.OK},
+		{"position not found", "/cosmos/simpleswap/v1/position/" + other, codes.NotFound},
+		{"position with an invalid address", "/cosmos/simpleswap/v1/position/invalid", codes.InvalidArgument},
+		{"liquidity providers", "/cosmos/simpleswap/v1/liquidity_providers", codes.OK},
+		{"liquidity providers with an invalid denom", "/cosmos/simpleswap/v1/liquidity_providers?denom=1ETH", codes.InvalidArgument},
+		{"liquidity providers with a key and an offset", "/cosmos/simpleswap/v1/liquidity_providers?" + bothKeyAndOffset, codes.InvalidArgument},
+		{"liquidity providers by denom", "/cosmos/simpleswap/v1/liquidity_providers/denom/ETH", codes.OK},
+		{"liquidity providers by invalid denom", "/cosmos/simpleswap/v1/liquidity_providers/denom/1ETH", codes.InvalidArgument},
+		{"liquidity providers by denom with a key and an offset", "/cosmos/simpleswap/v1/liquidity_providers/denom/ETH?" + bothKeyAndOffset, codes.InvalidArgument},
+		{"coin reserve", "/cosmos/simpleswap/v1/coin_reserve/ETH", codes.OK},
+		{"coin reserve of a whitelisted coin not deposited", "/cosmos/simpleswap/v1/coin_reserve/WETH", codes.OK},
+		{"coin reserve not found", "/cosmos/simpleswap/v1/coin_reserve/BTC", codes.NotFound},
+		{"coin reserve of an invalid denom", "/cosmos/simpleswap/v1/coin_reserve/1ETH", codes.InvalidArgument},
+		{"coin reserves", "/cosmos/simpleswap/v1/coin_reserves", codes.OK},
+		{"gauge", "/cosmos/simpleswap/v1/gauges/1", codes.OK},
+		{"gauge not found", "/cosmos/simpleswap/v1/gauges/2", codes.NotFound},
+		{"gauge with an invalid id", "/cosmos/simpleswap/v1/gauges/first", codes.InvalidArgument},
+		{"gauges", "/cosmos/simpleswap/v1/gauges", codes.OK},
+		{"gauges with a key and an offset", "/cosmos/simpleswap/v1/gauges?" + bothKeyAndOffset, codes.InvalidArgument},
+		{"pending rewards", "/cosmos/simpleswap/v1/pending_rewards/" + provider, codes.OK},
+		{"pending rewards with an invalid address", "/cosmos/simpleswap/v1/pending_rewards/invalid", codes.InvalidArgument},
+		{"locks", "/cosmos/simpleswap/v1/locks/" + provider, codes.OK},
+		{"locks with an invalid address", "/cosmos/simpleswap/v1/locks/invalid", codes.InvalidArgument},
+		{"asset rate", "/cosmos/simpleswap/v1/asset_rate/ETH", codes.OK},
+		{"asset rate of an invalid denom", "/cosmos/simpleswap/v1/asset_rate/1ETH", codes.InvalidArgument},
+		{"limit orders by owner", "/cosmos/simpleswap/v1/limit_orders/owner/" + provider, codes.OK},
+		{"limit orders by invalid owner", "/cosmos/simpleswap/v1/limit_orders/owner/invalid", codes.InvalidArgument},
+		{"limit orders by owner with a key and an offset", "/cosmos/simpleswap/v1/limit_orders/owner/" + provider + "?" + bothKeyAndOffset, codes.InvalidArgument},
+		{"limit orders by pair", "/cosmos/simpleswap/v1/limit_orders/pair/ETH/WETH", codes.OK},
+		{"limit orders by invalid pair", "/cosmos/simpleswap/v1/limit_orders/pair/ETH/1ETH", codes.InvalidArgument},
+		{"dca plans by owner", "/cosmos/simpleswap/v1/dca_plans/owner/" + provider, codes.OK},
+		{"dca plans by invalid owner", "/cosmos/simpleswap/v1/dca_plans/owner/invalid", codes.InvalidArgument},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			httpStatus, code := s.queryGateway(tc.path)
+			s.Require().Equal(tc.code, code, tc.path)
+			s.Require().Equal(runtime.HTTPStatusFromCode(tc.code), httpStatus, tc.path)
+		})
+	}
+
+	// The params are set at genesis, a store without them is not found
+	s.simpleSwapKeeper.Params.Remove(s.ctx)
+	httpStatus, code := s.queryGateway("/cosmos/simpleswap/v1/params")
+	s.Require().Equal(codes.NotFound, code)
+	s.Require().Equal(http.StatusNotFound, httpStatus)
+	s.Require().NoError(s.simpleSwapKeeper.Params.Set(s.ctx, simpleswap.DefaultParams()))
+
+	// The state that cannot be decoded is an internal error
+	store := s.ctx.KVStore(s.storeKey)
+	store.Set(append(simpleswap.LiquidityProvidersKey.Bytes(), provider...), []byte{0xff})
+	store.Set(append(simpleswap.CoinsReserveKey.Bytes(), "WETH"...), []byte{0xff})
+	for _, path := range []string{
+		"/cosmos/simpleswap/v1/liquidity_provider/" + provider,
+		"/cosmos/simpleswap/v1/position/" + provider,
+		"/cosmos/simpleswap/v1/coin_reserve/WETH",
+		"/cosmos/simpleswap/v1/coin_reserves",
+	} {
+		httpStatus, code := s.queryGateway(path)
+		s.Require().Equal(codes.Internal, code, path)
+		s.Require().Equal(http.StatusInternalServerError, httpStatus, path)
+	}
+}
@@ -0,0 +1,207 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/simpleswap"
+)
+
+// lockRewardWeight returns the reward weight of shares locked for the duration: the shares times
+// the multiplier of the duration.
+func lockRewardWeight(params simpleswap.Params, shares sdk.Coin, duration time.Duration) math.Int {
+	multiplier, ok := params.LockMultiplier(duration)
+	if !ok {
+		// The duration is no longer allowed, the lock keeps the base weight
+		multiplier = math.LegacyOneDec()
+	}
+
+	return multiplier.MulInt(shares.Amount).TruncateInt()
+}
+
+// rewardAccumulator returns the reward accumulator, empty until the first lock.
+func (k Keeper) rewardAccumulator(ctx context.Context) (simpleswap.RewardAccumulator, error) {
+	acc, err := k.RewardAccumulator.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return simpleswap.RewardAccumulator{TotalWeight: math.ZeroInt()}, nil
+	}
+
+	return acc, err
+}
+
+// settledRewards returns the rewards of the address settled up to the accumulator: the rewards
+// its weight earned since its last settlement are added to its coins.
+func (k Keeper) settledRewards(ctx context.Context, address string, acc simpleswap.RewardAccumulator) (simpleswap.Rewards, error) {
+	rewards, err := k.Rewards.Get(ctx, address)
+	if errors.Is(err, collections.ErrNotFound) {
+		return simpleswap.Rewards{Weight: math.ZeroInt(), RewardsPerWeight: acc.RewardsPerWeight}, nil
+	} else if err != nil {
+		return simpleswap.Rewards{}, err
+	}
+
+	rewards.Coins = rewards.Coins.Add(accruedRewards(rewards, acc)...)
+	rewards.RewardsPerWeight = acc.RewardsPerWeight
+	if rewards.Weight.IsNil() {
+		// The rewards credited before the locks were weighted
+		rewards.Weight = math.ZeroInt()
+	}
+	return rewards, nil
+}
+
+// accruedRewards returns the rewards earned by the weight since the snapshot of the rewards,
+// truncated. The truncated dust stays in the module account.
+func accruedRewards(rewards simpleswap.Rewards, acc simpleswap.RewardAccumulator) sdk.Coins {
+	if rewards.Weight.IsNil() || !rewards.Weight.IsPositive() {
+		return sdk.NewCoins()
+	}
+
+	accrued, _ := acc.RewardsPerWeight.Sub(rewards.RewardsPerWeight).
+		MulDecTruncate(math.LegacyNewDecFromInt(rewards.Weight)).
+		TruncateDecimal()
+	return accrued
+}
+
+// setRewards stores the rewards of the address, or removes them once they have neither coins
+// to claim nor weight.
+func (k Keeper) setRewards(ctx context.Context, address string, rewards simpleswap.Rewards) error {
+	if rewards.Coins.IsZero() && rewards.Weight.IsZero() {
+		return k.Rewards.Remove(ctx, address)
+	}
+
+	return k.Rewards.Set(ctx, address, rewards)
+}
+
+// addRewardWeight settles the rewards of the lock owner and adds the weight, negative for a
+// released lock, to its weight and to the total weight.
+func (k Keeper) addRewardWeight(ctx context.Context, owner string, weight math.Int) error {
+	acc, err := k.rewardAccumulator(ctx)
+	if err != nil {
+		return err
+	}
+
+	rewards, err := k.settledRewards(ctx, owner, acc)
+	if err != nil {
+		return err
+	}
+
+	rewards.Weight = rewards.Weight.Add(weight)
+	acc.TotalWeight = acc.TotalWeight.Add(weight)
+	if err := k.RewardAccumulator.Set(ctx, acc); err != nil {
+		return err
+	}
+
+	return k.setRewards(ctx, owner, rewards)
+}
+
+// DistributeGauges distributes one epoch of rewards of every active gauge to the lock owners, pro
+// rata to the reward weight of their locks. The rewards are added per unit of weight to the
+// accumulator and settled by owner when its weight changes or it claims, so the distribution
+// does not visit the locks. The rounding remainder stays in the gauge and is distributed in the
+// following epochs.
+func (k Keeper) DistributeGauges(ctx context.Context) error {
+	acc, err := k.rewardAccumulator(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Nobody to reward, the epoch is not counted and the rewards are kept for later
+	if !acc.TotalWeight.IsPositive() {
+		return nil
+	}
+
+	var gauges []simpleswap.Gauge
+	err = k.Gauges.Walk(ctx, nil, func(_ uint64, gauge simpleswap.Gauge) (bool, error) {
+		if gauge.FilledEpochs < gauge.NumEpochs {
+			gauges = append(gauges, gauge)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	totalWeight := math.LegacyNewDecFromInt(acc.TotalWeight)
+	for _, gauge := range gauges {
+		// Spread the remaining rewards evenly over the remaining epochs
+		remaining := gauge.Coins.Sub(gauge.DistributedCoins...)
+		remainingEpochs := math.NewIntFromUint64(gauge.NumEpochs - gauge.FilledEpochs)
+
+		epochRewards := sdk.NewCoins()
+		for _, coin := range remaining {
+			epochRewards = epochRewards.Add(sdk.NewCoin(coin.Denom, coin.Amount.Quo(remainingEpochs)))
+		}
+
+		// The rewards per weight are truncated, only the rewards they add up to are distributed
+		rewardsPerWeight := sdk.NewDecCoinsFromCoins(epochRewards...).QuoDecTruncate(totalWeight)
+		distributed, _ := rewardsPerWeight.MulDecTruncate(totalWeight).TruncateDecimal()
+		acc.RewardsPerWeight = acc.RewardsPerWeight.Add(rewardsPerWeight...)
+
+		gauge.DistributedCoins = gauge.DistributedCoins.Add(distributed...)
+		gauge.FilledEpochs++
+		if err := k.Gauges.Set(ctx, gauge.Id, gauge); err != nil {
+			return err
+		}
+	}
+
+	return k.RewardAccumulator.Set(ctx, acc)
+}
+
+// endBlockStep is a step of the EndBlocker, named in the events of its failures.
+type endBlockStep struct {
+	name string
+	run  func(ctx context.Context) error
+}
+
+// EndBlocker releases the mature share locks, clears the batch auction of the block, fills the
+// limit orders the pool pays the limit price for, executes the due DCA slices, prunes the expired
+// pool stats buckets, and distributes the gauge rewards at the end of every incentives epoch.
+//
+// Every step runs in a cache context. A step failing, e.g. on a lock or a gauge it cannot pay
+// out, is logged and emits an end_block_step_failed event, its writes are discarded and the next
+// steps run. Only the store corruption errors, the values the collections cannot decode, are
+// returned and halt the chain.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	steps := []endBlockStep{
+		{"release_mature_locks", k.ReleaseMatureLocks},
+		{"clear_batch_auction", k.ClearBatchAuction},
+		{"match_limit_orders", k.MatchLimitOrders},
+		{"execute_dca_plans", k.ExecuteDCAPlans},
+		{"prune_pool_stats", k.PrunePoolStats},
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if params.IncentivesEpochBlocks > 0 && sdkCtx.BlockHeight()%params.IncentivesEpochBlocks == 0 {
+		steps = append(steps, endBlockStep{"distribute_gauges", k.DistributeGauges})
+	}
+
+	for _, step := range steps {
+		cacheCtx, write := sdkCtx.CacheContext()
+		err := step.run(cacheCtx)
+		if errors.Is(err, collections.ErrEncoding) {
+			return err
+		}
+
+		if err != nil {
+			sdkCtx.Logger().Error("simpleswap end block step failed", "step", step.name, "err", err)
+			sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+				simpleswap.EventTypeEndBlockStepFailed,
+				sdk.NewAttribute(simpleswap.AttributeKeyStep, step.name),
+				sdk.NewAttribute(simpleswap.AttributeKeyReason, err.Error()),
+			))
+			continue
+		}
+
+		write()
+	}
+
+	return nil
+}
@@ -0,0 +1,187 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	types "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/simpleswap"
+	"github.com/golang/mock/gomock"
+)
+
+func (s *KeeperTestSuite) setLiquidityProvider(lp types.AccAddress, shares int64) {
+	share := types.NewCoin(simpleswap.PoolShareDenom(simpleswap.DefaultPoolId), math.NewInt(shares))
+	s.Require().NoError(s.simpleSwapKeeper.LiquidityProviders.Set(s.ctx, lp.String(), simpleswap.LiquidityProvider{
+		StableCoin: &types.Coin{Denom: "ETH", Amount: math.NewInt(shares)},
+		PoolShare:  &share,
+	}))
+}
+
+// lockShares locks shares pool shares of the owner for the duration, escrowing them.
+func (s *KeeperTestSuite) lockShares(owner types.AccAddress, shares int64, duration time.Duration) uint64 {
+	share := types.NewInt64Coin(simpleswap.PoolShareDenom(simpleswap.DefaultPoolId), shares)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, owner, simpleswap.ModuleName, types.NewCoins(share)).Return(nil).Times(1)
+	resp, err := s.msgServer.LockShares(s.ctx, &simpleswap.MsgLockShares{
+		Owner:    owner.String(),
+		Shares:   share,
+		Duration: duration,
+	})
+	s.Require().NoError(err)
+
+	return resp.LockId
+}
+
+func (s *KeeperTestSuite) TestGaugeIncentives() {
+	require := s.Require()
+	owner, lp1, lp2 := s.addrs[0], s.addrs[1], s.addrs[2]
+
+	s.initGenesis(simpleswap.DefaultParams())
+	s.setLiquidityProvider(lp1, 100)
+	s.setLiquidityProvider(lp2, 300)
+	s.setLiquidityProvider(owner, 1000)
+	s.lockShares(lp1, 100, 24*time.Hour)
+	s.lockShares(lp2, 300, 24*time.Hour)
+
+	// Invalid gauges are rejected before any funds are moved
+	_, err := s.msgServer.CreateGauge(s.ctx, &simpleswap.MsgCreateGauge{
+		Owner:     owner.String(),
+		PoolId:    2,
+		Coins:     types.NewCoins(types.NewInt64Coin("REWARD", 1000)),
+		NumEpochs: 2,
+	})
+	require.ErrorIs(err, simpleswap.ErrInvalidPoolId)
+
+	_, err = s.msgServer.CreateGauge(s.ctx, &simpleswap.MsgCreateGauge{
+		Owner:  owner.String(),
+		PoolId: simpleswap.DefaultPoolId,
+		Coins:  types.NewCoins(types.NewInt64Coin("REWARD", 1000)),
+	})
+	require.ErrorIs(err, simpleswap.ErrInvalidNumEpochs)
+
+	rewards := types.NewCoins(types.NewInt64Coin("REWARD", 1000))
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, owner, simpleswap.ModuleName, rewards).Return(nil).Times(2)
+
+	resp, err := s.msgServer.CreateGauge(s.ctx, &simpleswap.MsgCreateGauge{
+		Owner:     owner.String(),
+		PoolId:    simpleswap.DefaultPoolId,
+		Coins:     rewards,
+		NumEpochs: 2,
+	})
+	require.NoError(err)
+	require.Equal(uint64(0), resp.GaugeId)
+
+	_, err = s.msgServer.AddToGauge(s.ctx, &simpleswap.MsgAddToGauge{
+		Owner:   owner.String(),
+		GaugeId: resp.GaugeId,
+		Coins:   rewards,
+	})
+	require.NoError(err)
+
+	_, err = s.msgServer.AddToGauge(s.ctx, &simpleswap.MsgAddToGauge{
+		Owner:   owner.String(),
+		GaugeId: 7,
+		Coins:   rewards,
+	})
+	require.ErrorIs(err, simpleswap.ErrGaugeNotFound)
+
+	// No distribution outside of the epoch boundaries
+	params := simpleswap.DefaultParams()
+	require.NoError(s.simpleSwapKeeper.EndBlocker(s.ctx.WithBlockHeight(params.IncentivesEpochBlocks + 1)))
+	pending, err := s.queryClient.PendingRewards(s.ctx, &simpleswap.QueryPendingRewardsRequest{LpAddress: lp1.String()})
+	require.NoError(err)
+	require.True(pending.Rewards.IsZero())
+
+	// First epoch distributes half of the gauge pro rata to the locked shares, the shares not
+	// locked earn nothing
+	require.NoError(s.simpleSwapKeeper.EndBlocker(s.ctx.WithBlockHeight(params.IncentivesEpochBlocks)))
+	pending, err = s.queryClient.PendingRewards(s.ctx, &simpleswap.QueryPendingRewardsRequest{LpAddress: lp1.String()})
+	require.NoError(err)
+	require.Equal(types.NewCoins(types.NewInt64Coin("REWARD", 250)), pending.Rewards)
+	pending, err = s.queryClient.PendingRewards(s.ctx, &simpleswap.QueryPendingRewardsRequest{LpAddress: lp2.String()})
+	require.NoError(err)
+	require.Equal(types.NewCoins(types.NewInt64Coin("REWARD", 750)), pending.Rewards)
+	pending, err = s.queryClient.PendingRewards(s.ctx, &simpleswap.QueryPendingRewardsRequest{LpAddress: owner.String()})
+	require.NoError(err)
+	require.True(pending.Rewards.IsZero())
+
+	// Second epoch distributes the rest and finishes the gauge
+	require.NoError(s.simpleSwapKeeper.EndBlocker(s.ctx.WithBlockHeight(2 * params.IncentivesEpochBlocks)))
+	gauge, err := s.queryClient.Gauge(s.ctx, &simpleswap.QueryGaugeRequest{GaugeId: resp.GaugeId})
+	require.NoError(err)
+	require.Equal(uint64(2), gauge.Gauge.FilledEpochs)
+	require.Equal(gauge.Gauge.Coins, gauge.Gauge.DistributedCoins)
+
+	_, err = s.msgServer.AddToGauge(s.ctx, &simpleswap.MsgAddToGauge{
+		Owner:   owner.String(),
+		GaugeId: resp.GaugeId,
+		Coins:   rewards,
+	})
+	require.ErrorIs(err, simpleswap.ErrGaugeFinished)
+
+	gauges, err := s.queryClient.Gauges(s.ctx, &simpleswap.QueryGaugesRequest{})
+	require.NoError(err)
+	require.Len(gauges.Gauges, 1)
+
+	// Claiming pays out and clears the pending rewards
+	claimed := types.NewCoins(types.NewInt64Coin("REWARD", 500))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(s.ctx, simpleswap.ModuleName, lp1, claimed).Return(nil).Times(1)
+	claim, err := s.msgServer.ClaimRewards(s.ctx, &simpleswap.MsgClaimRewards{Owner: lp1.String()})
+	require.NoError(err)
+	require.Equal(claimed, claim.Rewards)
+
+	_, err = s.msgServer.ClaimRewards(s.ctx, &simpleswap.MsgClaimRewards{Owner: lp1.String()})
+	require.ErrorIs(err, simpleswap.ErrNoRewards)
+}
+
+func (s *KeeperTestSuite) TestEndBlockerStepFailure() {
+	require := s.Require()
+	owner := s.addrs[1]
+
+	s.initGenesis(simpleswap.DefaultParams())
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	ctx := s.ctx.WithBlockHeight(1).WithBlockTime(now).WithEventManager(types.NewEventManager())
+
+	// The shares of the mature lock cannot be paid out, the release of the locks fails
+	shares := types.NewInt64Coin(simpleswap.PoolShareDenom(simpleswap.DefaultPoolId), 100)
+	lock := simpleswap.PeriodLock{Id: 0, Owner: owner.String(), Shares: shares, Duration: time.Hour, EndTime: now}
+	require.NoError(s.simpleSwapKeeper.Locks.Set(ctx, lock.Id, lock))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), simpleswap.ModuleName, owner, types.NewCoins(shares)).Return(errors.New("module account underfunded")).Times(1)
+
+	// An expired pool stats bucket, pruned by a later step
+	expired := now.Add(-365 * 24 * time.Hour)
+	bucketKey := collections.Join3(expired, "ETH", "WETH")
+	require.NoError(s.simpleSwapKeeper.PoolStats.Set(ctx, bucketKey, simpleswap.PoolStatsBucket{
+		StartTime:   expired,
+		InputDenom:  "ETH",
+		OutputDenom: "WETH",
+		Volume:      math.NewInt(1000),
+		Fees:        math.NewInt(3),
+	}))
+
+	// The failure is reported and its writes discarded, the next steps still run
+	require.NoError(s.simpleSwapKeeper.EndBlocker(ctx))
+	_, err := s.simpleSwapKeeper.Locks.Get(ctx, lock.Id)
+	require.NoError(err)
+	has, err := s.simpleSwapKeeper.PoolStats.Has(ctx, bucketKey)
+	require.NoError(err)
+	require.False(has)
+
+	var failed []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != simpleswap.EventTypeEndBlockStepFailed {
+			continue
+		}
+		step, ok := event.GetAttribute(simpleswap.AttributeKeyStep)
+		require.True(ok)
+		failed = append(failed, step.Value)
+	}
+	require.Equal([]string{"release_mature_locks"}, failed)
+
+	// A lock the store cannot decode is a corruption, it halts the chain
+	lockKey, err := collections.EncodeKeyWithPrefix(simpleswap.LocksKey, collections.Uint64Key, lock.Id)
+	require.NoError(err)
+	ctx.KVStore(s.storeKey).Set(lockKey, []byte{0xff})
+	require.ErrorIs(s.simpleSwapKeeper.EndBlocker(ctx), collections.ErrEncoding)
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/codec"
+	"cosmossdk.io/collections/indexes"
+)
+
+// PaginatedMulti indexes the primary keys of a map by a reference key of their values, like an
+// indexes.Multi, and exposes its keys to query.CollectionPaginate, so that the values of a
+// reference key are paged over the index prefix instead of filtered out of the whole map.
+type PaginatedMulti[ReferenceKey, PrimaryKey, Value any] struct {
+	refKeys   collections.KeySet[collections.Pair[ReferenceKey, PrimaryKey]]
+	getRefKey func(pk PrimaryKey, value Value) (ReferenceKey, error)
+}
+
+// NewPaginatedMulti creates a paginated multi index under the prefix.
+func NewPaginatedMulti[ReferenceKey, PrimaryKey, Value any](
+	sb *collections.SchemaBuilder,
+	prefix collections.Prefix,
+	name string,
+	refCodec codec.KeyCodec[ReferenceKey],
+	pkCodec codec.KeyCodec[PrimaryKey],
+	getRefKey func(pk PrimaryKey, value Value) (ReferenceKey, error),
+) *PaginatedMulti[ReferenceKey, PrimaryKey, Value] {
+	return &PaginatedMulti[ReferenceKey, PrimaryKey, Value]{
+		refKeys:   collections.NewKeySet(sb, prefix, name, collections.PairKeyCodec(refCodec, pkCodec)),
+		getRefKey: getRefKey,
+	}
+}
+
+// Reference implements collections.Index, the previous reference of the value is replaced.
+func (m *PaginatedMulti[ReferenceKey, PrimaryKey, Value]) Reference(ctx context.Context, pk PrimaryKey, newValue Value, lazyOldValue func() (Value, error)) error {
+	oldValue, err := lazyOldValue()
+	switch {
+	case err == nil:
+		if err := m.unreference(ctx, pk, oldValue); err != nil {
+			return err
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	refKey, err := m.getRefKey(pk, newValue)
+	if err != nil {
+		return err
+	}
+
+	return m.refKeys.Set(ctx, collections.Join(refKey, pk))
+}
+
+// Unreference implements collections.Index.
+func (m *PaginatedMulti[ReferenceKey, PrimaryKey, Value]) Unreference(ctx context.Context, pk PrimaryKey, getValue func() (Value, error)) error {
+	value, err := getValue()
+	if err != nil {
+		return err
+	}
+
+	return m.unreference(ctx, pk, value)
+}
+
+func (m *PaginatedMulti[ReferenceKey, PrimaryKey, Value]) unreference(ctx context.Context, pk PrimaryKey, value Value) error {
+	refKey, err := m.getRefKey(pk, value)
+	if err != nil {
+		return err
+	}
+
+	return m.refKeys.Remove(ctx, collections.Join(refKey, pk))
+}
+
+// MatchExact returns an iterator over the primary keys of the reference key.
+func (m *PaginatedMulti[ReferenceKey, PrimaryKey, Value]) MatchExact(ctx context.Context, refKey ReferenceKey) (indexes.MultiIterator[ReferenceKey, PrimaryKey], error) {
+	iter, err := m.refKeys.Iterate(ctx, collections.NewPrefixedPairRange[ReferenceKey, PrimaryKey](refKey))
+	return (indexes.MultiIterator[ReferenceKey, PrimaryKey])(iter), err
+}
+
+// IterateRaw iterates over the raw keys of the index, for query.CollectionPaginate.
+func (m *PaginatedMulti[ReferenceKey, PrimaryKey, Value]) IterateRaw(ctx context.Context, start, end []byte, order collections.Order) (collections.Iterator[collections.Pair[ReferenceKey, PrimaryKey], collections.NoValue], error) {
+	return m.refKeys.IterateRaw(ctx, start, end, order)
+}
+
+// KeyCodec returns the key codec of the index, for query.CollectionPaginate.
+func (m *PaginatedMulti[ReferenceKey, PrimaryKey, Value]) KeyCodec() codec.KeyCodec[collections.Pair[ReferenceKey, PrimaryKey]] {
+	return m.refKeys.KeyCodec()
+}
@@ -1,11 +1,15 @@
 package keeper
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	storetypes "cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	types "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/simpleswap"
@@ -13,8 +17,9 @@ import (
 )
 
 type Keeper struct {
-	cdc          codec.BinaryCodec
+	cdc          codec.Codec
 	addressCodec address.Codec
+	storeService storetypes.KVStoreService
 
 	// authority is the address capable of executing a MsgUpdateParams and other authority-gated message.
 	// typically, this should be the x/gov module account.
@@ -24,13 +29,45 @@ type Keeper struct {
 	Schema             collections.Schema
 	Params             collections.Item[simpleswap.Params]
 	Pool               collections.Item[simpleswap.Pool]
-	LiquidityProviders collections.Map[string, simpleswap.LiquidityProvider]
+	LiquidityProviders *collections.IndexedMap[string, simpleswap.LiquidityProvider, LiquidityProvidersIndexes]
 	CoinsReserve       collections.Map[string, types.Coin]
+	Gauges             collections.Map[uint64, simpleswap.Gauge]
+	GaugeSequence      collections.Sequence
+	Rewards            collections.Map[string, simpleswap.Rewards]
+	RewardAccumulator  collections.Item[simpleswap.RewardAccumulator]
+	Locks              *collections.IndexedMap[uint64, simpleswap.PeriodLock, LocksIndexes]
+	LockSequence       collections.Sequence
+	AssetRates         collections.Map[string, simpleswap.AssetRate]
+	LimitOrders        *collections.IndexedMap[uint64, simpleswap.LimitOrder, LimitOrdersIndexes]
+	LimitOrderSequence collections.Sequence
+	LimitOrderCursor   collections.Item[uint64]
+	DCAPlans           *collections.IndexedMap[uint64, simpleswap.DCAPlan, DCAPlansIndexes]
+	DCAPlanSequence    collections.Sequence
+	BatchSwaps         collections.Map[uint64, simpleswap.BatchSwap]
+	BatchSwapSequence  collections.Sequence
+	PoolStats          collections.Map[collections.Triple[time.Time, string, string], simpleswap.PoolStatsBucket]
 	BankKeeper         expectedkeepers.BankKeeper
+
+	// OracleKeeper is an optional price oracle used to guard swaps against depegs.
+	OracleKeeper expectedkeepers.OracleKeeper
+
+	hooks simpleswap.SimpleSwapHooks
+
+	// rateProviders are the rate providers set by other modules, by denom. The coins
+	// without one use the governance rate provider.
+	rateProviders map[string]simpleswap.RateProvider
+
+	// transferKeeper is the optional IBC transfer keeper. It is set once the IBC keepers
+	// are created, after the dependency injection, the servers share the keeper to see it.
+	transferKeeper expectedkeepers.TransferKeeper
+
+	// router routes the messages nested in a flash swap, flash swaps only send the output
+	// without it.
+	router baseapp.MessageRouter
 }
 
 // NewKeeper creates a new Keeper instance
-func NewKeeper(cdc codec.BinaryCodec, addressCodec address.Codec, storeService storetypes.KVStoreService, bankKeeper expectedkeepers.BankKeeper, authority string) Keeper {
+func NewKeeper(cdc codec.Codec, addressCodec address.Codec, storeService storetypes.KVStoreService, bankKeeper expectedkeepers.BankKeeper, authority string) Keeper {
 	if _, err := addressCodec.StringToBytes(authority); err != nil {
 		panic(fmt.Errorf("invalid authority address: %w", err))
 	}
@@ -39,12 +76,29 @@ func NewKeeper(cdc codec.BinaryCodec, addressCodec address.Codec, storeService s
 	k := Keeper{
 		cdc:                cdc,
 		addressCodec:       addressCodec,
+		storeService:       storeService,
 		authority:          authority,
 		Params:             collections.NewItem(sb, simpleswap.ParamsKey, "params", codec.CollValue[simpleswap.Params](cdc)),
 		Pool:               collections.NewItem(sb, simpleswap.PoolKey, "pool", codec.CollValue[simpleswap.Pool](cdc)),
-		LiquidityProviders: collections.NewMap(sb, simpleswap.LiquidityProvidersKey, "liquidity_providers", collections.StringKey, codec.CollValue[simpleswap.LiquidityProvider](cdc)),
+		LiquidityProviders: collections.NewIndexedMap(sb, simpleswap.LiquidityProvidersKey, "liquidity_providers", collections.StringKey, codec.CollValue[simpleswap.LiquidityProvider](cdc), NewLiquidityProvidersIndexes(sb)),
 		CoinsReserve:       collections.NewMap(sb, simpleswap.CoinsReserveKey, "coins_reserve", collections.StringKey, codec.CollValue[types.Coin](cdc)),
+		Gauges:             collections.NewMap(sb, simpleswap.GaugesKey, "gauges", collections.Uint64Key, codec.CollValue[simpleswap.Gauge](cdc)),
+		GaugeSequence:      collections.NewSequence(sb, simpleswap.GaugeSequenceKey, "gauge_sequence"),
+		Rewards:            collections.NewMap(sb, simpleswap.RewardsKey, "rewards", collections.StringKey, codec.CollValue[simpleswap.Rewards](cdc)),
+		RewardAccumulator:  collections.NewItem(sb, simpleswap.RewardAccumulatorKey, "reward_accumulator", codec.CollValue[simpleswap.RewardAccumulator](cdc)),
+		Locks:              collections.NewIndexedMap(sb, simpleswap.LocksKey, "locks", collections.Uint64Key, codec.CollValue[simpleswap.PeriodLock](cdc), NewLocksIndexes(sb)),
+		LockSequence:       collections.NewSequence(sb, simpleswap.LockSequenceKey, "lock_sequence"),
+		AssetRates:         collections.NewMap(sb, simpleswap.AssetRatesKey, "asset_rates", collections.StringKey, codec.CollValue[simpleswap.AssetRate](cdc)),
+		LimitOrders:        collections.NewIndexedMap(sb, simpleswap.LimitOrdersKey, "limit_orders", collections.Uint64Key, codec.CollValue[simpleswap.LimitOrder](cdc), NewLimitOrdersIndexes(sb)),
+		LimitOrderSequence: collections.NewSequence(sb, simpleswap.LimitOrderSequenceKey, "limit_order_sequence"),
+		LimitOrderCursor:   collections.NewItem(sb, simpleswap.LimitOrderCursorKey, "limit_order_cursor", collections.Uint64Value),
+		DCAPlans:           collections.NewIndexedMap(sb, simpleswap.DCAPlansKey, "dca_plans", collections.Uint64Key, codec.CollValue[simpleswap.DCAPlan](cdc), NewDCAPlansIndexes(sb)),
+		DCAPlanSequence:    collections.NewSequence(sb, simpleswap.DCAPlanSequenceKey, "dca_plan_sequence"),
+		BatchSwaps:         collections.NewMap(sb, simpleswap.BatchSwapsKey, "batch_swaps", collections.Uint64Key, codec.CollValue[simpleswap.BatchSwap](cdc)),
+		BatchSwapSequence:  collections.NewSequence(sb, simpleswap.BatchSwapSequenceKey, "batch_swap_sequence"),
+		PoolStats:          collections.NewMap(sb, simpleswap.PoolStatsKey, "pool_stats", collections.TripleKeyCodec(types.TimeKey, collections.StringKey, collections.StringKey), codec.CollValue[simpleswap.PoolStatsBucket](cdc)),
 		BankKeeper:         bankKeeper,
+		rateProviders:      make(map[string]simpleswap.RateProvider),
 	}
 
 	schema, err := sb.Build()
@@ -61,3 +115,60 @@ func NewKeeper(cdc codec.BinaryCodec, addressCodec address.Codec, storeService s
 func (k Keeper) GetAuthority() string {
 	return k.authority
 }
+
+// SetOracleKeeper sets the optional price oracle used by the swap price guard.
+func (k *Keeper) SetOracleKeeper(oracleKeeper expectedkeepers.OracleKeeper) {
+	k.OracleKeeper = oracleKeeper
+}
+
+// SetTransferKeeper sets the optional IBC transfer keeper used to show the origin of the IBC denoms.
+func (k *Keeper) SetTransferKeeper(transferKeeper expectedkeepers.TransferKeeper) {
+	k.transferKeeper = transferKeeper
+}
+
+// SetRouter sets the message router executing the messages nested in a flash swap.
+func (k *Keeper) SetRouter(router baseapp.MessageRouter) {
+	k.router = router
+}
+
+// Hooks returns the registered simpleswap hooks, a no-op implementation is returned when none are set.
+func (k Keeper) Hooks() simpleswap.SimpleSwapHooks {
+	if k.hooks == nil {
+		return simpleswap.MultiSimpleSwapHooks{}
+	}
+
+	return k.hooks
+}
+
+// SetHooks sets the simpleswap hooks, it panics if hooks are already set.
+func (k *Keeper) SetHooks(sh simpleswap.SimpleSwapHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set simpleswap hooks twice")
+	}
+
+	k.hooks = sh
+
+	return k
+}
+
+// SetRateProvider sets the rate provider of a denom, it panics if the denom already has one.
+func (k *Keeper) SetRateProvider(denom string, provider simpleswap.RateProvider) *Keeper {
+	denom = simpleswap.ResolveDenom(denom)
+	if _, ok := k.rateProviders[denom]; ok {
+		panic(fmt.Sprintf("cannot set the rate provider of %s twice", denom))
+	}
+
+	k.rateProviders[denom] = provider
+
+	return k
+}
+
+// initializedPool returns the pool, ErrPoolNotInitialized if it is not set.
+func (k Keeper) initializedPool(ctx context.Context) (simpleswap.Pool, error) {
+	pool, err := k.Pool.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return simpleswap.Pool{}, simpleswap.ErrPoolNotInitialized
+	}
+
+	return pool, err
+}
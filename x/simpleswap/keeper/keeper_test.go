@@ -1,8 +1,10 @@
 package keeper_test
 
 import (
+	"context"
 	"testing"
 
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	cmttime "github.com/cometbft/cometbft/types/time"
@@ -13,6 +15,7 @@ import (
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/simpleswap"
 	expectedkeepers "github.com/cosmos/simpleswap/expected_keepers"
 	simpleswapKeeper "github.com/cosmos/simpleswap/keeper"
@@ -24,8 +27,10 @@ type KeeperTestSuite struct {
 	suite.Suite
 
 	ctx              sdk.Context
+	storeKey         *storetypes.KVStoreKey
 	simpleSwapKeeper simpleswapKeeper.Keeper
 	bankKeeper       *expectedkeepers.MockBankKeeper
+	oracleKeeper     *expectedkeepers.MockOracleKeeper
 	msgServer        simpleswap.MsgServer
 	queryClient      simpleswap.QueryClient
 
@@ -46,17 +51,101 @@ func (s *KeeperTestSuite) SetupTest() {
 	ctrl := gomock.NewController(s.T())
 	defer ctrl.Finish()
 	bankKeeper := expectedkeepers.NewMockBankKeeper(ctrl)
+	oracleKeeper := expectedkeepers.NewMockOracleKeeper(ctrl)
 	k := simpleswapKeeper.NewKeeper(encCfg.Codec, addresscodec.NewBech32Codec("cosmos"), storeService, bankKeeper, addrs[0].String())
+	k.SetOracleKeeper(oracleKeeper)
+	// No denom has bank metadata unless a test declares it
+	bankKeeper.EXPECT().GetDenomMetaData(gomock.Any(), gomock.Any()).Return(banktypes.Metadata{}, false).AnyTimes()
 	k.Params.Set(ctx, simpleswap.DefaultParams())
 
 	
 	s.ctx = ctx
+	s.storeKey = key
 	s.bankKeeper = bankKeeper
+	s.oracleKeeper = oracleKeeper
 	s.simpleSwapKeeper = k
 	simpleswap.RegisterInterfaces(encCfg.InterfaceRegistry)
 	s.addrs = addrs
 	queryHelper := baseapp.NewQueryServerTestHelper(ctx, encCfg.InterfaceRegistry)
-	simpleswap.RegisterQueryServer(queryHelper, simpleswapKeeper.NewQueryServerImpl(k))
+	simpleswap.RegisterQueryServer(queryHelper, simpleswapKeeper.NewQueryServerImpl(&s.simpleSwapKeeper))
 	s.queryClient = simpleswap.NewQueryClient(queryHelper)
-	s.msgServer = simpleswapKeeper.NewMsgServerImpl(k)
+	s.msgServer = simpleswapKeeper.NewMsgServerImpl(&s.simpleSwapKeeper)
+}
+
+// initGenesis initializes the pool with the given params, registering the share token metadata.
+func (s *KeeperTestSuite) initGenesis(params simpleswap.Params) {
+	s.bankKeeper.EXPECT().SetDenomMetaData(s.ctx, simpleswap.PoolShareDenomMetadata(simpleswap.DefaultPoolId, uint32(params.Decimals))).Times(1)
+	s.Require().NoError(s.simpleSwapKeeper.InitGenesis(s.ctx, &simpleswap.GenesisState{Params: params}))
+}
+
+func (s *KeeperTestSuite) TestPoolShareDenomMetadata() {
+	require := s.Require()
+
+	metadata := simpleswap.PoolShareDenomMetadata(simpleswap.DefaultPoolId, 6)
+	require.NoError(metadata.Validate())
+	require.Equal("simpleswap/pool/1", metadata.Base)
+	require.Equal(uint32(6), metadata.DenomUnits[1].Exponent)
+
+	s.initGenesis(simpleswap.DefaultParams())
+	pool, err := s.simpleSwapKeeper.Pool.Get(s.ctx)
+	require.NoError(err)
+	require.Equal(sdk.NewCoin("simpleswap/pool/1", math.ZeroInt()), *pool.ShareToken)
+}
+
+// mockHooks records the calls made to the simpleswap hooks.
+type mockHooks struct {
+	poolsCreated []uint64
+	swaps        []sdk.Coin
+}
+
+var _ simpleswap.SimpleSwapHooks = &mockHooks{}
+
+func (h *mockHooks) AfterPoolCreated(_ context.Context, poolId uint64) error {
+	h.poolsCreated = append(h.poolsCreated, poolId)
+	return nil
+}
+
+func (h *mockHooks) AfterJoinPool(context.Context, sdk.AccAddress, uint64, sdk.Coin, sdk.Coin) error {
+	return nil
+}
+
+func (h *mockHooks) AfterExitPool(context.Context, sdk.AccAddress, uint64, sdk.Coin, sdk.Coin) error {
+	return nil
+}
+
+func (h *mockHooks) AfterSwap(_ context.Context, _ sdk.AccAddress, _ uint64, _, output sdk.Coin) error {
+	h.swaps = append(h.swaps, output)
+	return nil
+}
+
+func (s *KeeperTestSuite) TestHooks() {
+	require := s.Require()
+
+	first, second := &mockHooks{}, &mockHooks{}
+	s.simpleSwapKeeper.SetHooks(simpleswap.NewMultiSimpleSwapHooks(first, second))
+	require.Panics(func() { s.simpleSwapKeeper.SetHooks(&mockHooks{}) })
+
+	s.initGenesis(simpleswap.DefaultParams())
+	require.Equal([]uint64{simpleswap.DefaultPoolId}, first.poolsCreated)
+	require.Equal([]uint64{simpleswap.DefaultPoolId}, second.poolsCreated)
+
+	trader := s.addrs[1]
+	coin := sdk.NewCoin("WETH", math.NewInt(10))
+	require.NoError(s.simpleSwapKeeper.CoinsReserve.Set(s.ctx, "WETH", coin))
+	s.bankKeeper.EXPECT().SpendableCoin(s.ctx, trader, "ETH").Return(sdk.NewCoin("ETH", math.NewInt(10))).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, trader, simpleswap.ModuleName, gomock.Any()).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(s.ctx, simpleswap.ModuleName, trader, gomock.Any()).Return(nil).Times(1)
+
+	msgServer := simpleswapKeeper.NewMsgServerImpl(&s.simpleSwapKeeper)
+	_, err := msgServer.SwapLiquidity(s.ctx, &simpleswap.MsgSwapLiquidity{
+		Trader: trader.String(),
+		Input:  sdk.NewCoin("ETH", math.NewInt(10)),
+		Output: coin,
+	})
+	require.NoError(err)
+
+	// The hooks receive the output paid after the swap fee, rounded up in favour of the pool
+	paid := sdk.NewCoin("WETH", math.NewInt(9))
+	require.Equal([]sdk.Coin{paid}, first.swaps)
+	require.Equal([]sdk.Coin{paid}, second.swaps)
 }
@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/simpleswap"
+)
+
+// LimitOrdersIndexes are the indexes of the limit orders.
+type LimitOrdersIndexes struct {
+	// Owner indexes the ids of the orders by their owner.
+	Owner *PaginatedMulti[string, uint64, simpleswap.LimitOrder]
+
+	// Pair indexes the ids of the orders by their input denom and output denom.
+	Pair *PaginatedMulti[collections.Pair[string, string], uint64, simpleswap.LimitOrder]
+}
+
+// NewLimitOrdersIndexes creates the indexes of the limit orders.
+func NewLimitOrdersIndexes(sb *collections.SchemaBuilder) LimitOrdersIndexes {
+	return LimitOrdersIndexes{
+		Owner: NewPaginatedMulti(sb, simpleswap.LimitOrdersByOwnerKey, "limit_orders_by_owner", collections.StringKey, collections.Uint64Key, LimitOrderOwner),
+		Pair:  NewPaginatedMulti(sb, simpleswap.LimitOrdersByPairKey, "limit_orders_by_pair", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.Uint64Key, LimitOrderPair),
+	}
+}
+
+// IndexesList implements collections.Indexes.
+func (i LimitOrdersIndexes) IndexesList() []collections.Index[uint64, simpleswap.LimitOrder] {
+	return []collections.Index[uint64, simpleswap.LimitOrder]{i.Owner, i.Pair}
+}
+
+// LimitOrderOwner returns the owner of the order, by which it is indexed.
+func LimitOrderOwner(_ uint64, order simpleswap.LimitOrder) (string, error) {
+	return order.Owner, nil
+}
+
+// LimitOrderPair returns the input denom and the output denom of the order, by which it is indexed.
+func LimitOrderPair(_ uint64, order simpleswap.LimitOrder) (collections.Pair[string, string], error) {
+	return collections.Join(order.Input.Denom, order.OutputDenom), nil
+}
+
+// MatchLimitOrders fills, in the order of their ids, the open limit orders the pool pays at
+// least the limit price for. An order is filled as a whole by a swap of its escrowed input for
+// the owner, the orders which cannot be filled rest until a later block or their cancellation.
+// At most LimitOrderMatchesPerBlock orders are tried per block, starting after the last order
+// tried in the previous block and wrapping around to the first ids, so that the resting orders
+// cannot delay the others indefinitely.
+func (k Keeper) MatchLimitOrders(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	limit := int(params.LimitOrderMatches())
+	var orders []simpleswap.LimitOrder
+	collect := func(ranger collections.Ranger[uint64]) error {
+		return k.LimitOrders.Walk(ctx, ranger, func(_ uint64, order simpleswap.LimitOrder) (bool, error) {
+			if len(orders) == limit {
+				return true, nil
+			}
+
+			orders = append(orders, order)
+			return false, nil
+		})
+	}
+
+	// Resume after the last order tried, then wrap around to the first ids
+	cursor, err := k.LimitOrderCursor.Get(ctx)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		err = collect(nil)
+	case err == nil:
+		if err = collect(new(collections.Range[uint64]).StartExclusive(cursor)); err == nil {
+			err = collect(new(collections.Range[uint64]).EndInclusive(cursor))
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(orders) == 0 {
+		return nil
+	}
+
+	for _, order := range orders {
+		if err := k.fillLimitOrder(sdk.UnwrapSDKContext(ctx), order); err != nil {
+			return err
+		}
+	}
+
+	return k.LimitOrderCursor.Set(ctx, orders[len(orders)-1].Id)
+}
+
+// fillLimitOrder fills the order if the pool pays at least its limit price.
+func (k Keeper) fillLimitOrder(ctx sdk.Context, order simpleswap.LimitOrder) error {
+	output, err := k.swapEscrowed(ctx, order.Owner, order.Input, order.OutputDenom, LimitOrderMinOutput(order))
+	if err != nil {
+		// The pool does not pay the limit price, the order rests
+		return nil
+	}
+
+	if err := k.LimitOrders.Remove(ctx, order.Id); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		simpleswap.EventTypeLimitOrderFilled,
+		sdk.NewAttribute(simpleswap.AttributeKeyOrderId, strconv.FormatUint(order.Id, 10)),
+		sdk.NewAttribute(simpleswap.AttributeKeyOwner, order.Owner),
+		sdk.NewAttribute(simpleswap.AttributeKeyInput, order.Input.String()),
+		sdk.NewAttribute(simpleswap.AttributeKeyOutput, output.String()),
+	))
+
+	return nil
+}
+
+// LimitOrderMinOutput returns the lowest output filling the order, i.e. its input at the limit
+// price rounded up.
+func LimitOrderMinOutput(order simpleswap.LimitOrder) math.Int {
+	return math.LegacyNewDecFromInt(order.Input.Amount).Mul(order.LimitPrice).Ceil().TruncateInt()
+}
@@ -0,0 +1,323 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	types "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/simpleswap"
+	"github.com/golang/mock/gomock"
+)
+
+func (s *KeeperTestSuite) TestPlaceLimitOrder() {
+	owner := s.addrs[1]
+
+	testCases := []struct {
+		name        string
+		input       types.Coin
+		outputDenom string
+		limitPrice  math.LegacyDec
+		expectErr   error
+	}{
+		{
+			name:        "input not whitelisted",
+			input:       types.NewInt64Coin("BTC", 1000),
+			outputDenom: "ETH",
+			limitPrice:  math.LegacyOneDec(),
+			expectErr:   simpleswap.ErrCoinInvalid,
+		},
+		{
+			name:        "zero input",
+			input:       types.NewInt64Coin("WETH", 0),
+			outputDenom: "ETH",
+			limitPrice:  math.LegacyOneDec(),
+			expectErr:   simpleswap.ErrCoinInvalid,
+		},
+		{
+			name:        "output not whitelisted",
+			input:       types.NewInt64Coin("WETH", 1000),
+			outputDenom: "BTC",
+			limitPrice:  math.LegacyOneDec(),
+			expectErr:   simpleswap.ErrCoinInvalid,
+		},
+		{
+			name:        "output is the input",
+			input:       types.NewInt64Coin("WETH", 1000),
+			outputDenom: "WETH",
+			limitPrice:  math.LegacyOneDec(),
+			expectErr:   simpleswap.ErrCoinInvalid,
+		},
+		{
+			name:        "non positive limit price",
+			input:       types.NewInt64Coin("WETH", 1000),
+			outputDenom: "ETH",
+			limitPrice:  math.LegacyZeroDec(),
+			expectErr:   simpleswap.ErrInvalidLimitPrice,
+		},
+		{
+			name:        "valid order",
+			input:       types.NewInt64Coin("WETH", 1000),
+			outputDenom: "ETH",
+			limitPrice:  math.LegacyMustNewDecFromStr("0.999"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			require := s.Require()
+			s.initGenesis(simpleswap.DefaultParams())
+
+			if tc.expectErr == nil {
+				s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, owner, simpleswap.ModuleName, types.NewCoins(tc.input)).Return(nil).Times(1)
+			}
+
+			resp, err := s.msgServer.PlaceLimitOrder(s.ctx, &simpleswap.MsgPlaceLimitOrder{
+				Owner:       owner.String(),
+				Input:       tc.input,
+				OutputDenom: tc.outputDenom,
+				LimitPrice:  tc.limitPrice,
+			})
+			if tc.expectErr != nil {
+				require.ErrorIs(err, tc.expectErr)
+				return
+			}
+			require.NoError(err)
+
+			order, err := s.simpleSwapKeeper.LimitOrders.Get(s.ctx, resp.OrderId)
+			require.NoError(err)
+			require.Equal(simpleswap.LimitOrder{
+				Id:          resp.OrderId,
+				Owner:       owner.String(),
+				Input:       tc.input,
+				OutputDenom: tc.outputDenom,
+				LimitPrice:  tc.limitPrice,
+			}, order)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMatchLimitOrders() {
+	require := s.Require()
+	seller, buyer := s.addrs[1], s.addrs[2]
+
+	s.initGenesis(simpleswap.DefaultParams())
+	require.NoError(s.simpleSwapKeeper.CoinsReserve.Set(s.ctx, "ETH", types.NewInt64Coin("ETH", 10_000)))
+	require.NoError(s.simpleSwapKeeper.CoinsReserve.Set(s.ctx, "WETH", types.NewInt64Coin("WETH", 10_000)))
+
+	// The seller asks for 1 ETH per WETH, which the pool does not pay net of the swap fee
+	weth := types.NewInt64Coin("WETH", 1000)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, seller, simpleswap.ModuleName, types.NewCoins(weth)).Return(nil).Times(1)
+	_, err := s.msgServer.PlaceLimitOrder(s.ctx, &simpleswap.MsgPlaceLimitOrder{
+		Owner:       seller.String(),
+		Input:       weth,
+		OutputDenom: "ETH",
+		LimitPrice:  math.LegacyOneDec(),
+	})
+	require.NoError(err)
+
+	// The buyer accepts 0.999 WETH per ETH
+	eth := types.NewInt64Coin("ETH", 1000)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, buyer, simpleswap.ModuleName, types.NewCoins(eth)).Return(nil).Times(1)
+	buyResp, err := s.msgServer.PlaceLimitOrder(s.ctx, &simpleswap.MsgPlaceLimitOrder{
+		Owner:       buyer.String(),
+		Input:       eth,
+		OutputDenom: "WETH",
+		LimitPrice:  math.LegacyMustNewDecFromStr("0.999"),
+	})
+	require.NoError(err)
+
+	// The open orders are queried by owner and by pair
+	byOwner, err := s.queryClient.LimitOrdersByOwner(s.ctx, &simpleswap.QueryLimitOrdersByOwnerRequest{Owner: seller.String()})
+	require.NoError(err)
+	require.Len(byOwner.Orders, 1)
+	require.Equal(weth, byOwner.Orders[0].Input)
+
+	byPair, err := s.queryClient.LimitOrdersByPair(s.ctx, &simpleswap.QueryLimitOrdersByPairRequest{InputDenom: "ETH", OutputDenom: "WETH"})
+	require.NoError(err)
+	require.Len(byPair.Orders, 1)
+	require.Equal(buyResp.OrderId, byPair.Orders[0].Id)
+
+	// The seller order is tried first, the escrow is released and swapped, but the payout is below
+	// the limit and the swap is discarded
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), simpleswap.ModuleName, seller, types.NewCoins(weth)).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().SpendableCoin(gomock.Any(), seller, "WETH").Return(weth).Times(1)
+	s.bankKeeper.EXPECT().SpendableCoin(gomock.Any(), seller, "ETH").Return(types.NewInt64Coin("ETH", 0)).Times(2)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), seller, simpleswap.ModuleName, types.NewCoins(weth)).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), simpleswap.ModuleName, seller, types.NewCoins(types.NewInt64Coin("ETH", 999))).Return(nil).Times(1)
+
+	// The buyer order is filled at 999 WETH, net of the swap fee
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), simpleswap.ModuleName, buyer, types.NewCoins(eth)).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().SpendableCoin(gomock.Any(), buyer, "ETH").Return(eth).Times(1)
+	s.bankKeeper.EXPECT().SpendableCoin(gomock.Any(), buyer, "WETH").Return(types.NewInt64Coin("WETH", 0)).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), buyer, simpleswap.ModuleName, types.NewCoins(eth)).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), simpleswap.ModuleName, buyer, types.NewCoins(types.NewInt64Coin("WETH", 999))).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().SpendableCoin(gomock.Any(), buyer, "WETH").Return(types.NewInt64Coin("WETH", 999)).Times(1)
+
+	require.NoError(s.simpleSwapKeeper.EndBlocker(s.ctx))
+
+	// Only the filled swap reached the reserves, the output reserve is debited before the swap fee
+	ethReserve, err := s.simpleSwapKeeper.CoinsReserve.Get(s.ctx, "ETH")
+	require.NoError(err)
+	require.Equal(types.NewInt64Coin("ETH", 11_000), ethReserve)
+	wethReserve, err := s.simpleSwapKeeper.CoinsReserve.Get(s.ctx, "WETH")
+	require.NoError(err)
+	require.Equal(types.NewInt64Coin("WETH", 9000), wethReserve)
+
+	_, err = s.simpleSwapKeeper.LimitOrders.Get(s.ctx, buyResp.OrderId)
+	require.Error(err)
+
+	var filled []types.Event
+	for _, event := range s.ctx.EventManager().Events() {
+		if event.Type == simpleswap.EventTypeLimitOrderFilled {
+			filled = append(filled, event)
+		}
+	}
+	require.Len(filled, 1)
+	output, ok := filled[0].GetAttribute(simpleswap.AttributeKeyOutput)
+	require.True(ok)
+	require.Equal("999WETH", output.Value)
+
+	// The resting order is cancelled by its owner only, and the escrow refunded
+	sellOrder := byOwner.Orders[0]
+	_, err = s.msgServer.CancelLimitOrder(s.ctx, &simpleswap.MsgCancelLimitOrder{Owner: buyer.String(), OrderId: sellOrder.Id})
+	require.ErrorIs(err, simpleswap.ErrLimitOrderNotOwned)
+
+	_, err = s.msgServer.CancelLimitOrder(s.ctx, &simpleswap.MsgCancelLimitOrder{Owner: buyer.String(), OrderId: buyResp.OrderId})
+	require.ErrorIs(err, simpleswap.ErrLimitOrderNotFound)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(s.ctx, simpleswap.ModuleName, seller, types.NewCoins(weth)).Return(nil).Times(1)
+	cancelResp, err := s.msgServer.CancelLimitOrder(s.ctx, &simpleswap.MsgCancelLimitOrder{Owner: seller.String(), OrderId: sellOrder.Id})
+	require.NoError(err)
+	require.Equal(weth, cancelResp.Refund)
+
+	byOwner, err = s.queryClient.LimitOrdersByOwner(s.ctx, &simpleswap.QueryLimitOrdersByOwnerRequest{Owner: seller.String()})
+	require.NoError(err)
+	require.Empty(byOwner.Orders)
+}
+
+func (s *KeeperTestSuite) TestLimitOrdersQueries() {
+	require := s.Require()
+	seller, buyer := s.addrs[1], s.addrs[2]
+
+	s.initGenesis(simpleswap.DefaultParams())
+
+	place := func(owner types.AccAddress, input types.Coin, outputDenom string) uint64 {
+		s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, owner, simpleswap.ModuleName, types.NewCoins(input)).Return(nil).Times(1)
+		resp, err := s.msgServer.PlaceLimitOrder(s.ctx, &simpleswap.MsgPlaceLimitOrder{
+			Owner:       owner.String(),
+			Input:       input,
+			OutputDenom: outputDenom,
+			LimitPrice:  math.LegacyOneDec(),
+		})
+		require.NoError(err)
+		return resp.OrderId
+	}
+
+	sellerWETH := place(seller, types.NewInt64Coin("WETH", 1000), "ETH")
+	sellerETH := place(seller, types.NewInt64Coin("ETH", 1000), "WETH")
+	buyerETH := place(buyer, types.NewInt64Coin("ETH", 500), "WETH")
+
+	// The orders of the owner are paged over the owner index
+	first, err := s.queryClient.LimitOrdersByOwner(s.ctx, &simpleswap.QueryLimitOrdersByOwnerRequest{
+		Owner:      seller.String(),
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Len(first.Orders, 1)
+	require.Equal(sellerWETH, first.Orders[0].Id)
+	require.Equal(uint64(2), first.Pagination.Total)
+
+	second, err := s.queryClient.LimitOrdersByOwner(s.ctx, &simpleswap.QueryLimitOrdersByOwnerRequest{
+		Owner:      seller.String(),
+		Pagination: &query.PageRequest{Key: first.Pagination.NextKey},
+	})
+	require.NoError(err)
+	require.Len(second.Orders, 1)
+	require.Equal(sellerETH, second.Orders[0].Id)
+	require.Nil(second.Pagination.NextKey)
+
+	// The orders of the pair are paged over the pair index, the reverse pair is not listed
+	byPair, err := s.queryClient.LimitOrdersByPair(s.ctx, &simpleswap.QueryLimitOrdersByPairRequest{
+		InputDenom:  "ETH",
+		OutputDenom: "WETH",
+		Pagination:  &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(err)
+	require.Len(byPair.Orders, 2)
+	require.Equal(sellerETH, byPair.Orders[0].Id)
+	require.Equal(buyerETH, byPair.Orders[1].Id)
+	require.Equal(uint64(2), byPair.Pagination.Total)
+
+	// A cancelled order leaves both indexes
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(s.ctx, simpleswap.ModuleName, seller, types.NewCoins(types.NewInt64Coin("ETH", 1000))).Return(nil).Times(1)
+	_, err = s.msgServer.CancelLimitOrder(s.ctx, &simpleswap.MsgCancelLimitOrder{Owner: seller.String(), OrderId: sellerETH})
+	require.NoError(err)
+
+	byOwner, err := s.queryClient.LimitOrdersByOwner(s.ctx, &simpleswap.QueryLimitOrdersByOwnerRequest{Owner: seller.String()})
+	require.NoError(err)
+	require.Len(byOwner.Orders, 1)
+	require.Equal(sellerWETH, byOwner.Orders[0].Id)
+
+	byPair, err = s.queryClient.LimitOrdersByPair(s.ctx, &simpleswap.QueryLimitOrdersByPairRequest{InputDenom: "ETH", OutputDenom: "WETH"})
+	require.NoError(err)
+	require.Len(byPair.Orders, 1)
+	require.Equal(buyerETH, byPair.Orders[0].Id)
+}
+
+func (s *KeeperTestSuite) TestMatchLimitOrdersPerBlock() {
+	require := s.Require()
+	seller, buyer := s.addrs[1], s.addrs[2]
+
+	params := simpleswap.DefaultParams()
+	params.LimitOrderMatchesPerBlock = 1
+	s.initGenesis(params)
+	require.NoError(s.simpleSwapKeeper.CoinsReserve.Set(s.ctx, "ETH", types.NewInt64Coin("ETH", 10_000)))
+	require.NoError(s.simpleSwapKeeper.CoinsReserve.Set(s.ctx, "WETH", types.NewInt64Coin("WETH", 10_000)))
+
+	// The seller order rests, the buyer order can be filled
+	weth := types.NewInt64Coin("WETH", 1000)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, seller, simpleswap.ModuleName, types.NewCoins(weth)).Return(nil).Times(1)
+	sellResp, err := s.msgServer.PlaceLimitOrder(s.ctx, &simpleswap.MsgPlaceLimitOrder{
+		Owner:       seller.String(),
+		Input:       weth,
+		OutputDenom: "ETH",
+		LimitPrice:  math.LegacyOneDec(),
+	})
+	require.NoError(err)
+
+	eth := types.NewInt64Coin("ETH", 1000)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, buyer, simpleswap.ModuleName, types.NewCoins(eth)).Return(nil).Times(1)
+	buyResp, err := s.msgServer.PlaceLimitOrder(s.ctx, &simpleswap.MsgPlaceLimitOrder{
+		Owner:       buyer.String(),
+		Input:       eth,
+		OutputDenom: "WETH",
+		LimitPrice:  math.LegacyMustNewDecFromStr("0.999"),
+	})
+	require.NoError(err)
+
+	// Only the seller order is tried in the first block
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), simpleswap.ModuleName, seller, types.NewCoins(weth)).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().SpendableCoin(gomock.Any(), seller, "WETH").Return(weth).Times(1)
+	s.bankKeeper.EXPECT().SpendableCoin(gomock.Any(), seller, "ETH").Return(types.NewInt64Coin("ETH", 0)).Times(2)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), seller, simpleswap.ModuleName, types.NewCoins(weth)).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), simpleswap.ModuleName, seller, types.NewCoins(types.NewInt64Coin("ETH", 999))).Return(nil).Times(1)
+	require.NoError(s.simpleSwapKeeper.MatchLimitOrders(s.ctx))
+
+	cursor, err := s.simpleSwapKeeper.LimitOrderCursor.Get(s.ctx)
+	require.NoError(err)
+	require.Equal(sellResp.OrderId, cursor)
+
+	// The resting seller order does not delay the buyer order, tried in the next block
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), simpleswap.ModuleName, buyer, types.NewCoins(eth)).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().SpendableCoin(gomock.Any(), buyer, "ETH").Return(eth).Times(1)
+	s.bankKeeper.EXPECT().SpendableCoin(gomock.Any(), buyer, "WETH").Return(types.NewInt64Coin("WETH", 0)).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), buyer, simpleswap.ModuleName, types.NewCoins(eth)).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), simpleswap.ModuleName, buyer, types.NewCoins(types.NewInt64Coin("WETH", 999))).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().SpendableCoin(gomock.Any(), buyer, "WETH").Return(types.NewInt64Coin("WETH", 999)).Times(1)
+	require.NoError(s.simpleSwapKeeper.MatchLimitOrders(s.ctx))
+
+	_, err = s.simpleSwapKeeper.LimitOrders.Get(s.ctx, buyResp.OrderId)
+	require.Error(err)
+	_, err = s.simpleSwapKeeper.LimitOrders.Get(s.ctx, sellResp.OrderId)
+	require.NoError(err)
+}
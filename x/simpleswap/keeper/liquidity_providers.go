@@ -0,0 +1,193 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/codec"
+	"cosmossdk.io/collections/indexes"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/simpleswap"
+)
+
+// LiquidityProvidersIndexes are the indexes of the liquidity providers.
+type LiquidityProvidersIndexes struct {
+	// Denom indexes the addresses of the liquidity providers by the denom of their stable coin,
+	// and sums the stable coins by denom.
+	Denom *DenomIndex
+}
+
+// NewLiquidityProvidersIndexes creates the indexes of the liquidity providers.
+func NewLiquidityProvidersIndexes(sb *collections.SchemaBuilder) LiquidityProvidersIndexes {
+	return LiquidityProvidersIndexes{
+		Denom: NewDenomIndex(sb),
+	}
+}
+
+// IndexesList implements collections.Indexes.
+func (i LiquidityProvidersIndexes) IndexesList() []collections.Index[string, simpleswap.LiquidityProvider] {
+	return []collections.Index[string, simpleswap.LiquidityProvider]{i.Denom}
+}
+
+// DenomIndex indexes the addresses of the liquidity providers by the denom of their stable coin,
+// like an indexes.Multi, and keeps the total of the stable coins of every denom, the exposure of
+// the pool to it. The index keys are paged over with query.CollectionPaginate.
+type DenomIndex struct {
+	refKeys collections.KeySet[collections.Pair[string, string]]
+
+	// Exposures are the totals of the stable coins by denom, the denoms without liquidity are
+	// not stored.
+	Exposures collections.Map[string, math.Int]
+}
+
+// NewDenomIndex creates the denom index of the liquidity providers.
+func NewDenomIndex(sb *collections.SchemaBuilder) *DenomIndex {
+	return &DenomIndex{
+		refKeys:   collections.NewKeySet(sb, simpleswap.LiquidityProvidersByDenomKey, "liquidity_providers_by_denom", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		Exposures: collections.NewMap(sb, simpleswap.LiquidityExposureKey, "liquidity_exposure", collections.StringKey, sdk.IntValue),
+	}
+}
+
+// Reference implements collections.Index, the previous position of the provider is replaced.
+func (i *DenomIndex) Reference(ctx context.Context, address string, provider simpleswap.LiquidityProvider, lazyOldValue func() (simpleswap.LiquidityProvider, error)) error {
+	oldProvider, err := lazyOldValue()
+	switch {
+	case err == nil:
+		if err := i.unreference(ctx, address, oldProvider); err != nil {
+			return err
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	denom, err := LiquidityProviderDenom(address, provider)
+	if err != nil {
+		return err
+	}
+
+	if err := i.refKeys.Set(ctx, collections.Join(denom, address)); err != nil {
+		return err
+	}
+
+	if provider.StableCoin == nil {
+		return nil
+	}
+
+	exposure, err := i.Exposure(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	return i.setExposure(ctx, denom, exposure.Add(provider.StableCoin.Amount))
+}
+
+// Unreference implements collections.Index.
+func (i *DenomIndex) Unreference(ctx context.Context, address string, getValue func() (simpleswap.LiquidityProvider, error)) error {
+	provider, err := getValue()
+	if err != nil {
+		return err
+	}
+
+	return i.unreference(ctx, address, provider)
+}
+
+func (i *DenomIndex) unreference(ctx context.Context, address string, provider simpleswap.LiquidityProvider) error {
+	denom, err := LiquidityProviderDenom(address, provider)
+	if err != nil {
+		return err
+	}
+
+	if err := i.refKeys.Remove(ctx, collections.Join(denom, address)); err != nil {
+		return err
+	}
+
+	if provider.StableCoin == nil {
+		return nil
+	}
+
+	exposure, err := i.Exposure(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	return i.setExposure(ctx, denom, exposure.Sub(provider.StableCoin.Amount))
+}
+
+// setExposure sets the exposure of the pool to the denom.
+func (i *DenomIndex) setExposure(ctx context.Context, denom string, exposure math.Int) error {
+	if exposure.IsZero() {
+		return i.Exposures.Remove(ctx, denom)
+	}
+
+	return i.Exposures.Set(ctx, denom, exposure)
+}
+
+// Exposure returns the total of the stable coins of the liquidity providers of the denom.
+func (i *DenomIndex) Exposure(ctx context.Context, denom string) (math.Int, error) {
+	exposure, err := i.Exposures.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.ZeroInt(), nil
+	}
+
+	return exposure, err
+}
+
+// Walk walks the addresses of the liquidity providers in the range of the index.
+func (i *DenomIndex) Walk(ctx context.Context, ranger collections.Ranger[collections.Pair[string, string]], walkFunc func(denom, address string) (stop bool, err error)) error {
+	return i.refKeys.Walk(ctx, ranger, func(key collections.Pair[string, string]) (bool, error) {
+		return walkFunc(key.K1(), key.K2())
+	})
+}
+
+// MatchExact returns an iterator over the addresses of the liquidity providers of the denom.
+func (i *DenomIndex) MatchExact(ctx context.Context, denom string) (indexes.MultiIterator[string, string], error) {
+	iter, err := i.refKeys.Iterate(ctx, collections.NewPrefixedPairRange[string, string](denom))
+	return (indexes.MultiIterator[string, string])(iter), err
+}
+
+// IterateRaw iterates over the raw keys of the index, for query.CollectionPaginate.
+func (i *DenomIndex) IterateRaw(ctx context.Context, start, end []byte, order collections.Order) (collections.Iterator[collections.Pair[string, string], collections.NoValue], error) {
+	return i.refKeys.IterateRaw(ctx, start, end, order)
+}
+
+// KeyCodec returns the key codec of the index, for query.CollectionPaginate.
+func (i *DenomIndex) KeyCodec() codec.KeyCodec[collections.Pair[string, string]] {
+	return i.refKeys.KeyCodec()
+}
+
+// LiquidityProviderDenom returns the denom of the stable coin of the liquidity provider, by which
+// it is indexed.
+func LiquidityProviderDenom(_ string, provider simpleswap.LiquidityProvider) (string, error) {
+	if provider.StableCoin == nil {
+		return "", nil
+	}
+
+	return provider.StableCoin.Denom, nil
+}
+
+// PendingFees returns the swap fees accrued by the liquidity provider and not yet withdrawn, in the
+// pool decimals: the fees credited to it, plus its share of the fees accrued by the pool since then,
+// as they are computed when its liquidity is added or removed.
+func PendingFees(pool simpleswap.Pool, provider simpleswap.LiquidityProvider) int64 {
+	fees := provider.AccruedFees
+	if provider.GloballyAccruedFees != pool.TotalAccruedFees && provider.PoolShare != nil && provider.PoolShare.Amount.IsPositive() && pool.TotalLiquidity != 0 {
+		// The product of the fees and the shares may not fit in an int64, the share of the fees does
+		// since the shares of the provider are part of the total liquidity
+		diff := math.NewInt(pool.TotalAccruedFees - provider.GloballyAccruedFees)
+		fees += diff.Mul(provider.PoolShare.Amount).QuoRaw(pool.TotalLiquidity).Int64()
+	}
+
+	return fees
+}
+
+// PoolShareRatio returns the ratio of the pool shares outstanding held by the liquidity provider.
+func PoolShareRatio(pool simpleswap.Pool, provider simpleswap.LiquidityProvider) math.LegacyDec {
+	if provider.PoolShare == nil || pool.ShareToken == nil || !pool.ShareToken.Amount.IsPositive() {
+		return math.LegacyZeroDec()
+	}
+
+	return math.LegacyNewDecFromInt(provider.PoolShare.Amount).QuoInt(pool.ShareToken.Amount)
+}
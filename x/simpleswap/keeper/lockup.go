@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/simpleswap"
+)
+
+// LocksIndexes are the indexes of the share locks.
+type LocksIndexes struct {
+	// EndTime indexes the ids of the locks by their end time, the zero time for the locks
+	// not unlocking.
+	EndTime *indexes.Multi[time.Time, uint64, simpleswap.PeriodLock]
+
+	// Owner indexes the ids of the locks by their owner.
+	Owner *indexes.Multi[string, uint64, simpleswap.PeriodLock]
+}
+
+// NewLocksIndexes creates the indexes of the share locks.
+func NewLocksIndexes(sb *collections.SchemaBuilder) LocksIndexes {
+	return LocksIndexes{
+		EndTime: indexes.NewMulti(sb, simpleswap.LocksByEndTimeKey, "locks_by_end_time", sdk.TimeKey, collections.Uint64Key, LockEndTime),
+		Owner:   indexes.NewMulti(sb, simpleswap.LocksByOwnerKey, "locks_by_owner", collections.StringKey, collections.Uint64Key, LockOwner),
+	}
+}
+
+// IndexesList implements collections.Indexes.
+func (i LocksIndexes) IndexesList() []collections.Index[uint64, simpleswap.PeriodLock] {
+	return []collections.Index[uint64, simpleswap.PeriodLock]{i.EndTime, i.Owner}
+}
+
+// LockEndTime returns the end time of the lock, by which it is indexed.
+func LockEndTime(_ uint64, lock simpleswap.PeriodLock) (time.Time, error) {
+	return lock.EndTime, nil
+}
+
+// LockOwner returns the owner of the lock, by which it is indexed.
+func LockOwner(_ uint64, lock simpleswap.PeriodLock) (string, error) {
+	return lock.Owner, nil
+}
+
+// AccountLocks returns the share locks of an owner in the order of their ids.
+func (k Keeper) AccountLocks(ctx context.Context, owner string) ([]simpleswap.PeriodLock, error) {
+	iter, err := k.Locks.Indexes.Owner.MatchExact(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return indexes.CollectValues(ctx, k.Locks, iter)
+}
+
+// ReleaseMatureLocks returns the shares of every unlocking lock whose end time has passed
+// to its owner and deletes the lock.
+func (k Keeper) ReleaseMatureLocks(ctx context.Context) error {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	// Only the unlocking locks up to the block time are visited, the locks not unlocking
+	// are indexed by the zero time
+	mature := new(collections.Range[collections.Pair[time.Time, uint64]]).
+		StartExclusive(collections.Join(time.Time{}, uint64(math.MaxUint64))).
+		EndInclusive(collections.Join(blockTime, uint64(math.MaxUint64)))
+
+	var ids []uint64
+	err := k.Locks.Indexes.EndTime.Walk(ctx, mature, func(_ time.Time, id uint64) (bool, error) {
+		ids = append(ids, id)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		lock, err := k.Locks.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		owner, err := k.addressCodec.StringToBytes(lock.Owner)
+		if err != nil {
+			return err
+		}
+
+		if err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, simpleswap.ModuleName, owner, sdk.NewCoins(lock.Shares)); err != nil {
+			return err
+		}
+
+		if err := k.Locks.Remove(ctx, lock.Id); err != nil {
+			return err
+		}
+
+		// The released shares no longer earn the gauge rewards
+		if !lock.RewardWeight.IsNil() {
+			if err := k.addRewardWeight(ctx, lock.Owner, lock.RewardWeight.Neg()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
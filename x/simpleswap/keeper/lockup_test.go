@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	types "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/simpleswap"
+	"github.com/golang/mock/gomock"
+)
+
+func (s *KeeperTestSuite) TestShareLockups() {
+	require := s.Require()
+	lp1, lp2 := s.addrs[1], s.addrs[2]
+	params := simpleswap.DefaultParams()
+	shareDenom := simpleswap.PoolShareDenom(simpleswap.DefaultPoolId)
+
+	s.initGenesis(params)
+
+	// Only pool shares and allowed durations can be locked
+	_, err := s.msgServer.LockShares(s.ctx, &simpleswap.MsgLockShares{
+		Owner:    lp1.String(),
+		Shares:   types.NewInt64Coin("ETH", 100),
+		Duration: 24 * time.Hour,
+	})
+	require.ErrorIs(err, simpleswap.ErrCoinInvalid)
+
+	_, err = s.msgServer.LockShares(s.ctx, &simpleswap.MsgLockShares{
+		Owner:    lp1.String(),
+		Shares:   types.NewInt64Coin(shareDenom, 100),
+		Duration: 3 * time.Hour,
+	})
+	require.ErrorIs(err, simpleswap.ErrInvalidLockDuration)
+
+	// lp1 locks its 100 shares and the 50 shares it received from lp2
+	shares := types.NewInt64Coin(shareDenom, 150)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, lp1, simpleswap.ModuleName, types.NewCoins(shares)).Return(nil).Times(1)
+	resp, err := s.msgServer.LockShares(s.ctx, &simpleswap.MsgLockShares{
+		Owner:    lp1.String(),
+		Shares:   shares,
+		Duration: 14 * 24 * time.Hour,
+	})
+	require.NoError(err)
+
+	locks, err := s.queryClient.AccountLocks(s.ctx, &simpleswap.QueryAccountLocksRequest{Owner: lp1.String()})
+	require.NoError(err)
+	require.Len(locks.Locks, 1)
+	require.True(locks.Locks[0].EndTime.IsZero())
+	require.Equal(math.NewInt(300), locks.Locks[0].RewardWeight)
+
+	// The shares locked for 14 days are weighted twice in the gauge distribution, the shares
+	// locked for a day once
+	s.lockShares(lp2, 50, 24*time.Hour)
+	acc, err := s.simpleSwapKeeper.RewardAccumulator.Get(s.ctx)
+	require.NoError(err)
+	require.Equal(math.NewInt(350), acc.TotalWeight)
+
+	require.NoError(s.simpleSwapKeeper.Gauges.Set(s.ctx, 0, simpleswap.Gauge{
+		PoolId:    simpleswap.DefaultPoolId,
+		Coins:     types.NewCoins(types.NewInt64Coin("REWARD", 350)),
+		NumEpochs: 1,
+	}))
+	require.NoError(s.simpleSwapKeeper.EndBlocker(s.ctx.WithBlockHeight(params.IncentivesEpochBlocks)))
+
+	pending, err := s.queryClient.PendingRewards(s.ctx, &simpleswap.QueryPendingRewardsRequest{LpAddress: lp1.String()})
+	require.NoError(err)
+	require.Equal(types.NewCoins(types.NewInt64Coin("REWARD", 300)), pending.Rewards)
+	pending, err = s.queryClient.PendingRewards(s.ctx, &simpleswap.QueryPendingRewardsRequest{LpAddress: lp2.String()})
+	require.NoError(err)
+	require.Equal(types.NewCoins(types.NewInt64Coin("REWARD", 50)), pending.Rewards)
+
+	// Only the owner can unlock, and only once
+	_, err = s.msgServer.BeginUnlock(s.ctx, &simpleswap.MsgBeginUnlock{Owner: lp2.String(), LockId: resp.LockId})
+	require.ErrorIs(err, simpleswap.ErrLockNotOwned)
+
+	_, err = s.msgServer.BeginUnlock(s.ctx, &simpleswap.MsgBeginUnlock{Owner: lp1.String(), LockId: 9})
+	require.ErrorIs(err, simpleswap.ErrLockNotFound)
+
+	unlock, err := s.msgServer.BeginUnlock(s.ctx, &simpleswap.MsgBeginUnlock{Owner: lp1.String(), LockId: resp.LockId})
+	require.NoError(err)
+	require.Equal(s.ctx.BlockTime().Add(14*24*time.Hour), unlock.EndTime)
+
+	_, err = s.msgServer.BeginUnlock(s.ctx, &simpleswap.MsgBeginUnlock{Owner: lp1.String(), LockId: resp.LockId})
+	require.ErrorIs(err, simpleswap.ErrLockUnlocking)
+
+	// The locks not unlocking are never released
+	bondedId := s.lockShares(lp2, 10, 24*time.Hour)
+
+	// The lock is kept until mature, then the shares are released
+	require.NoError(s.simpleSwapKeeper.EndBlocker(s.ctx.WithBlockTime(unlock.EndTime.Add(-time.Second))))
+	_, err = s.simpleSwapKeeper.Locks.Get(s.ctx, resp.LockId)
+	require.NoError(err)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), simpleswap.ModuleName, lp1, types.NewCoins(shares)).Return(nil).Times(1)
+	require.NoError(s.simpleSwapKeeper.EndBlocker(s.ctx.WithBlockTime(unlock.EndTime)))
+	has, err := s.simpleSwapKeeper.Locks.Has(s.ctx, resp.LockId)
+	require.NoError(err)
+	require.False(has)
+	has, err = s.simpleSwapKeeper.Locks.Has(s.ctx, bondedId)
+	require.NoError(err)
+	require.True(has)
+
+	// The locks of an owner are listed through the owner index
+	locks, err = s.queryClient.AccountLocks(s.ctx, &simpleswap.QueryAccountLocksRequest{Owner: lp1.String()})
+	require.NoError(err)
+	require.Empty(locks.Locks)
+	locks, err = s.queryClient.AccountLocks(s.ctx, &simpleswap.QueryAccountLocksRequest{Owner: lp2.String()})
+	require.NoError(err)
+	require.Len(locks.Locks, 2)
+	require.Equal(bondedId, locks.Locks[1].Id)
+
+	// The released shares no longer earn, the rewards they earned are kept for their owner
+	acc, err = s.simpleSwapKeeper.RewardAccumulator.Get(s.ctx)
+	require.NoError(err)
+	require.Equal(math.NewInt(60), acc.TotalWeight)
+
+	require.NoError(s.simpleSwapKeeper.Gauges.Set(s.ctx, 1, simpleswap.Gauge{
+		Id:        1,
+		PoolId:    simpleswap.DefaultPoolId,
+		Coins:     types.NewCoins(types.NewInt64Coin("REWARD", 120)),
+		NumEpochs: 1,
+	}))
+	require.NoError(s.simpleSwapKeeper.EndBlocker(s.ctx.WithBlockHeight(2 * params.IncentivesEpochBlocks)))
+
+	pending, err = s.queryClient.PendingRewards(s.ctx, &simpleswap.QueryPendingRewardsRequest{LpAddress: lp1.String()})
+	require.NoError(err)
+	require.Equal(types.NewCoins(types.NewInt64Coin("REWARD", 300)), pending.Rewards)
+	pending, err = s.queryClient.PendingRewards(s.ctx, &simpleswap.QueryPendingRewardsRequest{LpAddress: lp2.String()})
+	require.NoError(err)
+	require.Equal(types.NewCoins(types.NewInt64Coin("REWARD", 170)), pending.Rewards)
+
+	// The genesis exports the weights with the accumulator, they must add up to the lock weights
+	require.NoError(s.simpleSwapKeeper.GaugeSequence.Set(s.ctx, 2))
+	genesis, err := s.simpleSwapKeeper.ExportGenesis(s.ctx)
+	require.NoError(err)
+	require.NoError(genesis.Validate())
+	genesis.RewardAccumulator.TotalWeight = math.NewInt(61)
+	require.Error(genesis.Validate())
+}
+
+func (s *KeeperTestSuite) TestLockDurationsValidation() {
+	require := s.Require()
+
+	params := simpleswap.DefaultParams()
+	params.LockDurations = append(params.LockDurations, simpleswap.LockDuration{Duration: 24 * time.Hour, Multiplier: math.LegacyOneDec()})
+	require.ErrorIs(params.Validate(), simpleswap.ErrInvalidLockDuration)
+
+	params = simpleswap.DefaultParams()
+	params.LockDurations[0].Multiplier = math.LegacyNewDecWithPrec(5, 1)
+	require.ErrorIs(params.Validate(), simpleswap.ErrInvalidLockMultiplier)
+}
@@ -1,5 +1,11 @@
 package keeper
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/cosmos/simpleswap/migrations/v2"
+)
+
 // Migrator is a struct for handling in-place state migrations.
 type Migrator struct {
 	keeper Keeper
@@ -13,6 +19,6 @@ func NewMigrator(k Keeper) Migrator {
 }
 
 // Migrate1to2 migrates the module state from version 1 to version 2.
-// func (m Migrator) Migrate1to2(ctx context.Context) error {
-// 	return v2.Migrate(/* migrations arguments */)
-// }
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.Migrate(ctx, m.keeper.storeService, m.keeper.cdc, m.keeper.addressCodec, m.keeper.BankKeeper)
+}
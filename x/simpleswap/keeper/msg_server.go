@@ -2,35 +2,37 @@ package keeper
 
 import (
 	"context"
-	"fmt"
+	"errors"
+	"slices"
 	"strings"
 
-	maths "math"
-
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	math "cosmossdk.io/math"
 	types "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/simpleswap"
 )
 
 type msgServer struct {
-	k Keeper
+	k *Keeper
 }
 
 var _ simpleswap.MsgServer = msgServer{}
 
-// NewMsgServerImpl returns an implementation of the module MsgServer interface.
-func NewMsgServerImpl(keeper Keeper) simpleswap.MsgServer {
+// NewMsgServerImpl returns an implementation of the module MsgServer interface. The keeper is
+// shared, the optional keepers set after the msg server is created are used by it.
+func NewMsgServerImpl(keeper *Keeper) simpleswap.MsgServer {
 	return &msgServer{k: keeper}
 }
 
 // UpdateParams params is defining the handler for the MsgUpdateParams message.
 func (ms msgServer) UpdateParams(ctx context.Context, msg *simpleswap.MsgUpdateParams) (*simpleswap.MsgUpdateParamsResponse, error) {
 	if _, err := ms.k.addressCodec.StringToBytes(msg.Authority); err != nil {
-		return nil, fmt.Errorf("invalid authority address: %w", err)
+		return nil, errorsmod.Wrapf(simpleswap.ErrInvalidAddress, "invalid authority address: %s", err)
 	}
 
 	if authority := ms.k.GetAuthority(); !strings.EqualFold(msg.Authority, authority) {
-		return nil, fmt.Errorf("unauthorized, authority does not match the module's authority: got %s, want %s", msg.Authority, authority)
+		return nil, errorsmod.Wrapf(simpleswap.ErrUnauthorized, "authority does not match the module's authority: got %s, want %s", msg.Authority, authority)
 	}
 
 	if err := msg.Params.Validate(); err != nil {
@@ -46,89 +48,79 @@ func (ms msgServer) UpdateParams(ctx context.Context, msg *simpleswap.MsgUpdateP
 
 // AddLiquidity is defining the handler for the MsgAddLiquidity message.
 func (ms msgServer) AddLiquidity(ctx context.Context, msg *simpleswap.MsgAddLiquidity) (*simpleswap.MsgAddLiquidityResponse, error) {
-
 	// Check if the amount is zero
-	if msg.Token.Amount.Int64() == 0 {
-		return &simpleswap.MsgAddLiquidityResponse{
-			StatusCode: 400,
-		}, simpleswap.ErrZeroAmount
+	if msg.Token.Amount.IsNil() || msg.Token.Amount.IsZero() {
+		return nil, simpleswap.ErrZeroAmount
+	}
+
+	if !msg.Token.IsValid() {
+		return nil, errorsmod.Wrapf(simpleswap.ErrCoinInvalid, "for the token: %s", msg.Token)
 	}
 
 	// Check if the liquidity provider address is valid
-	_, err := ms.k.addressCodec.StringToBytes(msg.LiquidityProvider)
+	addr, err := ms.k.addressCodec.StringToBytes(msg.LiquidityProvider)
 	if err != nil {
-		return &simpleswap.MsgAddLiquidityResponse{
-			StatusCode: 401,
-		}, simpleswap.ErrInvalidProviderAddress
+		return nil, errorsmod.Wrapf(simpleswap.ErrInvalidProviderAddress, "%s: %s", msg.LiquidityProvider, err)
 	}
 
 	// Get the current pool state
-	currentPoolState, err := ms.k.Pool.Get(ctx)
+	currentPoolState, err := ms.k.initializedPool(ctx)
 	if err != nil {
-		return &simpleswap.MsgAddLiquidityResponse{
-			StatusCode: 500,
-		}, err
+		return nil, err
 	}
 
 	// Check if the coin being added is present in the whitelist
 	params, err := ms.k.Params.Get(ctx)
 	if err != nil {
-		return &simpleswap.MsgAddLiquidityResponse{
-			StatusCode: 500,
-		}, err
+		return nil, err
 	}
 
-	isInputCoinPresent := false
-	whitelistedCoins := params.GetWhitelistedCoins()
-	for _, coin := range whitelistedCoins {
-		if coin.Denom == msg.Token.Denom {
-			isInputCoinPresent = true
-		}
+	if !slices.Contains(params.WhitelistedDenoms(), msg.Token.Denom) {
+		return nil, errorsmod.Wrapf(simpleswap.ErrCoinInvalid, "for the denom: %s", msg.Token.Denom)
 	}
 
-	if !isInputCoinPresent {
-		return &simpleswap.MsgAddLiquidityResponse{
-			StatusCode: 400,
-		}, fmt.Errorf("error: %w, for the denom: %s", simpleswap.ErrCoinInvalid, msg.Token.Denom)
+	// Value the added liquidity in the pool decimals at the rate of the coin, the dust below the pool precision is kept by the pool
+	normalizedAmount, err := ms.k.liquidityValue(ctx, params, currentPoolState, msg.Token, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if normalizedAmount.IsZero() {
+		return nil, errorsmod.Wrapf(simpleswap.ErrZeroAmount, "the token %s is worth nothing in the pool decimals", msg.Token)
 	}
 
 	globallyAccruedFeesCurrent := currentPoolState.GetTotalAccruedFees()
 
-	// Get the liquidity provider address in AccAddress format
-	addr, err := types.AccAddressFromBech32(msg.LiquidityProvider)
+	// Get the liquidity provider, a position holds a single denom so that it is withdrawn in the
+	// denom it was deposited in
+	liquidityProvider, err := ms.k.LiquidityProviders.Get(ctx, msg.LiquidityProvider)
 	if err != nil {
-		return &simpleswap.MsgAddLiquidityResponse{
-			StatusCode: 500,
-		}, fmt.Errorf("error in converting address to AccAddress: %w", err)
+		if !errors.Is(err, collections.ErrNotFound) {
+			return nil, err
+		}
+
+		liquidityProvider.StableCoin = &types.Coin{
+			Denom:  msg.Token.Denom,
+			Amount: math.ZeroInt(),
+		}
+		liquidityProvider.PoolShare = &types.Coin{
+			Denom:  currentPoolState.ShareToken.Denom,
+			Amount: math.ZeroInt(),
+		}
 	}
 
-	// Transfer the stablecoin from the liquidity provider to the module account
-	err = ms.k.BankKeeper.SendCoinsFromAccountToModule(ctx, addr, simpleswap.ModuleName, types.NewCoins(msg.Token))
-	if err != nil {
-		return &simpleswap.MsgAddLiquidityResponse{
-			StatusCode: 500,
-		}, err
+	if liquidityProvider.StableCoin.Denom != msg.Token.Denom {
+		return nil, errorsmod.Wrapf(simpleswap.ErrDenomMismatch, "provided: %s, added: %s", liquidityProvider.StableCoin.Denom, msg.Token.Denom)
 	}
 
-	// Get the liquidity provider and update the stable coins
-	liquidityProvider, err := ms.k.LiquidityProviders.Get(ctx, msg.LiquidityProvider)
-	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			liquidityProvider.StableCoin = &types.Coin{
-				Denom:  msg.Token.Denom,
-				Amount: math.ZeroInt(),
-			}
-			liquidityProvider.PoolShare = &types.Coin{
-				Denom:  currentPoolState.ShareToken.Denom,
-				Amount: math.ZeroInt(),
-			}
-		} else {
-			return &simpleswap.MsgAddLiquidityResponse{
-				StatusCode: 500,
-			}, err
-		}
+	// Transfer the stablecoin from the liquidity provider to the module account
+	if err := ms.k.BankKeeper.SendCoinsFromAccountToModule(ctx, addr, simpleswap.ModuleName, types.NewCoins(msg.Token)); err != nil {
+		return nil, errorsmod.Wrapf(simpleswap.ErrInsufficientBalance, "cannot deposit %s: %s", msg.Token, err)
 	}
 
+	// The fees accrued on the shares held so far are credited before the new shares are minted
+	accruedFeesByLP := PendingFees(currentPoolState, liquidityProvider)
+
 	coin := liquidityProvider.StableCoin
 	coin.Amount = coin.Amount.Add(msg.Token.Amount)
 
@@ -138,17 +130,7 @@ func (ms msgServer) AddLiquidity(ctx context.Context, msg *simpleswap.MsgAddLiqu
 	// Coins to be minted
 	coinsToMint := types.Coin{
 		Denom:  poolShare.Denom,
-		Amount: msg.Token.Amount,
-	}
-
-	accruedFeesGloballyRecordedByLP := liquidityProvider.GloballyAccruedFees
-	accruedFeesByLP := liquidityProvider.AccruedFees
-
-	// See if the accrued fees by liquidity provider is equal to the globally accrued fees
-	if accruedFeesGloballyRecordedByLP != globallyAccruedFeesCurrent {
-		// Calculate the fees and update the Pool
-		diff := globallyAccruedFeesCurrent - accruedFeesGloballyRecordedByLP
-		accruedFeesByLP += (diff * poolShare.Amount.Int64()) / currentPoolState.TotalLiquidity
+		Amount: normalizedAmount,
 	}
 
 	// Update the pool share
@@ -161,17 +143,17 @@ func (ms msgServer) AddLiquidity(ctx context.Context, msg *simpleswap.MsgAddLiqu
 		AccruedFees:         accruedFeesByLP,
 		GloballyAccruedFees: globallyAccruedFeesCurrent,
 	}); err != nil {
-		return &simpleswap.MsgAddLiquidityResponse{
-			StatusCode: 500,
-		}, err
+		return nil, err
 	}
 
-	// Check if the coin is already present in the Coins Reserve
+	// Get the coin reserve, a coin whitelisted since the genesis has none until its first deposit
 	coinReserves, err := ms.k.CoinsReserve.Get(ctx, msg.Token.Denom)
 	if err != nil {
-		return &simpleswap.MsgAddLiquidityResponse{
-			StatusCode: 500,
-		}, err
+		if !errors.Is(err, collections.ErrNotFound) {
+			return nil, err
+		}
+
+		coinReserves = types.NewCoin(msg.Token.Denom, math.ZeroInt())
 	}
 
 	// Update the coin amount
@@ -179,364 +161,766 @@ func (ms msgServer) AddLiquidity(ctx context.Context, msg *simpleswap.MsgAddLiqu
 
 	// Update the Coins Reserve
 	if err := ms.k.CoinsReserve.Set(ctx, coin.Denom, coinReserves); err != nil {
-		return &simpleswap.MsgAddLiquidityResponse{
-			StatusCode: 500,
-		}, err
+		return nil, err
 	}
 
 	// Update the pool
 	if err := ms.k.Pool.Set(ctx, simpleswap.Pool{
-		TotalAccruedFees:  currentPoolState.TotalAccruedFees,
-		TotalLiquidity:    currentPoolState.TotalLiquidity + msg.Token.Amount.Int64(),
-		Decimals:          currentPoolState.Decimals,
-		ShareToken:        currentPoolState.ShareToken,
+		TotalAccruedFees: currentPoolState.TotalAccruedFees,
+		TotalLiquidity:   currentPoolState.TotalLiquidity + normalizedAmount.Int64(),
+		Decimals:         currentPoolState.Decimals,
+		ShareToken: &types.Coin{
+			Denom:  currentPoolState.ShareToken.Denom,
+			Amount: currentPoolState.ShareToken.Amount.Add(coinsToMint.Amount),
+		},
 		SwapFeePercentage: currentPoolState.SwapFeePercentage,
+		Id:                currentPoolState.Id,
 	}); err != nil {
-		return &simpleswap.MsgAddLiquidityResponse{
-			StatusCode: 500,
-		}, err
+		return nil, err
 	}
 
 	// MINT AND SEND the share token to the liquidity provider
-	err = ms.k.BankKeeper.MintCoins(ctx, simpleswap.ModuleName, types.NewCoins(coinsToMint))
-	if err != nil {
-		return &simpleswap.MsgAddLiquidityResponse{
-			StatusCode: 500,
-		}, err
+	if err := ms.k.BankKeeper.MintCoins(ctx, simpleswap.ModuleName, types.NewCoins(coinsToMint)); err != nil {
+		return nil, errorsmod.Wrapf(simpleswap.ErrMintShares, "%s: %s", coinsToMint, err)
 	}
 
 	if err := ms.k.BankKeeper.SendCoinsFromModuleToAccount(ctx, simpleswap.ModuleName, addr, types.NewCoins(coinsToMint)); err != nil {
-		return &simpleswap.MsgAddLiquidityResponse{
-			StatusCode: 500,
-		}, err
+		return nil, errorsmod.Wrapf(simpleswap.ErrPayout, "cannot send %s: %s", coinsToMint, err)
+	}
+
+	if err := ms.k.Hooks().AfterJoinPool(ctx, addr, currentPoolState.Id, msg.Token, coinsToMint); err != nil {
+		return nil, err
 	}
 
 	return &simpleswap.MsgAddLiquidityResponse{
-		StatusCode: 200,
+		Deposited:    msg.Token,
+		SharesMinted: coinsToMint,
 	}, nil
 }
 
+// SwapLiquidity is defining the handler for the MsgSwapLiquidity message. In the batch auction
+// mode the swap is queued and cleared at the end of the block.
 func (ms msgServer) SwapLiquidity(ctx context.Context, msg *simpleswap.MsgSwapLiquidity) (*simpleswap.MsgSwapLiquidityResponse, error) {
+	return ms.k.swap(ctx, msg, true)
+}
 
+// RemoveLiquidity is defining the handler for the MsgRemoveLiquidity message.
+func (ms msgServer) RemoveLiquidity(ctx context.Context, msg *simpleswap.MsgRemoveLiquidity) (*simpleswap.MsgRemoveLiquidityResponse, error) {
 	// Check if the amount is zero
-	if msg.Input.Amount.Int64() == 0 {
-		return &simpleswap.MsgSwapLiquidityResponse{
-			StatusCode: 400,
-		}, simpleswap.ErrZeroAmount
+	if msg.Token.Amount.IsNil() || msg.Token.Amount.IsZero() {
+		return nil, simpleswap.ErrZeroAmount
 	}
 
-	// Check if the input and output amount are equal
-	if msg.Input.Amount.Int64() != msg.Output.Amount.Int64() {
-		return &simpleswap.MsgSwapLiquidityResponse{
-			StatusCode: 400,
-		}, simpleswap.ErrAmountNotEqual
+	if !msg.Token.IsValid() {
+		return nil, errorsmod.Wrapf(simpleswap.ErrCoinInvalid, "for the token: %s", msg.Token)
 	}
 
 	// Check if the liquidity provider address is valid
-	_, err := ms.k.addressCodec.StringToBytes(msg.Trader)
+	addr, err := ms.k.addressCodec.StringToBytes(msg.LiquidityProvider)
 	if err != nil {
-		return &simpleswap.MsgSwapLiquidityResponse{
-			StatusCode: 401,
-		}, simpleswap.ErrInvalidProviderAddress
+		return nil, errorsmod.Wrapf(simpleswap.ErrInvalidProviderAddress, "%s: %s", msg.LiquidityProvider, err)
 	}
 
 	// Get the current pool state
-	currentPoolState, err := ms.k.Pool.Get(ctx)
+	currentPoolState, err := ms.k.initializedPool(ctx)
 	if err != nil {
-		return &simpleswap.MsgSwapLiquidityResponse{
-			StatusCode: 500,
-		}, err
+		return nil, err
 	}
 
-	// Check if the coins being swapped are present in the whitelist
 	params, err := ms.k.Params.Get(ctx)
 	if err != nil {
-		return &simpleswap.MsgSwapLiquidityResponse{
-			StatusCode: 500,
-		}, err
+		return nil, err
 	}
 
-	isInputCoinPresent := false
-	isOutputCoinPresent := false
-	whitelistedCoins := params.GetWhitelistedCoins()
-	for _, coin := range whitelistedCoins {
-		if coin.Denom == msg.Input.Denom {
-			isInputCoinPresent = true
-		} else if coin.Denom == msg.Output.Denom {
-			isOutputCoinPresent = true
+	// Get the liquidity provider
+	liquidityProvider, err := ms.k.LiquidityProviders.Get(ctx, msg.LiquidityProvider)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(simpleswap.ErrLiquidityProviderNotFound, "for the address: %s", msg.LiquidityProvider)
 		}
+		return nil, err
 	}
 
-	if !isInputCoinPresent || !isOutputCoinPresent {
-		if !isInputCoinPresent {
-			return &simpleswap.MsgSwapLiquidityResponse{
-				StatusCode: 400,
-			}, fmt.Errorf("error: %w, for the denom: %s", simpleswap.ErrCoinInvalid, msg.Input.Denom)
+	// The liquidity is withdrawn in the denom it was deposited in, the amounts of different denoms
+	// are not comparable
+	if liquidityProvider.StableCoin.Denom != msg.Token.Denom {
+		return nil, errorsmod.Wrapf(simpleswap.ErrDenomMismatch, "provided: %s, requested: %s", liquidityProvider.StableCoin.Denom, msg.Token.Denom)
+	}
+
+	// Check if the coins Reserve has the required amount of coins
+	coinsReserve, err := ms.k.CoinsReserve.Get(ctx, msg.Token.Denom)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return nil, err
 		}
-		return &simpleswap.MsgSwapLiquidityResponse{
-			StatusCode: 400,
-		}, fmt.Errorf("error: %w, for the denom: %s", simpleswap.ErrCoinInvalid, msg.Output.Denom)
-			
+
+		coinsReserve = types.NewCoin(msg.Token.Denom, math.ZeroInt())
 	}
 
-	// Get the liquidity provider address in AccAddress format
-	addr, err := types.AccAddressFromBech32(msg.Trader)
+	// Check if the liquidity provider has provided the requested amount of stable coins to the pool
+	if liquidityProvider.StableCoin.Amount.LT(msg.Token.Amount) {
+		return nil, errorsmod.Wrapf(simpleswap.ErrInsufficientLiquidity, "provided: %s, requested: %s", liquidityProvider.StableCoin, msg.Token)
+	}
+
+	// The fees credited to the liquidity provider, plus its share of the fees accrued by the pool
+	// since they were last credited
+	accruedFeesGlobally := currentPoolState.GetTotalAccruedFees()
+	accruedFees := PendingFees(currentPoolState, liquidityProvider)
+
+	// Check if the coins Reserve has the required amount of coins
+	if coinsReserve.Amount.LT(msg.Token.Amount) {
+		return nil, errorsmod.Wrapf(simpleswap.ErrInsufficientReserve, "got: %s, required: %s", coinsReserve, msg.Token)
+	}
+
+	// Update the coins reserve for the input coins provided by the liquidity provider
+	coinsReserve.Amount = coinsReserve.Amount.Sub(msg.Token.Amount)
+
+	if err := ms.k.CoinsReserve.Set(ctx, coinsReserve.Denom, coinsReserve); err != nil {
+		return nil, err
+	}
+
+	// Get the pool share for the LP, the shares are burned one to one with the removed liquidity
+	// in the pool decimals, rounded up in favour of the pool
+	poolShare := liquidityProvider.PoolShare
+	sharesToBurn := types.NewCoin(poolShare.Denom, poolShare.Amount)
+	if msg.Token.Amount.LT(liquidityProvider.StableCoin.Amount) {
+		tokenValue, err := ms.k.liquidityValue(ctx, params, currentPoolState, msg.Token, true)
+		if err != nil {
+			return nil, err
+		}
+
+		sharesToBurn.Amount = math.MinInt(tokenValue, poolShare.Amount)
+	}
+	poolShare.Amount = poolShare.Amount.Sub(sharesToBurn.Amount)
+
+	// Update the pool
+	if err := ms.k.Pool.Set(ctx, simpleswap.Pool{
+		TotalAccruedFees: currentPoolState.TotalAccruedFees,
+		TotalLiquidity:   currentPoolState.TotalLiquidity - sharesToBurn.Amount.Int64(),
+		Decimals:         currentPoolState.Decimals,
+		ShareToken: &types.Coin{
+			Denom:  currentPoolState.ShareToken.Denom,
+			Amount: currentPoolState.ShareToken.Amount.Sub(sharesToBurn.Amount),
+		},
+		SwapFeePercentage: currentPoolState.SwapFeePercentage,
+		Id:                currentPoolState.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	// Transfer LP coins from LP to Module Accounts inorder to burn them
+	if err := ms.k.BankKeeper.SendCoinsFromAccountToModule(ctx, addr, simpleswap.ModuleName, types.NewCoins(sharesToBurn)); err != nil {
+		return nil, errorsmod.Wrapf(simpleswap.ErrInsufficientBalance, "cannot return the shares %s: %s", sharesToBurn, err)
+	}
+
+	// Burn the share token	from the liquidity provider
+	if err := ms.k.BankKeeper.BurnCoins(ctx, simpleswap.ModuleName, types.NewCoins(sharesToBurn)); err != nil {
+		return nil, errorsmod.Wrapf(simpleswap.ErrBurnShares, "%s: %s", sharesToBurn, err)
+	}
+
+	// We check if the liquidity provider is removing all of its liquidity
+	// If not we update the liquidity provider
+	// If yes we remove the liquidity provider from the store
+	if msg.Token.Amount.LT(liquidityProvider.StableCoin.Amount) {
+		if err := ms.k.LiquidityProviders.Set(ctx, msg.LiquidityProvider, simpleswap.LiquidityProvider{
+			StableCoin: &types.Coin{
+				Denom:  liquidityProvider.StableCoin.Denom,
+				Amount: liquidityProvider.StableCoin.Amount.Sub(msg.Token.Amount),
+			},
+			PoolShare:           poolShare,
+			AccruedFees:         int64(0),
+			GloballyAccruedFees: accruedFeesGlobally,
+		}); err != nil {
+			return nil, err
+		}
+	} else {
+		// Remove the liquidity provider
+		if err := ms.k.LiquidityProviders.Remove(ctx, msg.LiquidityProvider); err != nil {
+			return nil, err
+		}
+	}
+
+	// Add the accrued fees to the output coin, converted from the pool decimals and rounded down in favour of the pool
+	feesPayout, err := ms.k.coinForValue(ctx, params, currentPoolState, msg.Token.Denom, math.NewInt(accruedFees), false)
+	if err != nil {
+		return nil, err
+	}
+	payout := msg.Token.Add(feesPayout)
+
+	// Transfer the stable coins from the module account to the liquidity provider
+	if err := ms.k.BankKeeper.SendCoinsFromModuleToAccount(ctx, simpleswap.ModuleName, addr, types.NewCoins(payout)); err != nil {
+		return nil, errorsmod.Wrapf(simpleswap.ErrPayout, "cannot send %s: %s", payout, err)
+	}
+
+	if err := ms.k.Hooks().AfterExitPool(ctx, addr, currentPoolState.Id, payout, sharesToBurn); err != nil {
+		return nil, err
+	}
+
+	return &simpleswap.MsgRemoveLiquidityResponse{
+		Withdrawn:    msg.Token,
+		Fees:         feesPayout,
+		SharesBurned: sharesToBurn,
+	}, nil
+}
+
+// CreateGauge is defining the handler for the MsgCreateGauge message.
+func (ms msgServer) CreateGauge(ctx context.Context, msg *simpleswap.MsgCreateGauge) (*simpleswap.MsgCreateGaugeResponse, error) {
+	// Check if the owner address is valid
+	owner, err := ms.k.addressCodec.StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, errorsmod.Wrapf(simpleswap.ErrInvalidAddress, "invalid owner address: %s", err)
+	}
+
+	// Check if the rewards are valid
+	if !msg.Coins.IsValid() || msg.Coins.IsZero() {
+		return nil, errorsmod.Wrapf(simpleswap.ErrCoinInvalid, "for the rewards: %s", msg.Coins)
+	}
+
+	if msg.NumEpochs == 0 {
+		return nil, simpleswap.ErrInvalidNumEpochs
+	}
+
+	// Check if the pool exists
+	pool, err := ms.k.initializedPool(ctx)
 	if err != nil {
-		return &simpleswap.MsgSwapLiquidityResponse{
-			StatusCode: 500,
-		}, fmt.Errorf("error in converting address to AccAddress: %w", err)
+		return nil, err
+	}
+
+	if pool.Id != msg.PoolId {
+		return nil, errorsmod.Wrapf(simpleswap.ErrInvalidPoolId, "for the pool id: %d", msg.PoolId)
 	}
 
-	// Check if the Liquidity Provider has the required input token
-	coins := ms.k.BankKeeper.SpendableCoin(ctx, addr, msg.Input.Denom)
-	if coins.Amount.LT(msg.Input.Amount) {
-		return &simpleswap.MsgSwapLiquidityResponse{
-			StatusCode: 409,
-		}, fmt.Errorf("error: %w for the denom: %s", simpleswap.ErrInsufficientLiquidity, msg.Input.Denom)
+	// Escrow the rewards in the module account
+	if err := ms.k.BankKeeper.SendCoinsFromAccountToModule(ctx, owner, simpleswap.ModuleName, msg.Coins); err != nil {
+		return nil, errorsmod.Wrapf(simpleswap.ErrInsufficientBalance, "cannot escrow %s: %s", msg.Coins, err)
 	}
 
-	// Check if the required output token is present in required quantity
-	coinsReserveOutputToken, err := ms.k.CoinsReserve.Get(ctx, msg.Output.Denom)
+	gaugeId, err := ms.k.GaugeSequence.Next(ctx)
 	if err != nil {
-		return &simpleswap.MsgSwapLiquidityResponse{
-			StatusCode: 500,
-		}, err
+		return nil, err
 	}
 
-	if coinsReserveOutputToken.Amount.LT(msg.Output.Amount) {
-		return &simpleswap.MsgSwapLiquidityResponse{
-			StatusCode: 409,
-		}, fmt.Errorf("error: %w for the denom: %s", simpleswap.ErrInsufficientLiquidity, msg.Output.Denom)
+	if err := ms.k.Gauges.Set(ctx, gaugeId, simpleswap.Gauge{
+		Id:               gaugeId,
+		PoolId:           msg.PoolId,
+		Owner:            msg.Owner,
+		Coins:            msg.Coins,
+		DistributedCoins: types.NewCoins(),
+		NumEpochs:        msg.NumEpochs,
+	}); err != nil {
+		return nil, err
 	}
 
-	// Transfer the input token from the trader to the module account
-	err = ms.k.BankKeeper.SendCoinsFromAccountToModule(ctx, addr, simpleswap.ModuleName, types.NewCoins(msg.Input))
+	return &simpleswap.MsgCreateGaugeResponse{GaugeId: gaugeId}, nil
+}
+
+// AddToGauge is defining the handler for the MsgAddToGauge message.
+func (ms msgServer) AddToGauge(ctx context.Context, msg *simpleswap.MsgAddToGauge) (*simpleswap.MsgAddToGaugeResponse, error) {
+	// Check if the owner address is valid
+	owner, err := ms.k.addressCodec.StringToBytes(msg.Owner)
 	if err != nil {
-		return &simpleswap.MsgSwapLiquidityResponse{
-			StatusCode: 500,
-		}, err
+		return nil, errorsmod.Wrapf(simpleswap.ErrInvalidAddress, "invalid owner address: %s", err)
 	}
 
-	// Get the coins reserve and Update the coins reserve for the input coins provided by the trader
-	coinsReserveInputToken, err := ms.k.CoinsReserve.Get(ctx, msg.Input.Denom)
+	// Check if the rewards are valid
+	if !msg.Coins.IsValid() || msg.Coins.IsZero() {
+		return nil, errorsmod.Wrapf(simpleswap.ErrCoinInvalid, "for the rewards: %s", msg.Coins)
+	}
+
+	gauge, err := ms.k.Gauges.Get(ctx, msg.GaugeId)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			coinsReserveInputToken = types.Coin{
-				Denom:  msg.Input.Denom,
-				Amount: math.ZeroInt(),
-			}
-		} else {
-			return &simpleswap.MsgSwapLiquidityResponse{
-				StatusCode: 500,
-			}, err
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(simpleswap.ErrGaugeNotFound, "for the gauge id: %d", msg.GaugeId)
 		}
+		return nil, err
 	}
 
-	coinsReserveOutputToken.Amount = coinsReserveOutputToken.Amount.Sub(msg.Output.Amount)
-	if err := ms.k.CoinsReserve.Set(ctx, msg.Output.Denom, coinsReserveOutputToken); err != nil {
-		return &simpleswap.MsgSwapLiquidityResponse{
-			StatusCode: 500,
-		}, err
+	// Rewards can only be added while the gauge is still distributing
+	if gauge.FilledEpochs >= gauge.NumEpochs {
+		return nil, errorsmod.Wrapf(simpleswap.ErrGaugeFinished, "for the gauge id: %d", msg.GaugeId)
 	}
 
-	// Calculate Fees and charge it from the output token
-	// TODO: Explain the below conversions and calculations
-	swapFeePercentage := currentPoolState.SwapFeePercentage
-	swapFee := msg.Output.Amount.Mul(math.NewInt(int64(swapFeePercentage))).Quo(math.NewInt(int64(maths.Pow10(int(currentPoolState.Decimals))) * int64(100)))
+	// Escrow the rewards in the module account
+	if err := ms.k.BankKeeper.SendCoinsFromAccountToModule(ctx, owner, simpleswap.ModuleName, msg.Coins); err != nil {
+		return nil, errorsmod.Wrapf(simpleswap.ErrInsufficientBalance, "cannot escrow %s: %s", msg.Coins, err)
+	}
+
+	gauge.Coins = gauge.Coins.Add(msg.Coins...)
+	if err := ms.k.Gauges.Set(ctx, gauge.Id, gauge); err != nil {
+		return nil, err
+	}
 
-	// Deduct the swap fee from the output token
-	msg.Output.Amount = msg.Output.Amount.Sub(swapFee)
+	return &simpleswap.MsgAddToGaugeResponse{}, nil
+}
 
-	// Update the Coins Reserve For Input Token
-	coinsReserveInputToken.Amount = coinsReserveInputToken.Amount.Add(msg.Input.Amount)
-	if err := ms.k.CoinsReserve.Set(ctx, msg.Input.Denom, coinsReserveInputToken); err != nil {
-		return &simpleswap.MsgSwapLiquidityResponse{
-			StatusCode: 500,
-		}, err
+// ClaimRewards is defining the handler for the MsgClaimRewards message.
+func (ms msgServer) ClaimRewards(ctx context.Context, msg *simpleswap.MsgClaimRewards) (*simpleswap.MsgClaimRewardsResponse, error) {
+	// Check if the owner address is valid
+	owner, err := ms.k.addressCodec.StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, errorsmod.Wrapf(simpleswap.ErrInvalidAddress, "invalid owner address: %s", err)
 	}
 
-	// Update the fees in the Pool
-	currentPoolState.TotalAccruedFees += swapFee.Int64()
-	if err := ms.k.Pool.Set(ctx, simpleswap.Pool{
-		TotalAccruedFees:  currentPoolState.TotalAccruedFees,
-		TotalLiquidity:    currentPoolState.TotalLiquidity,
-		Decimals:          currentPoolState.Decimals,
-		ShareToken:        currentPoolState.ShareToken,
-		SwapFeePercentage: currentPoolState.SwapFeePercentage,
-	}); err != nil {
-		return &simpleswap.MsgSwapLiquidityResponse{
-			StatusCode: 500,
-		}, err
+	acc, err := ms.k.rewardAccumulator(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Settle the rewards earned by the locks of the owner since its last settlement
+	rewards, err := ms.k.settledRewards(ctx, msg.Owner, acc)
+	if err != nil {
+		return nil, err
 	}
-	// Send the Required Token to the liquidity provider
-	if err := ms.k.BankKeeper.SendCoinsFromModuleToAccount(ctx, simpleswap.ModuleName, addr, types.NewCoins(msg.Output)); err != nil {
-		return &simpleswap.MsgSwapLiquidityResponse{
-			StatusCode: 500,
-		}, err
+
+	claimed := rewards.Coins
+	if claimed.IsZero() {
+		return nil, errorsmod.Wrapf(simpleswap.ErrNoRewards, "for the address: %s", msg.Owner)
+	}
+
+	rewards.Coins = types.NewCoins()
+	if err := ms.k.setRewards(ctx, msg.Owner, rewards); err != nil {
+		return nil, err
+	}
+
+	// Pay the rewards out of the module account
+	if err := ms.k.BankKeeper.SendCoinsFromModuleToAccount(ctx, simpleswap.ModuleName, owner, claimed); err != nil {
+		return nil, errorsmod.Wrapf(simpleswap.ErrPayout, "cannot send %s: %s", claimed, err)
 	}
 
-	return &simpleswap.MsgSwapLiquidityResponse{
-		StatusCode: 200,
-	}, err
+	return &simpleswap.MsgClaimRewardsResponse{Rewards: claimed}, nil
 }
 
-func (ms msgServer) RemoveLiquidity(ctx context.Context, msg *simpleswap.MsgRemoveLiquidity) (*simpleswap.MsgRemoveLiquidityResponse, error) {
-	// Check if the amount is zero
-	if msg.Token.Amount.Int64() == 0 {
-		return &simpleswap.MsgRemoveLiquidityResponse{
-			StatusCode: 400,
-		}, simpleswap.ErrZeroAmount
+// LockShares is defining the handler for the MsgLockShares message.
+func (ms msgServer) LockShares(ctx context.Context, msg *simpleswap.MsgLockShares) (*simpleswap.MsgLockSharesResponse, error) {
+	// Check if the owner address is valid
+	owner, err := ms.k.addressCodec.StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, errorsmod.Wrapf(simpleswap.ErrInvalidAddress, "invalid owner address: %s", err)
 	}
 
-	// Check if the liquidity provider address is valid
-	_, err := ms.k.addressCodec.StringToBytes(msg.LiquidityProvider)
+	pool, err := ms.k.initializedPool(ctx)
 	if err != nil {
-		return &simpleswap.MsgRemoveLiquidityResponse{
-			StatusCode: 401,
-		}, simpleswap.ErrInvalidProviderAddress
+		return nil, err
 	}
 
-	// Get the current pool state
-	currentPoolState, err := ms.k.Pool.Get(ctx)
+	// Only the pool shares can be locked
+	if !msg.Shares.IsValid() || !msg.Shares.IsPositive() || msg.Shares.Denom != pool.ShareToken.Denom {
+		return nil, errorsmod.Wrapf(simpleswap.ErrCoinInvalid, "for the shares: %s", msg.Shares)
+	}
+
+	params, err := ms.k.Params.Get(ctx)
 	if err != nil {
-		return &simpleswap.MsgRemoveLiquidityResponse{
-			StatusCode: 500,
-		}, err
+		return nil, err
 	}
 
-	// Get the liquidity provider
-	liquidityProvider, err := ms.k.LiquidityProviders.Get(ctx, msg.LiquidityProvider)
+	if _, ok := params.LockMultiplier(msg.Duration); !ok {
+		return nil, errorsmod.Wrapf(simpleswap.ErrInvalidLockDuration, "for the duration: %s", msg.Duration)
+	}
+
+	// Escrow the shares in the module account
+	if err := ms.k.BankKeeper.SendCoinsFromAccountToModule(ctx, owner, simpleswap.ModuleName, types.NewCoins(msg.Shares)); err != nil {
+		return nil, errorsmod.Wrapf(simpleswap.ErrInsufficientBalance, "cannot escrow %s: %s", msg.Shares, err)
+	}
+
+	lockId, err := ms.k.LockSequence.Next(ctx)
 	if err != nil {
-		return &simpleswap.MsgRemoveLiquidityResponse{
-			StatusCode: 500,
-		}, err
+		return nil, err
+	}
+
+	// The lock earns the gauge rewards by the weight of its duration until it is released
+	lock := simpleswap.PeriodLock{
+		Id:           lockId,
+		Owner:        msg.Owner,
+		Shares:       msg.Shares,
+		Duration:     msg.Duration,
+		RewardWeight: lockRewardWeight(params, msg.Shares, msg.Duration),
+	}
+	if err := ms.k.Locks.Set(ctx, lockId, lock); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.addRewardWeight(ctx, lock.Owner, lock.RewardWeight); err != nil {
+		return nil, err
 	}
 
-	// TODO: Add check for same denomination
+	return &simpleswap.MsgLockSharesResponse{LockId: lockId}, nil
+}
+
+// BeginUnlock is defining the handler for the MsgBeginUnlock message.
+func (ms msgServer) BeginUnlock(ctx context.Context, msg *simpleswap.MsgBeginUnlock) (*simpleswap.MsgBeginUnlockResponse, error) {
+	// Check if the owner address is valid
+	if _, err := ms.k.addressCodec.StringToBytes(msg.Owner); err != nil {
+		return nil, errorsmod.Wrapf(simpleswap.ErrInvalidAddress, "invalid owner address: %s", err)
+	}
 
-	// Convert the address to AccAddress
-	addr, err := types.AccAddressFromBech32(msg.LiquidityProvider)
+	lock, err := ms.k.Locks.Get(ctx, msg.LockId)
 	if err != nil {
-		return &simpleswap.MsgRemoveLiquidityResponse{
-			StatusCode: 500,
-		}, fmt.Errorf("error in converting address to AccAddress: %w", err)
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(simpleswap.ErrLockNotFound, "for the lock id: %d", msg.LockId)
+		}
+		return nil, err
 	}
 
-	// Check if the coins Reserve has the required amount of coins
-	coinsReserve, err := ms.k.CoinsReserve.Get(ctx, msg.Token.Denom)
+	if lock.Owner != msg.Owner {
+		return nil, errorsmod.Wrapf(simpleswap.ErrLockNotOwned, "for the lock id: %d", msg.LockId)
+	}
+
+	if !lock.EndTime.IsZero() {
+		return nil, errorsmod.Wrapf(simpleswap.ErrLockUnlocking, "for the lock id: %d", msg.LockId)
+	}
+
+	// The shares are released by the EndBlocker once the end time is reached
+	lock.EndTime = types.UnwrapSDKContext(ctx).BlockTime().Add(lock.Duration)
+	if err := ms.k.Locks.Set(ctx, lock.Id, lock); err != nil {
+		return nil, err
+	}
+
+	return &simpleswap.MsgBeginUnlockResponse{EndTime: lock.EndTime}, nil
+}
+
+// SetAssetRate is defining the handler for the MsgSetAssetRate message.
+func (ms msgServer) SetAssetRate(ctx context.Context, msg *simpleswap.MsgSetAssetRate) (*simpleswap.MsgSetAssetRateResponse, error) {
+	if _, err := ms.k.addressCodec.StringToBytes(msg.Authority); err != nil {
+		return nil, errorsmod.Wrapf(simpleswap.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	if authority := ms.k.GetAuthority(); !strings.EqualFold(msg.Authority, authority) {
+		return nil, errorsmod.Wrapf(simpleswap.ErrUnauthorized, "authority does not match the module's authority: got %s, want %s", msg.Authority, authority)
+	}
+
+	params, err := ms.k.Params.Get(ctx)
 	if err != nil {
-		return &simpleswap.MsgRemoveLiquidityResponse{
-			StatusCode: 500,
-		}, err
+		return nil, err
 	}
 
-	// Check if the liquidity provider has provided the requested amount of stable coins to the pool
-	if liquidityProvider.StableCoin.Amount.LT(msg.Token.Amount) {
-		return &simpleswap.MsgRemoveLiquidityResponse{
-			StatusCode: 409,
-		}, fmt.Errorf("error: %w for the denom: %s, with the User: %s", simpleswap.ErrInsufficientLiquidity, msg.Token.Denom, msg.LiquidityProvider)
+	if !params.IsWhitelisted(msg.Denom) {
+		return nil, errorsmod.Wrapf(simpleswap.ErrCoinInvalid, "for the denom: %s", msg.Denom)
 	}
 
-	// Calculate if the liquidity provider has received his share of the fees totally
-	accruedFeesGlobally := currentPoolState.GetTotalAccruedFees()
-	globallyAccruedFeesRecordedByUser := liquidityProvider.GloballyAccruedFees
+	if msg.Rate.IsNil() || !msg.Rate.IsPositive() {
+		return nil, errorsmod.Wrapf(simpleswap.ErrInvalidAssetRate, "for the denom: %s", msg.Denom)
+	}
 
-	// Calculate the fees and update the liquidity provider
-	// TODO: Explain the below calculations
-	if accruedFeesGlobally != globallyAccruedFeesRecordedByUser {
-		diff := accruedFeesGlobally - globallyAccruedFeesRecordedByUser
-		liquidityProvider.AccruedFees += (diff * liquidityProvider.PoolShare.Amount.Int64()) / currentPoolState.TotalLiquidity
+	// The rates of the bridged coins are set by their denom on this chain
+	denom := simpleswap.ResolveDenom(msg.Denom)
+	if err := ms.k.AssetRates.Set(ctx, denom, simpleswap.AssetRate{Denom: denom, Rate: msg.Rate}); err != nil {
+		return nil, err
 	}
 
-	// Calculate the accrued fees to the liquidity provider
-	accruedFees := liquidityProvider.AccruedFees
+	return &simpleswap.MsgSetAssetRateResponse{}, nil
+}
 
-	// Check if the coins Reserve has the required amount of coins
-	if coinsReserve.Amount.LT(msg.Token.Amount) {
-		return &simpleswap.MsgRemoveLiquidityResponse{
-			StatusCode: 409,
-		}, fmt.Errorf("error: %w for the denom: %s", simpleswap.ErrInsufficientLiquidity, msg.Token.Denom)
+// PlaceLimitOrder is defining the handler for the MsgPlaceLimitOrder message.
+func (ms msgServer) PlaceLimitOrder(ctx context.Context, msg *simpleswap.MsgPlaceLimitOrder) (*simpleswap.MsgPlaceLimitOrderResponse, error) {
+	// Check if the owner address is valid
+	owner, err := ms.k.addressCodec.StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, errorsmod.Wrapf(simpleswap.ErrInvalidAddress, "invalid owner address: %s", err)
 	}
 
-	// Update the coins reserve for the input coins provided by the liquidity provider
-	coinsReserve.Amount = coinsReserve.Amount.Sub(msg.Token.Amount)
+	params, err := ms.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	if err := ms.k.CoinsReserve.Set(ctx, coinsReserve.Denom, coinsReserve); err != nil {
-		return &simpleswap.MsgRemoveLiquidityResponse{
-			StatusCode: 500,
-		}, err
+	// Only the whitelisted coins of the pool can be swapped, the output can be given by its trace
+	outputDenom := simpleswap.ResolveDenom(msg.OutputDenom)
+	if !msg.Input.IsValid() || !msg.Input.IsPositive() || !params.IsWhitelisted(msg.Input.Denom) {
+		return nil, errorsmod.Wrapf(simpleswap.ErrCoinInvalid, "for the input: %s", msg.Input)
 	}
 
-	// Update the pool
-	if err := ms.k.Pool.Set(ctx, simpleswap.Pool{
-		TotalAccruedFees:  currentPoolState.TotalAccruedFees,
-		TotalLiquidity:    currentPoolState.TotalLiquidity - msg.Token.Amount.Int64(),
-		Decimals:          currentPoolState.Decimals,
-		ShareToken:        currentPoolState.ShareToken,
-		SwapFeePercentage: currentPoolState.SwapFeePercentage,
-	}); err != nil {
-		return &simpleswap.MsgRemoveLiquidityResponse{
-			StatusCode: 500,
-		}, err
+	if !params.IsWhitelisted(outputDenom) || outputDenom == msg.Input.Denom {
+		return nil, errorsmod.Wrapf(simpleswap.ErrCoinInvalid, "for the denom: %s", msg.OutputDenom)
 	}
 
-	// Get the pool share for the LP
-	poolShare := liquidityProvider.PoolShare
+	if msg.LimitPrice.IsNil() || !msg.LimitPrice.IsPositive() {
+		return nil, errorsmod.Wrapf(simpleswap.ErrInvalidLimitPrice, "got: %s", msg.LimitPrice)
+	}
 
-	// Transfer LP coins from LP to Module Accounts inorder to burn them
-	err = ms.k.BankKeeper.SendCoinsFromAccountToModule(ctx, addr, simpleswap.ModuleName, types.NewCoins(types.NewCoin(poolShare.Denom, poolShare.Amount.Sub(msg.Token.Amount))))
+	// Escrow the input in the module account
+	if err := ms.k.BankKeeper.SendCoinsFromAccountToModule(ctx, owner, simpleswap.ModuleName, types.NewCoins(msg.Input)); err != nil {
+		return nil, errorsmod.Wrapf(simpleswap.ErrInsufficientBalance, "cannot escrow %s: %s", msg.Input, err)
+	}
+
+	orderId, err := ms.k.LimitOrderSequence.Next(ctx)
 	if err != nil {
-		return &simpleswap.MsgRemoveLiquidityResponse{
-			StatusCode: 500,
-		}, err
+		return nil, err
 	}
 
-	// Burn the share token	from the liquidity provider
-	err = ms.k.BankKeeper.BurnCoins(ctx, simpleswap.ModuleName, types.NewCoins(types.NewCoin(poolShare.Denom, poolShare.Amount.Sub(msg.Token.Amount))))
+	// The order is filled by the EndBlocker once the pool pays the limit price
+	if err := ms.k.LimitOrders.Set(ctx, orderId, simpleswap.LimitOrder{
+		Id:          orderId,
+		Owner:       msg.Owner,
+		Input:       msg.Input,
+		OutputDenom: outputDenom,
+		LimitPrice:  msg.LimitPrice,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &simpleswap.MsgPlaceLimitOrderResponse{OrderId: orderId}, nil
+}
+
+// CancelLimitOrder is defining the handler for the MsgCancelLimitOrder message.
+func (ms msgServer) CancelLimitOrder(ctx context.Context, msg *simpleswap.MsgCancelLimitOrder) (*simpleswap.MsgCancelLimitOrderResponse, error) {
+	// Check if the owner address is valid
+	owner, err := ms.k.addressCodec.StringToBytes(msg.Owner)
 	if err != nil {
-		return &simpleswap.MsgRemoveLiquidityResponse{
-			StatusCode: 500,
-		}, err
+		return nil, errorsmod.Wrapf(simpleswap.ErrInvalidAddress, "invalid owner address: %s", err)
 	}
 
-	// We check if the liquidity provider is removing all of its liquidity
-	// If not we update the liquidity provider
-	// If yes we remove the liquidity provider from the store
-	if msg.Token.Amount.LT(liquidityProvider.StableCoin.Amount) {
-		if err := ms.k.LiquidityProviders.Set(ctx, msg.LiquidityProvider, simpleswap.LiquidityProvider{
-			StableCoin: &types.Coin{
-				Denom:  msg.Token.Denom,
-				Amount: liquidityProvider.StableCoin.Amount.Sub(msg.Token.Amount),
-			},
-			PoolShare:           poolShare,
-			AccruedFees:         int64(0),
-			GloballyAccruedFees: accruedFeesGlobally,
-		}); err != nil {
-			return &simpleswap.MsgRemoveLiquidityResponse{
-				StatusCode: 500,
-			}, err
+	order, err := ms.k.LimitOrders.Get(ctx, msg.OrderId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(simpleswap.ErrLimitOrderNotFound, "for the order id: %d", msg.OrderId)
 		}
-	} else {
-		// Remove the liquidity provider
-		if err := ms.k.LiquidityProviders.Remove(ctx, msg.LiquidityProvider); err != nil {
-			return &simpleswap.MsgRemoveLiquidityResponse{
-				StatusCode: 500,
-			}, err
+		return nil, err
+	}
+
+	if order.Owner != msg.Owner {
+		return nil, errorsmod.Wrapf(simpleswap.ErrLimitOrderNotOwned, "for the order id: %d", msg.OrderId)
+	}
+
+	if err := ms.k.LimitOrders.Remove(ctx, order.Id); err != nil {
+		return nil, err
+	}
+
+	// Refund the escrowed input
+	if err := ms.k.BankKeeper.SendCoinsFromModuleToAccount(ctx, simpleswap.ModuleName, owner, types.NewCoins(order.Input)); err != nil {
+		return nil, errorsmod.Wrapf(simpleswap.ErrPayout, "cannot send %s: %s", order.Input, err)
+	}
+
+	return &simpleswap.MsgCancelLimitOrderResponse{Refund: order.Input}, nil
+}
+
+// CreateDCAPlan is defining the handler for the MsgCreateDCAPlan message.
+func (ms msgServer) CreateDCAPlan(ctx context.Context, msg *simpleswap.MsgCreateDCAPlan) (*simpleswap.MsgCreateDCAPlanResponse, error) {
+	// Check if the owner address is valid
+	owner, err := ms.k.addressCodec.StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, errorsmod.Wrapf(simpleswap.ErrInvalidAddress, "invalid owner address: %s", err)
+	}
+
+	params, err := ms.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the whitelisted coins of the pool can be swapped, the output can be given by its trace
+	outputDenom := simpleswap.ResolveDenom(msg.OutputDenom)
+	if !msg.Total.IsValid() || !msg.Total.IsPositive() || !params.IsWhitelisted(msg.Total.Denom) {
+		return nil, errorsmod.Wrapf(simpleswap.ErrCoinInvalid, "for the total: %s", msg.Total)
+	}
+
+	if !params.IsWhitelisted(outputDenom) || outputDenom == msg.Total.Denom {
+		return nil, errorsmod.Wrapf(simpleswap.ErrCoinInvalid, "for the denom: %s", msg.OutputDenom)
+	}
+
+	if msg.SliceAmount.IsNil() || !msg.SliceAmount.IsPositive() || msg.SliceAmount.GT(msg.Total.Amount) {
+		return nil, errorsmod.Wrapf(simpleswap.ErrInvalidDCAPlan, "the slice amount must be positive and at most the total, got: %s", msg.SliceAmount)
+	}
+
+	if msg.IntervalBlocks == 0 {
+		return nil, errorsmod.Wrap(simpleswap.ErrInvalidDCAPlan, "the interval must be at least one block")
+	}
+
+	if msg.MinOutputPerSlice.IsNil() || msg.MinOutputPerSlice.IsNegative() {
+		return nil, errorsmod.Wrap(simpleswap.ErrInvalidDCAPlan, "the minimum output per slice must not be negative")
+	}
+
+	// Escrow the total input in the module account
+	if err := ms.k.BankKeeper.SendCoinsFromAccountToModule(ctx, owner, simpleswap.ModuleName, types.NewCoins(msg.Total)); err != nil {
+		return nil, errorsmod.Wrapf(simpleswap.ErrInsufficientBalance, "cannot escrow %s: %s", msg.Total, err)
+	}
+
+	planId, err := ms.k.DCAPlanSequence.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The slices are executed by the EndBlocker, starting with the current block
+	if err := ms.k.DCAPlans.Set(ctx, planId, simpleswap.DCAPlan{
+		Id:                  planId,
+		Owner:               msg.Owner,
+		Total:               msg.Total,
+		Remaining:           msg.Total,
+		SliceAmount:         msg.SliceAmount,
+		OutputDenom:         outputDenom,
+		MinOutputPerSlice:   msg.MinOutputPerSlice,
+		IntervalBlocks:      msg.IntervalBlocks,
+		NextExecutionHeight: types.UnwrapSDKContext(ctx).BlockHeight(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &simpleswap.MsgCreateDCAPlanResponse{PlanId: planId}, nil
+}
+
+// CancelDCAPlan is defining the handler for the MsgCancelDCAPlan message.
+func (ms msgServer) CancelDCAPlan(ctx context.Context, msg *simpleswap.MsgCancelDCAPlan) (*simpleswap.MsgCancelDCAPlanResponse, error) {
+	// Check if the owner address is valid
+	owner, err := ms.k.addressCodec.StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, errorsmod.Wrapf(simpleswap.ErrInvalidAddress, "invalid owner address: %s", err)
+	}
+
+	plan, err := ms.k.DCAPlans.Get(ctx, msg.PlanId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(simpleswap.ErrDCAPlanNotFound, "for the plan id: %d", msg.PlanId)
 		}
+		return nil, err
 	}
-	
-	// Add the accrued fees to the output coin
-	msg.Token.Amount = msg.Token.Amount.Add(math.NewInt(accruedFees))
 
-	// Transfer the stable coins from the module account to the liquidity provider
-	err = ms.k.BankKeeper.SendCoinsFromModuleToAccount(ctx, simpleswap.ModuleName, addr, types.NewCoins(msg.Token))
+	if plan.Owner != msg.Owner {
+		return nil, errorsmod.Wrapf(simpleswap.ErrDCAPlanNotOwned, "for the plan id: %d", msg.PlanId)
+	}
+
+	if err := ms.k.DCAPlans.Remove(ctx, plan.Id); err != nil {
+		return nil, err
+	}
+
+	// Refund the input not yet swapped
+	if err := ms.k.BankKeeper.SendCoinsFromModuleToAccount(ctx, simpleswap.ModuleName, owner, types.NewCoins(plan.Remaining)); err != nil {
+		return nil, errorsmod.Wrapf(simpleswap.ErrPayout, "cannot send %s: %s", plan.Remaining, err)
+	}
+
+	return &simpleswap.MsgCancelDCAPlanResponse{Refund: plan.Remaining}, nil
+}
+
+// FlashSwap is defining the handler for the MsgFlashSwap message.
+func (ms msgServer) FlashSwap(ctx context.Context, msg *simpleswap.MsgFlashSwap) (*simpleswap.MsgFlashSwapResponse, error) {
+	// Check if the trader address is valid
+	trader, err := ms.k.addressCodec.StringToBytes(msg.Trader)
 	if err != nil {
-		return &simpleswap.MsgRemoveLiquidityResponse{
-			StatusCode: 500,
-		}, err
+		return nil, errorsmod.Wrapf(simpleswap.ErrInvalidAddress, "invalid trader address: %s", err)
 	}
 
-	return &simpleswap.MsgRemoveLiquidityResponse{
-		StatusCode: 200,
+	sdkCtx := types.UnwrapSDKContext(ctx)
+	if sdkCtx.Value(flashSwapContextKey{}) != nil {
+		return nil, simpleswap.ErrFlashSwapReentrant
+	}
+
+	params, err := ms.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := ms.k.initializedPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the whitelisted coins of the pool can be borrowed and repaid, the input can be given by its trace
+	inputDenom := simpleswap.ResolveDenom(msg.InputDenom)
+	if !msg.Output.IsValid() || !msg.Output.IsPositive() || !params.IsWhitelisted(msg.Output.Denom) {
+		return nil, errorsmod.Wrapf(simpleswap.ErrCoinInvalid, "for the output: %s", msg.Output)
+	}
+
+	if !params.IsWhitelisted(inputDenom) || inputDenom == msg.Output.Denom {
+		return nil, errorsmod.Wrapf(simpleswap.ErrCoinInvalid, "for the denom: %s", msg.InputDenom)
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	surchargeRate, err := ms.k.oracleSurcharge(ctx, params, inputDenom, msg.Output.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	// The output is valued in favour of the pool, the repayment is worth its value plus the flash fee
+	value, err := ms.k.liquidityValue(ctx, params, pool, msg.Output, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if value.IsZero() {
+		return nil, simpleswap.ErrZeroAmount
+	}
+
+	principal, fee, err := ms.k.flashSwapRepayment(ctx, params, pool, inputDenom, value, surchargeRate)
+	if err != nil {
+		return nil, err
+	}
+
+	// Send the output out of the reserves before the nested messages
+	outputReserve, err := ms.k.CoinsReserve.Get(ctx, msg.Output.Denom)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	if err != nil || outputReserve.Amount.LT(msg.Output.Amount) {
+		return nil, errorsmod.Wrapf(simpleswap.ErrInsufficientReserve, "for the denom: %s", msg.Output.Denom)
+	}
+
+	if err := ms.k.CoinsReserve.Set(ctx, msg.Output.Denom, outputReserve.Sub(msg.Output)); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.BankKeeper.SendCoinsFromModuleToAccount(ctx, simpleswap.ModuleName, trader, types.NewCoins(msg.Output)); err != nil {
+		return nil, errorsmod.Wrapf(simpleswap.ErrPayout, "cannot send %s: %s", msg.Output, err)
+	}
+
+	msgResponses, err := ms.k.executeFlashSwapMsgs(sdkCtx, trader, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Collect the repayment, the transaction reverts if the trader cannot pay it
+	repaid := principal.Add(fee)
+	if err := ms.k.BankKeeper.SendCoinsFromAccountToModule(ctx, trader, simpleswap.ModuleName, types.NewCoins(repaid)); err != nil {
+		return nil, errorsmod.Wrapf(simpleswap.ErrFlashSwapNotRepaid, "owed: %s: %s", repaid, err)
+	}
+
+	// The principal is added to the reserves, the flash fee accrues to the liquidity providers
+	inputReserve, err := ms.k.CoinsReserve.Get(ctx, inputDenom)
+	if errors.Is(err, collections.ErrNotFound) {
+		inputReserve = types.NewCoin(inputDenom, math.ZeroInt())
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.CoinsReserve.Set(ctx, inputDenom, inputReserve.Add(principal)); err != nil {
+		return nil, err
+	}
+
+	feeValue, err := ms.k.liquidityValue(ctx, params, pool, fee, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Read the pool again, the nested messages may have swapped
+	pool, err = ms.k.initializedPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pool.TotalAccruedFees += feeValue.Int64()
+	if err := ms.k.Pool.Set(ctx, pool); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.recordSwapStats(ctx, inputDenom, msg.Output.Denom, value, feeValue); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.Hooks().AfterSwap(ctx, trader, pool.Id, repaid, msg.Output); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(types.NewEvent(
+		simpleswap.EventTypeFlashSwap,
+		types.NewAttribute(simpleswap.AttributeKeyTrader, msg.Trader),
+		types.NewAttribute(simpleswap.AttributeKeyOutput, msg.Output.String()),
+		types.NewAttribute(simpleswap.AttributeKeyInput, repaid.String()),
+		types.NewAttribute(simpleswap.AttributeKeyFee, fee.String()),
+	))
+
+	return &simpleswap.MsgFlashSwapResponse{
+		Repaid:       repaid,
+		MsgResponses: msgResponses,
 	}, nil
 }
@@ -4,9 +4,12 @@ import (
 	"fmt"
 	"testing"
 
+	errorsmod "cosmossdk.io/errors"
 	math "cosmossdk.io/math"
 	types "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/simpleswap"
+	simpleswapKeeper "github.com/cosmos/simpleswap/keeper"
 	// expectedkeepers "github.com/cosmos/simpleswap/expected_keepers"
 	// "github.com/golang/mock/gomock"
 )
@@ -31,7 +34,7 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 			request: &simpleswap.MsgUpdateParams{
 				Authority: s.addrs[1].String(),
 			},
-			expectErrMsg: fmt.Sprintf("unauthorized, authority does not match the module's authority: got %s, want %s", s.addrs[1].String(), s.simpleSwapKeeper.GetAuthority()),
+			expectErrMsg: fmt.Sprintf("authority does not match the module's authority: got %s, want %s", s.addrs[1].String(), s.simpleSwapKeeper.GetAuthority()),
 		},
 		{
 			name: "set valid params",
@@ -49,12 +52,39 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 						},
 					},
 					Decimals:          6,
-					ShareToken:        &types.Coin{Denom: "LP", Amount: math.NewInt(100)},
 					SwapFeePercentage: 3,
 				},
 			},
 			expectErrMsg: "",
 		},
+		{
+			name: "set a fee swap denom not whitelisted",
+			request: &simpleswap.MsgUpdateParams{
+				Authority: s.simpleSwapKeeper.GetAuthority(),
+				Params: simpleswap.Params{
+					WhitelistedCoins:   []*types.Coin{{Denom: "ETH", Amount: math.ZeroInt()}},
+					Decimals:           6,
+					SwapFeePercentage:  3,
+					FeeSwapDenoms:      []string{"WETH"},
+					FeeSwapMaxSlippage: math.LegacyNewDecWithPrec(1, 2),
+				},
+			},
+			expectErrMsg: simpleswap.ErrInvalidFeeSwapDenom.Error(),
+		},
+		{
+			name: "set a fee swap max slippage of 100%",
+			request: &simpleswap.MsgUpdateParams{
+				Authority: s.simpleSwapKeeper.GetAuthority(),
+				Params: simpleswap.Params{
+					WhitelistedCoins:   []*types.Coin{{Denom: "ETH", Amount: math.ZeroInt()}},
+					Decimals:           6,
+					SwapFeePercentage:  3,
+					FeeSwapDenoms:      []string{"ETH"},
+					FeeSwapMaxSlippage: math.LegacyOneDec(),
+				},
+			},
+			expectErrMsg: simpleswap.ErrInvalidFeeSwapSlippage.Error(),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -98,32 +128,68 @@ func (s *KeeperTestSuite) TestAddLiquidity() {
 	t.Run("add liquidity with valid provider address first time", func(t *testing.T) {
 		require := s.Require()
 
-		
+		s.initGenesis(simpleswap.DefaultParams())
+		shareDenom := simpleswap.PoolShareDenom(simpleswap.DefaultPoolId)
+
+		s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, s.addrs[1], simpleswap.ModuleName, types.NewCoins(types.Coin{Denom: "ETH", Amount: math.NewInt(100)})).Return(nil).Times(1)
+		s.bankKeeper.EXPECT().MintCoins(s.ctx, simpleswap.ModuleName, types.NewCoins(types.Coin{Denom: shareDenom, Amount: math.NewInt(100)})).Return(nil).Times(1)
+		s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(s.ctx, simpleswap.ModuleName, s.addrs[1], types.NewCoins(types.Coin{Denom: shareDenom, Amount: math.NewInt(100)})).Return(nil).Times(1)
+
 		response, err := s.msgServer.AddLiquidity(s.ctx, &simpleswap.MsgAddLiquidity{
 			LiquidityProvider: s.addrs[1].String(),
 			Token:             types.Coin{Denom: "ETH", Amount: math.NewInt(100)},
 		})
 		require.NoError(err)
-		require.Equal(&simpleswap.MsgAddLiquidityResponse{StatusCode: 200}, response)
-		s.bankKeeper.EXPECT().MintCoins(s.ctx, simpleswap.ModuleName, types.NewCoins(types.Coin{Denom: "LP", Amount: math.NewInt(100)})).Return(nil).Times(1)
-		s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, s.addrs[1], simpleswap.ModuleName, types.NewCoins(types.Coin{Denom: "ETH", Amount: math.NewInt(100)})).Return(nil).Times(1)
+		require.Equal(&simpleswap.MsgAddLiquidityResponse{
+			Deposited:    types.NewInt64Coin("ETH", 100),
+			SharesMinted: types.NewInt64Coin(shareDenom, 100),
+		}, response)
 
 		lp, err := s.simpleSwapKeeper.LiquidityProviders.Get(s.ctx, s.addrs[1].String())
 		require.NoError(err)
 		require.Equal("ETH", lp.StableCoin.Denom)
 		require.Equal(math.NewInt(100), lp.StableCoin.Amount)
-		require.Equal("LP", lp.PoolShare.Denom)
+		require.Equal(shareDenom, lp.PoolShare.Denom)
 		require.Equal(math.NewInt(100), lp.PoolShare.Amount)
 
 		pool, err := s.simpleSwapKeeper.Pool.Get(s.ctx)
 		require.NoError(err)
-		require.Equal(100, pool.TotalLiquidity)
+		require.Equal(int64(100), pool.TotalLiquidity)
+		require.Equal(types.NewCoin(shareDenom, math.NewInt(100)), *pool.ShareToken)
 
 		coinsReserve, err := s.simpleSwapKeeper.CoinsReserve.Get(s.ctx, "ETH")
 		require.NoError(err)
 		require.Equal(math.NewInt(100), coinsReserve.Amount)
 	})
 
+	t.Run("add liquidity to a pool without liquidity but with accrued fees", func(t *testing.T) {
+		require := s.Require()
+
+		s.initGenesis(simpleswap.DefaultParams())
+		shareDenom := simpleswap.PoolShareDenom(simpleswap.DefaultPoolId)
+
+		// The fees of the swaps made after the last liquidity was removed are not owed to a new provider
+		pool, err := s.simpleSwapKeeper.Pool.Get(s.ctx)
+		require.NoError(err)
+		pool.TotalAccruedFees = 10
+		require.NoError(s.simpleSwapKeeper.Pool.Set(s.ctx, pool))
+
+		s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, s.addrs[2], simpleswap.ModuleName, types.NewCoins(types.NewInt64Coin("ETH", 100))).Return(nil).Times(1)
+		s.bankKeeper.EXPECT().MintCoins(s.ctx, simpleswap.ModuleName, types.NewCoins(types.NewInt64Coin(shareDenom, 100))).Return(nil).Times(1)
+		s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(s.ctx, simpleswap.ModuleName, s.addrs[2], types.NewCoins(types.NewInt64Coin(shareDenom, 100))).Return(nil).Times(1)
+
+		_, err = s.msgServer.AddLiquidity(s.ctx, &simpleswap.MsgAddLiquidity{
+			LiquidityProvider: s.addrs[2].String(),
+			Token:             types.NewInt64Coin("ETH", 100),
+		})
+		require.NoError(err)
+
+		lp, err := s.simpleSwapKeeper.LiquidityProviders.Get(s.ctx, s.addrs[2].String())
+		require.NoError(err)
+		require.Zero(lp.AccruedFees)
+		require.Equal(int64(10), lp.GloballyAccruedFees)
+	})
+
 	// t.Run("add liquidity with valid provider address second time", func(t *testing.T) {
 	// 	require := s.Require()
 
@@ -153,4 +219,503 @@ func (s *KeeperTestSuite) TestAddLiquidity() {
 	// })
 }
 
+func (s *KeeperTestSuite) TestRemoveLiquidityBurnsShares() {
+	require := s.Require()
+	lp := s.addrs[1]
+	shareDenom := simpleswap.PoolShareDenom(simpleswap.DefaultPoolId)
+
+	s.initGenesis(simpleswap.DefaultParams())
+	s.setLiquidityProvider(lp, 100)
+	require.NoError(s.simpleSwapKeeper.CoinsReserve.Set(s.ctx, "ETH", types.NewCoin("ETH", math.NewInt(100))))
+	pool, err := s.simpleSwapKeeper.Pool.Get(s.ctx)
+	require.NoError(err)
+	pool.TotalLiquidity = 100
+	pool.ShareToken.Amount = math.NewInt(100)
+	require.NoError(s.simpleSwapKeeper.Pool.Set(s.ctx, pool))
+
+	// Removing 40 of the liquidity burns 40 shares
+	burned := types.NewCoins(types.NewCoin(shareDenom, math.NewInt(40)))
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, lp, simpleswap.ModuleName, burned).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().BurnCoins(s.ctx, simpleswap.ModuleName, burned).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(s.ctx, simpleswap.ModuleName, lp, types.NewCoins(types.NewCoin("ETH", math.NewInt(40)))).Return(nil).Times(1)
+
+	resp, err := s.msgServer.RemoveLiquidity(s.ctx, &simpleswap.MsgRemoveLiquidity{
+		LiquidityProvider: lp.String(),
+		Token:             types.NewCoin("ETH", math.NewInt(40)),
+	})
+	require.NoError(err)
+	require.Equal(&simpleswap.MsgRemoveLiquidityResponse{
+		Withdrawn:    types.NewInt64Coin("ETH", 40),
+		Fees:         types.NewInt64Coin("ETH", 0),
+		SharesBurned: types.NewInt64Coin(shareDenom, 40),
+	}, resp)
+
+	provider, err := s.simpleSwapKeeper.LiquidityProviders.Get(s.ctx, lp.String())
+	require.NoError(err)
+	require.Equal(math.NewInt(60), provider.PoolShare.Amount)
+
+	pool, err = s.simpleSwapKeeper.Pool.Get(s.ctx)
+	require.NoError(err)
+	require.Equal(types.NewCoin(shareDenom, math.NewInt(60)), *pool.ShareToken)
+}
+
+func (s *KeeperTestSuite) TestLiquidityAcrossDenoms() {
+	require := s.Require()
+	lp := s.addrs[1]
+	shareDenom := simpleswap.PoolShareDenom(simpleswap.DefaultPoolId)
+
+	// 1 ETH is 10^18 units, 1 WETH is 10^8, both are worth 10^6 in the pool decimals
+	params := simpleswap.DefaultParams()
+	params.AssetExponents = []simpleswap.AssetExponent{{Denom: "ETH", Exponent: 18}, {Denom: "WETH", Exponent: 8}}
+	s.initGenesis(params)
+
+	eth := types.NewCoin("ETH", math.NewIntWithDecimal(1, 18))
+	shares := types.NewCoins(types.NewInt64Coin(shareDenom, 1_000_000))
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, lp, simpleswap.ModuleName, types.NewCoins(eth)).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().MintCoins(s.ctx, simpleswap.ModuleName, shares).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(s.ctx, simpleswap.ModuleName, lp, shares).Return(nil).Times(1)
+	_, err := s.msgServer.AddLiquidity(s.ctx, &simpleswap.MsgAddLiquidity{LiquidityProvider: lp.String(), Token: eth})
+	require.NoError(err)
+
+	// The 10^18 units of ETH provided cannot be withdrawn as 10^18 units, 10^10 WETH, of WETH
+	require.NoError(s.simpleSwapKeeper.CoinsReserve.Set(s.ctx, "WETH", types.NewCoin("WETH", math.NewIntWithDecimal(1, 18))))
+	_, err = s.msgServer.RemoveLiquidity(s.ctx, &simpleswap.MsgRemoveLiquidity{
+		LiquidityProvider: lp.String(),
+		Token:             types.NewCoin("WETH", math.NewIntWithDecimal(1, 18)),
+	})
+	require.ErrorIs(err, simpleswap.ErrDenomMismatch)
+
+	// Nor can WETH be added to the ETH position
+	_, err = s.msgServer.AddLiquidity(s.ctx, &simpleswap.MsgAddLiquidity{LiquidityProvider: lp.String(), Token: types.NewInt64Coin("WETH", 100_000_000)})
+	require.ErrorIs(err, simpleswap.ErrDenomMismatch)
+
+	// A partial removal keeps the denom of the position
+	half := types.NewCoin("ETH", math.NewIntWithDecimal(5, 17))
+	burned := types.NewCoins(types.NewInt64Coin(shareDenom, 500_000))
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, lp, simpleswap.ModuleName, burned).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().BurnCoins(s.ctx, simpleswap.ModuleName, burned).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(s.ctx, simpleswap.ModuleName, lp, types.NewCoins(half)).Return(nil).Times(1)
+	_, err = s.msgServer.RemoveLiquidity(s.ctx, &simpleswap.MsgRemoveLiquidity{LiquidityProvider: lp.String(), Token: half})
+	require.NoError(err)
+
+	provider, err := s.simpleSwapKeeper.LiquidityProviders.Get(s.ctx, lp.String())
+	require.NoError(err)
+	require.Equal(half, *provider.StableCoin)
+}
+
+func (s *KeeperTestSuite) TestPendingFeesLargeAmounts() {
+	// The product of the fees and the shares overflows an int64, their ratio does not
+	shares := types.NewCoin(simpleswap.PoolShareDenom(simpleswap.DefaultPoolId), math.NewInt(10_000_000_000_000))
+	pool := simpleswap.Pool{TotalAccruedFees: 1_000_000_000_000, TotalLiquidity: 20_000_000_000_000, ShareToken: &shares}
+	provider := simpleswap.LiquidityProvider{PoolShare: &shares, AccruedFees: 7}
+
+	s.Require().Equal(int64(500_000_000_007), simpleswapKeeper.PendingFees(pool, provider))
+}
+
+func (s *KeeperTestSuite) TestDecimalNormalization() {
+	require := s.Require()
+	trader := s.addrs[1]
+
+	params := simpleswap.DefaultParams()
+	params.AssetExponents = []simpleswap.AssetExponent{{Denom: "ETH", Exponent: 18}, {Denom: "WETH", Exponent: 8}}
+	s.initGenesis(params)
+
+	// 1 ETH and a few wei of dust are worth 1 WETH in the pool decimals, the dust is kept by the pool
+	input := types.NewCoin("ETH", math.NewIntWithDecimal(1, 18).AddRaw(123))
+	shareDenom := simpleswap.PoolShareDenom(simpleswap.DefaultPoolId)
+	shares := types.NewCoins(types.NewCoin(shareDenom, math.NewInt(1_000_000)))
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, trader, simpleswap.ModuleName, types.NewCoins(input)).Return(nil).Times(2)
+	s.bankKeeper.EXPECT().MintCoins(s.ctx, simpleswap.ModuleName, shares).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(s.ctx, simpleswap.ModuleName, trader, shares).Return(nil).Times(1)
+
+	_, err := s.msgServer.AddLiquidity(s.ctx, &simpleswap.MsgAddLiquidity{LiquidityProvider: trader.String(), Token: input})
+	require.NoError(err)
+
+	pool, err := s.simpleSwapKeeper.Pool.Get(s.ctx)
+	require.NoError(err)
+	require.Equal(int64(1_000_000), pool.TotalLiquidity)
+
+	// The output must be worth the input in the pool decimals
+	require.NoError(s.simpleSwapKeeper.CoinsReserve.Set(s.ctx, "WETH", types.NewCoin("WETH", math.NewInt(200_000_000))))
+	s.bankKeeper.EXPECT().SpendableCoin(s.ctx, trader, "ETH").Return(input).AnyTimes()
+	_, err = s.msgServer.SwapLiquidity(s.ctx, &simpleswap.MsgSwapLiquidity{
+		Trader: trader.String(),
+		Input:  input,
+		Output: types.NewCoin("WETH", math.NewInt(100_000_001)),
+	})
+	require.ErrorIs(err, simpleswap.ErrAmountNotEqual)
+
+	// The fee of 300 in the pool decimals is rounded up and the payout is converted back to WETH
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(s.ctx, simpleswap.ModuleName, trader, types.NewCoins(types.NewCoin("WETH", math.NewInt(99_970_000)))).Return(nil).Times(1)
+	_, err = s.msgServer.SwapLiquidity(s.ctx, &simpleswap.MsgSwapLiquidity{
+		Trader: trader.String(),
+		Input:  input,
+		Output: types.NewCoin("WETH", math.NewInt(100_000_000)),
+	})
+	require.NoError(err)
+
+	pool, err = s.simpleSwapKeeper.Pool.Get(s.ctx)
+	require.NoError(err)
+	require.Equal(int64(300), pool.TotalAccruedFees)
+
+	// Exponents must be declared once for whitelisted coins
+	params.AssetExponents = append(params.AssetExponents, simpleswap.AssetExponent{Denom: "ETH", Exponent: 6})
+	require.ErrorIs(params.Validate(), simpleswap.ErrInvalidAssetExponent)
+}
+
+func (s *KeeperTestSuite) TestSwapLiquidityOracleGuard() {
+	testCases := []struct {
+		name          string
+		mode          simpleswap.OracleGuardMode
+		inputPrice    math.LegacyDec
+		outputPrice   math.LegacyDec
+		expectErr     error
+		expectedFees  int64
+		expectedOut   math.Int
+		noOracleCalls bool
+	}{
+		{
+			name:          "guard disabled",
+			mode:          simpleswap.ORACLE_GUARD_MODE_DISABLED,
+			expectedFees:  1,
+			expectedOut:   math.NewInt(999),
+			noOracleCalls: true,
+		},
+		{
+			name:         "ratio within bound",
+			mode:         simpleswap.ORACLE_GUARD_MODE_REJECT,
+			inputPrice:   math.LegacyMustNewDecFromStr("1.01"),
+			outputPrice:  math.LegacyOneDec(),
+			expectedFees: 1,
+			expectedOut:  math.NewInt(999),
+		},
+		{
+			name:        "ratio exceeds bound in favour of the trader in reject mode",
+			mode:        simpleswap.ORACLE_GUARD_MODE_REJECT,
+			inputPrice:  math.LegacyMustNewDecFromStr("0.9"),
+			outputPrice: math.LegacyOneDec(),
+			expectErr:   simpleswap.ErrOraclePriceDeviation,
+		},
+		{
+			name:         "ratio exceeds bound in favour of the trader in surcharge mode",
+			mode:         simpleswap.ORACLE_GUARD_MODE_SURCHARGE,
+			inputPrice:   math.LegacyMustNewDecFromStr("0.9"),
+			outputPrice:  math.LegacyOneDec(),
+			expectedFees: 101,
+			expectedOut:  math.NewInt(899),
+		},
+		{
+			name:         "ratio exceeds bound in favour of the pool in reject mode",
+			mode:         simpleswap.ORACLE_GUARD_MODE_REJECT,
+			inputPrice:   math.LegacyMustNewDecFromStr("1.2"),
+			outputPrice:  math.LegacyOneDec(),
+			expectedFees: 1,
+			expectedOut:  math.NewInt(999),
+		},
+		{
+			name:         "ratio exceeds bound in favour of the pool in surcharge mode",
+			mode:         simpleswap.ORACLE_GUARD_MODE_SURCHARGE,
+			inputPrice:   math.LegacyOneDec(),
+			outputPrice:  math.LegacyMustNewDecFromStr("0.8"),
+			expectedFees: 1,
+			expectedOut:  math.NewInt(999),
+		},
+		{
+			name:        "non positive oracle price",
+			mode:        simpleswap.ORACLE_GUARD_MODE_REJECT,
+			inputPrice:  math.LegacyZeroDec(),
+			outputPrice: math.LegacyOneDec(),
+			expectErr:   simpleswap.ErrInvalidOraclePrice,
+		},
+	}
 
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			require := s.Require()
+			trader := s.addrs[2]
+
+			params := simpleswap.DefaultParams()
+			params.OracleGuardMode = tc.mode
+			s.initGenesis(params)
+			require.NoError(s.simpleSwapKeeper.CoinsReserve.Set(s.ctx, "WETH", types.NewCoin("WETH", math.NewInt(1000))))
+
+			if !tc.noOracleCalls {
+				s.oracleKeeper.EXPECT().GetPrice(s.ctx, "ETH").Return(tc.inputPrice, nil).Times(1)
+				s.oracleKeeper.EXPECT().GetPrice(s.ctx, "WETH").Return(tc.outputPrice, nil).Times(1)
+			}
+
+			if tc.expectErr == nil {
+				s.bankKeeper.EXPECT().SpendableCoin(s.ctx, trader, "ETH").Return(types.NewCoin("ETH", math.NewInt(1000))).Times(1)
+				s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, trader, simpleswap.ModuleName, types.NewCoins(types.NewCoin("ETH", math.NewInt(1000)))).Return(nil).Times(1)
+				s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(s.ctx, simpleswap.ModuleName, trader, types.NewCoins(types.NewCoin("WETH", tc.expectedOut))).Return(nil).Times(1)
+			}
+
+			_, err := s.msgServer.SwapLiquidity(s.ctx, &simpleswap.MsgSwapLiquidity{
+				Trader: trader.String(),
+				Input:  types.NewCoin("ETH", math.NewInt(1000)),
+				Output: types.NewCoin("WETH", math.NewInt(1000)),
+			})
+			if tc.expectErr != nil {
+				require.ErrorIs(err, tc.expectErr)
+				return
+			}
+			require.NoError(err)
+
+			pool, err := s.simpleSwapKeeper.Pool.Get(s.ctx)
+			require.NoError(err)
+			require.Equal(tc.expectedFees, pool.TotalAccruedFees)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSwapLiquidityMaxSwapFee() {
+	require := s.Require()
+	trader := s.addrs[2]
+
+	s.initGenesis(simpleswap.DefaultParams())
+	require.NoError(s.simpleSwapKeeper.CoinsReserve.Set(s.ctx, "WETH", types.NewCoin("WETH", math.NewInt(1000))))
+
+	// The swap fee is above the bound accepted by the trader
+	_, err := s.msgServer.SwapLiquidity(s.ctx, &simpleswap.MsgSwapLiquidity{
+		Trader:               trader.String(),
+		Input:                types.NewCoin("ETH", math.NewInt(1000)),
+		Output:               types.NewCoin("WETH", math.NewInt(1000)),
+		MaxSwapFeePercentage: 29999,
+	})
+	require.ErrorIs(err, simpleswap.ErrSwapFeeTooHigh)
+
+	// The swap fee is within the bound
+	s.bankKeeper.EXPECT().SpendableCoin(s.ctx, trader, "ETH").Return(types.NewCoin("ETH", math.NewInt(1000))).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, trader, simpleswap.ModuleName, types.NewCoins(types.NewCoin("ETH", math.NewInt(1000)))).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(s.ctx, simpleswap.ModuleName, trader, types.NewCoins(types.NewCoin("WETH", math.NewInt(999)))).Return(nil).Times(1)
+	resp, err := s.msgServer.SwapLiquidity(s.ctx, &simpleswap.MsgSwapLiquidity{
+		Trader:               trader.String(),
+		Input:                types.NewCoin("ETH", math.NewInt(1000)),
+		Output:               types.NewCoin("WETH", math.NewInt(1000)),
+		MaxSwapFeePercentage: 30000,
+	})
+	require.NoError(err)
+	require.Equal(&simpleswap.MsgSwapLiquidityResponse{
+		Input:   types.NewInt64Coin("ETH", 1000),
+		Output:  types.NewInt64Coin("WETH", 999),
+		SwapFee: types.NewInt64Coin("WETH", 1),
+	}, resp)
+}
+
+func (s *KeeperTestSuite) TestSwapLiquidityMaxSwapFeeSurcharge() {
+	require := s.Require()
+	trader := s.addrs[2]
+
+	params := simpleswap.DefaultParams()
+	params.OracleGuardMode = simpleswap.ORACLE_GUARD_MODE_SURCHARGE
+	s.initGenesis(params)
+	require.NoError(s.simpleSwapKeeper.CoinsReserve.Set(s.ctx, "WETH", types.NewCoin("WETH", math.NewInt(1000))))
+
+	// The ETH depeg adds a surcharge of 10%, i.e. 10_000_000 in the swap fee units
+	s.oracleKeeper.EXPECT().GetPrice(s.ctx, "ETH").Return(math.LegacyMustNewDecFromStr("0.9"), nil).Times(2)
+	s.oracleKeeper.EXPECT().GetPrice(s.ctx, "WETH").Return(math.LegacyOneDec(), nil).Times(2)
+
+	// The swap fee alone is within the bound but not with the surcharge, nothing is charged
+	_, err := s.msgServer.SwapLiquidity(s.ctx, &simpleswap.MsgSwapLiquidity{
+		Trader:               trader.String(),
+		Input:                types.NewCoin("ETH", math.NewInt(1000)),
+		Output:               types.NewCoin("WETH", math.NewInt(1000)),
+		MaxSwapFeePercentage: 30000,
+	})
+	require.ErrorIs(err, simpleswap.ErrSwapFeeTooHigh)
+
+	// The bound includes the surcharge
+	s.bankKeeper.EXPECT().SpendableCoin(s.ctx, trader, "ETH").Return(types.NewCoin("ETH", math.NewInt(1000))).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, trader, simpleswap.ModuleName, types.NewCoins(types.NewCoin("ETH", math.NewInt(1000)))).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(s.ctx, simpleswap.ModuleName, trader, types.NewCoins(types.NewCoin("WETH", math.NewInt(899)))).Return(nil).Times(1)
+	_, err = s.msgServer.SwapLiquidity(s.ctx, &simpleswap.MsgSwapLiquidity{
+		Trader:               trader.String(),
+		Input:                types.NewCoin("ETH", math.NewInt(1000)),
+		Output:               types.NewCoin("WETH", math.NewInt(1000)),
+		MaxSwapFeePercentage: 10_030_000,
+	})
+	require.NoError(err)
+}
+
+func (s *KeeperTestSuite) TestLiquidityMsgErrors() {
+	lp := s.addrs[1]
+	shareDenom := simpleswap.PoolShareDenom(simpleswap.DefaultPoolId)
+	eth, weth := types.NewInt64Coin("ETH", 1000), types.NewInt64Coin("WETH", 1000)
+
+	addLiquidity := func(token types.Coin) func() error {
+		return func() error {
+			_, err := s.msgServer.AddLiquidity(s.ctx, &simpleswap.MsgAddLiquidity{LiquidityProvider: lp.String(), Token: token})
+			return err
+		}
+	}
+	swapLiquidity := func(trader string) func() error {
+		return func() error {
+			_, err := s.msgServer.SwapLiquidity(s.ctx, &simpleswap.MsgSwapLiquidity{Trader: trader, Input: eth, Output: weth})
+			return err
+		}
+	}
+	removeLiquidity := func(token types.Coin) func() error {
+		return func() error {
+			_, err := s.msgServer.RemoveLiquidity(s.ctx, &simpleswap.MsgRemoveLiquidity{LiquidityProvider: lp.String(), Token: token})
+			return err
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		setup     func()
+		run       func() error
+		expectErr error
+	}{
+		{
+			name:      "add a zero amount",
+			run:       addLiquidity(types.NewInt64Coin("ETH", 0)),
+			expectErr: simpleswap.ErrZeroAmount,
+		},
+		{
+			name: "add with an invalid provider address",
+			run: func() error {
+				_, err := s.msgServer.AddLiquidity(s.ctx, &simpleswap.MsgAddLiquidity{LiquidityProvider: "foo", Token: eth})
+				return err
+			},
+			expectErr: simpleswap.ErrInvalidProviderAddress,
+		},
+		{
+			name: "add to a pool not initialized",
+			setup: func() {
+				s.Require().NoError(s.simpleSwapKeeper.Pool.Remove(s.ctx))
+			},
+			run:       addLiquidity(eth),
+			expectErr: simpleswap.ErrPoolNotInitialized,
+		},
+		{
+			name:      "add a coin not whitelisted",
+			run:       addLiquidity(types.NewInt64Coin("USDT", 1000)),
+			expectErr: simpleswap.ErrCoinInvalid,
+		},
+		{
+			name: "add more than the balance",
+			setup: func() {
+				s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, lp, simpleswap.ModuleName, types.NewCoins(eth)).Return(sdkerrors.ErrInsufficientFunds).Times(1)
+			},
+			run:       addLiquidity(eth),
+			expectErr: simpleswap.ErrInsufficientBalance,
+		},
+		{
+			name: "add when the shares cannot be minted",
+			setup: func() {
+				s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, lp, simpleswap.ModuleName, types.NewCoins(eth)).Return(nil).Times(1)
+				s.bankKeeper.EXPECT().MintCoins(s.ctx, simpleswap.ModuleName, types.NewCoins(types.NewInt64Coin(shareDenom, 1000))).Return(sdkerrors.ErrInvalidCoins).Times(1)
+			},
+			run:       addLiquidity(eth),
+			expectErr: simpleswap.ErrMintShares,
+		},
+		{
+			name:      "swap with an invalid trader address",
+			run:       swapLiquidity("foo"),
+			expectErr: simpleswap.ErrInvalidAddress,
+		},
+		{
+			name: "swap more than the balance",
+			setup: func() {
+				s.bankKeeper.EXPECT().SpendableCoin(s.ctx, lp, "ETH").Return(types.NewInt64Coin("ETH", 500)).Times(1)
+			},
+			run:       swapLiquidity(lp.String()),
+			expectErr: simpleswap.ErrInsufficientBalance,
+		},
+		{
+			name: "swap more than the reserve",
+			setup: func() {
+				s.Require().NoError(s.simpleSwapKeeper.CoinsReserve.Set(s.ctx, "WETH", types.NewInt64Coin("WETH", 500)))
+				s.bankKeeper.EXPECT().SpendableCoin(s.ctx, lp, "ETH").Return(eth).Times(1)
+			},
+			run:       swapLiquidity(lp.String()),
+			expectErr: simpleswap.ErrInsufficientReserve,
+		},
+		{
+			name: "swap when the output cannot be paid out",
+			setup: func() {
+				s.Require().NoError(s.simpleSwapKeeper.CoinsReserve.Set(s.ctx, "WETH", weth))
+				s.bankKeeper.EXPECT().SpendableCoin(s.ctx, lp, "ETH").Return(eth).Times(1)
+				s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, lp, simpleswap.ModuleName, types.NewCoins(eth)).Return(nil).Times(1)
+				s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(s.ctx, simpleswap.ModuleName, lp, types.NewCoins(types.NewInt64Coin("WETH", 999))).Return(sdkerrors.ErrInsufficientFunds).Times(1)
+			},
+			run:       swapLiquidity(lp.String()),
+			expectErr: simpleswap.ErrPayout,
+		},
+		{
+			name:      "remove without liquidity",
+			run:       removeLiquidity(types.NewInt64Coin("ETH", 100)),
+			expectErr: simpleswap.ErrLiquidityProviderNotFound,
+		},
+		{
+			name: "add another denom than the position",
+			setup: func() {
+				s.setLiquidityProvider(lp, 100)
+			},
+			run:       addLiquidity(weth),
+			expectErr: simpleswap.ErrDenomMismatch,
+		},
+		{
+			name: "remove another denom than the position",
+			setup: func() {
+				s.setLiquidityProvider(lp, 100)
+				s.Require().NoError(s.simpleSwapKeeper.CoinsReserve.Set(s.ctx, "WETH", weth))
+			},
+			run:       removeLiquidity(types.NewInt64Coin("WETH", 100)),
+			expectErr: simpleswap.ErrDenomMismatch,
+		},
+		{
+			name: "remove more than provided",
+			setup: func() {
+				s.setLiquidityProvider(lp, 100)
+				s.Require().NoError(s.simpleSwapKeeper.CoinsReserve.Set(s.ctx, "ETH", eth))
+			},
+			run:       removeLiquidity(types.NewInt64Coin("ETH", 200)),
+			expectErr: simpleswap.ErrInsufficientLiquidity,
+		},
+		{
+			name: "remove more than the reserve",
+			setup: func() {
+				s.setLiquidityProvider(lp, 100)
+				s.Require().NoError(s.simpleSwapKeeper.CoinsReserve.Set(s.ctx, "ETH", types.NewInt64Coin("ETH", 50)))
+			},
+			run:       removeLiquidity(types.NewInt64Coin("ETH", 100)),
+			expectErr: simpleswap.ErrInsufficientReserve,
+		},
+		{
+			name: "remove when the shares cannot be burned",
+			setup: func() {
+				s.setLiquidityProvider(lp, 100)
+				s.Require().NoError(s.simpleSwapKeeper.CoinsReserve.Set(s.ctx, "ETH", eth))
+				shares := types.NewCoins(types.NewInt64Coin(shareDenom, 100))
+				s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, lp, simpleswap.ModuleName, shares).Return(nil).Times(1)
+				s.bankKeeper.EXPECT().BurnCoins(s.ctx, simpleswap.ModuleName, shares).Return(sdkerrors.ErrInvalidCoins).Times(1)
+			},
+			run:       removeLiquidity(types.NewInt64Coin("ETH", 100)),
+			expectErr: simpleswap.ErrBurnShares,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			require := s.Require()
+			// Each case starts from a fresh store without liquidity providers
+			s.SetupTest()
+			s.initGenesis(simpleswap.DefaultParams())
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			err := tc.run()
+			require.ErrorIs(err, tc.expectErr)
+
+			// The error reaches the clients with the code it is registered with
+			codespace, code, _ := errorsmod.ABCIInfo(err, false)
+			require.Equal(simpleswap.ModuleName, codespace)
+			require.Equal(tc.expectErr.(*errorsmod.Error).ABCICode(), code)
+		})
+	}
+}
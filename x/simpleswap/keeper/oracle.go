@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/simpleswap"
+)
+
+// OracleValueRatio returns the value of the input denom in the output denom at the oracle prices,
+// relative to its value at the rates the pool swaps them at: above one when the oracle values the
+// input more than the pool does. It returns false without an oracle.
+func (k Keeper) OracleValueRatio(ctx context.Context, inputDenom, outputDenom string) (math.LegacyDec, bool, error) {
+	if k.OracleKeeper == nil {
+		return math.LegacyDec{}, false, nil
+	}
+
+	inputPrice, err := k.OracleKeeper.GetPrice(ctx, inputDenom)
+	if err != nil {
+		return math.LegacyDec{}, false, errorsmod.Wrapf(simpleswap.ErrInvalidOraclePrice, "for the denom: %s: %v", inputDenom, err)
+	}
+
+	outputPrice, err := k.OracleKeeper.GetPrice(ctx, outputDenom)
+	if err != nil {
+		return math.LegacyDec{}, false, errorsmod.Wrapf(simpleswap.ErrInvalidOraclePrice, "for the denom: %s: %v", outputDenom, err)
+	}
+
+	if inputPrice.IsNil() || !inputPrice.IsPositive() {
+		return math.LegacyDec{}, false, errorsmod.Wrapf(simpleswap.ErrInvalidOraclePrice, "for the denom: %s", inputDenom)
+	}
+
+	if outputPrice.IsNil() || !outputPrice.IsPositive() {
+		return math.LegacyDec{}, false, errorsmod.Wrapf(simpleswap.ErrInvalidOraclePrice, "for the denom: %s", outputDenom)
+	}
+
+	inputRate, err := k.AssetRate(ctx, inputDenom)
+	if err != nil {
+		return math.LegacyDec{}, false, err
+	}
+
+	outputRate, err := k.AssetRate(ctx, outputDenom)
+	if err != nil {
+		return math.LegacyDec{}, false, err
+	}
+
+	poolRatio := inputRate.Quo(outputRate)
+	return inputPrice.Quo(outputPrice).Quo(poolRatio), true, nil
+}
+
+// oracleSurcharge checks the oracle price ratio between the input and output denoms
+// against the ratio of their rates, at which the pool swaps them. Only a deviation in favour
+// of the trader, when the pool pays more than the input is worth at the oracle prices, is
+// guarded. It returns the surcharge rate to apply on the swap output, which is zero unless
+// the guard runs in surcharge mode and the bound is exceeded.
+func (k Keeper) oracleSurcharge(ctx context.Context, params simpleswap.Params, inputDenom, outputDenom string) (math.LegacyDec, error) {
+	if params.OracleGuardMode == simpleswap.ORACLE_GUARD_MODE_DISABLED {
+		return math.LegacyZeroDec(), nil
+	}
+
+	valueRatio, ok, err := k.OracleValueRatio(ctx, inputDenom, outputDenom)
+	if err != nil {
+		return math.LegacyDec{}, err
+	} else if !ok {
+		return math.LegacyZeroDec(), nil
+	}
+
+	// The deviation is the share of the output the input is not worth at the oracle prices, it
+	// is negative when the input is worth more than the output and the pool gains on the swap.
+	// Charged as a surcharge, it brings the output down to the value of the input.
+	deviation := math.LegacyOneDec().Sub(valueRatio)
+	if deviation.LTE(params.OracleMaxDeviation) {
+		return math.LegacyZeroDec(), nil
+	}
+
+	if params.OracleGuardMode == simpleswap.ORACLE_GUARD_MODE_SURCHARGE {
+		return deviation, nil
+	}
+
+	return math.LegacyDec{}, errorsmod.Wrapf(simpleswap.ErrOraclePriceDeviation, "between %s and %s: %s", inputDenom, outputDenom, deviation)
+}
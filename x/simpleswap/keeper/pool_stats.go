@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/simpleswap"
+)
+
+// year is the duration the fees of a stats window are annualized over.
+const year = 365 * 24 * time.Hour
+
+// statsBucketStart returns the start of the pool stats bucket of the block time.
+func statsBucketStart(ctx context.Context) time.Time {
+	return sdk.UnwrapSDKContext(ctx).BlockTime().UTC().Truncate(simpleswap.StatsBucketDuration)
+}
+
+// recordSwapStats adds a swap to the stats bucket of its pair for the hour of the block, the volume
+// and the fee are in the pool decimals.
+func (k Keeper) recordSwapStats(ctx context.Context, inputDenom, outputDenom string, volume, fee math.Int) error {
+	startTime := statsBucketStart(ctx)
+	key := collections.Join3(startTime, inputDenom, outputDenom)
+	bucket, err := k.PoolStats.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		bucket = simpleswap.PoolStatsBucket{
+			StartTime:   startTime,
+			InputDenom:  inputDenom,
+			OutputDenom: outputDenom,
+			Volume:      math.ZeroInt(),
+			Fees:        math.ZeroInt(),
+		}
+	} else if err != nil {
+		return err
+	}
+
+	bucket.Volume = bucket.Volume.Add(volume)
+	bucket.Fees = bucket.Fees.Add(fee)
+	bucket.SwapCount++
+
+	return k.PoolStats.Set(ctx, key, bucket)
+}
+
+// PrunePoolStats removes the pool stats buckets which started before the retention of the params.
+func (k Keeper) PrunePoolStats(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	cutoff := statsBucketStart(ctx).Add(-params.PoolStatsRetention())
+	ranger := new(collections.Range[collections.Triple[time.Time, string, string]]).
+		EndExclusive(collections.TriplePrefix[time.Time, string, string](cutoff))
+
+	iter, err := k.PoolStats.Iterate(ctx, ranger)
+	if err != nil {
+		return err
+	}
+
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := k.PoolStats.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PoolStatsWindow sums the pool stats buckets of the window ending with the bucket of the block, and
+// annualizes the fees over the total liquidity of the pool.
+func (k Keeper) PoolStatsWindow(ctx context.Context, pool simpleswap.Pool, window time.Duration) (simpleswap.PoolStatsWindow, error) {
+	stats := simpleswap.PoolStatsWindow{
+		Volume: math.ZeroInt(),
+		Fees:   math.ZeroInt(),
+		FeeApr: math.LegacyZeroDec(),
+		Pairs:  []simpleswap.PairStats{},
+	}
+
+	start := statsBucketStart(ctx).Add(simpleswap.StatsBucketDuration - window)
+	ranger := new(collections.Range[collections.Triple[time.Time, string, string]]).
+		StartInclusive(collections.TriplePrefix[time.Time, string, string](start))
+
+	pairs := make(map[[2]string]*simpleswap.PairStats)
+	err := k.PoolStats.Walk(ctx, ranger, func(_ collections.Triple[time.Time, string, string], bucket simpleswap.PoolStatsBucket) (bool, error) {
+		stats.Volume = stats.Volume.Add(bucket.Volume)
+		stats.Fees = stats.Fees.Add(bucket.Fees)
+		stats.SwapCount += bucket.SwapCount
+
+		pair, ok := pairs[[2]string{bucket.InputDenom, bucket.OutputDenom}]
+		if !ok {
+			pair = &simpleswap.PairStats{
+				InputDenom:  bucket.InputDenom,
+				OutputDenom: bucket.OutputDenom,
+				Volume:      math.ZeroInt(),
+				Fees:        math.ZeroInt(),
+			}
+			pairs[[2]string{bucket.InputDenom, bucket.OutputDenom}] = pair
+		}
+		pair.Volume = pair.Volume.Add(bucket.Volume)
+		pair.Fees = pair.Fees.Add(bucket.Fees)
+		pair.SwapCount += bucket.SwapCount
+
+		return false, nil
+	})
+	if err != nil {
+		return simpleswap.PoolStatsWindow{}, err
+	}
+
+	for _, pair := range pairs {
+		stats.Pairs = append(stats.Pairs, *pair)
+	}
+	sort.Slice(stats.Pairs, func(i, j int) bool {
+		if stats.Pairs[i].InputDenom != stats.Pairs[j].InputDenom {
+			return stats.Pairs[i].InputDenom < stats.Pairs[j].InputDenom
+		}
+		return stats.Pairs[i].OutputDenom < stats.Pairs[j].OutputDenom
+	})
+
+	if pool.TotalLiquidity > 0 {
+		stats.FeeApr = math.LegacyNewDecFromInt(stats.Fees).MulInt64(int64(year)).QuoInt64(int64(window)).QuoInt64(pool.TotalLiquidity)
+	}
+
+	return stats, nil
+}
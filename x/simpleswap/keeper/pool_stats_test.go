@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/simpleswap"
+	"github.com/golang/mock/gomock"
+)
+
+func (s *KeeperTestSuite) TestPoolStats() {
+	require := s.Require()
+	trader := s.addrs[1]
+
+	s.initGenesis(simpleswap.DefaultParams())
+	pool, err := s.simpleSwapKeeper.Pool.Get(s.ctx)
+	require.NoError(err)
+	pool.TotalLiquidity = 1_000_000
+	require.NoError(s.simpleSwapKeeper.Pool.Set(s.ctx, pool))
+	require.NoError(s.simpleSwapKeeper.CoinsReserve.Set(s.ctx, "ETH", sdk.NewInt64Coin("ETH", 1_000_000)))
+	require.NoError(s.simpleSwapKeeper.CoinsReserve.Set(s.ctx, "WETH", sdk.NewInt64Coin("WETH", 1_000_000)))
+
+	s.bankKeeper.EXPECT().SpendableCoin(gomock.Any(), trader, "ETH").Return(sdk.NewInt64Coin("ETH", 1_000_000)).AnyTimes()
+	s.bankKeeper.EXPECT().SpendableCoin(gomock.Any(), trader, "WETH").Return(sdk.NewInt64Coin("WETH", 1_000_000)).AnyTimes()
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), trader, simpleswap.ModuleName, gomock.Any()).Return(nil).AnyTimes()
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), simpleswap.ModuleName, trader, gomock.Any()).Return(nil).AnyTimes()
+
+	// The swaps are accumulated by pair in the bucket of the hour of their block, the fee of 0.3% is
+	// rounded up
+	now := s.ctx.BlockTime()
+	for _, swap := range []struct {
+		ago    time.Duration
+		input  sdk.Coin
+		output sdk.Coin
+	}{
+		{48 * time.Hour, sdk.NewInt64Coin("WETH", 2000), sdk.NewInt64Coin("ETH", 2000)},
+		{2 * time.Hour, sdk.NewInt64Coin("ETH", 1000), sdk.NewInt64Coin("WETH", 1000)},
+		{0, sdk.NewInt64Coin("ETH", 1000), sdk.NewInt64Coin("WETH", 1000)},
+	} {
+		_, err := s.msgServer.SwapLiquidity(s.ctx.WithBlockTime(now.Add(-swap.ago)), &simpleswap.MsgSwapLiquidity{
+			Trader: trader.String(),
+			Input:  swap.input,
+			Output: swap.output,
+		})
+		require.NoError(err)
+	}
+
+	resp, err := s.queryClient.PoolStats(s.ctx, &simpleswap.QueryPoolStatsRequest{})
+	require.NoError(err)
+
+	require.Equal(math.NewInt(2000), resp.Daily.Volume)
+	require.Equal(math.NewInt(2), resp.Daily.Fees)
+	require.Equal(uint64(2), resp.Daily.SwapCount)
+	require.Equal([]simpleswap.PairStats{
+		{InputDenom: "ETH", OutputDenom: "WETH", Volume: math.NewInt(2000), Fees: math.NewInt(2), SwapCount: 2},
+	}, resp.Daily.Pairs)
+	// The fees of a day are annualized over the total liquidity
+	require.Equal(math.LegacyMustNewDecFromStr("0.00073"), resp.Daily.FeeApr)
+
+	require.Equal(math.NewInt(4000), resp.Weekly.Volume)
+	require.Equal(math.NewInt(3), resp.Weekly.Fees)
+	require.Equal(uint64(3), resp.Weekly.SwapCount)
+	require.Equal([]simpleswap.PairStats{
+		{InputDenom: "ETH", OutputDenom: "WETH", Volume: math.NewInt(2000), Fees: math.NewInt(2), SwapCount: 2},
+		{InputDenom: "WETH", OutputDenom: "ETH", Volume: math.NewInt(2000), Fees: math.NewInt(1), SwapCount: 1},
+	}, resp.Weekly.Pairs)
+	require.Equal(math.LegacyNewDec(3*365).QuoInt64(7).QuoInt64(1_000_000), resp.Weekly.FeeApr)
+
+	// The buckets older than the retention are pruned at the end of the block
+	countBuckets := func() int {
+		iter, err := s.simpleSwapKeeper.PoolStats.Iterate(s.ctx, nil)
+		require.NoError(err)
+		keys, err := iter.Keys()
+		require.NoError(err)
+		return len(keys)
+	}
+	require.Equal(3, countBuckets())
+
+	require.NoError(s.simpleSwapKeeper.PrunePoolStats(s.ctx.WithBlockTime(now.Add(5 * 24 * time.Hour))))
+	require.Equal(3, countBuckets())
+
+	require.NoError(s.simpleSwapKeeper.PrunePoolStats(s.ctx.WithBlockTime(now.Add(5*24*time.Hour + time.Hour))))
+	require.Equal(2, countBuckets())
+
+	// The retention cannot be shorter than the weekly stats
+	params := simpleswap.DefaultParams()
+	params.StatsRetention = 24 * time.Hour
+	require.ErrorIs(params.Validate(), simpleswap.ErrInvalidStatsRetention)
+}
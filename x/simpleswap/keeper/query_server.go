@@ -3,11 +3,13 @@ package keeper
 import (
 	"context"
 	"errors"
-	"fmt"
-	"strings"
+	"slices"
+	"time"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -16,21 +18,26 @@ import (
 
 var _ simpleswap.QueryServer = queryServer{}
 
-// NewQueryServerImpl returns an implementation of the module QueryServer.
-func NewQueryServerImpl(k Keeper) simpleswap.QueryServer {
+// NewQueryServerImpl returns an implementation of the module QueryServer. The keeper is shared,
+// the optional keepers set after the query server is created are used by it.
+func NewQueryServerImpl(k *Keeper) simpleswap.QueryServer {
 	return queryServer{k}
 }
 
 type queryServer struct {
-	k Keeper
+	k *Keeper
 }
 
 // Params defines the handler for the Query/Params RPC method.
 func (qs queryServer) Params(ctx context.Context, req *simpleswap.QueryParamsRequest) (*simpleswap.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
 	params, err := qs.k.Params.Get(ctx)
 	if err != nil {
 		if errors.Is(err, collections.ErrNotFound) {
-			return &simpleswap.QueryParamsResponse{Params: simpleswap.Params{}}, nil
+			return nil, status.Error(codes.NotFound, "params not found")
 		}
 
 		return nil, status.Error(codes.Internal, err.Error())
@@ -41,6 +48,10 @@ func (qs queryServer) Params(ctx context.Context, req *simpleswap.QueryParamsReq
 
 // Pool defines the handler for the Query/Pool RPC method.
 func (qs queryServer) Pool(ctx context.Context, req *simpleswap.QueryPoolRequest) (*simpleswap.QueryPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
 	pool, err := qs.k.Pool.Get(ctx)
 	if err != nil {
 		if errors.Is(err, collections.ErrNotFound) {
@@ -50,17 +61,52 @@ func (qs queryServer) Pool(ctx context.Context, req *simpleswap.QueryPoolRequest
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &simpleswap.QueryPoolResponse{Pool: pool}, nil
+	params, err := qs.k.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &simpleswap.QueryPoolResponse{Pool: pool, Assets: qs.k.WhitelistOrigins(ctx, params)}, nil
+}
+
+// PoolStats defines the handler for the Query/PoolStats RPC method.
+func (qs queryServer) PoolStats(ctx context.Context, req *simpleswap.QueryPoolStatsRequest) (*simpleswap.QueryPoolStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	pool, err := qs.k.Pool.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	daily, err := qs.k.PoolStatsWindow(ctx, pool, 24*time.Hour)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	weekly, err := qs.k.PoolStatsWindow(ctx, pool, 7*24*time.Hour)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &simpleswap.QueryPoolStatsResponse{Daily: daily, Weekly: weekly}, nil
 }
 
 // LiquidityProvider defines the handler for the Query/LiquidityProvider RPC method.
 func (qs queryServer) LiquidityProvider(ctx context.Context, req *simpleswap.QueryLiquidityProviderRequest) (*simpleswap.QueryLiquidityProviderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := qs.validateAddress(req.LpAddress); err != nil {
+		return nil, err
+	}
+
 	lp, err := qs.k.LiquidityProviders.Get(ctx, req.LpAddress)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			return &simpleswap.QueryLiquidityProviderResponse{
-				LiquidityProvider: simpleswap.LiquidityProvider{},
-			}, fmt.Errorf("liquidity provider not found for %s", req.LpAddress)
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "liquidity provider not found for %s", req.LpAddress)
 		}
 
 		return nil, status.Error(codes.Internal, err.Error())
@@ -69,48 +115,441 @@ func (qs queryServer) LiquidityProvider(ctx context.Context, req *simpleswap.Que
 	return &simpleswap.QueryLiquidityProviderResponse{LiquidityProvider: lp}, nil
 }
 
-// CoinReserves defines the handler for the Query/CoinReserves RPC method.
-func (qs queryServer) CoinReserve(ctx context.Context, req *simpleswap.QueryCoinReserveRequest) (*simpleswap.QueryCoinReserveResponse, error) {
-	reserves, err := qs.k.CoinsReserve.Get(ctx, req.CoinDenom)
+// Position defines the handler for the Query/Position RPC method.
+func (qs queryServer) Position(ctx context.Context, req *simpleswap.QueryPositionRequest) (*simpleswap.QueryPositionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := qs.validateAddress(req.Address); err != nil {
+		return nil, err
+	}
+
+	provider, err := qs.k.LiquidityProviders.Get(ctx, req.Address)
 	if err != nil {
 		if errors.Is(err, collections.ErrNotFound) {
-			return &simpleswap.QueryCoinReserveResponse{
-				CoinReserve: types.Coin{},
-			}, fmt.Errorf("coin reserve not found for %s", req.CoinDenom)
+			return nil, status.Errorf(codes.NotFound, "liquidity provider not found for %s", req.Address)
 		}
 
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &simpleswap.QueryCoinReserveResponse{CoinReserve: reserves}, nil
+	if provider.StableCoin == nil {
+		return nil, status.Errorf(codes.Internal, "liquidity provider %s has no stable coin", req.Address)
+	}
+
+	params, err := qs.k.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pool, err := qs.k.Pool.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// The pending fees are paid out in the stable coin, rounded down as in a removal of liquidity
+	pendingFees, err := qs.k.coinForValue(ctx, params, pool, provider.StableCoin.Denom, math.NewInt(PendingFees(pool, provider)), false)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	ratio := PoolShareRatio(pool, provider)
+	reserveShares := types.NewCoins()
+	stableReserve := math.ZeroInt()
+	err = qs.k.CoinsReserve.Walk(ctx, nil, func(denom string, reserve types.Coin) (bool, error) {
+		reserveShares = reserveShares.Add(types.NewCoin(denom, ratio.MulInt(reserve.Amount).TruncateInt()))
+		if denom == provider.StableCoin.Denom {
+			stableReserve = reserve.Amount
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &simpleswap.QueryPositionResponse{
+		LiquidityProvider:   provider,
+		PendingFees:         pendingFees,
+		PoolSharePercentage: ratio.MulInt64(100),
+		ReserveShares:       reserveShares,
+		ExitPayout:          provider.StableCoin.Add(pendingFees),
+		ExitAvailable:       stableReserve.GTE(provider.StableCoin.Amount),
+	}, nil
 }
 
+// LiquidityProviders defines the handler for the Query/LiquidityProviders RPC method.
+func (qs queryServer) LiquidityProviders(ctx context.Context, req *simpleswap.QueryLiquidityProvidersRequest) (*simpleswap.QueryLiquidityProvidersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// The bridged coins can be given by their transfer path trace, without a denom all the liquidity
+	// providers are listed
+	denom := req.Denom
+	if denom != "" {
+		resolved, err := resolveDenom(denom)
+		if err != nil {
+			return nil, err
+		}
+		denom = resolved
+	}
+
+	if err := validatePageRequest(req.Pagination); err != nil {
+		return nil, err
+	}
+
+	pool, err := qs.k.Pool.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	providers, pageRes, err := query.CollectionFilteredPaginate(ctx, qs.k.LiquidityProviders, req.Pagination, func(_ string, provider simpleswap.LiquidityProvider) (bool, error) {
+		return req.Denom == "" || (provider.StableCoin != nil && provider.StableCoin.Denom == denom), nil
+	}, func(address string, provider simpleswap.LiquidityProvider) (simpleswap.LiquidityProviderEntry, error) {
+		return simpleswap.LiquidityProviderEntry{
+			Address:           address,
+			LiquidityProvider: provider,
+			PoolShareRatio:    PoolShareRatio(pool, provider),
+			PendingFees:       PendingFees(pool, provider),
+		}, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &simpleswap.QueryLiquidityProvidersResponse{LiquidityProviders: providers, Pagination: pageRes}, nil
+}
+
+// LiquidityProvidersByDenom defines the handler for the Query/LiquidityProvidersByDenom RPC method.
+func (qs queryServer) LiquidityProvidersByDenom(ctx context.Context, req *simpleswap.QueryLiquidityProvidersByDenomRequest) (*simpleswap.QueryLiquidityProvidersByDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// The bridged coins can be given by their transfer path trace
+	denom, err := resolveDenom(req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validatePageRequest(req.Pagination); err != nil {
+		return nil, err
+	}
+
+	pool, err := qs.k.Pool.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Only the page of the liquidity providers of the denom is read, the total exposure is kept
+	// by the index
+	exposure, err := qs.k.LiquidityProviders.Indexes.Denom.Exposure(ctx, denom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	providers, pageRes, err := query.CollectionPaginate(ctx, qs.k.LiquidityProviders.Indexes.Denom, req.Pagination, func(key collections.Pair[string, string], _ collections.NoValue) (simpleswap.LiquidityProviderEntry, error) {
+		provider, err := qs.k.LiquidityProviders.Get(ctx, key.K2())
+		if err != nil {
+			return simpleswap.LiquidityProviderEntry{}, err
+		}
+
+		return simpleswap.LiquidityProviderEntry{
+			Address:           key.K2(),
+			LiquidityProvider: provider,
+			PoolShareRatio:    PoolShareRatio(pool, provider),
+			PendingFees:       PendingFees(pool, provider),
+		}, nil
+	}, query.WithCollectionPaginationPairPrefix[string, string](denom))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &simpleswap.QueryLiquidityProvidersByDenomResponse{
+		LiquidityProviders: providers,
+		TotalExposure:      types.Coin{Denom: denom, Amount: exposure},
+		Pagination:         pageRes,
+	}, nil
+}
+
+// CoinReserve defines the handler for the Query/CoinReserve RPC method.
+func (qs queryServer) CoinReserve(ctx context.Context, req *simpleswap.QueryCoinReserveRequest) (*simpleswap.QueryCoinReserveResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// The reserve of a bridged coin can be queried by its transfer path trace
+	denom, err := resolveDenom(req.CoinDenom)
+	if err != nil {
+		return nil, err
+	}
 
-func (qs queryServer) CoinReserves(ctx context.Context, req *simpleswap.QueryCoinReservesRequest) (*simpleswap.QueryCoinReservesResponse, error) {
 	params, err := qs.k.Params.Get(ctx)
 	if err != nil {
-		if errors.Is(err, collections.ErrNotFound) {
-			return &simpleswap.QueryCoinReservesResponse{
-				CoinReserves: []types.Coin{},
-			}, err
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	reserve, err := qs.k.CoinsReserve.Get(ctx, denom)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		// A whitelisted coin has an empty reserve until it is first deposited
+		if !slices.Contains(params.WhitelistedDenoms(), denom) {
+			return nil, status.Errorf(codes.NotFound, "coin reserve not found for %s", req.CoinDenom)
 		}
+		reserve = types.NewCoin(denom, math.ZeroInt())
 	}
 
-	whitelsitedCoins := params.WhitelistedCoins
-	var reserves []types.Coin 
-	for _, coin := range whitelsitedCoins {
-		reserve, err := qs.k.CoinsReserve.Get(ctx, coin.Denom)
+	return &simpleswap.QueryCoinReserveResponse{CoinReserve: reserve, Origin: qs.k.DenomOrigin(ctx, params, denom)}, nil
+}
+
+// CoinReserves defines the handler for the Query/CoinReserves RPC method.
+func (qs queryServer) CoinReserves(ctx context.Context, req *simpleswap.QueryCoinReservesRequest) (*simpleswap.QueryCoinReservesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	params, err := qs.k.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// The reserves are listed in the order of the whitelist, as their origins, the coins not yet
+	// deposited with an empty reserve
+	whitelistedDenoms := params.WhitelistedDenoms()
+	reserves := make([]types.Coin, 0, len(whitelistedDenoms))
+	for _, denom := range whitelistedDenoms {
+		reserve, err := qs.k.CoinsReserve.Get(ctx, denom)
 		if err != nil {
-			if errors.Is(err, collections.ErrNotFound) {
-				return &simpleswap.QueryCoinReservesResponse{
-					CoinReserves: []types.Coin{},
-				}, fmt.Errorf("coin reserve not found for %s", coin.Denom)
+			if !errors.Is(err, collections.ErrNotFound) {
+				return nil, status.Error(codes.Internal, err.Error())
 			}
-
-			return nil, status.Error(codes.Internal, err.Error())
+			reserve = types.NewCoin(denom, math.ZeroInt())
 		}
 		reserves = append(reserves, reserve)
 	}
 
-	return &simpleswap.QueryCoinReservesResponse{CoinReserves: reserves}, nil
-}
\ No newline at end of file
+	return &simpleswap.QueryCoinReservesResponse{CoinReserves: reserves, Origins: qs.k.WhitelistOrigins(ctx, params)}, nil
+}
+
+// Gauge defines the handler for the Query/Gauge RPC method.
+func (qs queryServer) Gauge(ctx context.Context, req *simpleswap.QueryGaugeRequest) (*simpleswap.QueryGaugeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	gauge, err := qs.k.Gauges.Get(ctx, req.GaugeId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "gauge not found for %d", req.GaugeId)
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &simpleswap.QueryGaugeResponse{Gauge: gauge}, nil
+}
+
+// Gauges defines the handler for the Query/Gauges RPC method.
+func (qs queryServer) Gauges(ctx context.Context, req *simpleswap.QueryGaugesRequest) (*simpleswap.QueryGaugesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validatePageRequest(req.Pagination); err != nil {
+		return nil, err
+	}
+
+	gauges, pageRes, err := query.CollectionPaginate(ctx, qs.k.Gauges, req.Pagination, func(_ uint64, gauge simpleswap.Gauge) (simpleswap.Gauge, error) {
+		return gauge, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &simpleswap.QueryGaugesResponse{Gauges: gauges, Pagination: pageRes}, nil
+}
+
+// PendingRewards defines the handler for the Query/PendingRewards RPC method.
+func (qs queryServer) PendingRewards(ctx context.Context, req *simpleswap.QueryPendingRewardsRequest) (*simpleswap.QueryPendingRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := qs.validateAddress(req.LpAddress); err != nil {
+		return nil, err
+	}
+
+	acc, err := qs.k.rewardAccumulator(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// The rewards earned since the last settlement are pending too
+	rewards, err := qs.k.settledRewards(ctx, req.LpAddress, acc)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &simpleswap.QueryPendingRewardsResponse{Rewards: types.NewCoins(rewards.Coins...)}, nil
+}
+
+// AccountLocks defines the handler for the Query/AccountLocks RPC method.
+func (qs queryServer) AccountLocks(ctx context.Context, req *simpleswap.QueryAccountLocksRequest) (*simpleswap.QueryAccountLocksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := qs.validateAddress(req.Owner); err != nil {
+		return nil, err
+	}
+
+	locks, err := qs.k.AccountLocks(ctx, req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &simpleswap.QueryAccountLocksResponse{Locks: locks}, nil
+}
+
+// AssetRate defines the handler for the Query/AssetRate RPC method.
+func (qs queryServer) AssetRate(ctx context.Context, req *simpleswap.QueryAssetRateRequest) (*simpleswap.QueryAssetRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	denom, err := resolveDenom(req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	rate, err := qs.k.AssetRate(ctx, denom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &simpleswap.QueryAssetRateResponse{Rate: rate}, nil
+}
+
+// LimitOrdersByOwner defines the handler for the Query/LimitOrdersByOwner RPC method.
+func (qs queryServer) LimitOrdersByOwner(ctx context.Context, req *simpleswap.QueryLimitOrdersByOwnerRequest) (*simpleswap.QueryLimitOrdersByOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := qs.validateAddress(req.Owner); err != nil {
+		return nil, err
+	}
+
+	if err := validatePageRequest(req.Pagination); err != nil {
+		return nil, err
+	}
+
+	// Only the page of the orders of the owner is read from the index
+	orders, pageRes, err := query.CollectionPaginate(ctx, qs.k.LimitOrders.Indexes.Owner, req.Pagination, func(key collections.Pair[string, uint64], _ collections.NoValue) (simpleswap.LimitOrder, error) {
+		return qs.k.LimitOrders.Get(ctx, key.K2())
+	}, query.WithCollectionPaginationPairPrefix[string, uint64](req.Owner))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &simpleswap.QueryLimitOrdersByOwnerResponse{Orders: orders, Pagination: pageRes}, nil
+}
+
+// LimitOrdersByPair defines the handler for the Query/LimitOrdersByPair RPC method.
+func (qs queryServer) LimitOrdersByPair(ctx context.Context, req *simpleswap.QueryLimitOrdersByPairRequest) (*simpleswap.QueryLimitOrdersByPairResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// The bridged coins can be given by their transfer path trace
+	inputDenom, err := resolveDenom(req.InputDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	outputDenom, err := resolveDenom(req.OutputDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validatePageRequest(req.Pagination); err != nil {
+		return nil, err
+	}
+
+	// Only the page of the orders of the pair is read from the index
+	pair := collections.Join(inputDenom, outputDenom)
+	orders, pageRes, err := query.CollectionPaginate(ctx, qs.k.LimitOrders.Indexes.Pair, req.Pagination, func(key collections.Pair[collections.Pair[string, string], uint64], _ collections.NoValue) (simpleswap.LimitOrder, error) {
+		return qs.k.LimitOrders.Get(ctx, key.K2())
+	}, query.WithCollectionPaginationPairPrefix[collections.Pair[string, string], uint64](pair))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &simpleswap.QueryLimitOrdersByPairResponse{Orders: orders, Pagination: pageRes}, nil
+}
+
+// DCAPlansByOwner defines the handler for the Query/DCAPlansByOwner RPC method.
+func (qs queryServer) DCAPlansByOwner(ctx context.Context, req *simpleswap.QueryDCAPlansByOwnerRequest) (*simpleswap.QueryDCAPlansByOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := qs.validateAddress(req.Owner); err != nil {
+		return nil, err
+	}
+
+	if err := validatePageRequest(req.Pagination); err != nil {
+		return nil, err
+	}
+
+	// Only the page of the plans of the owner is read from the index
+	plans, pageRes, err := query.CollectionPaginate(ctx, qs.k.DCAPlans.Indexes.Owner, req.Pagination, func(key collections.Pair[string, uint64], _ collections.NoValue) (simpleswap.DCAPlan, error) {
+		return qs.k.DCAPlans.Get(ctx, key.K2())
+	}, query.WithCollectionPaginationPairPrefix[string, uint64](req.Owner))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &simpleswap.QueryDCAPlansByOwnerResponse{Plans: plans, Pagination: pageRes}, nil
+}
+
+// validateAddress checks the address of a request is a valid account address.
+func (qs queryServer) validateAddress(address string) error {
+	if address == "" {
+		return status.Error(codes.InvalidArgument, "empty address")
+	}
+
+	if _, err := qs.k.addressCodec.StringToBytes(address); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid address %s: %s", address, err)
+	}
+
+	return nil
+}
+
+// resolveDenom checks the denom of a request is valid, and resolves it to its IBC denom if it is
+// the transfer path trace of a bridged coin.
+func resolveDenom(denom string) (string, error) {
+	if denom == "" {
+		return "", status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	if err := types.ValidateDenom(denom); err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "invalid denom %s: %s", denom, err)
+	}
+
+	return simpleswap.ResolveDenom(denom), nil
+}
+
+// validatePageRequest checks the pagination of a request, which starts either from a key or after
+// an offset.
+func validatePageRequest(pageReq *query.PageRequest) error {
+	if pageReq != nil && len(pageReq.Key) != 0 && pageReq.Offset != 0 {
+		return status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+	}
+
+	return nil
+}
@@ -3,6 +3,12 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/cosmos/simpleswap"
 )
 
@@ -31,7 +37,16 @@ func (s *KeeperTestSuite) TestQueryLiquidityProvider() {
 		_, err := s.queryClient.LiquidityProvider(s.ctx, &simpleswap.QueryLiquidityProviderRequest{
 			LpAddress: s.addrs[0].String(),
 		})
-		require.Error(err)
+		require.Equal(codes.NotFound, status.Code(err))
+	})
+
+	t.Run("invalid address", func(t *testing.T) {
+		require := s.Require()
+
+		_, err := s.queryClient.LiquidityProvider(s.ctx, &simpleswap.QueryLiquidityProviderRequest{
+			LpAddress: "invalid",
+		})
+		require.Equal(codes.InvalidArgument, status.Code(err))
 	})
 }
 
@@ -44,7 +59,26 @@ func (s *KeeperTestSuite) TestQueryCoinReserve() {
 		_, err := s.queryClient.CoinReserve(s.ctx, &simpleswap.QueryCoinReserveRequest{
 			CoinDenom: "invalid",
 		})
-		require.Error(err)
+		require.Equal(codes.NotFound, status.Code(err))
+
+		// A whitelisted coin not yet deposited has an empty reserve
+		resp, err := s.queryClient.CoinReserve(s.ctx, &simpleswap.QueryCoinReserveRequest{
+			CoinDenom: "ETH",
+		})
+		require.NoError(err)
+		require.Equal(sdk.NewInt64Coin("ETH", 0), resp.CoinReserve)
+	})
+
+	t.Run("invalid denom", func(t *testing.T) {
+		require := s.Require()
+
+		_, err := s.queryClient.CoinReserve(s.ctx, &simpleswap.QueryCoinReserveRequest{})
+		require.Equal(codes.InvalidArgument, status.Code(err))
+
+		_, err = s.queryClient.CoinReserve(s.ctx, &simpleswap.QueryCoinReserveRequest{
+			CoinDenom: "1ETH",
+		})
+		require.Equal(codes.InvalidArgument, status.Code(err))
 	})
 }
 
@@ -54,7 +88,222 @@ func (s *KeeperTestSuite) TestQueryCoinsReserve() {
 	t.Run("empty coins reserve", func(t *testing.T) {
 		require := s.Require()
 
-		_, err := s.queryClient.CoinReserves(s.ctx, &simpleswap.QueryCoinReservesRequest{})
-		require.Error(err)
+		// The whitelisted coins not yet deposited are listed with an empty reserve
+		require.NoError(s.simpleSwapKeeper.CoinsReserve.Set(s.ctx, "WETH", sdk.NewInt64Coin("WETH", 1_000)))
+		resp, err := s.queryClient.CoinReserves(s.ctx, &simpleswap.QueryCoinReservesRequest{})
+		require.NoError(err)
+		require.Equal([]sdk.Coin{
+			sdk.NewInt64Coin("ETH", 0),
+			sdk.NewInt64Coin("WETH", 1_000),
+			sdk.NewInt64Coin("stkETH", 0),
+		}, resp.CoinReserves)
+		require.Len(resp.Origins, 3)
 	})
 }
+
+func (s *KeeperTestSuite) TestQueryPosition() {
+	require := s.Require()
+
+	// The pool accrued 60 in fees since the provider joined it
+	shareDenom := simpleswap.PoolShareDenom(simpleswap.DefaultPoolId)
+	require.NoError(s.simpleSwapKeeper.Pool.Set(s.ctx, simpleswap.Pool{
+		TotalAccruedFees: 100,
+		TotalLiquidity:   4_000_000,
+		Decimals:         6,
+		ShareToken:       &sdk.Coin{Denom: shareDenom, Amount: math.NewInt(4_000_000)},
+		Id:               simpleswap.DefaultPoolId,
+	}))
+	require.NoError(s.simpleSwapKeeper.CoinsReserve.Set(s.ctx, "ETH", sdk.NewInt64Coin("ETH", 1_500_000)))
+	require.NoError(s.simpleSwapKeeper.CoinsReserve.Set(s.ctx, "WETH", sdk.NewInt64Coin("WETH", 2_500_001)))
+
+	provider := simpleswap.LiquidityProvider{
+		StableCoin:          &sdk.Coin{Denom: "ETH", Amount: math.NewInt(1_000_000)},
+		PoolShare:           &sdk.Coin{Denom: shareDenom, Amount: math.NewInt(1_000_000)},
+		AccruedFees:         5,
+		GloballyAccruedFees: 40,
+	}
+	require.NoError(s.simpleSwapKeeper.LiquidityProviders.Set(s.ctx, s.addrs[0].String(), provider))
+
+	// The recorded fees are completed with the share of the fees accrued since then
+	resp, err := s.queryClient.Position(s.ctx, &simpleswap.QueryPositionRequest{Address: s.addrs[0].String()})
+	require.NoError(err)
+	require.Equal(provider, resp.LiquidityProvider)
+	require.Equal(sdk.NewInt64Coin("ETH", 20), resp.PendingFees)
+	require.Equal(math.LegacyNewDec(25), resp.PoolSharePercentage)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("ETH", 375_000), sdk.NewInt64Coin("WETH", 625_000)), resp.ReserveShares)
+	require.Equal(sdk.NewInt64Coin("ETH", 1_000_020), resp.ExitPayout)
+	require.True(resp.ExitAvailable)
+
+	// The reserve of the stable coin drained by swaps cannot pay out a full exit
+	require.NoError(s.simpleSwapKeeper.CoinsReserve.Set(s.ctx, "ETH", sdk.NewInt64Coin("ETH", 900_000)))
+	resp, err = s.queryClient.Position(s.ctx, &simpleswap.QueryPositionRequest{Address: s.addrs[0].String()})
+	require.NoError(err)
+	require.False(resp.ExitAvailable)
+
+	_, err = s.queryClient.Position(s.ctx, &simpleswap.QueryPositionRequest{Address: s.addrs[1].String()})
+	require.Equal(codes.NotFound, status.Code(err))
+
+	_, err = s.queryClient.Position(s.ctx, &simpleswap.QueryPositionRequest{Address: "invalid"})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *KeeperTestSuite) TestQueryLiquidityProviders() {
+	require := s.Require()
+
+	// The pool accrued 60 in fees since the providers joined it
+	shareDenom := simpleswap.PoolShareDenom(simpleswap.DefaultPoolId)
+	require.NoError(s.simpleSwapKeeper.Pool.Set(s.ctx, simpleswap.Pool{
+		TotalAccruedFees: 100,
+		TotalLiquidity:   4_000_000,
+		Decimals:         6,
+		ShareToken:       &sdk.Coin{Denom: shareDenom, Amount: math.NewInt(4_000_000)},
+		Id:               simpleswap.DefaultPoolId,
+	}))
+
+	providers := map[string]simpleswap.LiquidityProvider{
+		s.addrs[0].String(): {
+			StableCoin:          &sdk.Coin{Denom: "ETH", Amount: math.NewInt(1_000_000)},
+			PoolShare:           &sdk.Coin{Denom: shareDenom, Amount: math.NewInt(1_000_000)},
+			AccruedFees:         5,
+			GloballyAccruedFees: 40,
+		},
+		s.addrs[1].String(): {
+			StableCoin:          &sdk.Coin{Denom: "WETH", Amount: math.NewInt(2_000_000)},
+			PoolShare:           &sdk.Coin{Denom: shareDenom, Amount: math.NewInt(2_000_000)},
+			GloballyAccruedFees: 100,
+		},
+		s.addrs[2].String(): {
+			StableCoin:          &sdk.Coin{Denom: "ETH", Amount: math.NewInt(1_000_000)},
+			PoolShare:           &sdk.Coin{Denom: shareDenom, Amount: math.NewInt(1_000_000)},
+			GloballyAccruedFees: 40,
+		},
+	}
+	for address, provider := range providers {
+		require.NoError(s.simpleSwapKeeper.LiquidityProviders.Set(s.ctx, address, provider))
+	}
+
+	// All the providers are returned with their share of the pool and their pending fees
+	resp, err := s.queryClient.LiquidityProviders(s.ctx, &simpleswap.QueryLiquidityProvidersRequest{})
+	require.NoError(err)
+	require.Len(resp.LiquidityProviders, 3)
+	require.Equal(uint64(3), resp.Pagination.Total)
+
+	entries := make(map[string]simpleswap.LiquidityProviderEntry)
+	for _, entry := range resp.LiquidityProviders {
+		require.Equal(providers[entry.Address], entry.LiquidityProvider)
+		entries[entry.Address] = entry
+	}
+	require.Equal(math.LegacyNewDecWithPrec(25, 2), entries[s.addrs[0].String()].PoolShareRatio)
+	require.Equal(int64(20), entries[s.addrs[0].String()].PendingFees)
+	require.Equal(math.LegacyNewDecWithPrec(5, 1), entries[s.addrs[1].String()].PoolShareRatio)
+	require.Equal(int64(0), entries[s.addrs[1].String()].PendingFees)
+	require.Equal(int64(15), entries[s.addrs[2].String()].PendingFees)
+
+	// The providers are filtered by the denom of their stable coin, and paginated
+	resp, err = s.queryClient.LiquidityProviders(s.ctx, &simpleswap.QueryLiquidityProvidersRequest{
+		Denom:      "ETH",
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Len(resp.LiquidityProviders, 1)
+	require.Equal("ETH", resp.LiquidityProviders[0].LiquidityProvider.StableCoin.Denom)
+	require.Equal(uint64(2), resp.Pagination.Total)
+	require.NotNil(resp.Pagination.NextKey)
+
+	next, err := s.queryClient.LiquidityProviders(s.ctx, &simpleswap.QueryLiquidityProvidersRequest{
+		Denom:      "ETH",
+		Pagination: &query.PageRequest{Key: resp.Pagination.NextKey},
+	})
+	require.NoError(err)
+	require.Len(next.LiquidityProviders, 1)
+	require.Equal("ETH", next.LiquidityProviders[0].LiquidityProvider.StableCoin.Denom)
+	require.NotEqual(resp.LiquidityProviders[0].Address, next.LiquidityProviders[0].Address)
+	require.Nil(next.Pagination.NextKey)
+
+	resp, err = s.queryClient.LiquidityProviders(s.ctx, &simpleswap.QueryLiquidityProvidersRequest{Denom: "stkETH"})
+	require.NoError(err)
+	require.Empty(resp.LiquidityProviders)
+}
+
+func (s *KeeperTestSuite) TestQueryLiquidityProvidersByDenom() {
+	require := s.Require()
+
+	shareDenom := simpleswap.PoolShareDenom(simpleswap.DefaultPoolId)
+	require.NoError(s.simpleSwapKeeper.Pool.Set(s.ctx, simpleswap.Pool{
+		TotalLiquidity: 6_000_000,
+		Decimals:       6,
+		ShareToken:     &sdk.Coin{Denom: shareDenom, Amount: math.NewInt(6_000_000)},
+		Id:             simpleswap.DefaultPoolId,
+	}))
+
+	provider := func(denom string, amount int64) simpleswap.LiquidityProvider {
+		return simpleswap.LiquidityProvider{
+			StableCoin: &sdk.Coin{Denom: denom, Amount: math.NewInt(amount)},
+			PoolShare:  &sdk.Coin{Denom: shareDenom, Amount: math.NewInt(amount)},
+		}
+	}
+	require.NoError(s.simpleSwapKeeper.LiquidityProviders.Set(s.ctx, s.addrs[0].String(), provider("WETH", 1_000_000)))
+	require.NoError(s.simpleSwapKeeper.LiquidityProviders.Set(s.ctx, s.addrs[1].String(), provider("WETH", 2_000_000)))
+	require.NoError(s.simpleSwapKeeper.LiquidityProviders.Set(s.ctx, s.addrs[2].String(), provider("ETH", 3_000_000)))
+
+	// The providers of the denom are returned with the total exposure to it
+	resp, err := s.queryClient.LiquidityProvidersByDenom(s.ctx, &simpleswap.QueryLiquidityProvidersByDenomRequest{Denom: "WETH"})
+	require.NoError(err)
+	require.Len(resp.LiquidityProviders, 2)
+	require.Equal(sdk.NewInt64Coin("WETH", 3_000_000), resp.TotalExposure)
+	require.Nil(resp.Pagination.NextKey)
+	for _, entry := range resp.LiquidityProviders {
+		require.Equal("WETH", entry.LiquidityProvider.StableCoin.Denom)
+	}
+
+	// The total exposure covers all the pages
+	first, err := s.queryClient.LiquidityProvidersByDenom(s.ctx, &simpleswap.QueryLiquidityProvidersByDenomRequest{
+		Denom:      "WETH",
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Equal(resp.LiquidityProviders[:1], first.LiquidityProviders)
+	require.Equal(sdk.NewInt64Coin("WETH", 3_000_000), first.TotalExposure)
+	require.Equal(uint64(2), first.Pagination.Total)
+	require.NotNil(first.Pagination.NextKey)
+
+	next, err := s.queryClient.LiquidityProvidersByDenom(s.ctx, &simpleswap.QueryLiquidityProvidersByDenomRequest{
+		Denom:      "WETH",
+		Pagination: &query.PageRequest{Key: first.Pagination.NextKey},
+	})
+	require.NoError(err)
+	require.Equal(resp.LiquidityProviders[1:], next.LiquidityProviders)
+	require.Nil(next.Pagination.NextKey)
+
+	offset, err := s.queryClient.LiquidityProvidersByDenom(s.ctx, &simpleswap.QueryLiquidityProvidersByDenomRequest{
+		Denom:      "WETH",
+		Pagination: &query.PageRequest{Offset: 1},
+	})
+	require.NoError(err)
+	require.Equal(resp.LiquidityProviders[1:], offset.LiquidityProviders)
+
+	reverse, err := s.queryClient.LiquidityProvidersByDenom(s.ctx, &simpleswap.QueryLiquidityProvidersByDenomRequest{
+		Denom:      "WETH",
+		Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+	})
+	require.NoError(err)
+	require.Equal(resp.LiquidityProviders[1:], reverse.LiquidityProviders)
+
+	// The index follows the updates and the removals of the providers
+	require.NoError(s.simpleSwapKeeper.LiquidityProviders.Set(s.ctx, s.addrs[0].String(), provider("ETH", 1_000_000)))
+	require.NoError(s.simpleSwapKeeper.LiquidityProviders.Remove(s.ctx, s.addrs[2].String()))
+
+	resp, err = s.queryClient.LiquidityProvidersByDenom(s.ctx, &simpleswap.QueryLiquidityProvidersByDenomRequest{Denom: "ETH"})
+	require.NoError(err)
+	require.Len(resp.LiquidityProviders, 1)
+	require.Equal(s.addrs[0].String(), resp.LiquidityProviders[0].Address)
+	require.Equal(sdk.NewInt64Coin("ETH", 1_000_000), resp.TotalExposure)
+
+	resp, err = s.queryClient.LiquidityProvidersByDenom(s.ctx, &simpleswap.QueryLiquidityProvidersByDenomRequest{Denom: "stkETH"})
+	require.NoError(err)
+	require.Empty(resp.LiquidityProviders)
+	require.True(resp.TotalExposure.IsZero())
+
+	_, err = s.queryClient.LiquidityProvidersByDenom(s.ctx, &simpleswap.QueryLiquidityProvidersByDenomRequest{})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+}
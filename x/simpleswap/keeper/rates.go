@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/simpleswap"
+)
+
+var _ simpleswap.RateProvider = governanceRateProvider{}
+
+// governanceRateProvider is the built-in rate provider, it reads the rates set with
+// MsgSetAssetRate. A coin without a governance rate has a rate of 1.
+type governanceRateProvider struct {
+	k Keeper
+}
+
+// GetRate implements the simpleswap.RateProvider interface.
+func (p governanceRateProvider) GetRate(ctx context.Context, denom string) (math.LegacyDec, error) {
+	assetRate, err := p.k.AssetRates.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return math.LegacyOneDec(), nil
+		}
+		return math.LegacyDec{}, err
+	}
+
+	return assetRate.Rate, nil
+}
+
+// GovernanceRateProvider returns the built-in rate provider reading the governance-set rates.
+func (k Keeper) GovernanceRateProvider() simpleswap.RateProvider {
+	return governanceRateProvider{k: k}
+}
+
+// AssetRate returns the redemption rate of a denom from its rate provider.
+func (k Keeper) AssetRate(ctx context.Context, denom string) (math.LegacyDec, error) {
+	provider, ok := k.rateProviders[denom]
+	if !ok {
+		provider = k.GovernanceRateProvider()
+	}
+
+	rate, err := provider.GetRate(ctx, denom)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	if rate.IsNil() || !rate.IsPositive() {
+		return math.LegacyDec{}, errorsmod.Wrapf(simpleswap.ErrInvalidAssetRate, "for the denom: %s", denom)
+	}
+
+	return rate, nil
+}
+
+// liquidityValue returns the value of a coin in the pool reference asset, i.e. its amount
+// normalized to the pool decimals and scaled by its rate. Callers round the values owed to
+// the pool up and the values owed by the pool down.
+func (k Keeper) liquidityValue(ctx context.Context, params simpleswap.Params, pool simpleswap.Pool, coin sdk.Coin, roundUp bool) (math.Int, error) {
+	rate, err := k.AssetRate(ctx, coin.Denom)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	value := math.LegacyNewDecFromInt(k.normalizeAmount(ctx, params, pool, coin, roundUp)).Mul(rate)
+	if roundUp {
+		return value.Ceil().TruncateInt(), nil
+	}
+
+	return value.TruncateInt(), nil
+}
+
+// coinForValue returns the coin of the denom worth the value in the pool reference asset,
+// with the same rounding rules as liquidityValue.
+func (k Keeper) coinForValue(ctx context.Context, params simpleswap.Params, pool simpleswap.Pool, denom string, value math.Int, roundUp bool) (sdk.Coin, error) {
+	rate, err := k.AssetRate(ctx, denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	normalized := math.LegacyNewDecFromInt(value).Quo(rate)
+	if roundUp {
+		return k.denormalizeAmount(ctx, params, pool, denom, normalized.Ceil().TruncateInt(), true), nil
+	}
+
+	return k.denormalizeAmount(ctx, params, pool, denom, normalized.TruncateInt(), false), nil
+}
@@ -0,0 +1,200 @@
+package keeper_test
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	types "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/simpleswap"
+)
+
+// fixedRateProvider is a rate provider returning a constant rate.
+type fixedRateProvider struct {
+	rate math.LegacyDec
+}
+
+func (p fixedRateProvider) GetRate(context.Context, string) (math.LegacyDec, error) {
+	return p.rate, nil
+}
+
+func (s *KeeperTestSuite) TestSetAssetRate() {
+	testCases := []struct {
+		name      string
+		authority string
+		denom     string
+		rate      math.LegacyDec
+		expectErr error
+	}{
+		{
+			name:      "not whitelisted",
+			authority: s.simpleSwapKeeper.GetAuthority(),
+			denom:     "BTC",
+			rate:      math.LegacyOneDec(),
+			expectErr: simpleswap.ErrCoinInvalid,
+		},
+		{
+			name:      "non positive rate",
+			authority: s.simpleSwapKeeper.GetAuthority(),
+			denom:     "stkETH",
+			rate:      math.LegacyZeroDec(),
+			expectErr: simpleswap.ErrInvalidAssetRate,
+		},
+		{
+			name:      "valid rate",
+			authority: s.simpleSwapKeeper.GetAuthority(),
+			denom:     "stkETH",
+			rate:      math.LegacyMustNewDecFromStr("1.05"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			require := s.Require()
+			s.initGenesis(simpleswap.DefaultParams())
+
+			_, err := s.msgServer.SetAssetRate(s.ctx, &simpleswap.MsgSetAssetRate{
+				Authority: tc.authority,
+				Denom:     tc.denom,
+				Rate:      tc.rate,
+			})
+			if tc.expectErr != nil {
+				require.ErrorIs(err, tc.expectErr)
+				return
+			}
+			require.NoError(err)
+
+			resp, err := s.queryClient.AssetRate(s.ctx, &simpleswap.QueryAssetRateRequest{Denom: tc.denom})
+			require.NoError(err)
+			require.Equal(tc.rate, resp.Rate)
+		})
+	}
+
+	_, err := s.msgServer.SetAssetRate(s.ctx, &simpleswap.MsgSetAssetRate{
+		Authority: s.addrs[1].String(),
+		Denom:     "stkETH",
+		Rate:      math.LegacyOneDec(),
+	})
+	s.Require().ErrorContains(err, "unauthorized")
+}
+
+func (s *KeeperTestSuite) TestSwapLiquidityAtAssetRates() {
+	require := s.Require()
+	trader := s.addrs[1]
+
+	s.initGenesis(simpleswap.DefaultParams())
+	_, err := s.msgServer.SetAssetRate(s.ctx, &simpleswap.MsgSetAssetRate{
+		Authority: s.simpleSwapKeeper.GetAuthority(),
+		Denom:     "stkETH",
+		Rate:      math.LegacyMustNewDecFromStr("1.05"),
+	})
+	require.NoError(err)
+
+	// Coins without a rate are worth 1
+	resp, err := s.queryClient.AssetRate(s.ctx, &simpleswap.QueryAssetRateRequest{Denom: "ETH"})
+	require.NoError(err)
+	require.Equal(math.LegacyOneDec(), resp.Rate)
+
+	input := types.NewCoin("stkETH", math.NewInt(100))
+	require.NoError(s.simpleSwapKeeper.CoinsReserve.Set(s.ctx, "ETH", types.NewCoin("ETH", math.NewInt(1000))))
+	s.bankKeeper.EXPECT().SpendableCoin(s.ctx, trader, "stkETH").Return(input).AnyTimes()
+
+	// 100 stkETH are not worth 100 ETH
+	_, err = s.msgServer.SwapLiquidity(s.ctx, &simpleswap.MsgSwapLiquidity{
+		Trader: trader.String(),
+		Input:  input,
+		Output: types.NewCoin("ETH", math.NewInt(100)),
+	})
+	require.ErrorIs(err, simpleswap.ErrAmountNotEqual)
+
+	// 100 stkETH are worth 105 ETH, minus the swap fee rounded up
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, trader, simpleswap.ModuleName, types.NewCoins(input)).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(s.ctx, simpleswap.ModuleName, trader, types.NewCoins(types.NewCoin("ETH", math.NewInt(104)))).Return(nil).Times(1)
+	_, err = s.msgServer.SwapLiquidity(s.ctx, &simpleswap.MsgSwapLiquidity{
+		Trader: trader.String(),
+		Input:  input,
+		Output: types.NewCoin("ETH", math.NewInt(105)),
+	})
+	require.NoError(err)
+}
+
+func (s *KeeperTestSuite) TestRateProvider() {
+	require := s.Require()
+
+	s.initGenesis(simpleswap.DefaultParams())
+	_, err := s.msgServer.SetAssetRate(s.ctx, &simpleswap.MsgSetAssetRate{
+		Authority: s.simpleSwapKeeper.GetAuthority(),
+		Denom:     "stkETH",
+		Rate:      math.LegacyMustNewDecFromStr("1.05"),
+	})
+	require.NoError(err)
+
+	// A rate provider set by another module takes precedence over the governance rate
+	s.simpleSwapKeeper.SetRateProvider("stkETH", fixedRateProvider{rate: math.LegacyMustNewDecFromStr("1.1")})
+	require.Panics(func() { s.simpleSwapKeeper.SetRateProvider("stkETH", fixedRateProvider{}) })
+
+	rate, err := s.simpleSwapKeeper.AssetRate(s.ctx, "stkETH")
+	require.NoError(err)
+	require.Equal(math.LegacyMustNewDecFromStr("1.1"), rate)
+
+	governanceRate, err := s.simpleSwapKeeper.GovernanceRateProvider().GetRate(s.ctx, "stkETH")
+	require.NoError(err)
+	require.Equal(math.LegacyMustNewDecFromStr("1.05"), governanceRate)
+
+	// Non positive rates are rejected
+	s.simpleSwapKeeper.SetRateProvider("WETH", fixedRateProvider{rate: math.LegacyZeroDec()})
+	_, err = s.simpleSwapKeeper.AssetRate(s.ctx, "WETH")
+	require.ErrorIs(err, simpleswap.ErrInvalidAssetRate)
+}
+
+func (s *KeeperTestSuite) TestLiquidityAtAssetRates() {
+	require := s.Require()
+	ethProvider, stkProvider := s.addrs[1], s.addrs[2]
+	shareDenom := simpleswap.PoolShareDenom(simpleswap.DefaultPoolId)
+
+	s.initGenesis(simpleswap.DefaultParams())
+	s.simpleSwapKeeper.SetRateProvider("stkETH", fixedRateProvider{rate: math.LegacyMustNewDecFromStr("1.1")})
+
+	// 100 ETH mint 100 shares, 100 stkETH are worth 110 ETH and mint 110 shares
+	for _, deposit := range []struct {
+		provider types.AccAddress
+		token    types.Coin
+		shares   types.Coin
+	}{
+		{ethProvider, types.NewInt64Coin("ETH", 100), types.NewInt64Coin(shareDenom, 100)},
+		{stkProvider, types.NewInt64Coin("stkETH", 100), types.NewInt64Coin(shareDenom, 110)},
+	} {
+		s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, deposit.provider, simpleswap.ModuleName, types.NewCoins(deposit.token)).Return(nil).Times(1)
+		s.bankKeeper.EXPECT().MintCoins(s.ctx, simpleswap.ModuleName, types.NewCoins(deposit.shares)).Return(nil).Times(1)
+		s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(s.ctx, simpleswap.ModuleName, deposit.provider, types.NewCoins(deposit.shares)).Return(nil).Times(1)
+		resp, err := s.msgServer.AddLiquidity(s.ctx, &simpleswap.MsgAddLiquidity{LiquidityProvider: deposit.provider.String(), Token: deposit.token})
+		require.NoError(err)
+		require.Equal(deposit.shares, resp.SharesMinted)
+	}
+
+	// The ETH provided cannot be withdrawn as the stkETH worth more, nor the other way around
+	_, err := s.msgServer.RemoveLiquidity(s.ctx, &simpleswap.MsgRemoveLiquidity{
+		LiquidityProvider: ethProvider.String(),
+		Token:             types.NewInt64Coin("stkETH", 100),
+	})
+	require.ErrorIs(err, simpleswap.ErrDenomMismatch)
+
+	_, err = s.msgServer.RemoveLiquidity(s.ctx, &simpleswap.MsgRemoveLiquidity{
+		LiquidityProvider: stkProvider.String(),
+		Token:             types.NewInt64Coin("ETH", 100),
+	})
+	require.ErrorIs(err, simpleswap.ErrDenomMismatch)
+
+	// The stkETH withdrawn burns the shares it is worth at the rate
+	withdrawn := types.NewInt64Coin("stkETH", 50)
+	burned := types.NewCoins(types.NewInt64Coin(shareDenom, 55))
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, stkProvider, simpleswap.ModuleName, burned).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().BurnCoins(s.ctx, simpleswap.ModuleName, burned).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(s.ctx, simpleswap.ModuleName, stkProvider, types.NewCoins(withdrawn)).Return(nil).Times(1)
+	resp, err := s.msgServer.RemoveLiquidity(s.ctx, &simpleswap.MsgRemoveLiquidity{
+		LiquidityProvider: stkProvider.String(),
+		Token:             withdrawn,
+	})
+	require.NoError(err)
+	require.Equal(burned[0], resp.SharesBurned)
+}
@@ -0,0 +1,336 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/simpleswap"
+)
+
+// SwapExactAmountIn swaps the whole input coin of the trader for the output coin worth the
+// same in the pool, and returns the output paid to the trader net of the swap fee. The swap
+// fails if the output paid is below minOutput.
+func (k Keeper) SwapExactAmountIn(ctx context.Context, trader sdk.AccAddress, input sdk.Coin, outputDenom string, minOutput math.Int) (sdk.Coin, error) {
+	traderAddr, err := k.addressCodec.BytesToString(trader)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	pool, err := k.initializedPool(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	output, err := k.equivalentCoin(ctx, params, pool, input, outputDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	resp, err := k.swap(ctx, &simpleswap.MsgSwapLiquidity{
+		Trader: traderAddr,
+		Input:  input,
+		Output: output,
+	}, false)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	paid := resp.Output
+	if paid.Amount.LT(minOutput) {
+		return sdk.Coin{}, errorsmod.Wrapf(simpleswap.ErrMinOutput, "got: %s, minimum: %s", paid, minOutput)
+	}
+
+	return paid, nil
+}
+
+// swap executes the swap of the message, or queues it in the batch auction mode if batch is set.
+// The swaps of the module itself are executed immediately.
+func (k Keeper) swap(ctx context.Context, msg *simpleswap.MsgSwapLiquidity, batch bool) (*simpleswap.MsgSwapLiquidityResponse, error) {
+	// Check if the amount is zero
+	if msg.Input.Amount.IsNil() || msg.Input.Amount.IsZero() {
+		return nil, simpleswap.ErrZeroAmount
+	}
+
+	if !msg.Input.IsValid() {
+		return nil, errorsmod.Wrapf(simpleswap.ErrCoinInvalid, "for the input: %s", msg.Input)
+	}
+
+	if msg.Output.Amount.IsNil() || !msg.Output.IsValid() {
+		return nil, errorsmod.Wrapf(simpleswap.ErrCoinInvalid, "for the output: %s", msg.Output)
+	}
+
+	// Check if the trader address is valid
+	addr, err := k.addressCodec.StringToBytes(msg.Trader)
+	if err != nil {
+		return nil, errorsmod.Wrapf(simpleswap.ErrInvalidAddress, "invalid trader address: %s", err)
+	}
+
+	// Get the current pool state
+	currentPoolState, err := k.initializedPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if the coins being swapped are present in the whitelist
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	whitelistedDenoms := params.WhitelistedDenoms()
+	if !slices.Contains(whitelistedDenoms, msg.Input.Denom) {
+		return nil, errorsmod.Wrapf(simpleswap.ErrCoinInvalid, "for the denom: %s", msg.Input.Denom)
+	}
+
+	if !slices.Contains(whitelistedDenoms, msg.Output.Denom) || msg.Output.Denom == msg.Input.Denom {
+		return nil, errorsmod.Wrapf(simpleswap.ErrCoinInvalid, "for the denom: %s", msg.Output.Denom)
+	}
+
+	// Check the swap fee is within the bound accepted by the trader
+	if msg.MaxSwapFeePercentage != 0 && params.SwapFeePercentage > msg.MaxSwapFeePercentage {
+		return nil, errorsmod.Wrapf(simpleswap.ErrSwapFeeTooHigh, "the swap fee percentage is %d", params.SwapFeePercentage)
+	}
+
+	// Check if the input and output are worth the same in the pool decimals at the rates of the coins, rounding in favour of the pool
+	normalizedInput, err := k.liquidityValue(ctx, params, currentPoolState, msg.Input, false)
+	if err != nil {
+		return nil, err
+	}
+
+	normalizedOutput, err := k.liquidityValue(ctx, params, currentPoolState, msg.Output, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if normalizedInput.IsZero() {
+		return nil, errorsmod.Wrapf(simpleswap.ErrZeroAmount, "the input %s is worth nothing in the pool decimals", msg.Input)
+	}
+
+	if !normalizedInput.Equal(normalizedOutput) {
+		return nil, errorsmod.Wrapf(simpleswap.ErrAmountNotEqual, "the input %s is worth %s, the output %s is worth %s", msg.Input, normalizedInput, msg.Output, normalizedOutput)
+	}
+
+	// In the batch auction mode the input is escrowed and the swap is cleared at the end of the block
+	if batch && params.BatchAuction {
+		if err := k.queueBatchSwap(ctx, msg); err != nil {
+			return nil, err
+		}
+
+		return &simpleswap.MsgSwapLiquidityResponse{
+			Input:   msg.Input,
+			Output:  sdk.NewCoin(msg.Output.Denom, math.ZeroInt()),
+			SwapFee: sdk.NewCoin(msg.Output.Denom, math.ZeroInt()),
+			Queued:  true,
+		}, nil
+	}
+
+	// Check the oracle price of the coins being swapped against the depeg bound
+	surchargeRate, err := k.oracleSurcharge(ctx, params, msg.Input.Denom, msg.Output.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	// The surcharge is charged on top of the swap fee, it must be within the bound too
+	if err := checkMaxSwapFee(currentPoolState, params.SwapFeePercentage, surchargeRate, msg.MaxSwapFeePercentage); err != nil {
+		return nil, err
+	}
+
+	// Check if the trader has the required input token
+	coins := k.BankKeeper.SpendableCoin(ctx, addr, msg.Input.Denom)
+	if coins.Amount.LT(msg.Input.Amount) {
+		return nil, errorsmod.Wrapf(simpleswap.ErrInsufficientBalance, "got: %s, required: %s", coins, msg.Input)
+	}
+
+	// Check if the required output token is present in required quantity
+	coinsReserveOutputToken, err := k.CoinsReserve.Get(ctx, msg.Output.Denom)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return nil, err
+		}
+
+		coinsReserveOutputToken = sdk.NewCoin(msg.Output.Denom, math.ZeroInt())
+	}
+
+	if coinsReserveOutputToken.Amount.LT(msg.Output.Amount) {
+		return nil, errorsmod.Wrapf(simpleswap.ErrInsufficientReserve, "got: %s, required: %s", coinsReserveOutputToken, msg.Output)
+	}
+
+	// Transfer the input token from the trader to the module account
+	if err := k.BankKeeper.SendCoinsFromAccountToModule(ctx, addr, simpleswap.ModuleName, sdk.NewCoins(msg.Input)); err != nil {
+		return nil, errorsmod.Wrapf(simpleswap.ErrInsufficientBalance, "cannot send %s: %s", msg.Input, err)
+	}
+
+	// Get the coins reserve and Update the coins reserve for the input coins provided by the trader
+	coinsReserveInputToken, err := k.CoinsReserve.Get(ctx, msg.Input.Denom)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return nil, err
+		}
+
+		coinsReserveInputToken = sdk.NewCoin(msg.Input.Denom, math.ZeroInt())
+	}
+
+	coinsReserveOutputToken.Amount = coinsReserveOutputToken.Amount.Sub(msg.Output.Amount)
+	if err := k.CoinsReserve.Set(ctx, msg.Output.Denom, coinsReserveOutputToken); err != nil {
+		return nil, err
+	}
+
+	// Calculate Fees in the pool decimals and charge it from the output token
+	swapFee := computeSwapFee(currentPoolState, normalizedOutput, surchargeRate)
+
+	// Deduct the swap fee from the output token, the payout is rounded down in favour of the pool
+	payout, err := k.coinForValue(ctx, params, currentPoolState, msg.Output.Denom, normalizedOutput.Sub(swapFee), false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update the Coins Reserve For Input Token
+	coinsReserveInputToken.Amount = coinsReserveInputToken.Amount.Add(msg.Input.Amount)
+	if err := k.CoinsReserve.Set(ctx, msg.Input.Denom, coinsReserveInputToken); err != nil {
+		return nil, err
+	}
+
+	// Update the fees in the Pool
+	currentPoolState.TotalAccruedFees += swapFee.Int64()
+	if err := k.Pool.Set(ctx, simpleswap.Pool{
+		TotalAccruedFees:  currentPoolState.TotalAccruedFees,
+		TotalLiquidity:    currentPoolState.TotalLiquidity,
+		Decimals:          currentPoolState.Decimals,
+		ShareToken:        currentPoolState.ShareToken,
+		SwapFeePercentage: currentPoolState.SwapFeePercentage,
+		Id:                currentPoolState.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.recordSwapStats(ctx, msg.Input.Denom, msg.Output.Denom, normalizedOutput, swapFee); err != nil {
+		return nil, err
+	}
+
+	// Send the Required Token to the trader
+	if err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, simpleswap.ModuleName, addr, sdk.NewCoins(payout)); err != nil {
+		return nil, errorsmod.Wrapf(simpleswap.ErrPayout, "cannot send %s: %s", payout, err)
+	}
+
+	if err := k.Hooks().AfterSwap(ctx, addr, currentPoolState.Id, msg.Input, payout); err != nil {
+		return nil, err
+	}
+
+	return &simpleswap.MsgSwapLiquidityResponse{
+		Input:   msg.Input,
+		Output:  payout,
+		SwapFee: msg.Output.Sub(payout),
+	}, nil
+}
+
+// swapEscrowed swaps input coins escrowed in the module account for their owner, who receives
+// at least minOutput. The swap runs in a cached context, which is only written when it succeeds:
+// on an error the escrow is kept in the module account.
+func (k Keeper) swapEscrowed(ctx sdk.Context, owner string, input sdk.Coin, outputDenom string, minOutput math.Int) (sdk.Coin, error) {
+	ownerAddr, err := k.addressCodec.StringToBytes(owner)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+
+	// Release the escrow to the owner, who is the trader of the swap
+	if err := k.BankKeeper.SendCoinsFromModuleToAccount(cacheCtx, simpleswap.ModuleName, ownerAddr, sdk.NewCoins(input)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	output, err := k.SwapExactAmountIn(cacheCtx, ownerAddr, input, outputDenom, minOutput)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	write()
+
+	return output, nil
+}
+
+// computeSwapFee returns the fee charged on the output of a swap in the pool decimals, with the oracle
+// depeg surcharge on top of the swap fee. The swap fee percentage is expressed with the pool
+// decimals, i.e. divided by 10^decimals * 100. The fee is rounded up in favour of the pool.
+func computeSwapFee(pool simpleswap.Pool, output math.Int, surchargeRate math.LegacyDec) math.Int {
+	return surchargedFee(pool, output, pool.SwapFeePercentage, surchargeRate)
+}
+
+// checkMaxSwapFee checks the swap fee percentage, with the oracle surcharge rate on top, is within
+// the bound accepted by the trader, 0 for no bound. The bound is in the units of the swap fee
+// percentage, i.e. multiplied by 10^decimals * 100.
+func checkMaxSwapFee(pool simpleswap.Pool, swapFeePercentage int32, surchargeRate math.LegacyDec, maxSwapFeePercentage int32) error {
+	if maxSwapFeePercentage == 0 {
+		return nil
+	}
+
+	feePercentage := math.LegacyNewDec(int64(swapFeePercentage)).Add(surchargeRate.MulInt(pow10(pool.Decimals).MulRaw(100)))
+	if feePercentage.GT(math.LegacyNewDec(int64(maxSwapFeePercentage))) {
+		return errorsmod.Wrapf(simpleswap.ErrSwapFeeTooHigh, "the swap fee percentage is %s with the oracle surcharge, the maximum is %d", feePercentage, maxSwapFeePercentage)
+	}
+
+	return nil
+}
+
+// surchargedFee returns the fee percentage of the output in the pool decimals, rounded up, with
+// the oracle depeg surcharge on top and capped at the output.
+func surchargedFee(pool simpleswap.Pool, output math.Int, percentage int32, surchargeRate math.LegacyDec) math.Int {
+	swapFeeDenominator := pow10(pool.Decimals).MulRaw(100)
+	fee := output.Mul(math.NewInt(int64(percentage))).Add(swapFeeDenominator).SubRaw(1).Quo(swapFeeDenominator)
+
+	fee = fee.Add(math.LegacyNewDecFromInt(output).Mul(surchargeRate).Ceil().TruncateInt())
+	return math.MinInt(fee, output)
+}
+
+// EquivalentCoin returns the coin of the denom worth the input at the pool price, i.e. the
+// output of a swap of the input before the swap fee.
+func (k Keeper) EquivalentCoin(ctx context.Context, input sdk.Coin, denom string) (sdk.Coin, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	pool, err := k.Pool.Get(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return k.equivalentCoin(ctx, params, pool, input, denom)
+}
+
+// equivalentCoin picks the coin of the denom whose value, rounded up as in a swap, is the
+// value of the input.
+func (k Keeper) equivalentCoin(ctx context.Context, params simpleswap.Params, pool simpleswap.Pool, input sdk.Coin, denom string) (sdk.Coin, error) {
+	value, err := k.liquidityValue(ctx, params, pool, input, false)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	for _, roundUp := range []bool{false, true} {
+		candidate, err := k.coinForValue(ctx, params, pool, denom, value, roundUp)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+
+		candidateValue, err := k.liquidityValue(ctx, params, pool, candidate, true)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+
+		if candidateValue.Equal(value) {
+			return candidate, nil
+		}
+	}
+
+	return sdk.Coin{}, errorsmod.Wrapf(simpleswap.ErrAmountNotEqual, "for the input: %s", input)
+}
@@ -4,9 +4,35 @@ import "cosmossdk.io/collections"
 
 const ModuleName = "simpleswap"
 
+// DefaultPoolId is the identifier of the pool managed by the module.
+const DefaultPoolId uint64 = 1
+
 var (
 	ParamsKey  = collections.NewPrefix(0)
 	PoolKey    = collections.NewPrefix(1)
 	LiquidityProvidersKey = collections.NewPrefix(2)
 	CoinsReserveKey = collections.NewPrefix(3)
+	GaugesKey       = collections.NewPrefix(4)
+	GaugeSequenceKey = collections.NewPrefix(5)
+	RewardsKey      = collections.NewPrefix(6)
+	LocksKey        = collections.NewPrefix(7)
+	LockSequenceKey = collections.NewPrefix(8)
+	AssetRatesKey   = collections.NewPrefix(9)
+	LimitOrdersKey  = collections.NewPrefix(10)
+	LimitOrderSequenceKey = collections.NewPrefix(11)
+	DCAPlansKey     = collections.NewPrefix(12)
+	DCAPlanSequenceKey = collections.NewPrefix(13)
+	BatchSwapsKey   = collections.NewPrefix(14)
+	BatchSwapSequenceKey = collections.NewPrefix(15)
+	LiquidityProvidersByDenomKey = collections.NewPrefix(16)
+	PoolStatsKey    = collections.NewPrefix(17)
+	LocksByEndTimeKey = collections.NewPrefix(18)
+	LimitOrderCursorKey = collections.NewPrefix(19)
+	DCAPlansByNextExecutionKey = collections.NewPrefix(20)
+	LiquidityExposureKey = collections.NewPrefix(21)
+	RewardAccumulatorKey = collections.NewPrefix(22)
+	LocksByOwnerKey = collections.NewPrefix(23)
+	LimitOrdersByOwnerKey = collections.NewPrefix(24)
+	LimitOrdersByPairKey = collections.NewPrefix(25)
+	DCAPlansByOwnerKey = collections.NewPrefix(26)
 )
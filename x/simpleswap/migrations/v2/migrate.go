@@ -0,0 +1,399 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/simpleswap"
+	expectedkeepers "github.com/cosmos/simpleswap/expected_keepers"
+)
+
+// The prefixes of the collections read and written by the migration. They are frozen here, the
+// migration keeps writing the version 2 layout whatever the keeper collections become.
+var (
+	paramsKey                    = collections.NewPrefix(0)
+	poolKey                      = collections.NewPrefix(1)
+	liquidityProvidersKey        = collections.NewPrefix(2)
+	rewardsKey                   = collections.NewPrefix(6)
+	locksKey                     = collections.NewPrefix(7)
+	limitOrdersKey               = collections.NewPrefix(10)
+	dcaPlansKey                  = collections.NewPrefix(12)
+	liquidityProvidersByDenomKey = collections.NewPrefix(16)
+	locksByEndTimeKey            = collections.NewPrefix(18)
+	dcaPlansByNextExecutionKey   = collections.NewPrefix(20)
+	liquidityExposureKey         = collections.NewPrefix(21)
+	rewardAccumulatorKey         = collections.NewPrefix(22)
+	locksByOwnerKey              = collections.NewPrefix(23)
+	limitOrdersByOwnerKey        = collections.NewPrefix(24)
+	limitOrdersByPairKey         = collections.NewPrefix(25)
+	dcaPlansByOwnerKey           = collections.NewPrefix(26)
+)
+
+// store holds the version 2 collections of the module.
+type store struct {
+	params                    collections.Item[simpleswap.Params]
+	pool                      collections.Item[simpleswap.Pool]
+	liquidityProviders        collections.Map[string, simpleswap.LiquidityProvider]
+	liquidityProvidersByDenom collections.KeySet[collections.Pair[string, string]]
+	liquidityExposure         collections.Map[string, math.Int]
+	rewards                   collections.Map[string, simpleswap.Rewards]
+	rewardAccumulator         collections.Item[simpleswap.RewardAccumulator]
+	locks                     collections.Map[uint64, simpleswap.PeriodLock]
+	locksByEndTime            collections.KeySet[collections.Pair[time.Time, uint64]]
+	locksByOwner              collections.KeySet[collections.Pair[string, uint64]]
+	limitOrders               collections.Map[uint64, simpleswap.LimitOrder]
+	limitOrdersByOwner        collections.KeySet[collections.Pair[string, uint64]]
+	limitOrdersByPair         collections.KeySet[collections.Pair[collections.Pair[string, string], uint64]]
+	dcaPlans                  collections.Map[uint64, simpleswap.DCAPlan]
+	dcaPlansByNextExecution   collections.KeySet[collections.Pair[int64, uint64]]
+	dcaPlansByOwner           collections.KeySet[collections.Pair[string, uint64]]
+}
+
+// newStore creates the version 2 collections of the module on its store.
+func newStore(storeService corestore.KVStoreService, cdc codec.BinaryCodec) (store, error) {
+	sb := collections.NewSchemaBuilder(storeService)
+	s := store{
+		params:                    collections.NewItem(sb, paramsKey, "params", codec.CollValue[simpleswap.Params](cdc)),
+		pool:                      collections.NewItem(sb, poolKey, "pool", codec.CollValue[simpleswap.Pool](cdc)),
+		liquidityProviders:        collections.NewMap(sb, liquidityProvidersKey, "liquidity_providers", collections.StringKey, codec.CollValue[simpleswap.LiquidityProvider](cdc)),
+		liquidityProvidersByDenom: collections.NewKeySet(sb, liquidityProvidersByDenomKey, "liquidity_providers_by_denom", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		liquidityExposure:         collections.NewMap(sb, liquidityExposureKey, "liquidity_exposure", collections.StringKey, sdk.IntValue),
+		rewards:                   collections.NewMap(sb, rewardsKey, "rewards", collections.StringKey, codec.CollValue[simpleswap.Rewards](cdc)),
+		rewardAccumulator:         collections.NewItem(sb, rewardAccumulatorKey, "reward_accumulator", codec.CollValue[simpleswap.RewardAccumulator](cdc)),
+		locks:                     collections.NewMap(sb, locksKey, "locks", collections.Uint64Key, codec.CollValue[simpleswap.PeriodLock](cdc)),
+		locksByEndTime:            collections.NewKeySet(sb, locksByEndTimeKey, "locks_by_end_time", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key)),
+		locksByOwner:              collections.NewKeySet(sb, locksByOwnerKey, "locks_by_owner", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		limitOrders:               collections.NewMap(sb, limitOrdersKey, "limit_orders", collections.Uint64Key, codec.CollValue[simpleswap.LimitOrder](cdc)),
+		limitOrdersByOwner:        collections.NewKeySet(sb, limitOrdersByOwnerKey, "limit_orders_by_owner", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		limitOrdersByPair:         collections.NewKeySet(sb, limitOrdersByPairKey, "limit_orders_by_pair", collections.PairKeyCodec(collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.Uint64Key)),
+		dcaPlans:                  collections.NewMap(sb, dcaPlansKey, "dca_plans", collections.Uint64Key, codec.CollValue[simpleswap.DCAPlan](cdc)),
+		dcaPlansByNextExecution:   collections.NewKeySet(sb, dcaPlansByNextExecutionKey, "dca_plans_by_next_execution", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+		dcaPlansByOwner:           collections.NewKeySet(sb, dcaPlansByOwnerKey, "dca_plans_by_owner", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+	}
+
+	_, err := sb.Build()
+	return s, err
+}
+
+// Migrate migrates the simpleswap state from version 1 to version 2:
+//
+//  1. The params added since version 1 are set to their defaults, the removed share token
+//     param is dropped.
+//  2. The shares of the liquidity providers, minted in the share token param denom, are
+//     burned and minted again in the share denom of the pool.
+//  3. The pool gets its id, and its share token the total shares outstanding.
+//  4. The bank metadata of the share denom is registered.
+//  5. The liquidity providers are indexed by the denom of their stable coin, and the stable
+//     coins are summed by denom into the exposures.
+//  6. The share locks are indexed by their end time and by their owner, the limit orders by
+//     their owner and by their pair, and the DCA plans by their next execution height and by
+//     their owner.
+//  7. The share locks are weighted by the multiplier of their duration, and their weights are
+//     summed by owner and in total into the reward accumulator.
+func Migrate(
+	ctx context.Context,
+	storeService corestore.KVStoreService,
+	cdc codec.BinaryCodec,
+	addressCodec address.Codec,
+	bankKeeper expectedkeepers.BankKeeper,
+) error {
+	s, err := newStore(storeService, cdc)
+	if err != nil {
+		return err
+	}
+
+	params, err := migrateParams(ctx, s.params)
+	if err != nil {
+		return err
+	}
+
+	pool, err := s.pool.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// The version 1 pool holds the share token param, with its initial amount
+	var legacyDenom string
+	if pool.ShareToken != nil {
+		legacyDenom = pool.ShareToken.Denom
+	}
+	shareDenom := simpleswap.PoolShareDenom(simpleswap.DefaultPoolId)
+
+	totalShares := math.ZeroInt()
+	iter, err := s.liquidityProviders.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return err
+		}
+
+		provider := kv.Value
+		if provider.PoolShare == nil || provider.PoolShare.Denom == shareDenom {
+			if provider.PoolShare != nil {
+				totalShares = totalShares.Add(provider.PoolShare.Amount)
+			}
+			continue
+		}
+
+		shares, err := reissueShares(ctx, addressCodec, bankKeeper, kv.Key, *provider.PoolShare, legacyDenom, shareDenom)
+		if err != nil {
+			return err
+		}
+
+		provider.PoolShare = &shares
+		if err := s.liquidityProviders.Set(ctx, kv.Key, provider); err != nil {
+			return err
+		}
+		totalShares = totalShares.Add(shares.Amount)
+	}
+
+	pool.Id = simpleswap.DefaultPoolId
+	pool.ShareToken = &sdk.Coin{Denom: shareDenom, Amount: totalShares}
+	if err := s.pool.Set(ctx, pool); err != nil {
+		return err
+	}
+
+	bankKeeper.SetDenomMetaData(ctx, simpleswap.PoolShareDenomMetadata(simpleswap.DefaultPoolId, uint32(params.Decimals)))
+
+	// The providers without a stable coin are indexed under the empty denom
+	err = index(ctx, s.liquidityProviders, s.liquidityProvidersByDenom, func(provider simpleswap.LiquidityProvider) string {
+		if provider.StableCoin == nil {
+			return ""
+		}
+		return provider.StableCoin.Denom
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := sumExposures(ctx, s.liquidityProviders, s.liquidityExposure); err != nil {
+		return err
+	}
+
+	// The locks not unlocking are indexed under the zero time
+	err = index(ctx, s.locks, s.locksByEndTime, func(lock simpleswap.PeriodLock) time.Time {
+		return lock.EndTime
+	})
+	if err != nil {
+		return err
+	}
+
+	err = index(ctx, s.locks, s.locksByOwner, func(lock simpleswap.PeriodLock) string {
+		return lock.Owner
+	})
+	if err != nil {
+		return err
+	}
+
+	err = index(ctx, s.limitOrders, s.limitOrdersByOwner, func(order simpleswap.LimitOrder) string {
+		return order.Owner
+	})
+	if err != nil {
+		return err
+	}
+
+	err = index(ctx, s.limitOrders, s.limitOrdersByPair, func(order simpleswap.LimitOrder) collections.Pair[string, string] {
+		return collections.Join(order.Input.Denom, order.OutputDenom)
+	})
+	if err != nil {
+		return err
+	}
+
+	err = index(ctx, s.dcaPlans, s.dcaPlansByNextExecution, func(plan simpleswap.DCAPlan) int64 {
+		return plan.NextExecutionHeight
+	})
+	if err != nil {
+		return err
+	}
+
+	err = index(ctx, s.dcaPlans, s.dcaPlansByOwner, func(plan simpleswap.DCAPlan) string {
+		return plan.Owner
+	})
+	if err != nil {
+		return err
+	}
+
+	return weighLocks(ctx, params, s)
+}
+
+// migrateParams keeps the whitelist, the swap fee and the decimals of the version 1 params, the
+// params added since then are set to their defaults.
+func migrateParams(ctx context.Context, paramsItem collections.Item[simpleswap.Params]) (simpleswap.Params, error) {
+	legacyParams, err := paramsItem.Get(ctx)
+	if err != nil {
+		return simpleswap.Params{}, err
+	}
+
+	params := simpleswap.DefaultParams()
+	params.WhitelistedCoins = legacyParams.WhitelistedCoins
+	params.SwapFeePercentage = legacyParams.SwapFeePercentage
+	params.Decimals = legacyParams.Decimals
+
+	if err := params.Validate(); err != nil {
+		return simpleswap.Params{}, fmt.Errorf("invalid migrated params: %w", err)
+	}
+
+	return params, paramsItem.Set(ctx, params)
+}
+
+// reissueShares burns the legacy shares held by the liquidity provider, up to its recorded shares,
+// and mints its recorded shares in the share denom of the pool.
+func reissueShares(
+	ctx context.Context,
+	addressCodec address.Codec,
+	bankKeeper expectedkeepers.BankKeeper,
+	providerAddress string,
+	legacyShares sdk.Coin,
+	legacyDenom string,
+	shareDenom string,
+) (sdk.Coin, error) {
+	addr, err := addressCodec.StringToBytes(providerAddress)
+	if err != nil {
+		return sdk.Coin{}, fmt.Errorf("invalid liquidity provider address %s: %w", providerAddress, err)
+	}
+
+	if legacyShares.Denom != legacyDenom {
+		return sdk.Coin{}, fmt.Errorf("the shares of the liquidity provider %s are not in the share token denom %s: %s", providerAddress, legacyDenom, legacyShares)
+	}
+
+	held := bankKeeper.SpendableCoin(ctx, addr, legacyDenom)
+	burned := sdk.NewCoin(legacyDenom, math.MinInt(held.Amount, legacyShares.Amount))
+	if burned.IsPositive() {
+		if err := bankKeeper.SendCoinsFromAccountToModule(ctx, addr, simpleswap.ModuleName, sdk.NewCoins(burned)); err != nil {
+			return sdk.Coin{}, err
+		}
+
+		if err := bankKeeper.BurnCoins(ctx, simpleswap.ModuleName, sdk.NewCoins(burned)); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	shares := sdk.NewCoin(shareDenom, legacyShares.Amount)
+	if shares.IsPositive() {
+		if err := bankKeeper.MintCoins(ctx, simpleswap.ModuleName, sdk.NewCoins(shares)); err != nil {
+			return sdk.Coin{}, err
+		}
+
+		if err := bankKeeper.SendCoinsFromModuleToAccount(ctx, simpleswap.ModuleName, addr, sdk.NewCoins(shares)); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	return shares, nil
+}
+
+// index adds the key of every value of the map to the index, under the reference key of the value.
+func index[R, K, V any](ctx context.Context, values collections.Map[K, V], refKeys collections.KeySet[collections.Pair[R, K]], refKey func(value V) R) error {
+	// The keys are collected first, the store is not written while it is iterated
+	keys := []collections.Pair[R, K]{}
+	err := values.Walk(ctx, nil, func(key K, value V) (bool, error) {
+		keys = append(keys, collections.Join(refKey(value), key))
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := refKeys.Set(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// weighLocks sets the reward weight of every lock to its shares times the multiplier of its
+// duration, the weight of every owner to the sum of the weights of its locks, and the total weight
+// of the reward accumulator to the sum of the weights of all the locks.
+func weighLocks(ctx context.Context, params simpleswap.Params, s store) error {
+	var locks []simpleswap.PeriodLock
+	err := s.locks.Walk(ctx, nil, func(_ uint64, lock simpleswap.PeriodLock) (bool, error) {
+		locks = append(locks, lock)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	owners := []string{}
+	weights := make(map[string]math.Int)
+	totalWeight := math.ZeroInt()
+	for _, lock := range locks {
+		multiplier, ok := params.LockMultiplier(lock.Duration)
+		if !ok {
+			multiplier = math.LegacyOneDec()
+		}
+
+		lock.RewardWeight = multiplier.MulInt(lock.Shares.Amount).TruncateInt()
+		if err := s.locks.Set(ctx, lock.Id, lock); err != nil {
+			return err
+		}
+
+		if _, ok := weights[lock.Owner]; !ok {
+			owners = append(owners, lock.Owner)
+			weights[lock.Owner] = math.ZeroInt()
+		}
+		weights[lock.Owner] = weights[lock.Owner].Add(lock.RewardWeight)
+		totalWeight = totalWeight.Add(lock.RewardWeight)
+	}
+
+	for _, owner := range owners {
+		rewards, err := s.rewards.Get(ctx, owner)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+
+		rewards.Weight = weights[owner]
+		if err := s.rewards.Set(ctx, owner, rewards); err != nil {
+			return err
+		}
+	}
+
+	return s.rewardAccumulator.Set(ctx, simpleswap.RewardAccumulator{TotalWeight: totalWeight})
+}
+
+// sumExposures sets the exposures to the totals of the stable coins of the liquidity providers by
+// denom, the denoms without liquidity are not stored.
+func sumExposures(ctx context.Context, liquidityProviders collections.Map[string, simpleswap.LiquidityProvider], exposures collections.Map[string, math.Int]) error {
+	denoms := []string{}
+	totals := make(map[string]math.Int)
+	err := liquidityProviders.Walk(ctx, nil, func(_ string, provider simpleswap.LiquidityProvider) (bool, error) {
+		if provider.StableCoin == nil || provider.StableCoin.Amount.IsZero() {
+			return false, nil
+		}
+
+		denom := provider.StableCoin.Denom
+		if _, ok := totals[denom]; !ok {
+			denoms = append(denoms, denom)
+			totals[denom] = math.ZeroInt()
+		}
+		totals[denom] = totals[denom].Add(provider.StableCoin.Amount)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, denom := range denoms {
+		if err := exposures.Set(ctx, denom, totals[denom]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
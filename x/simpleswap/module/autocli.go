@@ -21,6 +21,11 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "pool",
 					Short:     "Get the simpleswap module pool",
 				},
+				{
+					RpcMethod: "PoolStats",
+					Use:       "pool-stats",
+					Short:     "Get the volume, the fees and the swap count of the pool over the last 24 hours and 7 days",
+				},
 				{
 					RpcMethod: "LiquidityProvider",
 					Use:       "liquidity-provider lpAddress",
@@ -29,6 +34,27 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "lpAddress"},
 					},
 				},
+				{
+					RpcMethod: "Position",
+					Use:       "position address",
+					Short:     "Get the pending fees, the pool share and the exit payout of a liquidity provider",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "LiquidityProviders",
+					Use:       "liquidity-providers",
+					Short:     "Get the liquidity providers with their share of the pool and their pending fees, filtered by stable coin with --denom",
+				},
+				{
+					RpcMethod: "LiquidityProvidersByDenom",
+					Use:       "liquidity-providers-by-denom denom",
+					Short:     "Get the liquidity providers of a stable coin and their total exposure to it",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+					},
+				},
 				{
 					RpcMethod: "CoinReserve",
 					Use:       "coin-reserve coin",
@@ -42,6 +68,68 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "coin-reserves",
 					Short:     "Get all the reserves",
 				},
+				{
+					RpcMethod: "Gauge",
+					Use:       "gauge gauge-id",
+					Short:     "Get a gauge by its id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "gauge_id"},
+					},
+				},
+				{
+					RpcMethod: "Gauges",
+					Use:       "gauges",
+					Short:     "Get all the gauges",
+				},
+				{
+					RpcMethod: "PendingRewards",
+					Use:       "pending-rewards lp-address",
+					Short:     "Get the unclaimed gauge rewards of a lock owner",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "lp_address"},
+					},
+				},
+				{
+					RpcMethod: "AccountLocks",
+					Use:       "account-locks owner",
+					Short:     "Get the share locks of an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "owner"},
+					},
+				},
+				{
+					RpcMethod: "AssetRate",
+					Use:       "asset-rate denom",
+					Short:     "Get the redemption rate of a whitelisted coin",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+					},
+				},
+				{
+					RpcMethod: "LimitOrdersByOwner",
+					Use:       "limit-orders-by-owner owner",
+					Short:     "Get the open limit orders of an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "owner"},
+					},
+				},
+				{
+					RpcMethod: "LimitOrdersByPair",
+					Use:       "limit-orders-by-pair input-denom output-denom",
+					Short:     "Get the open limit orders swapping the input denom for the output denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "input_denom"},
+						{ProtoField: "output_denom"},
+					},
+				},
+				{
+					RpcMethod: "DCAPlansByOwner",
+					Use:       "dca-plans-by-owner owner",
+					Short:     "Get the DCA plans of an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "owner"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -76,6 +164,89 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "token"},
 					},
 				},
+				{
+					RpcMethod: "CreateGauge",
+					Use:       "create-gauge poolId coins numEpochs",
+					Short:     "Create a gauge rewarding the share locks of a pool",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "poolId"},
+						{ProtoField: "coins"},
+						{ProtoField: "numEpochs"},
+					},
+				},
+				{
+					RpcMethod: "AddToGauge",
+					Use:       "add-to-gauge gaugeId coins",
+					Short:     "Add rewards to an existing gauge",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "gaugeId"},
+						{ProtoField: "coins"},
+					},
+				},
+				{
+					RpcMethod: "ClaimRewards",
+					Use:       "claim-rewards",
+					Short:     "Claim the gauge rewards of the lock owner",
+				},
+				{
+					RpcMethod: "LockShares",
+					Use:       "lock-shares shares duration",
+					Short:     "Lock pool shares for one of the allowed durations, e.g. 168h",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "shares"},
+						{ProtoField: "duration"},
+					},
+				},
+				{
+					RpcMethod: "BeginUnlock",
+					Use:       "begin-unlock lockId",
+					Short:     "Begin the unbonding of a share lock",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "lockId"},
+					},
+				},
+				{
+					RpcMethod: "PlaceLimitOrder",
+					Use:       "place-limit-order input outputDenom limitPrice",
+					Short:     "Place an order to swap the input once the pool pays at least the limit price, net of the swap fee",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "input"},
+						{ProtoField: "outputDenom"},
+						{ProtoField: "limitPrice"},
+					},
+				},
+				{
+					RpcMethod: "CancelLimitOrder",
+					Use:       "cancel-limit-order orderId",
+					Short:     "Cancel an open limit order and refund its escrowed input",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "orderId"},
+					},
+				},
+				{
+					RpcMethod: "CreateDCAPlan",
+					Use:       "create-dca-plan total sliceAmount intervalBlocks outputDenom minOutputPerSlice",
+					Short:     "Swap the total input for the output denom in slices, one every interval of blocks",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "total"},
+						{ProtoField: "sliceAmount"},
+						{ProtoField: "intervalBlocks"},
+						{ProtoField: "outputDenom"},
+						{ProtoField: "minOutputPerSlice"},
+					},
+				},
+				{
+					RpcMethod: "CancelDCAPlan",
+					Use:       "cancel-dca-plan planId",
+					Short:     "Cancel a DCA plan and refund its input not yet swapped",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "planId"},
+					},
+				},
+				{
+					RpcMethod: "FlashSwap",
+					Skip:      true, // the nested messages are read from a file by the flash-swap command
+				},
 			},
 		},
 	}
@@ -1,16 +1,21 @@
 package module
 
 import (
+	"sort"
+
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
+	"github.com/cosmos/simpleswap"
 	modulev1 "github.com/cosmos/simpleswap/api/module/v1"
+	expectedkeepers "github.com/cosmos/simpleswap/expected_keepers"
 	"github.com/cosmos/simpleswap/keeper"
 )
 
@@ -26,6 +31,7 @@ func init() {
 	appmodule.Register(
 		&modulev1.Module{},
 		appmodule.Provide(ProvideModule),
+		appmodule.Invoke(InvokeSetHooks),
 	)
 }
 
@@ -38,14 +44,24 @@ type ModuleInputs struct {
 
 	Config *modulev1.Module
 
-	BankKeeper bankkeeper.Keeper
+	AccountKeeper expectedkeepers.AccountKeeper
+	BankKeeper    bankkeeper.Keeper
+
+	// MsgServiceRouter executes the messages nested in a flash swap
+	MsgServiceRouter baseapp.MessageRouter `optional:"true"`
+
+	// OracleKeeper is optional, the swap price guard is inactive without it
+	OracleKeeper expectedkeepers.OracleKeeper `optional:"true"`
+
+	// RateProviders are the rate providers of whitelisted coins provided by other modules
+	RateProviders []simpleswap.RateProviderWrapper `optional:"true"`
 }
 
 type ModuleOutputs struct {
 	depinject.Out
 
 	Module appmodule.AppModule
-	Keeper keeper.Keeper
+	Keeper *keeper.Keeper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -56,7 +72,47 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	}
 
 	k := keeper.NewKeeper(in.Cdc, in.AddressCodec, in.StoreService, in.BankKeeper, authority.String())
-	m := NewAppModule(in.Cdc, k)
+	if in.OracleKeeper != nil {
+		k.SetOracleKeeper(in.OracleKeeper)
+	}
+
+	if in.MsgServiceRouter != nil {
+		k.SetRouter(in.MsgServiceRouter)
+	}
+
+	// sort the rate providers by denom to set them in a deterministic order
+	rateProviders := append([]simpleswap.RateProviderWrapper(nil), in.RateProviders...)
+	sort.Slice(rateProviders, func(i, j int) bool { return rateProviders[i].Denom < rateProviders[j].Denom })
+	for _, rateProvider := range rateProviders {
+		k.SetRateProvider(rateProvider.Denom, rateProvider.Provider)
+	}
+
+	m := NewAppModule(in.Cdc, &k, in.AccountKeeper, in.BankKeeper)
+
+	return ModuleOutputs{Module: m, Keeper: &k}
+}
+
+// InvokeSetHooks sets the simpleswap hooks provided by other modules, keyed by module name. The
+// hooks are set once all the modules are provided, so that a module providing hooks can depend on
+// the simpleswap keeper.
+func InvokeSetHooks(k *keeper.Keeper, hooks map[string]simpleswap.SimpleSwapHooksWrapper) error {
+	if k == nil || len(hooks) == 0 {
+		return nil
+	}
+
+	// sort the module names to register the hooks in a deterministic order
+	modNames := make([]string, 0, len(hooks))
+	for modName := range hooks {
+		modNames = append(modNames, modName)
+	}
+	sort.Strings(modNames)
+
+	var multiHooks simpleswap.MultiSimpleSwapHooks
+	for _, modName := range modNames {
+		multiHooks = append(multiHooks, hooks[modName])
+	}
+
+	k.SetHooks(multiHooks)
 
-	return ModuleOutputs{Module: m, Keeper: k}
+	return nil
 }
@@ -4,8 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/math"
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 
@@ -16,30 +21,40 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/cosmos/simpleswap"
+	expectedkeepers "github.com/cosmos/simpleswap/expected_keepers"
 	"github.com/cosmos/simpleswap/keeper"
 )
 
 var (
-	_ module.AppModuleBasic = AppModule{}
-	_ module.HasGenesis     = AppModule{}
-	_ appmodule.AppModule   = AppModule{}
+	_ module.AppModuleBasic   = AppModule{}
+	_ module.HasGenesis       = AppModule{}
+	_ appmodule.AppModule     = AppModule{}
+	_ appmodule.HasEndBlocker = AppModule{}
 )
 
 // ConsensusVersion defines the current module consensus version.
-const ConsensusVersion = 1
+const ConsensusVersion = 2
 
 type AppModule struct {
-	cdc    codec.Codec
-	keeper keeper.Keeper
+	cdc           codec.Codec
+	keeper        *keeper.Keeper
+	accountKeeper expectedkeepers.AccountKeeper
+	bankKeeper    expectedkeepers.BankKeeper
 }
 
 // NewAppModule creates a new AppModule object
-func NewAppModule(cdc codec.Codec, keeper keeper.Keeper) AppModule {
+func NewAppModule(cdc codec.Codec, keeper *keeper.Keeper, accountKeeper expectedkeepers.AccountKeeper, bankKeeper expectedkeepers.BankKeeper) AppModule {
 	return AppModule{
-		cdc:    cdc,
-		keeper: keeper,
+		cdc:           cdc,
+		keeper:        keeper,
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
 	}
 }
 
@@ -77,10 +92,10 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	simpleswap.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServerImpl(am.keeper))
 
 	// Register in place module state migration migrations
-	// m := keeper.NewMigrator(am.keeper)
-	// if err := cfg.RegisterMigration(simpleswap.ModuleName, 1, m.Migrate1to2); err != nil {
-	// 	panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", simpleswap.ModuleName, err))
-	// }
+	m := keeper.NewMigrator(*am.keeper)
+	if err := cfg.RegisterMigration(simpleswap.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", simpleswap.ModuleName, err))
+	}
 }
 
 // DefaultGenesis returns default genesis state as raw bytes for the module.
@@ -121,6 +136,14 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 
 	return cdc.MustMarshalJSON(gs)
 }
+
+// EndBlock releases the mature share locks, clears the batch auction, fills the limit orders,
+// executes the DCA slices, prunes the pool stats and distributes the gauge rewards. A failing step
+// is reported and skipped, only the store corruptions are returned.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	return am.keeper.EndBlocker(ctx)
+}
+
 func (AppModule) GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   simpleswap.ModuleName,
@@ -133,6 +156,18 @@ func (AppModule) GetTxCmd() *cobra.Command {
 		addLiquidityCmd(),
 		swapLiquidityCmd(),
 		removeLiquidityCmd(),
+		createGaugeCmd(),
+		addToGaugeCmd(),
+		claimRewardsCmd(),
+		lockSharesCmd(),
+		beginUnlockCmd(),
+		grantSwapCmd(),
+		placeLimitOrderCmd(),
+		cancelLimitOrderCmd(),
+		createDCAPlanCmd(),
+		cancelDCAPlanCmd(),
+		flashSwapCmd(),
+		submitUpdateParamsProposalCmd(),
 	)
 	return cmd
 }
@@ -175,6 +210,9 @@ func addLiquidityCmd() *cobra.Command {
 	return cmd
 }
 
+// flagMaxSwapFeePercentage is the flag of the swap fee bound of a swap.
+const flagMaxSwapFeePercentage = "max-swap-fee-percentage"
+
 func swapLiquidityCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "swap-liquidity [trader] [input] [output]",
@@ -202,15 +240,22 @@ func swapLiquidityCmd() *cobra.Command {
 				return err
 			}
 
+			maxSwapFeePercentage, err := cmd.Flags().GetInt32(flagMaxSwapFeePercentage)
+			if err != nil {
+				return err
+			}
+
 			msg := &simpleswap.MsgSwapLiquidity{
-				Trader: providerAddress.String(),
-				Input:             input,
-				Output:            output,
+				Trader:               providerAddress.String(),
+				Input:                input,
+				Output:               output,
+				MaxSwapFeePercentage: maxSwapFeePercentage,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	cmd.Flags().Int32(flagMaxSwapFeePercentage, 0, "The highest swap fee accepted, in the units of the swap fee percentage param, 0 for no bound")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -249,130 +294,957 @@ func removeLiquidityCmd() *cobra.Command {
 	return cmd
 }
 
-func (AppModule) GetQueryCmd() *cobra.Command {
+func createGaugeCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   simpleswap.ModuleName,
-		Short: "Querying commands for the simpleswap module",
-	}
+		Use:   "create-gauge [pool-id] [coins] [num-epochs]",
+		Short: "Create a gauge rewarding the liquidity providers of a pool",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
 
-	cmd.AddCommand(
-		getParamsCmd(),
-		getPoolCmd(),
-		getLiquidityProviderCmd(),
-		getCoinReserveCmd(),
-		getCoinReservesCmd(),
-	)
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			numEpochs, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &simpleswap.MsgCreateGauge{
+				Owner:     clientCtx.GetFromAddress().String(),
+				PoolId:    poolId,
+				Coins:     coins,
+				NumEpochs: numEpochs,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
-func getParamsCmd() *cobra.Command {
-	return &cobra.Command{
-		Use:   "params",
-		Short: "Get the simpleswap module parameters",
-		RunE: func(cmd *cobra.Command, _ []string) error {
-			clientCtx, err := client.GetClientQueryContext(cmd)
+func addToGaugeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-to-gauge [gauge-id] [coins]",
+		Short: "Add rewards to an existing gauge",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			queryClient := simpleswap.NewQueryClient(clientCtx)
+			gaugeId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
 
-			res, err := queryClient.Params(context.Background(), &simpleswap.QueryParamsRequest{})
+			coins, err := sdk.ParseCoinsNormalized(args[1])
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(res)
+			msg := &simpleswap.MsgAddToGauge{
+				Owner:   clientCtx.GetFromAddress().String(),
+				GaugeId: gaugeId,
+				Coins:   coins,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
 }
 
-func getPoolCmd() *cobra.Command {
-	return &cobra.Command{
-		Use:   "pool",
-		Short: "Get the simpleswap module pool",
+func claimRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-rewards",
+		Short: "Claim the gauge rewards of the liquidity provider",
+		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			clientCtx, err := client.GetClientQueryContext(cmd)
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			queryClient := simpleswap.NewQueryClient(clientCtx)
+			msg := &simpleswap.MsgClaimRewards{
+				Owner: clientCtx.GetFromAddress().String(),
+			}
 
-			res, err := queryClient.Pool(context.Background(), &simpleswap.QueryPoolRequest{})
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func lockSharesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lock-shares [shares] [duration]",
+		Short: "Lock pool shares for one of the allowed durations, e.g. 168h",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(res)
+			shares, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &simpleswap.MsgLockShares{
+				Owner:    clientCtx.GetFromAddress().String(),
+				Shares:   shares,
+				Duration: duration,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
 }
 
-func getLiquidityProviderCmd() *cobra.Command {
-	return &cobra.Command{
-		Use:   "liquidity-provider [address]",
-		Short: "Get the simpleswap module liquidity provider",
+func beginUnlockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "begin-unlock [lock-id]",
+		Short: "Begin the unbonding of a share lock",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx, err := client.GetClientQueryContext(cmd)
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			queryClient := simpleswap.NewQueryClient(clientCtx)
+			lockId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
 
-			res, err := queryClient.LiquidityProvider(context.Background(), &simpleswap.QueryLiquidityProviderRequest{LpAddress: args[0]})
+			msg := &simpleswap.MsgBeginUnlock{
+				Owner:  clientCtx.GetFromAddress().String(),
+				LockId: lockId,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// flagExpiration is the flag of the expiration of a swap authorization.
+const flagExpiration = "expiration"
+
+func grantSwapCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-swap [grantee] [pairs] [spend-limit] [max-swap-fee-percentage] --expiration [unix-timestamp]",
+		Short: "Grant an address the authorization to swap on your behalf, within bounds",
+		Long: `Grant an address the authorization to swap the coins of the pairs, e.g. WETH:ETH,stkETH:ETH,
+up to the spend limit of input coins and at a swap fee of at most the max swap fee percentage, until the expiration.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(res)
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var pairs []simpleswap.DenomPair
+			for _, pair := range strings.Split(args[1], ",") {
+				input, output, ok := strings.Cut(pair, ":")
+				if !ok {
+					return fmt.Errorf("invalid pair %s, expected input:output", pair)
+				}
+				pairs = append(pairs, simpleswap.DenomPair{Input: input, Output: output})
+			}
+
+			spendLimit, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			maxSwapFeePercentage, err := strconv.ParseInt(args[3], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			exp, err := cmd.Flags().GetInt64(flagExpiration)
+			if err != nil {
+				return err
+			}
+			expiration := time.Unix(exp, 0)
+
+			authorization := simpleswap.NewSwapAuthorization(pairs, spendLimit, int32(maxSwapFeePercentage), expiration)
+			if err := authorization.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, &expiration)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	cmd.Flags().Int64(flagExpiration, 0, "The Unix timestamp of the expiration of the authorization")
+	_ = cmd.MarkFlagRequired(flagExpiration)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
 }
 
-func getCoinReserveCmd() *cobra.Command {
-	return &cobra.Command{
-		Use:   "coin-reserve [denom]",
-		Short: "Get the simpleswap module coin reserve",
-		Args:  cobra.ExactArgs(1),
+func placeLimitOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "place-limit-order [input] [output-denom] [limit-price]",
+		Short: "Place an order to swap the input once the pool pays at least the limit price, net of the swap fee",
+		Long: `Place an order to swap the input for the output denom once the pool pays at least the limit price
+per unit of input, net of the swap fee, e.g. 10000000WETH ETH 0.999. The input is escrowed until the order
+is filled at the end of a block, or cancelled.`,
+		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx, err := client.GetClientQueryContext(cmd)
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			queryClient := simpleswap.NewQueryClient(clientCtx)
+			input, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
 
-			res, err := queryClient.CoinReserve(context.Background(), &simpleswap.QueryCoinReserveRequest{CoinDenom: args[0]})
+			limitPrice, err := math.LegacyNewDecFromStr(args[2])
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(res)
+			msg := &simpleswap.MsgPlaceLimitOrder{
+				Owner:       clientCtx.GetFromAddress().String(),
+				Input:       input,
+				OutputDenom: args[1],
+				LimitPrice:  limitPrice,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
 }
 
-func getCoinReservesCmd() *cobra.Command {
-	return &cobra.Command{
-		Use:   "coin-reserves",
-		Short: "Get all the reserves",
-		RunE: func(cmd *cobra.Command, _ []string) error {
-			clientCtx, err := client.GetClientQueryContext(cmd)
+func cancelLimitOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-limit-order [order-id]",
+		Short: "Cancel an open limit order and refund its escrowed input",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			queryClient := simpleswap.NewQueryClient(clientCtx)
+			orderId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
 
-			res, err := queryClient.CoinReserves(context.Background(), &simpleswap.QueryCoinReservesRequest{})
+			msg := &simpleswap.MsgCancelLimitOrder{
+				Owner:   clientCtx.GetFromAddress().String(),
+				OrderId: orderId,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func createDCAPlanCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-dca-plan [total] [slice-amount] [interval-blocks] [output-denom] [min-output-per-slice]",
+		Short: "Swap the total input for the output denom in slices, one every interval of blocks",
+		Long: `Escrow the total input and swap it for the output denom in slices of the slice amount, one at the
+end of every interval of blocks starting with the current block, e.g. 100000000stkETH 1000000 10 ETH 990000.
+A slice paying less than the minimum output per slice, net of the swap fee, is skipped and retried.`,
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(res)
+			total, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			sliceAmount, ok := math.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid slice amount %s", args[1])
+			}
+
+			intervalBlocks, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			minOutputPerSlice, ok := math.NewIntFromString(args[4])
+			if !ok {
+				return fmt.Errorf("invalid minimum output per slice %s", args[4])
+			}
+
+			msg := &simpleswap.MsgCreateDCAPlan{
+				Owner:             clientCtx.GetFromAddress().String(),
+				Total:             total,
+				SliceAmount:       sliceAmount,
+				IntervalBlocks:    intervalBlocks,
+				OutputDenom:       args[3],
+				MinOutputPerSlice: minOutputPerSlice,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func cancelDCAPlanCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-dca-plan [plan-id]",
+		Short: "Cancel a DCA plan and refund its input not yet swapped",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			planId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &simpleswap.MsgCancelDCAPlan{
+				Owner:  clientCtx.GetFromAddress().String(),
+				PlanId: planId,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func flashSwapCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "flash-swap [output] [input-denom] [msgs-file]",
+		Short: "Borrow the output from the pool, execute messages and repay the input in the same transaction",
+		Long: `Receive the output from the reserves, execute the messages of the JSON file, signed by the trader,
+and repay the input denom worth the output plus the flash fee, e.g. 1000000ETH WETH msgs.json where msgs.json is:
+{"messages": [{"@type": "/cosmos.simpleswap.v1.MsgSwapLiquidity", ...}]}
+The whole transaction reverts if the repayment cannot be collected from the trader.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			output, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			contents, err := os.ReadFile(args[2])
+			if err != nil {
+				return err
+			}
+
+			var file struct {
+				Messages []json.RawMessage `json:"messages"`
+			}
+			if err := json.Unmarshal(contents, &file); err != nil {
+				return err
+			}
+
+			msgs := make([]sdk.Msg, len(file.Messages))
+			for i, rawMsg := range file.Messages {
+				if err := clientCtx.Codec.UnmarshalInterfaceJSON(rawMsg, &msgs[i]); err != nil {
+					return err
+				}
+			}
+
+			msg, err := simpleswap.NewMsgFlashSwap(clientCtx.GetFromAddress().String(), output, args[1], msgs)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// flagAuthority is the flag of the authority of the module, which executes the proposed messages.
+const flagAuthority = "authority"
+
+func submitUpdateParamsProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-update-params-proposal [params-file]",
+		Short: "Submit a governance proposal to update the module parameters",
+		Long: `Submit a governance proposal executing a MsgUpdateParams with the parameters of the JSON file once it
+passes, e.g. params.json --title "Lower the swap fee" --summary "..." --deposit 10000000mini where params.json
+holds all the parameters, as returned by the params query:
+{"whitelistedCoins": [...], "swapFeePercentage": 20000, "decimals": "6", ...}`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var params simpleswap.Params
+			if err := clientCtx.Codec.UnmarshalJSON(contents, &params); err != nil {
+				return err
+			}
+
+			if err := params.Validate(); err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := govcli.ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if err := proposal.SetMsgs([]sdk.Msg{&simpleswap.MsgUpdateParams{
+				Authority: authority,
+				Params:    params,
+			}}); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+	cmd.Flags().String(flagAuthority, authtypes.NewModuleAddress(govtypes.ModuleName).String(), "The authority of the module, the gov module account by default")
+	govcli.AddGovPropFlagsToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func (AppModule) GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   simpleswap.ModuleName,
+		Short: "Querying commands for the simpleswap module",
+	}
+
+	cmd.AddCommand(
+		getParamsCmd(),
+		getPoolCmd(),
+		getPoolStatsCmd(),
+		getLiquidityProviderCmd(),
+		getPositionCmd(),
+		getLiquidityProvidersCmd(),
+		getLiquidityProvidersByDenomCmd(),
+		getCoinReserveCmd(),
+		getCoinReservesCmd(),
+		getGaugeCmd(),
+		getGaugesCmd(),
+		getPendingRewardsCmd(),
+		getAccountLocksCmd(),
+		getAssetRateCmd(),
+		getLimitOrdersByOwnerCmd(),
+		getLimitOrdersByPairCmd(),
+		getDCAPlansByOwnerCmd(),
+	)
+	return cmd
+}
+
+func getParamsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Get the simpleswap module parameters",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := simpleswap.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &simpleswap.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func getPoolCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "pool",
+		Short: "Get the simpleswap module pool",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := simpleswap.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Pool(context.Background(), &simpleswap.QueryPoolRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func getPoolStatsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "pool-stats",
+		Short: "Get the volume, the fees and the swap count of the pool over the last 24 hours and 7 days",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := simpleswap.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PoolStats(context.Background(), &simpleswap.QueryPoolStatsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func getLiquidityProviderCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "liquidity-provider [address]",
+		Short: "Get the simpleswap module liquidity provider",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := simpleswap.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LiquidityProvider(context.Background(), &simpleswap.QueryLiquidityProviderRequest{LpAddress: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+// flagDenom is the flag of the stable coin the liquidity providers are filtered by.
+const flagDenom = "denom"
+
+func getPositionCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "position [address]",
+		Short: "Get the pending fees, the pool share and the exit payout of a liquidity provider",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := simpleswap.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Position(context.Background(), &simpleswap.QueryPositionRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func getLiquidityProvidersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liquidity-providers",
+		Short: "Get the liquidity providers with their share of the pool and their pending fees",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(flagDenom)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := simpleswap.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LiquidityProviders(context.Background(), &simpleswap.QueryLiquidityProvidersRequest{
+				Denom:      denom,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(flagDenom, "", "Only list the liquidity providers of this stable coin")
+	flags.AddPaginationFlagsToCmd(cmd, "liquidity-providers")
+	return cmd
+}
+
+func getLiquidityProvidersByDenomCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liquidity-providers-by-denom [denom]",
+		Short: "Get the liquidity providers of a stable coin and their total exposure to it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := simpleswap.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LiquidityProvidersByDenom(context.Background(), &simpleswap.QueryLiquidityProvidersByDenomRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "liquidity-providers-by-denom")
+	return cmd
+}
+
+func getCoinReserveCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "coin-reserve [denom]",
+		Short: "Get the simpleswap module coin reserve",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := simpleswap.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CoinReserve(context.Background(), &simpleswap.QueryCoinReserveRequest{CoinDenom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func getCoinReservesCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "coin-reserves",
+		Short: "Get all the reserves",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := simpleswap.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CoinReserves(context.Background(), &simpleswap.QueryCoinReservesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func getGaugeCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "gauge [gauge-id]",
+		Short: "Get a gauge by its id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			gaugeId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := simpleswap.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Gauge(context.Background(), &simpleswap.QueryGaugeRequest{GaugeId: gaugeId})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func getGaugesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gauges",
+		Short: "Get all the gauges",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := simpleswap.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Gauges(context.Background(), &simpleswap.QueryGaugesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "gauges")
+	return cmd
+}
+
+func getPendingRewardsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "pending-rewards [address]",
+		Short: "Get the unclaimed gauge rewards of a liquidity provider",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := simpleswap.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PendingRewards(context.Background(), &simpleswap.QueryPendingRewardsRequest{LpAddress: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func getAccountLocksCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "account-locks [address]",
+		Short: "Get the share locks of an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := simpleswap.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AccountLocks(context.Background(), &simpleswap.QueryAccountLocksRequest{Owner: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func getAssetRateCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "asset-rate [denom]",
+		Short: "Get the redemption rate of a whitelisted coin",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := simpleswap.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AssetRate(context.Background(), &simpleswap.QueryAssetRateRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func getLimitOrdersByOwnerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "limit-orders-by-owner [address]",
+		Short: "Get the open limit orders of an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := simpleswap.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LimitOrdersByOwner(context.Background(), &simpleswap.QueryLimitOrdersByOwnerRequest{Owner: args[0], Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "limit-orders-by-owner")
+	return cmd
+}
+
+func getLimitOrdersByPairCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "limit-orders-by-pair [input-denom] [output-denom]",
+		Short: "Get the open limit orders swapping the input denom for the output denom",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := simpleswap.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LimitOrdersByPair(context.Background(), &simpleswap.QueryLimitOrdersByPairRequest{
+				InputDenom:  args[0],
+				OutputDenom: args[1],
+				Pagination:  pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "limit-orders-by-pair")
+	return cmd
+}
+
+func getDCAPlansByOwnerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dca-plans-by-owner [address]",
+		Short: "Get the DCA plans of an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := simpleswap.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DCAPlansByOwner(context.Background(), &simpleswap.QueryDCAPlansByOwnerRequest{Owner: args[0], Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "dca-plans-by-owner")
+	return cmd
 }
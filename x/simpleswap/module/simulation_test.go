@@ -0,0 +1,85 @@
+package module_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/simpleswap"
+	"github.com/cosmos/simpleswap/keeper"
+	"github.com/cosmos/simpleswap/module"
+)
+
+// TestStoreDecoder checks the registered store decoder decodes an entry of every collection.
+func TestStoreDecoder(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	key := storetypes.NewKVStoreKey(simpleswap.ModuleName)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	addrs := simtestutil.CreateIncrementalAccounts(2)
+	k := keeper.NewKeeper(encCfg.Codec, addresscodec.NewBech32Codec("cosmos"), runtime.NewKVStoreService(key), nil, addrs[0].String())
+
+	sdr := make(simtypes.StoreDecoderRegistry)
+	module.NewAppModule(encCfg.Codec, &k, nil, nil).RegisterStoreDecoder(sdr)
+	decoder, ok := sdr[simpleswap.ModuleName]
+	require.True(t, ok)
+
+	eth := sdk.NewInt64Coin("ETH", 100)
+	provider := addrs[1].String()
+	require.NoError(t, k.Params.Set(ctx, simpleswap.DefaultParams()))
+	require.NoError(t, k.Pool.Set(ctx, simpleswap.Pool{Id: simpleswap.DefaultPoolId, Decimals: 6}))
+	require.NoError(t, k.LiquidityProviders.Set(ctx, provider, simpleswap.LiquidityProvider{StableCoin: &eth}))
+	require.NoError(t, k.CoinsReserve.Set(ctx, eth.Denom, eth))
+	require.NoError(t, k.Gauges.Set(ctx, 1, simpleswap.Gauge{Id: 1, Owner: provider}))
+	require.NoError(t, k.GaugeSequence.Set(ctx, 2))
+	require.NoError(t, k.Rewards.Set(ctx, provider, simpleswap.Rewards{Coins: sdk.NewCoins(eth), Weight: math.OneInt()}))
+	require.NoError(t, k.RewardAccumulator.Set(ctx, simpleswap.RewardAccumulator{TotalWeight: math.OneInt()}))
+	require.NoError(t, k.Locks.Set(ctx, 1, simpleswap.PeriodLock{Id: 1, Owner: provider}))
+	require.NoError(t, k.LockSequence.Set(ctx, 2))
+	require.NoError(t, k.AssetRates.Set(ctx, eth.Denom, simpleswap.AssetRate{Denom: eth.Denom, Rate: math.LegacyOneDec()}))
+	require.NoError(t, k.LimitOrders.Set(ctx, 1, simpleswap.LimitOrder{Id: 1, Owner: provider, Input: eth, OutputDenom: "WETH"}))
+	require.NoError(t, k.LimitOrderSequence.Set(ctx, 2))
+	require.NoError(t, k.LimitOrderCursor.Set(ctx, 1))
+	require.NoError(t, k.DCAPlans.Set(ctx, 1, simpleswap.DCAPlan{Id: 1, Owner: provider}))
+	require.NoError(t, k.DCAPlanSequence.Set(ctx, 2))
+	require.NoError(t, k.BatchSwaps.Set(ctx, 1, simpleswap.BatchSwap{Id: 1, Trader: provider, Input: eth, OutputDenom: "WETH"}))
+	require.NoError(t, k.BatchSwapSequence.Set(ctx, 2))
+	startTime := time.Unix(0, 0).UTC()
+	require.NoError(t, k.PoolStats.Set(ctx, collections.Join3(startTime, eth.Denom, "WETH"), simpleswap.PoolStatsBucket{
+		StartTime:   startTime,
+		InputDenom:  eth.Denom,
+		OutputDenom: "WETH",
+		Volume:      eth.Amount,
+		Fees:        math.OneInt(),
+		SwapCount:   1,
+	}))
+
+	// Every prefix of keys.go holds an entry which is decoded
+	prefixes := make(map[byte]bool)
+	iter := ctx.KVStore(key).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		pair := kv.Pair{Key: iter.Key(), Value: iter.Value()}
+		require.NotPanics(t, func() {
+			require.NotEmpty(t, decoder(pair, pair))
+		})
+		prefixes[pair.Key[0]] = true
+	}
+	require.Len(t, prefixes, int(simpleswap.DCAPlansByOwnerKey[0])+1)
+
+	// The keys of another module are not decoded
+	require.Panics(t, func() {
+		decoder(kv.Pair{Key: []byte{0xff}}, kv.Pair{Key: []byte{0xff}})
+	})
+}
@@ -0,0 +1,64 @@
+package simpleswap
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.HasValidateBasic = &MsgUpdateParams{}
+	_ sdk.HasValidateBasic = &MsgAddLiquidity{}
+	_ sdk.HasValidateBasic = &MsgSwapLiquidity{}
+	_ sdk.HasValidateBasic = &MsgRemoveLiquidity{}
+)
+
+// The ValidateBasic methods do not check the addresses, which are decoded with the address codec
+// of the app by the signer extraction and the handlers, not with the global bech32 config.
+
+// ValidateBasic does the stateless checks of the message, run in CheckTx before it reaches the
+// handler, which checks the authority, the params and the pool state.
+func (msg MsgUpdateParams) ValidateBasic() error {
+	return msg.Params.Validate()
+}
+
+// ValidateBasic does the stateless checks of the message, the whitelist is checked by the handler.
+func (msg MsgAddLiquidity) ValidateBasic() error {
+	return validateLiquidityToken(msg.Token)
+}
+
+// ValidateBasic does the stateless checks of the message, the whitelist and the value of the
+// coins are checked by the handler.
+func (msg MsgSwapLiquidity) ValidateBasic() error {
+	if err := validateLiquidityToken(msg.Input); err != nil {
+		return err
+	}
+
+	if err := validateLiquidityToken(msg.Output); err != nil {
+		return err
+	}
+
+	if msg.Input.Denom == msg.Output.Denom {
+		return errorsmod.Wrapf(ErrCoinInvalid, "cannot swap %s for itself", msg.Input.Denom)
+	}
+
+	return nil
+}
+
+// ValidateBasic does the stateless checks of the message, the liquidity provided is checked by
+// the handler.
+func (msg MsgRemoveLiquidity) ValidateBasic() error {
+	return validateLiquidityToken(msg.Token)
+}
+
+// validateLiquidityToken checks the coin has a valid denom and a positive amount.
+func validateLiquidityToken(token sdk.Coin) error {
+	if token.Amount.IsNil() || token.Amount.IsZero() {
+		return errorsmod.Wrapf(ErrZeroAmount, "for the denom: %s", token.Denom)
+	}
+
+	if err := token.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrCoinInvalid, "%s", err)
+	}
+
+	return nil
+}
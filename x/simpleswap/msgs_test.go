@@ -0,0 +1,211 @@
+package simpleswap_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/simpleswap"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	validAddress = sdk.AccAddress("simpleswap_address__").String()
+	eth          = sdk.NewInt64Coin("ETH", 1000)
+	weth         = sdk.NewInt64Coin("WETH", 1000)
+)
+
+func TestMsgUpdateParamsValidateBasic(t *testing.T) {
+	invalidParams := simpleswap.DefaultParams()
+	invalidParams.SwapFeePercentage = 0
+
+	testCases := []struct {
+		name      string
+		msg       simpleswap.MsgUpdateParams
+		expectErr error
+	}{
+		{
+			name: "valid",
+			msg:  simpleswap.MsgUpdateParams{Authority: validAddress, Params: simpleswap.DefaultParams()},
+		},
+		{
+			name: "authority checked by the handler",
+			msg:  simpleswap.MsgUpdateParams{Authority: "foo", Params: simpleswap.DefaultParams()},
+		},
+		{
+			name:      "invalid params",
+			msg:       simpleswap.MsgUpdateParams{Authority: validAddress, Params: invalidParams},
+			expectErr: simpleswap.ErrZeroSwapFee,
+		},
+		{
+			name:      "empty params",
+			msg:       simpleswap.MsgUpdateParams{Authority: validAddress},
+			expectErr: simpleswap.ErrCoinsNotPresent,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectErr != nil {
+				require.ErrorIs(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgAddLiquidityValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       simpleswap.MsgAddLiquidity
+		expectErr error
+	}{
+		{
+			name: "valid",
+			msg:  simpleswap.MsgAddLiquidity{LiquidityProvider: validAddress, Token: eth},
+		},
+		{
+			name: "provider address checked by the handler",
+			msg:  simpleswap.MsgAddLiquidity{LiquidityProvider: "foo", Token: eth},
+		},
+		{
+			name:      "empty token",
+			msg:       simpleswap.MsgAddLiquidity{LiquidityProvider: validAddress},
+			expectErr: simpleswap.ErrZeroAmount,
+		},
+		{
+			name:      "zero amount",
+			msg:       simpleswap.MsgAddLiquidity{LiquidityProvider: validAddress, Token: sdk.NewInt64Coin("ETH", 0)},
+			expectErr: simpleswap.ErrZeroAmount,
+		},
+		{
+			name:      "negative amount",
+			msg:       simpleswap.MsgAddLiquidity{LiquidityProvider: validAddress, Token: sdk.Coin{Denom: "ETH", Amount: math.NewInt(-1)}},
+			expectErr: simpleswap.ErrCoinInvalid,
+		},
+		{
+			name:      "invalid denom",
+			msg:       simpleswap.MsgAddLiquidity{LiquidityProvider: validAddress, Token: sdk.Coin{Denom: "1ETH", Amount: math.NewInt(1000)}},
+			expectErr: simpleswap.ErrCoinInvalid,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectErr != nil {
+				require.ErrorIs(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSwapLiquidityValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       simpleswap.MsgSwapLiquidity
+		expectErr error
+	}{
+		{
+			name: "valid",
+			msg:  simpleswap.MsgSwapLiquidity{Trader: validAddress, Input: eth, Output: weth},
+		},
+		{
+			name: "trader address checked by the handler",
+			msg:  simpleswap.MsgSwapLiquidity{Trader: "foo", Input: eth, Output: weth},
+		},
+		{
+			name:      "zero input",
+			msg:       simpleswap.MsgSwapLiquidity{Trader: validAddress, Input: sdk.NewInt64Coin("ETH", 0), Output: weth},
+			expectErr: simpleswap.ErrZeroAmount,
+		},
+		{
+			name:      "negative input",
+			msg:       simpleswap.MsgSwapLiquidity{Trader: validAddress, Input: sdk.Coin{Denom: "ETH", Amount: math.NewInt(-1)}, Output: weth},
+			expectErr: simpleswap.ErrCoinInvalid,
+		},
+		{
+			name:      "invalid input denom",
+			msg:       simpleswap.MsgSwapLiquidity{Trader: validAddress, Input: sdk.Coin{Denom: "E", Amount: math.NewInt(1000)}, Output: weth},
+			expectErr: simpleswap.ErrCoinInvalid,
+		},
+		{
+			name:      "empty output",
+			msg:       simpleswap.MsgSwapLiquidity{Trader: validAddress, Input: eth},
+			expectErr: simpleswap.ErrZeroAmount,
+		},
+		{
+			name:      "negative output",
+			msg:       simpleswap.MsgSwapLiquidity{Trader: validAddress, Input: eth, Output: sdk.Coin{Denom: "WETH", Amount: math.NewInt(-1)}},
+			expectErr: simpleswap.ErrCoinInvalid,
+		},
+		{
+			name:      "invalid output denom",
+			msg:       simpleswap.MsgSwapLiquidity{Trader: validAddress, Input: eth, Output: sdk.Coin{Denom: "WETH!", Amount: math.NewInt(1000)}},
+			expectErr: simpleswap.ErrCoinInvalid,
+		},
+		{
+			name:      "same denom",
+			msg:       simpleswap.MsgSwapLiquidity{Trader: validAddress, Input: eth, Output: eth},
+			expectErr: simpleswap.ErrCoinInvalid,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectErr != nil {
+				require.ErrorIs(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRemoveLiquidityValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       simpleswap.MsgRemoveLiquidity
+		expectErr error
+	}{
+		{
+			name: "valid",
+			msg:  simpleswap.MsgRemoveLiquidity{LiquidityProvider: validAddress, Token: eth},
+		},
+		{
+			name: "provider address checked by the handler",
+			msg:  simpleswap.MsgRemoveLiquidity{Token: eth},
+		},
+		{
+			name:      "zero amount",
+			msg:       simpleswap.MsgRemoveLiquidity{LiquidityProvider: validAddress, Token: sdk.NewInt64Coin("ETH", 0)},
+			expectErr: simpleswap.ErrZeroAmount,
+		},
+		{
+			name:      "negative amount",
+			msg:       simpleswap.MsgRemoveLiquidity{LiquidityProvider: validAddress, Token: sdk.Coin{Denom: "ETH", Amount: math.NewInt(-1)}},
+			expectErr: simpleswap.ErrCoinInvalid,
+		},
+		{
+			name:      "invalid denom",
+			msg:       simpleswap.MsgRemoveLiquidity{LiquidityProvider: validAddress, Token: sdk.Coin{Denom: "", Amount: math.NewInt(1000)}},
+			expectErr: simpleswap.ErrCoinInvalid,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectErr != nil {
+				require.ErrorIs(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
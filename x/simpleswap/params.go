@@ -1,13 +1,39 @@
 package simpleswap
 
 import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	types "github.com/cosmos/cosmos-sdk/types"
 )
 
+// MaxAssetExponent is the largest decimal exponent a whitelisted coin can declare.
+const MaxAssetExponent = 18
+
+const (
+	// StatsBucketDuration is the duration of a pool stats bucket, the swaps are accumulated by hour.
+	StatsBucketDuration = time.Hour
+
+	// MinStatsRetention is the shortest retention of the pool stats buckets, the weekly stats are
+	// computed from the buckets of the last 7 days.
+	MinStatsRetention = 7 * 24 * time.Hour
+
+	// DefaultLimitOrderMatchesPerBlock is the number of open limit orders tried at the end of a
+	// block when the param is not set.
+	DefaultLimitOrderMatchesPerBlock = 100
+
+	// DefaultDCAPlanMaxSkips is the number of slices of a DCA plan skipped in a row closing the
+	// plan when the param is not set.
+	DefaultDCAPlanMaxSkips = 10
+
+	// DefaultDCASlicesPerBlock is the number of due DCA slices executed at the end of a block
+	// when the param is not set.
+	DefaultDCASlicesPerBlock = 100
+)
+
 // DefaultParams returns default module parameters.
 func DefaultParams() Params {
-	shareToken := types.NewCoin("USDT", math.NewInt(100))
 	return Params{
 		WhitelistedCoins: []*types.Coin{
 			{
@@ -23,10 +49,34 @@ func DefaultParams() Params {
 				Amount: math.ZeroInt(),
 			},
 		},
-		Decimals:          6,
-		ShareToken:        &shareToken,
-		SwapFeePercentage: 30000,
+		Decimals:              6,
+		SwapFeePercentage:     30000,
+		OracleGuardMode:       ORACLE_GUARD_MODE_DISABLED,
+		OracleMaxDeviation:    math.LegacyNewDecWithPrec(2, 2),
+		IncentivesEpochBlocks: 100,
+		LockDurations: []LockDuration{
+			{Duration: 24 * time.Hour, Multiplier: math.LegacyOneDec()},
+			{Duration: 7 * 24 * time.Hour, Multiplier: math.LegacyNewDecWithPrec(15, 1)},
+			{Duration: 14 * 24 * time.Hour, Multiplier: math.LegacyNewDec(2)},
+		},
+		FeeSwapMaxSlippage:        math.LegacyNewDecWithPrec(1, 2),
+		FlashSwapFeePercentage:    30000,
+		StatsRetention:            MinStatsRetention,
+		LimitOrderMatchesPerBlock: DefaultLimitOrderMatchesPerBlock,
+		DcaPlanMaxSkips:           DefaultDCAPlanMaxSkips,
+		DcaSlicesPerBlock:         DefaultDCASlicesPerBlock,
+	}
+}
+
+// LockMultiplier returns the reward-weight multiplier of a lock duration, false if the duration is not allowed.
+func (p Params) LockMultiplier(duration time.Duration) (math.LegacyDec, bool) {
+	for _, lockDuration := range p.LockDurations {
+		if lockDuration.Duration == duration {
+			return lockDuration.Multiplier, true
+		}
 	}
+
+	return math.LegacyDec{}, false
 }
 
 // Validate does the sanity check on the params.
@@ -37,10 +87,22 @@ func (p Params) Validate() error {
 		return ErrCoinsNotPresent
 	}
 
+	// Check the whitelist entries are valid and resolve to distinct denoms
+	seenDenoms := make(map[string]bool)
 	for _, coin := range p.WhitelistedCoins {
 		if coin.Denom == "" {
 			return ErrCoinInvalid
 		}
+
+		if err := validateWhitelistEntry(coin.Denom); err != nil {
+			return errorsmod.Wrapf(ErrCoinInvalid, "for the denom: %s, %s", coin.Denom, err)
+		}
+
+		denom := ResolveDenom(coin.Denom)
+		if seenDenoms[denom] {
+			return errorsmod.Wrapf(ErrDuplicateCoin, "for the denom: %s", coin.Denom)
+		}
+		seenDenoms[denom] = true
 	}
 
 	if p.SwapFeePercentage == 0 {
@@ -51,9 +113,153 @@ func (p Params) Validate() error {
 		return ErrZeroDecimals
 	}
 
-	if p.ShareToken.Denom == "" {
-		return ErrShareTokenInvalid
+	if p.StatsRetention != 0 && p.StatsRetention < MinStatsRetention {
+		return errorsmod.Wrapf(ErrInvalidStatsRetention, "got: %s, minimum: %s", p.StatsRetention, MinStatsRetention)
+	}
+
+	if p.FlashSwapFeePercentage < 0 {
+		return errorsmod.Wrap(ErrInvalidFlashSwap, "the flash swap fee percentage must not be negative")
+	}
+
+	// Check the oracle guard configuration
+	if _, ok := OracleGuardMode_name[int32(p.OracleGuardMode)]; !ok {
+		return ErrInvalidOracleGuardMode
+	}
+
+	if p.OracleGuardMode != ORACLE_GUARD_MODE_DISABLED {
+		if p.OracleMaxDeviation.IsNil() || p.OracleMaxDeviation.IsNegative() || p.OracleMaxDeviation.GTE(math.LegacyOneDec()) {
+			return ErrInvalidOracleDeviation
+		}
+	}
+
+	if p.IncentivesEpochBlocks < 0 {
+		return ErrInvalidIncentivesEpoch
+	}
+
+	// Check the lock durations are positive, unique and have a multiplier of at least 1
+	seenDurations := make(map[time.Duration]bool)
+	for _, lockDuration := range p.LockDurations {
+		if lockDuration.Duration <= 0 || seenDurations[lockDuration.Duration] {
+			return ErrInvalidLockDuration
+		}
+		seenDurations[lockDuration.Duration] = true
+
+		if lockDuration.Multiplier.IsNil() || lockDuration.Multiplier.LT(math.LegacyOneDec()) {
+			return ErrInvalidLockMultiplier
+		}
+	}
+
+	// Check the asset exponents are declared once for whitelisted coins
+	seenExponents := make(map[string]bool)
+	for _, assetExponent := range p.AssetExponents {
+		denom := ResolveDenom(assetExponent.Denom)
+		if !p.IsWhitelisted(denom) || seenExponents[denom] || assetExponent.Exponent > MaxAssetExponent {
+			return ErrInvalidAssetExponent
+		}
+		seenExponents[denom] = true
+	}
+
+	// Check the fee swap denoms are whitelisted once and, when fee swaps are enabled, the slippage bound is a ratio
+	seenFeeSwapDenoms := make(map[string]bool)
+	for _, feeSwapDenom := range p.FeeSwapDenoms {
+		denom := ResolveDenom(feeSwapDenom)
+		if !p.IsWhitelisted(denom) || seenFeeSwapDenoms[denom] {
+			return errorsmod.Wrapf(ErrInvalidFeeSwapDenom, "for the denom: %s", feeSwapDenom)
+		}
+		seenFeeSwapDenoms[denom] = true
+	}
+
+	if len(p.FeeSwapDenoms) > 0 {
+		if p.FeeSwapMaxSlippage.IsNil() || p.FeeSwapMaxSlippage.IsNegative() || p.FeeSwapMaxSlippage.GTE(math.LegacyOneDec()) {
+			return ErrInvalidFeeSwapSlippage
+		}
 	}
 
 	return nil
 }
+
+// PoolStatsRetention returns how long the pool stats buckets are kept, the minimum retention if
+// it is not set.
+func (p Params) PoolStatsRetention() time.Duration {
+	if p.StatsRetention == 0 {
+		return MinStatsRetention
+	}
+
+	return p.StatsRetention
+}
+
+// LimitOrderMatches returns how many open limit orders are tried at the end of a block, the
+// default number if it is not set.
+func (p Params) LimitOrderMatches() uint32 {
+	if p.LimitOrderMatchesPerBlock == 0 {
+		return DefaultLimitOrderMatchesPerBlock
+	}
+
+	return p.LimitOrderMatchesPerBlock
+}
+
+// DCAMaxSkips returns how many slices of a DCA plan skipped in a row close the plan, the default
+// number if it is not set.
+func (p Params) DCAMaxSkips() uint32 {
+	if p.DcaPlanMaxSkips == 0 {
+		return DefaultDCAPlanMaxSkips
+	}
+
+	return p.DcaPlanMaxSkips
+}
+
+// DCASlices returns how many due DCA slices are executed at the end of a block, the default
+// number if it is not set.
+func (p Params) DCASlices() uint32 {
+	if p.DcaSlicesPerBlock == 0 {
+		return DefaultDCASlicesPerBlock
+	}
+
+	return p.DcaSlicesPerBlock
+}
+
+// IsFeeSwapDenom returns true if the fees can be paid in the denom, swapped through the pool.
+func (p Params) IsFeeSwapDenom(denom string) bool {
+	for _, feeSwapDenom := range p.FeeSwapDenoms {
+		if ResolveDenom(feeSwapDenom) == denom {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsWhitelisted returns true if the denom, or the transfer path trace it resolves from, is in
+// the coins whitelist.
+func (p Params) IsWhitelisted(denom string) bool {
+	denom = ResolveDenom(denom)
+	for _, coin := range p.WhitelistedCoins {
+		if ResolveDenom(coin.Denom) == denom {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WhitelistedDenoms returns the denoms on this chain of the whitelisted coins, in the order
+// of the whitelist.
+func (p Params) WhitelistedDenoms() []string {
+	denoms := make([]string, 0, len(p.WhitelistedCoins))
+	for _, coin := range p.WhitelistedCoins {
+		denoms = append(denoms, ResolveDenom(coin.Denom))
+	}
+
+	return denoms
+}
+
+// AssetExponent returns the declared decimal exponent of a denom, false if it is not declared.
+func (p Params) AssetExponent(denom string) (uint32, bool) {
+	for _, assetExponent := range p.AssetExponents {
+		if ResolveDenom(assetExponent.Denom) == denom {
+			return assetExponent.Exponent, true
+		}
+	}
+
+	return 0, false
+}
@@ -5,10 +5,12 @@ package simpleswap
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -156,6 +158,8 @@ var xxx_messageInfo_QueryPoolRequest proto.InternalMessageInfo
 type QueryPoolResponse struct {
 	// pool defines the pool information.
 	Pool Pool `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool"`
+	// assets are the origins of the whitelisted coins of the pool.
+	Assets []DenomOrigin `protobuf:"bytes,2,rep,name=assets,proto3" json:"assets"`
 }
 
 func (m *QueryPoolResponse) Reset()         { *m = QueryPoolResponse{} }
@@ -198,7 +202,233 @@ func (m *QueryPoolResponse) GetPool() Pool {
 	return Pool{}
 }
 
+func (m *QueryPoolResponse) GetAssets() []DenomOrigin {
+	if m != nil {
+		return m.Assets
+	}
+	return nil
+}
+
 // QueryLiquidityProviderRequest is the request type for the Query/LiquidityProvider RPC method.
+// QueryPoolStatsRequest is the request type for the Query/PoolStats RPC method.
+type QueryPoolStatsRequest struct {
+}
+
+func (m *QueryPoolStatsRequest) Reset()         { *m = QueryPoolStatsRequest{} }
+func (m *QueryPoolStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolStatsRequest) ProtoMessage()    {}
+func (*QueryPoolStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2e4e806b4add1b6, []int{4}
+}
+func (m *QueryPoolStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolStatsRequest.Merge(m, src)
+}
+func (m *QueryPoolStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolStatsRequest proto.InternalMessageInfo
+
+// QueryPoolStatsResponse is the response type for the Query/PoolStats RPC method.
+type QueryPoolStatsResponse struct {
+	// daily are the stats of the hourly buckets of the last 24 hours, the current one included.
+	Daily PoolStatsWindow `protobuf:"bytes,1,opt,name=daily,proto3" json:"daily"`
+	// weekly are the stats of the hourly buckets of the last 7 days, the current one included.
+	Weekly PoolStatsWindow `protobuf:"bytes,2,opt,name=weekly,proto3" json:"weekly"`
+}
+
+func (m *QueryPoolStatsResponse) Reset()         { *m = QueryPoolStatsResponse{} }
+func (m *QueryPoolStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolStatsResponse) ProtoMessage()    {}
+func (*QueryPoolStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2e4e806b4add1b6, []int{5}
+}
+func (m *QueryPoolStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolStatsResponse.Merge(m, src)
+}
+func (m *QueryPoolStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolStatsResponse proto.InternalMessageInfo
+
+func (m *QueryPoolStatsResponse) GetDaily() PoolStatsWindow {
+	if m != nil {
+		return m.Daily
+	}
+	return PoolStatsWindow{}
+}
+
+func (m *QueryPoolStatsResponse) GetWeekly() PoolStatsWindow {
+	if m != nil {
+		return m.Weekly
+	}
+	return PoolStatsWindow{}
+}
+
+// PoolStatsWindow are the stats of the swaps of the pool over a time window, in the pool decimals.
+type PoolStatsWindow struct {
+	// volume is the value of the outputs of the swaps, before the swap fee.
+	Volume cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=volume,proto3,customtype=cosmossdk.io/math.Int" json:"volume"`
+	// fees are the swap fees accrued by the liquidity providers.
+	Fees cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=fees,proto3,customtype=cosmossdk.io/math.Int" json:"fees"`
+	// swap_count is the number of swaps.
+	SwapCount uint64 `protobuf:"varint,3,opt,name=swap_count,json=swapCount,proto3" json:"swap_count,omitempty"`
+	// fee_apr is the estimated annual return of the fees for the liquidity providers: the fees of
+	// the window over the total liquidity, annualized.
+	FeeApr cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=fee_apr,json=feeApr,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_apr"`
+	// pairs are the stats of every pair of coins swapped during the window, by input and output denoms.
+	Pairs []PairStats `protobuf:"bytes,5,rep,name=pairs,proto3" json:"pairs"`
+}
+
+func (m *PoolStatsWindow) Reset()         { *m = PoolStatsWindow{} }
+func (m *PoolStatsWindow) String() string { return proto.CompactTextString(m) }
+func (*PoolStatsWindow) ProtoMessage()    {}
+func (*PoolStatsWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2e4e806b4add1b6, []int{6}
+}
+func (m *PoolStatsWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolStatsWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolStatsWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolStatsWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolStatsWindow.Merge(m, src)
+}
+func (m *PoolStatsWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolStatsWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolStatsWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolStatsWindow proto.InternalMessageInfo
+
+func (m *PoolStatsWindow) GetSwapCount() uint64 {
+	if m != nil {
+		return m.SwapCount
+	}
+	return 0
+}
+
+func (m *PoolStatsWindow) GetPairs() []PairStats {
+	if m != nil {
+		return m.Pairs
+	}
+	return nil
+}
+
+// PairStats are the stats of the swaps of an input coin for an output coin, in the pool decimals.
+type PairStats struct {
+	InputDenom  string `protobuf:"bytes,1,opt,name=input_denom,json=inputDenom,proto3" json:"input_denom,omitempty"`
+	OutputDenom string `protobuf:"bytes,2,opt,name=output_denom,json=outputDenom,proto3" json:"output_denom,omitempty"`
+	// volume is the value of the outputs of the swaps, before the swap fee.
+	Volume cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=volume,proto3,customtype=cosmossdk.io/math.Int" json:"volume"`
+	// fees are the swap fees accrued by the liquidity providers.
+	Fees      cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=fees,proto3,customtype=cosmossdk.io/math.Int" json:"fees"`
+	SwapCount uint64                `protobuf:"varint,5,opt,name=swap_count,json=swapCount,proto3" json:"swap_count,omitempty"`
+}
+
+func (m *PairStats) Reset()         { *m = PairStats{} }
+func (m *PairStats) String() string { return proto.CompactTextString(m) }
+func (*PairStats) ProtoMessage()    {}
+func (*PairStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2e4e806b4add1b6, []int{7}
+}
+func (m *PairStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairStats.Merge(m, src)
+}
+func (m *PairStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairStats proto.InternalMessageInfo
+
+func (m *PairStats) GetInputDenom() string {
+	if m != nil {
+		return m.InputDenom
+	}
+	return ""
+}
+
+func (m *PairStats) GetOutputDenom() string {
+	if m != nil {
+		return m.OutputDenom
+	}
+	return ""
+}
+
+func (m *PairStats) GetSwapCount() uint64 {
+	if m != nil {
+		return m.SwapCount
+	}
+	return 0
+}
+
 type QueryLiquidityProviderRequest struct {
 	// lp_address defines the address of the liquidity provider.
 	LpAddress string `protobuf:"bytes,1,opt,name=lp_address,json=lpAddress,proto3" json:"lp_address,omitempty"`
@@ -208,7 +438,7 @@ func (m *QueryLiquidityProviderRequest) Reset()         { *m = QueryLiquidityPro
 func (m *QueryLiquidityProviderRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryLiquidityProviderRequest) ProtoMessage()    {}
 func (*QueryLiquidityProviderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a2e4e806b4add1b6, []int{4}
+	return fileDescriptor_a2e4e806b4add1b6, []int{8}
 }
 func (m *QueryLiquidityProviderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -254,7 +484,7 @@ func (m *QueryLiquidityProviderResponse) Reset()         { *m = QueryLiquidityPr
 func (m *QueryLiquidityProviderResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLiquidityProviderResponse) ProtoMessage()    {}
 func (*QueryLiquidityProviderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a2e4e806b4add1b6, []int{5}
+	return fileDescriptor_a2e4e806b4add1b6, []int{9}
 }
 func (m *QueryLiquidityProviderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -290,22 +520,24 @@ func (m *QueryLiquidityProviderResponse) GetLiquidityProvider() LiquidityProvide
 	return LiquidityProvider{}
 }
 
-type QueryCoinReserveRequest struct {
-	CoinDenom string `protobuf:"bytes,1,opt,name=coin_denom,json=coinDenom,proto3" json:"coin_denom,omitempty"`
+// QueryPositionRequest is the request type for the Query/Position RPC method.
+type QueryPositionRequest struct {
+	// address is the address of the liquidity provider.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryCoinReserveRequest) Reset()         { *m = QueryCoinReserveRequest{} }
-func (m *QueryCoinReserveRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryCoinReserveRequest) ProtoMessage()    {}
-func (*QueryCoinReserveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a2e4e806b4add1b6, []int{6}
+func (m *QueryPositionRequest) Reset()         { *m = QueryPositionRequest{} }
+func (m *QueryPositionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionRequest) ProtoMessage()    {}
+func (*QueryPositionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2e4e806b4add1b6, []int{10}
 }
-func (m *QueryCoinReserveRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPositionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCoinReserveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPositionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCoinReserveRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPositionRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -315,41 +547,58 @@ func (m *QueryCoinReserveRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryCoinReserveRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCoinReserveRequest.Merge(m, src)
+func (m *QueryPositionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionRequest.Merge(m, src)
 }
-func (m *QueryCoinReserveRequest) XXX_Size() int {
+func (m *QueryPositionRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCoinReserveRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCoinReserveRequest.DiscardUnknown(m)
+func (m *QueryPositionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryCoinReserveRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryPositionRequest proto.InternalMessageInfo
 
-func (m *QueryCoinReserveRequest) GetCoinDenom() string {
+func (m *QueryPositionRequest) GetAddress() string {
 	if m != nil {
-		return m.CoinDenom
+		return m.Address
 	}
 	return ""
 }
 
-type QueryCoinReserveResponse struct {
-	CoinReserve types.Coin `protobuf:"bytes,1,opt,name=coin_reserve,json=coinReserve,proto3" json:"coin_reserve"`
+// QueryPositionResponse is the response type for the Query/Position RPC method.
+type QueryPositionResponse struct {
+	// liquidity_provider defines the liquidity provider information, as recorded at its last deposit
+	// or withdrawal.
+	LiquidityProvider LiquidityProvider `protobuf:"bytes,1,opt,name=liquidity_provider,json=liquidityProvider,proto3" json:"liquidity_provider"`
+	// pending_fees are the swap fees accrued by the liquidity provider and not yet withdrawn, up to
+	// the current block, paid out in its stable coin.
+	PendingFees types.Coin `protobuf:"bytes,2,opt,name=pending_fees,json=pendingFees,proto3" json:"pending_fees"`
+	// pool_share_percentage is the percentage of the pool shares outstanding held by the liquidity
+	// provider, e.g. 25 for a quarter of the pool.
+	PoolSharePercentage cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=pool_share_percentage,json=poolSharePercentage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"pool_share_percentage"`
+	// reserve_shares are the shares of the liquidity provider of each reserve of the pool, rounded down.
+	ReserveShares github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=reserve_shares,json=reserveShares,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reserve_shares"`
+	// exit_payout is what the removal of all the liquidity of the provider pays out in the current
+	// block: its stable coin and its pending fees. No exit fee is charged.
+	ExitPayout types.Coin `protobuf:"bytes,5,opt,name=exit_payout,json=exitPayout,proto3" json:"exit_payout"`
+	// exit_available is false when the reserve of the stable coin is below the liquidity of the
+	// provider, which cannot remove all of it until swaps refill the reserve.
+	ExitAvailable bool `protobuf:"varint,6,opt,name=exit_available,json=exitAvailable,proto3" json:"exit_available,omitempty"`
 }
 
-func (m *QueryCoinReserveResponse) Reset()         { *m = QueryCoinReserveResponse{} }
-func (m *QueryCoinReserveResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryCoinReserveResponse) ProtoMessage()    {}
-func (*QueryCoinReserveResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a2e4e806b4add1b6, []int{7}
+func (m *QueryPositionResponse) Reset()         { *m = QueryPositionResponse{} }
+func (m *QueryPositionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionResponse) ProtoMessage()    {}
+func (*QueryPositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2e4e806b4add1b6, []int{11}
 }
-func (m *QueryCoinReserveResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPositionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCoinReserveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCoinReserveResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPositionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -359,40 +608,74 @@ func (m *QueryCoinReserveResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryCoinReserveResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCoinReserveResponse.Merge(m, src)
+func (m *QueryPositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionResponse.Merge(m, src)
 }
-func (m *QueryCoinReserveResponse) XXX_Size() int {
+func (m *QueryPositionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCoinReserveResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCoinReserveResponse.DiscardUnknown(m)
+func (m *QueryPositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryCoinReserveResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPositionResponse proto.InternalMessageInfo
 
-func (m *QueryCoinReserveResponse) GetCoinReserve() types.Coin {
+func (m *QueryPositionResponse) GetLiquidityProvider() LiquidityProvider {
 	if m != nil {
-		return m.CoinReserve
+		return m.LiquidityProvider
+	}
+	return LiquidityProvider{}
+}
+
+func (m *QueryPositionResponse) GetPendingFees() types.Coin {
+	if m != nil {
+		return m.PendingFees
 	}
 	return types.Coin{}
 }
 
-type QueryCoinReservesRequest struct {
+func (m *QueryPositionResponse) GetReserveShares() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ReserveShares
+	}
+	return nil
 }
 
-func (m *QueryCoinReservesRequest) Reset()         { *m = QueryCoinReservesRequest{} }
-func (m *QueryCoinReservesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryCoinReservesRequest) ProtoMessage()    {}
-func (*QueryCoinReservesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a2e4e806b4add1b6, []int{8}
+func (m *QueryPositionResponse) GetExitPayout() types.Coin {
+	if m != nil {
+		return m.ExitPayout
+	}
+	return types.Coin{}
 }
-func (m *QueryCoinReservesRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryPositionResponse) GetExitAvailable() bool {
+	if m != nil {
+		return m.ExitAvailable
+	}
+	return false
+}
+
+// QueryLiquidityProvidersRequest is the request type for the Query/LiquidityProviders RPC method.
+type QueryLiquidityProvidersRequest struct {
+	// denom filters the liquidity providers by the denom of their stable coin, or the transfer path
+	// trace of a bridged coin, all the liquidity providers are returned if empty.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryLiquidityProvidersRequest) Reset()         { *m = QueryLiquidityProvidersRequest{} }
+func (m *QueryLiquidityProvidersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidityProvidersRequest) ProtoMessage()    {}
+func (*QueryLiquidityProvidersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2e4e806b4add1b6, []int{12}
+}
+func (m *QueryLiquidityProvidersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCoinReservesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLiquidityProvidersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCoinReservesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLiquidityProvidersRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -402,34 +685,52 @@ func (m *QueryCoinReservesRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryCoinReservesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCoinReservesRequest.Merge(m, src)
+func (m *QueryLiquidityProvidersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityProvidersRequest.Merge(m, src)
 }
-func (m *QueryCoinReservesRequest) XXX_Size() int {
+func (m *QueryLiquidityProvidersRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCoinReservesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCoinReservesRequest.DiscardUnknown(m)
+func (m *QueryLiquidityProvidersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityProvidersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryCoinReservesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryLiquidityProvidersRequest proto.InternalMessageInfo
 
-type QueryCoinReservesResponse struct {
-	CoinReserves []types.Coin `protobuf:"bytes,1,rep,name=coin_reserves,json=coinReserves,proto3" json:"coin_reserves"`
+func (m *QueryLiquidityProvidersRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *QueryCoinReservesResponse) Reset()         { *m = QueryCoinReservesResponse{} }
-func (m *QueryCoinReservesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryCoinReservesResponse) ProtoMessage()    {}
-func (*QueryCoinReservesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a2e4e806b4add1b6, []int{9}
+func (m *QueryLiquidityProvidersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (m *QueryCoinReservesResponse) XXX_Unmarshal(b []byte) error {
+
+// QueryLiquidityProvidersResponse is the response type for the Query/LiquidityProviders RPC method.
+type QueryLiquidityProvidersResponse struct {
+	// liquidity_providers defines the liquidity providers, in the order of their addresses.
+	LiquidityProviders []LiquidityProviderEntry `protobuf:"bytes,1,rep,name=liquidity_providers,json=liquidityProviders,proto3" json:"liquidity_providers"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryLiquidityProvidersResponse) Reset()         { *m = QueryLiquidityProvidersResponse{} }
+func (m *QueryLiquidityProvidersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidityProvidersResponse) ProtoMessage()    {}
+func (*QueryLiquidityProvidersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2e4e806b4add1b6, []int{13}
+}
+func (m *QueryLiquidityProvidersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCoinReservesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLiquidityProvidersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCoinReservesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLiquidityProvidersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -439,748 +740,7122 @@ func (m *QueryCoinReservesResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryCoinReservesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCoinReservesResponse.Merge(m, src)
+func (m *QueryLiquidityProvidersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityProvidersResponse.Merge(m, src)
 }
-func (m *QueryCoinReservesResponse) XXX_Size() int {
+func (m *QueryLiquidityProvidersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCoinReservesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCoinReservesResponse.DiscardUnknown(m)
+func (m *QueryLiquidityProvidersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityProvidersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryCoinReservesResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryLiquidityProvidersResponse proto.InternalMessageInfo
 
-func (m *QueryCoinReservesResponse) GetCoinReserves() []types.Coin {
+func (m *QueryLiquidityProvidersResponse) GetLiquidityProviders() []LiquidityProviderEntry {
 	if m != nil {
-		return m.CoinReserves
+		return m.LiquidityProviders
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.simpleswap.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.simpleswap.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryPoolRequest)(nil), "cosmos.simpleswap.v1.QueryPoolRequest")
-	proto.RegisterType((*QueryPoolResponse)(nil), "cosmos.simpleswap.v1.QueryPoolResponse")
-	proto.RegisterType((*QueryLiquidityProviderRequest)(nil), "cosmos.simpleswap.v1.QueryLiquidityProviderRequest")
-	proto.RegisterType((*QueryLiquidityProviderResponse)(nil), "cosmos.simpleswap.v1.QueryLiquidityProviderResponse")
-	proto.RegisterType((*QueryCoinReserveRequest)(nil), "cosmos.simpleswap.v1.QueryCoinReserveRequest")
-	proto.RegisterType((*QueryCoinReserveResponse)(nil), "cosmos.simpleswap.v1.QueryCoinReserveResponse")
-	proto.RegisterType((*QueryCoinReservesRequest)(nil), "cosmos.simpleswap.v1.QueryCoinReservesRequest")
-	proto.RegisterType((*QueryCoinReservesResponse)(nil), "cosmos.simpleswap.v1.QueryCoinReservesResponse")
+func (m *QueryLiquidityProvidersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("cosmos/simpleswap/v1/query.proto", fileDescriptor_a2e4e806b4add1b6) }
-
-var fileDescriptor_a2e4e806b4add1b6 = []byte{
-	// 663 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0x4f, 0x6b, 0x13, 0x41,
-	0x18, 0xc6, 0xb3, 0x5a, 0x03, 0x9d, 0x56, 0x30, 0x63, 0xc0, 0x66, 0x4d, 0xd7, 0xb2, 0xfe, 0x69,
-	0xad, 0xb8, 0x43, 0x5a, 0xb5, 0x7a, 0x50, 0xb1, 0x0a, 0x22, 0x88, 0xd4, 0x1c, 0x3c, 0x78, 0x09,
-	0x93, 0x64, 0x5c, 0x07, 0x76, 0x77, 0x36, 0x3b, 0x9b, 0x48, 0x08, 0xbd, 0xd8, 0x2f, 0xa0, 0x78,
-	0xf5, 0x2a, 0x78, 0xf4, 0x0b, 0x78, 0xef, 0xb1, 0xe0, 0xc5, 0x93, 0x48, 0x22, 0xf8, 0x35, 0x64,
-	0x67, 0x66, 0x93, 0xd1, 0xdd, 0xa4, 0xf1, 0x12, 0x36, 0xef, 0xfb, 0x3e, 0xef, 0xf3, 0xdb, 0xe1,
-	0x19, 0x16, 0xac, 0xb5, 0x18, 0xf7, 0x19, 0x47, 0x9c, 0xfa, 0xa1, 0x47, 0xf8, 0x1b, 0x1c, 0xa2,
-	0x5e, 0x0d, 0x75, 0xba, 0x24, 0xea, 0x3b, 0x61, 0xc4, 0x62, 0x06, 0xcb, 0x72, 0xc2, 0x99, 0x4c,
-	0x38, 0xbd, 0x9a, 0x69, 0x29, 0x5d, 0x13, 0x73, 0x82, 0x7a, 0xb5, 0x26, 0x89, 0x71, 0x0d, 0xb5,
-	0x18, 0x0d, 0xa4, 0xca, 0xdc, 0xd4, 0xfb, 0x62, 0xdd, 0x78, 0x2a, 0xc4, 0x2e, 0x0d, 0x70, 0x4c,
-	0x59, 0x3a, 0x9b, 0xcf, 0x10, 0xf7, 0x43, 0xc2, 0xd5, 0x44, 0xd5, 0x65, 0xcc, 0xf5, 0x08, 0xc2,
-	0x21, 0x45, 0x38, 0x08, 0x58, 0x2c, 0xe4, 0x69, 0xf7, 0xbc, 0xd2, 0xa7, 0x36, 0x3a, 0xbe, 0x59,
-	0xc2, 0x3e, 0x0d, 0x18, 0x12, 0xbf, 0xaa, 0x54, 0x76, 0x99, 0xcb, 0xc4, 0x23, 0x4a, 0x9e, 0x54,
-	0xb5, 0x22, 0xb7, 0x34, 0x64, 0x43, 0xbd, 0xb4, 0xf8, 0x63, 0x97, 0x01, 0x7c, 0x9e, 0xac, 0xdc,
-	0xc3, 0x11, 0xf6, 0x79, 0x9d, 0x74, 0xba, 0x84, 0xc7, 0xf6, 0x0b, 0x70, 0xf6, 0xaf, 0x2a, 0x0f,
-	0x59, 0xc0, 0x09, 0xbc, 0x0f, 0x8a, 0xa1, 0xa8, 0xac, 0x18, 0x6b, 0xc6, 0xc6, 0xd2, 0x56, 0xd5,
-	0xc9, 0x3b, 0x40, 0x47, 0xaa, 0x76, 0x17, 0x0f, 0x7f, 0x5c, 0x28, 0x7c, 0xfe, 0xfd, 0x65, 0xd3,
-	0xa8, 0x2b, 0x99, 0x0d, 0xc1, 0x19, 0xb9, 0x97, 0x31, 0x2f, 0xf5, 0x7a, 0x06, 0x4a, 0x5a, 0x4d,
-	0x39, 0xdd, 0x01, 0x0b, 0x21, 0x63, 0x9e, 0xf2, 0x31, 0xa7, 0xf8, 0x30, 0xe6, 0xe9, 0x2e, 0x42,
-	0x62, 0xdf, 0x03, 0xab, 0x62, 0xdf, 0x53, 0xda, 0xe9, 0xd2, 0x36, 0x8d, 0xfb, 0x7b, 0x11, 0xeb,
-	0xd1, 0x36, 0x89, 0x94, 0x21, 0x5c, 0x05, 0xc0, 0x0b, 0x1b, 0xb8, 0xdd, 0x8e, 0x08, 0x97, 0x6f,
-	0xb2, 0x58, 0x5f, 0xf4, 0xc2, 0x07, 0xb2, 0x60, 0x1f, 0x18, 0xc0, 0x9a, 0xb6, 0x40, 0xd1, 0x61,
-	0x00, 0xbd, 0xb4, 0x99, 0x1c, 0xaa, 0xe8, 0x2a, 0xd6, 0xf5, 0x7c, 0xd6, 0xcc, 0x32, 0x1d, 0xbc,
-	0xe4, 0xfd, 0xdb, 0xb5, 0x6f, 0x83, 0x73, 0x02, 0xe2, 0x21, 0xa3, 0x41, 0x9d, 0x70, 0x12, 0xf5,
-	0x88, 0xc6, 0x9f, 0xa4, 0xb1, 0xd1, 0x26, 0x01, 0xf3, 0x53, 0xfe, 0xa4, 0xf2, 0x28, 0x29, 0xd8,
-	0x2d, 0xb0, 0x92, 0x55, 0x2a, 0xf0, 0xc7, 0x60, 0x59, 0x48, 0x23, 0x59, 0x57, 0xc8, 0x95, 0x14,
-	0x39, 0x49, 0xb4, 0xa3, 0xb2, 0xec, 0x24, 0x7a, 0x1d, 0x72, 0xa9, 0x35, 0x59, 0x68, 0x9b, 0x59,
-	0x93, 0x71, 0x78, 0x5e, 0x81, 0x4a, 0x4e, 0x4f, 0x11, 0x3c, 0x01, 0xa7, 0x75, 0x82, 0xe4, 0xfc,
-	0x4f, 0xce, 0x8d, 0xb0, 0xac, 0x21, 0xf0, 0xad, 0xf7, 0x45, 0x70, 0x4a, 0x18, 0xc1, 0x03, 0x03,
-	0x14, 0x65, 0xe8, 0xe0, 0x46, 0xfe, 0xf1, 0x67, 0x33, 0x6e, 0x5e, 0x9d, 0x63, 0x52, 0x42, 0xdb,
-	0x97, 0xde, 0x7e, 0xfb, 0xf5, 0xe1, 0x84, 0x05, 0xab, 0x28, 0xf7, 0x3a, 0xcb, 0x70, 0xc3, 0x01,
-	0x58, 0x48, 0x12, 0x09, 0xaf, 0xcc, 0x5a, 0x3c, 0x09, 0xbe, 0xb9, 0x7e, 0xec, 0x9c, 0xb2, 0xb7,
-	0x85, 0x7d, 0x15, 0x9a, 0x53, 0xec, 0x13, 0xd3, 0xaf, 0x06, 0x28, 0x65, 0x32, 0x06, 0xb7, 0x67,
-	0x58, 0x4c, 0xbb, 0x1f, 0xe6, 0x8d, 0xff, 0x13, 0x29, 0xc8, 0xbb, 0x02, 0x72, 0x07, 0xde, 0xcc,
-	0x87, 0xcc, 0xde, 0x17, 0x34, 0x98, 0xdc, 0xc2, 0x7d, 0xf8, 0xc9, 0x00, 0x4b, 0x5a, 0x60, 0xe0,
-	0xf5, 0x19, 0x10, 0xd9, 0x3b, 0x61, 0x3a, 0xf3, 0x8e, 0x2b, 0xda, 0x1d, 0x41, 0x5b, 0x83, 0x28,
-	0x9f, 0x56, 0x8f, 0x28, 0x1a, 0x4c, 0x6e, 0xdb, 0x3e, 0xfc, 0x68, 0x80, 0x65, 0x3d, 0xd8, 0x70,
-	0x4e, 0xe7, 0x71, 0xec, 0xd0, 0xdc, 0xf3, 0x0a, 0xf5, 0x9a, 0x40, 0xbd, 0x0c, 0x2f, 0x1e, 0x8f,
-	0xca, 0x77, 0x6f, 0x1d, 0x0e, 0x2d, 0xe3, 0x68, 0x68, 0x19, 0x3f, 0x87, 0x96, 0xf1, 0x6e, 0x64,
-	0x15, 0x8e, 0x46, 0x56, 0xe1, 0xfb, 0xc8, 0x2a, 0xbc, 0xac, 0xba, 0x34, 0x7e, 0xdd, 0x6d, 0x3a,
-	0x2d, 0xe6, 0x67, 0x17, 0x35, 0x8b, 0xe2, 0x6b, 0xb0, 0xfd, 0x27, 0x00, 0x00, 0xff, 0xff, 0xb4,
-	0xf0, 0x1f, 0x6a, 0x34, 0x07, 0x00, 0x00,
+// QueryLiquidityProvidersByDenomRequest is the request type for the Query/LiquidityProvidersByDenom
+// RPC method.
+type QueryLiquidityProvidersByDenomRequest struct {
+	// denom is the denom of the stable coin, or the transfer path trace of a bridged coin.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params returns the module parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// GetPool returns the pool information.
-	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
-	// GetLiquidityProvider returns the liquidity provider information.
-	LiquidityProvider(ctx context.Context, in *QueryLiquidityProviderRequest, opts ...grpc.CallOption) (*QueryLiquidityProviderResponse, error)
-	// GetCoinReserve returns the coin reserve information for a given coin denom.
-	CoinReserve(ctx context.Context, in *QueryCoinReserveRequest, opts ...grpc.CallOption) (*QueryCoinReserveResponse, error)
-	// GetCoinReserves returns the coin reserves information for all coin denoms.
-	CoinReserves(ctx context.Context, in *QueryCoinReservesRequest, opts ...grpc.CallOption) (*QueryCoinReservesResponse, error)
-}
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryLiquidityProvidersByDenomRequest) Reset()         { *m = QueryLiquidityProvidersByDenomRequest{} }
+func (m *QueryLiquidityProvidersByDenomRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidityProvidersByDenomRequest) ProtoMessage()    {}
+func (*QueryLiquidityProvidersByDenomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2e4e806b4add1b6, []int{14}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryLiquidityProvidersByDenomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.simpleswap.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLiquidityProvidersByDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidityProvidersByDenomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error) {
-	out := new(QueryPoolResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.simpleswap.v1.Query/Pool", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryLiquidityProvidersByDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityProvidersByDenomRequest.Merge(m, src)
 }
-
-func (c *queryClient) LiquidityProvider(ctx context.Context, in *QueryLiquidityProviderRequest, opts ...grpc.CallOption) (*QueryLiquidityProviderResponse, error) {
-	out := new(QueryLiquidityProviderResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.simpleswap.v1.Query/LiquidityProvider", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryLiquidityProvidersByDenomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidityProvidersByDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityProvidersByDenomRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) CoinReserve(ctx context.Context, in *QueryCoinReserveRequest, opts ...grpc.CallOption) (*QueryCoinReserveResponse, error) {
-	out := new(QueryCoinReserveResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.simpleswap.v1.Query/CoinReserve", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLiquidityProvidersByDenomRequest proto.InternalMessageInfo
+
+func (m *QueryLiquidityProvidersByDenomRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) CoinReserves(ctx context.Context, in *QueryCoinReservesRequest, opts ...grpc.CallOption) (*QueryCoinReservesResponse, error) {
-	out := new(QueryCoinReservesResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.simpleswap.v1.Query/CoinReserves", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLiquidityProvidersByDenomRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params returns the module parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// GetPool returns the pool information.
-	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
-	// GetLiquidityProvider returns the liquidity provider information.
-	LiquidityProvider(context.Context, *QueryLiquidityProviderRequest) (*QueryLiquidityProviderResponse, error)
-	// GetCoinReserve returns the coin reserve information for a given coin denom.
-	CoinReserve(context.Context, *QueryCoinReserveRequest) (*QueryCoinReserveResponse, error)
-	// GetCoinReserves returns the coin reserves information for all coin denoms.
-	CoinReserves(context.Context, *QueryCoinReservesRequest) (*QueryCoinReservesResponse, error)
+// QueryLiquidityProvidersByDenomResponse is the response type for the Query/LiquidityProvidersByDenom
+// RPC method.
+type QueryLiquidityProvidersByDenomResponse struct {
+	// liquidity_providers defines the liquidity providers of the stable coin, in the order of their
+	// addresses.
+	LiquidityProviders []LiquidityProviderEntry `protobuf:"bytes,1,rep,name=liquidity_providers,json=liquidityProviders,proto3" json:"liquidity_providers"`
+	// total_exposure is the stable coin provided by all the liquidity providers of the denom, across
+	// all the pages.
+	TotalExposure types.Coin `protobuf:"bytes,2,opt,name=total_exposure,json=totalExposure,proto3" json:"total_exposure"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryLiquidityProvidersByDenomResponse) Reset() {
+	*m = QueryLiquidityProvidersByDenomResponse{}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryLiquidityProvidersByDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidityProvidersByDenomResponse) ProtoMessage()    {}
+func (*QueryLiquidityProvidersByDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2e4e806b4add1b6, []int{15}
 }
-func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryPoolRequest) (*QueryPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
+func (m *QueryLiquidityProvidersByDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) LiquidityProvider(ctx context.Context, req *QueryLiquidityProviderRequest) (*QueryLiquidityProviderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidityProvider not implemented")
+func (m *QueryLiquidityProvidersByDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidityProvidersByDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) CoinReserve(ctx context.Context, req *QueryCoinReserveRequest) (*QueryCoinReserveResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CoinReserve not implemented")
+func (m *QueryLiquidityProvidersByDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityProvidersByDenomResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) CoinReserves(ctx context.Context, req *QueryCoinReservesRequest) (*QueryCoinReservesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CoinReserves not implemented")
+func (m *QueryLiquidityProvidersByDenomResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryLiquidityProvidersByDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityProvidersByDenomResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.simpleswap.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryLiquidityProvidersByDenomResponse proto.InternalMessageInfo
+
+func (m *QueryLiquidityProvidersByDenomResponse) GetLiquidityProviders() []LiquidityProviderEntry {
+	if m != nil {
+		return m.LiquidityProviders
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Pool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.simpleswap.v1.Query/Pool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Pool(ctx, req.(*QueryPoolRequest))
+func (m *QueryLiquidityProvidersByDenomResponse) GetTotalExposure() types.Coin {
+	if m != nil {
+		return m.TotalExposure
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Query_LiquidityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLiquidityProviderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LiquidityProvider(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.simpleswap.v1.Query/LiquidityProvider",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LiquidityProvider(ctx, req.(*QueryLiquidityProviderRequest))
+func (m *QueryLiquidityProvidersByDenomResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_CoinReserve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCoinReserveRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CoinReserve(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.simpleswap.v1.Query/CoinReserve",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CoinReserve(ctx, req.(*QueryCoinReserveRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// LiquidityProviderEntry is a liquidity provider with its share of the pool and its pending fees.
+type LiquidityProviderEntry struct {
+	// address defines the address of the liquidity provider.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// liquidity_provider defines the liquidity provider information.
+	LiquidityProvider LiquidityProvider `protobuf:"bytes,2,opt,name=liquidity_provider,json=liquidityProvider,proto3" json:"liquidity_provider"`
+	// pool_share_ratio is the ratio of the pool shares outstanding held by the liquidity provider.
+	PoolShareRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=pool_share_ratio,json=poolShareRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"pool_share_ratio"`
+	// pending_fees are the swap fees accrued by the liquidity provider and not yet withdrawn, in the
+	// pool decimals. They are paid out in its stable coin when its liquidity is removed.
+	PendingFees int64 `protobuf:"varint,4,opt,name=pending_fees,json=pendingFees,proto3" json:"pending_fees,omitempty"`
 }
 
-func _Query_CoinReserves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCoinReservesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CoinReserves(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.simpleswap.v1.Query/CoinReserves",
+func (m *LiquidityProviderEntry) Reset()         { *m = LiquidityProviderEntry{} }
+func (m *LiquidityProviderEntry) String() string { return proto.CompactTextString(m) }
+func (*LiquidityProviderEntry) ProtoMessage()    {}
+func (*LiquidityProviderEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2e4e806b4add1b6, []int{16}
+}
+func (m *LiquidityProviderEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidityProviderEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidityProviderEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CoinReserves(ctx, req.(*QueryCoinReservesRequest))
+}
+func (m *LiquidityProviderEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityProviderEntry.Merge(m, src)
+}
+func (m *LiquidityProviderEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidityProviderEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityProviderEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidityProviderEntry proto.InternalMessageInfo
+
+func (m *LiquidityProviderEntry) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.simpleswap.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Pool",
-			Handler:    _Query_Pool_Handler,
-		},
-		{
-			MethodName: "LiquidityProvider",
-			Handler:    _Query_LiquidityProvider_Handler,
-		},
-		{
-			MethodName: "CoinReserve",
-			Handler:    _Query_CoinReserve_Handler,
-		},
-		{
-			MethodName: "CoinReserves",
-			Handler:    _Query_CoinReserves_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cosmos/simpleswap/v1/query.proto",
+func (m *LiquidityProviderEntry) GetLiquidityProvider() LiquidityProvider {
+	if m != nil {
+		return m.LiquidityProvider
+	}
+	return LiquidityProvider{}
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *LiquidityProviderEntry) GetPendingFees() int64 {
+	if m != nil {
+		return m.PendingFees
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryCoinReserveRequest struct {
+	// coin_denom is the denom of the coin, or the transfer path trace of a bridged coin.
+	CoinDenom string `protobuf:"bytes,1,opt,name=coin_denom,json=coinDenom,proto3" json:"coin_denom,omitempty"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryCoinReserveRequest) Reset()         { *m = QueryCoinReserveRequest{} }
+func (m *QueryCoinReserveRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCoinReserveRequest) ProtoMessage()    {}
+func (*QueryCoinReserveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2e4e806b4add1b6, []int{17}
+}
+func (m *QueryCoinReserveRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCoinReserveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCoinReserveRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCoinReserveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCoinReserveRequest.Merge(m, src)
+}
+func (m *QueryCoinReserveRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCoinReserveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCoinReserveRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCoinReserveRequest proto.InternalMessageInfo
+
+func (m *QueryCoinReserveRequest) GetCoinDenom() string {
+	if m != nil {
+		return m.CoinDenom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryCoinReserveResponse struct {
+	CoinReserve types.Coin `protobuf:"bytes,1,opt,name=coin_reserve,json=coinReserve,proto3" json:"coin_reserve"`
+	// origin is the transfer path trace of a bridged coin, or the denom of a native coin.
+	Origin string `protobuf:"bytes,2,opt,name=origin,proto3" json:"origin,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryCoinReserveResponse) Reset()         { *m = QueryCoinReserveResponse{} }
+func (m *QueryCoinReserveResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCoinReserveResponse) ProtoMessage()    {}
+func (*QueryCoinReserveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2e4e806b4add1b6, []int{18}
+}
+func (m *QueryCoinReserveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCoinReserveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCoinReserveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryCoinReserveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCoinReserveResponse.Merge(m, src)
+}
+func (m *QueryCoinReserveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCoinReserveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCoinReserveResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPoolRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCoinReserveResponse proto.InternalMessageInfo
+
+func (m *QueryCoinReserveResponse) GetCoinReserve() types.Coin {
+	if m != nil {
+		return m.CoinReserve
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *QueryPoolRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCoinReserveResponse) GetOrigin() string {
+	if m != nil {
+		return m.Origin
+	}
+	return ""
 }
 
-func (m *QueryPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type QueryCoinReservesRequest struct {
 }
 
-func (m *QueryPoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCoinReservesRequest) Reset()         { *m = QueryCoinReservesRequest{} }
+func (m *QueryCoinReservesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCoinReservesRequest) ProtoMessage()    {}
+func (*QueryCoinReservesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2e4e806b4add1b6, []int{19}
+}
+func (m *QueryCoinReservesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCoinReservesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCoinReservesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryCoinReservesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCoinReservesRequest.Merge(m, src)
+}
+func (m *QueryCoinReservesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCoinReservesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCoinReservesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryCoinReservesRequest proto.InternalMessageInfo
+
+type QueryCoinReservesResponse struct {
+	CoinReserves []types.Coin `protobuf:"bytes,1,rep,name=coin_reserves,json=coinReserves,proto3" json:"coin_reserves"`
+	// origins are the origins of the denoms of the coin reserves.
+	Origins []DenomOrigin `protobuf:"bytes,2,rep,name=origins,proto3" json:"origins"`
 }
 
-func (m *QueryPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryCoinReservesResponse) Reset()         { *m = QueryCoinReservesResponse{} }
+func (m *QueryCoinReservesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCoinReservesResponse) ProtoMessage()    {}
+func (*QueryCoinReservesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2e4e806b4add1b6, []int{20}
+}
+func (m *QueryCoinReservesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCoinReservesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCoinReservesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryLiquidityProviderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryCoinReservesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCoinReservesResponse.Merge(m, src)
 }
-
-func (m *QueryLiquidityProviderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCoinReservesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCoinReservesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCoinReservesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryLiquidityProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LpAddress) > 0 {
-		i -= len(m.LpAddress)
-		copy(dAtA[i:], m.LpAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.LpAddress)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryCoinReservesResponse proto.InternalMessageInfo
+
+func (m *QueryCoinReservesResponse) GetCoinReserves() []types.Coin {
+	if m != nil {
+		return m.CoinReserves
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryLiquidityProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCoinReservesResponse) GetOrigins() []DenomOrigin {
+	if m != nil {
+		return m.Origins
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryLiquidityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGaugeRequest is the request type for the Query/Gauge RPC method.
+type QueryGaugeRequest struct {
+	// gauge_id defines the identifier of the gauge.
+	GaugeId uint64 `protobuf:"varint,1,opt,name=gauge_id,json=gaugeId,proto3" json:"gauge_id,omitempty"`
 }
 
-func (m *QueryLiquidityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.LiquidityProvider.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGaugeRequest) Reset()         { *m = QueryGaugeRequest{} }
+func (m *QueryGaugeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGaugeRequest) ProtoMessage()    {}
+func (*QueryGaugeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2e4e806b4add1b6, []int{21}
+}
+func (m *QueryGaugeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGaugeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGaugeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGaugeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGaugeRequest.Merge(m, src)
+}
+func (m *QueryGaugeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGaugeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGaugeRequest.DiscardUnknown(m)
 }
 
-func (m *QueryCoinReserveRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGaugeRequest proto.InternalMessageInfo
+
+func (m *QueryGaugeRequest) GetGaugeId() uint64 {
+	if m != nil {
+		return m.GaugeId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryCoinReserveRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGaugeResponse is the response type for the Query/Gauge RPC method.
+type QueryGaugeResponse struct {
+	// gauge defines the gauge information.
+	Gauge Gauge `protobuf:"bytes,1,opt,name=gauge,proto3" json:"gauge"`
 }
 
-func (m *QueryCoinReserveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CoinDenom) > 0 {
-		i -= len(m.CoinDenom)
-		copy(dAtA[i:], m.CoinDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CoinDenom)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryGaugeResponse) Reset()         { *m = QueryGaugeResponse{} }
+func (m *QueryGaugeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGaugeResponse) ProtoMessage()    {}
+func (*QueryGaugeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2e4e806b4add1b6, []int{22}
+}
+func (m *QueryGaugeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGaugeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGaugeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGaugeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGaugeResponse.Merge(m, src)
+}
+func (m *QueryGaugeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGaugeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGaugeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryCoinReserveResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGaugeResponse proto.InternalMessageInfo
+
+func (m *QueryGaugeResponse) GetGauge() Gauge {
+	if m != nil {
+		return m.Gauge
 	}
-	return dAtA[:n], nil
+	return Gauge{}
 }
 
-func (m *QueryCoinReserveResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGaugesRequest is the request type for the Query/Gauges RPC method.
+type QueryGaugesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryCoinReserveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.CoinReserve.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGaugesRequest) Reset()         { *m = QueryGaugesRequest{} }
+func (m *QueryGaugesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGaugesRequest) ProtoMessage()    {}
+func (*QueryGaugesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2e4e806b4add1b6, []int{23}
+}
+func (m *QueryGaugesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGaugesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGaugesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryCoinReservesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGaugesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGaugesRequest.Merge(m, src)
 }
-
-func (m *QueryCoinReservesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGaugesRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryCoinReservesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryGaugesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGaugesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryCoinReservesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGaugesRequest proto.InternalMessageInfo
+
+func (m *QueryGaugesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryCoinReservesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGaugesResponse is the response type for the Query/Gauges RPC method.
+type QueryGaugesResponse struct {
+	// gauges defines the gauges information.
+	Gauges []Gauge `protobuf:"bytes,1,rep,name=gauges,proto3" json:"gauges"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryCoinReservesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CoinReserves) > 0 {
-		for iNdEx := len(m.CoinReserves) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CoinReserves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryGaugesResponse) Reset()         { *m = QueryGaugesResponse{} }
+func (m *QueryGaugesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGaugesResponse) ProtoMessage()    {}
+func (*QueryGaugesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2e4e806b4add1b6, []int{24}
+}
+func (m *QueryGaugesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGaugesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGaugesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGaugesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGaugesResponse.Merge(m, src)
+}
+func (m *QueryGaugesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGaugesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGaugesResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryGaugesResponse proto.InternalMessageInfo
+
+func (m *QueryGaugesResponse) GetGauges() []Gauge {
+	if m != nil {
+		return m.Gauges
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryGaugesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// QueryPendingRewardsRequest is the request type for the Query/PendingRewards RPC method.
+type QueryPendingRewardsRequest struct {
+	// lp_address defines the address of the liquidity provider.
+	LpAddress string `protobuf:"bytes,1,opt,name=lp_address,json=lpAddress,proto3" json:"lp_address,omitempty"`
 }
 
-func (m *QueryPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPendingRewardsRequest) Reset()         { *m = QueryPendingRewardsRequest{} }
+func (m *QueryPendingRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRewardsRequest) ProtoMessage()    {}
+func (*QueryPendingRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2e4e806b4add1b6, []int{25}
+}
+func (m *QueryPendingRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryPendingRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRewardsRequest.Merge(m, src)
+}
+func (m *QueryPendingRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRewardsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryPendingRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingRewardsRequest) GetLpAddress() string {
+	if m != nil {
+		return m.LpAddress
 	}
-	var l int
-	_ = l
-	l = m.Pool.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *QueryLiquidityProviderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.LpAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryPendingRewardsResponse is the response type for the Query/PendingRewards RPC method.
+type QueryPendingRewardsResponse struct {
+	// rewards defines the rewards not yet claimed.
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
 }
 
-func (m *QueryLiquidityProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPendingRewardsResponse) Reset()         { *m = QueryPendingRewardsResponse{} }
+func (m *QueryPendingRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRewardsResponse) ProtoMessage()    {}
+func (*QueryPendingRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2e4e806b4add1b6, []int{26}
+}
+func (m *QueryPendingRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.LiquidityProvider.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryPendingRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRewardsResponse.Merge(m, src)
+}
+func (m *QueryPendingRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRewardsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryCoinReserveRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.CoinDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryPendingRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingRewardsResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
 	}
-	return n
+	return nil
+}
+
+// QueryAccountLocksRequest is the request type for the Query/AccountLocks RPC method.
+type QueryAccountLocksRequest struct {
+	// owner defines the address owning the locks.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *QueryAccountLocksRequest) Reset()         { *m = QueryAccountLocksRequest{} }
+func (m *QueryAccountLocksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountLocksRequest) ProtoMessage()    {}
+func (*QueryAccountLocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2e4e806b4add1b6, []int{27}
+}
+func (m *QueryAccountLocksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountLocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountLocksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountLocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountLocksRequest.Merge(m, src)
+}
+func (m *QueryAccountLocksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountLocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountLocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountLocksRequest proto.InternalMessageInfo
+
+func (m *QueryAccountLocksRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// QueryAccountLocksResponse is the response type for the Query/AccountLocks RPC method.
+type QueryAccountLocksResponse struct {
+	// locks defines the share locks of the account.
+	Locks []PeriodLock `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks"`
+}
+
+func (m *QueryAccountLocksResponse) Reset()         { *m = QueryAccountLocksResponse{} }
+func (m *QueryAccountLocksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountLocksResponse) ProtoMessage()    {}
+func (*QueryAccountLocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2e4e806b4add1b6, []int{28}
+}
+func (m *QueryAccountLocksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountLocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountLocksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountLocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountLocksResponse.Merge(m, src)
+}
+func (m *QueryAccountLocksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountLocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountLocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountLocksResponse proto.InternalMessageInfo
+
+func (m *QueryAccountLocksResponse) GetLocks() []PeriodLock {
+	if m != nil {
+		return m.Locks
+	}
+	return nil
+}
+
+// QueryAssetRateRequest is the request type for the Query/AssetRate RPC method.
+type QueryAssetRateRequest struct {
+	// denom defines the whitelisted coin.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryAssetRateRequest) Reset()         { *m = QueryAssetRateRequest{} }
+func (m *QueryAssetRateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAssetRateRequest) ProtoMessage()    {}
+func (*QueryAssetRateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2e4e806b4add1b6, []int{29}
+}
+func (m *QueryAssetRateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAssetRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAssetRateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAssetRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAssetRateRequest.Merge(m, src)
+}
+func (m *QueryAssetRateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAssetRateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAssetRateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAssetRateRequest proto.InternalMessageInfo
+
+func (m *QueryAssetRateRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryAssetRateResponse is the response type for the Query/AssetRate RPC method.
+type QueryAssetRateResponse struct {
+	// rate defines the redemption rate of the coin.
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+}
+
+func (m *QueryAssetRateResponse) Reset()         { *m = QueryAssetRateResponse{} }
+func (m *QueryAssetRateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAssetRateResponse) ProtoMessage()    {}
+func (*QueryAssetRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2e4e806b4add1b6, []int{30}
+}
+func (m *QueryAssetRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAssetRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAssetRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAssetRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAssetRateResponse.Merge(m, src)
+}
+func (m *QueryAssetRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAssetRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAssetRateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAssetRateResponse proto.InternalMessageInfo
+
+// QueryLimitOrdersByOwnerRequest is the request type for the Query/LimitOrdersByOwner RPC method.
+type QueryLimitOrdersByOwnerRequest struct {
+	// owner defines the address owning the orders.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryLimitOrdersByOwnerRequest) Reset()         { *m = QueryLimitOrdersByOwnerRequest{} }
+func (m *QueryLimitOrdersByOwnerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLimitOrdersByOwnerRequest) ProtoMessage()    {}
+func (*QueryLimitOrdersByOwnerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2e4e806b4add1b6, []int{31}
+}
+func (m *QueryLimitOrdersByOwnerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLimitOrdersByOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLimitOrdersByOwnerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLimitOrdersByOwnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLimitOrdersByOwnerRequest.Merge(m, src)
+}
+func (m *QueryLimitOrdersByOwnerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLimitOrdersByOwnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLimitOrdersByOwnerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLimitOrdersByOwnerRequest proto.InternalMessageInfo
+
+func (m *QueryLimitOrdersByOwnerRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryLimitOrdersByOwnerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryLimitOrdersByOwnerResponse is the response type for the Query/LimitOrdersByOwner RPC method.
+type QueryLimitOrdersByOwnerResponse struct {
+	// orders defines the open limit orders of the account, in the order of their ids.
+	Orders []LimitOrder `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryLimitOrdersByOwnerResponse) Reset()         { *m = QueryLimitOrdersByOwnerResponse{} }
+func (m *QueryLimitOrdersByOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLimitOrdersByOwnerResponse) ProtoMessage()    {}
+func (*QueryLimitOrdersByOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2e4e806b4add1b6, []int{32}
+}
+func (m *QueryLimitOrdersByOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLimitOrdersByOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLimitOrdersByOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLimitOrdersByOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLimitOrdersByOwnerResponse.Merge(m, src)
+}
+func (m *QueryLimitOrdersByOwnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLimitOrdersByOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLimitOrdersByOwnerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLimitOrdersByOwnerResponse proto.InternalMessageInfo
+
+func (m *QueryLimitOrdersByOwnerResponse) GetOrders() []LimitOrder {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+func (m *QueryLimitOrdersByOwnerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryLimitOrdersByPairRequest is the request type for the Query/LimitOrdersByPair RPC method.
+type QueryLimitOrdersByPairRequest struct {
+	// input_denom defines the denom of the coins sold, or the transfer path trace of a bridged coin.
+	InputDenom string `protobuf:"bytes,1,opt,name=input_denom,json=inputDenom,proto3" json:"input_denom,omitempty"`
+	// output_denom defines the denom of the coins bought, or the transfer path trace of a bridged coin.
+	OutputDenom string `protobuf:"bytes,2,opt,name=output_denom,json=outputDenom,proto3" json:"output_denom,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryLimitOrdersByPairRequest) Reset()         { *m = QueryLimitOrdersByPairRequest{} }
+func (m *QueryLimitOrdersByPairRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLimitOrdersByPairRequest) ProtoMessage()    {}
+func (*QueryLimitOrdersByPairRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2e4e806b4add1b6, []int{33}
+}
+func (m *QueryLimitOrdersByPairRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLimitOrdersByPairRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLimitOrdersByPairRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLimitOrdersByPairRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLimitOrdersByPairRequest.Merge(m, src)
+}
+func (m *QueryLimitOrdersByPairRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLimitOrdersByPairRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLimitOrdersByPairRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLimitOrdersByPairRequest proto.InternalMessageInfo
+
+func (m *QueryLimitOrdersByPairRequest) GetInputDenom() string {
+	if m != nil {
+		return m.InputDenom
+	}
+	return ""
+}
+
+func (m *QueryLimitOrdersByPairRequest) GetOutputDenom() string {
+	if m != nil {
+		return m.OutputDenom
+	}
+	return ""
+}
+
+func (m *QueryLimitOrdersByPairRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryLimitOrdersByPairResponse is the response type for the Query/LimitOrdersByPair RPC method.
+type QueryLimitOrdersByPairResponse struct {
+	// orders defines the open limit orders of the pair, in the order they are filled.
+	Orders []LimitOrder `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryLimitOrdersByPairResponse) Reset()         { *m = QueryLimitOrdersByPairResponse{} }
+func (m *QueryLimitOrdersByPairResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLimitOrdersByPairResponse) ProtoMessage()    {}
+func (*QueryLimitOrdersByPairResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2e4e806b4add1b6, []int{34}
+}
+func (m *QueryLimitOrdersByPairResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLimitOrdersByPairResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLimitOrdersByPairResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLimitOrdersByPairResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLimitOrdersByPairResponse.Merge(m, src)
+}
+func (m *QueryLimitOrdersByPairResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLimitOrdersByPairResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLimitOrdersByPairResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLimitOrdersByPairResponse proto.InternalMessageInfo
+
+func (m *QueryLimitOrdersByPairResponse) GetOrders() []LimitOrder {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+func (m *QueryLimitOrdersByPairResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDCAPlansByOwnerRequest is the request type for the Query/DCAPlansByOwner RPC method.
+type QueryDCAPlansByOwnerRequest struct {
+	// owner defines the address owning the plans.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDCAPlansByOwnerRequest) Reset()         { *m = QueryDCAPlansByOwnerRequest{} }
+func (m *QueryDCAPlansByOwnerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDCAPlansByOwnerRequest) ProtoMessage()    {}
+func (*QueryDCAPlansByOwnerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2e4e806b4add1b6, []int{35}
+}
+func (m *QueryDCAPlansByOwnerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDCAPlansByOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDCAPlansByOwnerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDCAPlansByOwnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDCAPlansByOwnerRequest.Merge(m, src)
+}
+func (m *QueryDCAPlansByOwnerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDCAPlansByOwnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDCAPlansByOwnerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDCAPlansByOwnerRequest proto.InternalMessageInfo
+
+func (m *QueryDCAPlansByOwnerRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryDCAPlansByOwnerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDCAPlansByOwnerResponse is the response type for the Query/DCAPlansByOwner RPC method.
+type QueryDCAPlansByOwnerResponse struct {
+	// plans defines the DCA plans of the account, in the order of their ids.
+	Plans []DCAPlan `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDCAPlansByOwnerResponse) Reset()         { *m = QueryDCAPlansByOwnerResponse{} }
+func (m *QueryDCAPlansByOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDCAPlansByOwnerResponse) ProtoMessage()    {}
+func (*QueryDCAPlansByOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2e4e806b4add1b6, []int{36}
+}
+func (m *QueryDCAPlansByOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDCAPlansByOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDCAPlansByOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDCAPlansByOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDCAPlansByOwnerResponse.Merge(m, src)
+}
+func (m *QueryDCAPlansByOwnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDCAPlansByOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDCAPlansByOwnerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDCAPlansByOwnerResponse proto.InternalMessageInfo
+
+func (m *QueryDCAPlansByOwnerResponse) GetPlans() []DCAPlan {
+	if m != nil {
+		return m.Plans
+	}
+	return nil
+}
+
+func (m *QueryDCAPlansByOwnerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.simpleswap.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.simpleswap.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryPoolRequest)(nil), "cosmos.simpleswap.v1.QueryPoolRequest")
+	proto.RegisterType((*QueryPoolResponse)(nil), "cosmos.simpleswap.v1.QueryPoolResponse")
+	proto.RegisterType((*QueryPoolStatsRequest)(nil), "cosmos.simpleswap.v1.QueryPoolStatsRequest")
+	proto.RegisterType((*QueryPoolStatsResponse)(nil), "cosmos.simpleswap.v1.QueryPoolStatsResponse")
+	proto.RegisterType((*PoolStatsWindow)(nil), "cosmos.simpleswap.v1.PoolStatsWindow")
+	proto.RegisterType((*PairStats)(nil), "cosmos.simpleswap.v1.PairStats")
+	proto.RegisterType((*QueryLiquidityProviderRequest)(nil), "cosmos.simpleswap.v1.QueryLiquidityProviderRequest")
+	proto.RegisterType((*QueryLiquidityProviderResponse)(nil), "cosmos.simpleswap.v1.QueryLiquidityProviderResponse")
+	proto.RegisterType((*QueryPositionRequest)(nil), "cosmos.simpleswap.v1.QueryPositionRequest")
+	proto.RegisterType((*QueryPositionResponse)(nil), "cosmos.simpleswap.v1.QueryPositionResponse")
+	proto.RegisterType((*QueryLiquidityProvidersRequest)(nil), "cosmos.simpleswap.v1.QueryLiquidityProvidersRequest")
+	proto.RegisterType((*QueryLiquidityProvidersResponse)(nil), "cosmos.simpleswap.v1.QueryLiquidityProvidersResponse")
+	proto.RegisterType((*QueryLiquidityProvidersByDenomRequest)(nil), "cosmos.simpleswap.v1.QueryLiquidityProvidersByDenomRequest")
+	proto.RegisterType((*QueryLiquidityProvidersByDenomResponse)(nil), "cosmos.simpleswap.v1.QueryLiquidityProvidersByDenomResponse")
+	proto.RegisterType((*LiquidityProviderEntry)(nil), "cosmos.simpleswap.v1.LiquidityProviderEntry")
+	proto.RegisterType((*QueryCoinReserveRequest)(nil), "cosmos.simpleswap.v1.QueryCoinReserveRequest")
+	proto.RegisterType((*QueryCoinReserveResponse)(nil), "cosmos.simpleswap.v1.QueryCoinReserveResponse")
+	proto.RegisterType((*QueryCoinReservesRequest)(nil), "cosmos.simpleswap.v1.QueryCoinReservesRequest")
+	proto.RegisterType((*QueryCoinReservesResponse)(nil), "cosmos.simpleswap.v1.QueryCoinReservesResponse")
+	proto.RegisterType((*QueryGaugeRequest)(nil), "cosmos.simpleswap.v1.QueryGaugeRequest")
+	proto.RegisterType((*QueryGaugeResponse)(nil), "cosmos.simpleswap.v1.QueryGaugeResponse")
+	proto.RegisterType((*QueryGaugesRequest)(nil), "cosmos.simpleswap.v1.QueryGaugesRequest")
+	proto.RegisterType((*QueryGaugesResponse)(nil), "cosmos.simpleswap.v1.QueryGaugesResponse")
+	proto.RegisterType((*QueryPendingRewardsRequest)(nil), "cosmos.simpleswap.v1.QueryPendingRewardsRequest")
+	proto.RegisterType((*QueryPendingRewardsResponse)(nil), "cosmos.simpleswap.v1.QueryPendingRewardsResponse")
+	proto.RegisterType((*QueryAccountLocksRequest)(nil), "cosmos.simpleswap.v1.QueryAccountLocksRequest")
+	proto.RegisterType((*QueryAccountLocksResponse)(nil), "cosmos.simpleswap.v1.QueryAccountLocksResponse")
+	proto.RegisterType((*QueryAssetRateRequest)(nil), "cosmos.simpleswap.v1.QueryAssetRateRequest")
+	proto.RegisterType((*QueryAssetRateResponse)(nil), "cosmos.simpleswap.v1.QueryAssetRateResponse")
+	proto.RegisterType((*QueryLimitOrdersByOwnerRequest)(nil), "cosmos.simpleswap.v1.QueryLimitOrdersByOwnerRequest")
+	proto.RegisterType((*QueryLimitOrdersByOwnerResponse)(nil), "cosmos.simpleswap.v1.QueryLimitOrdersByOwnerResponse")
+	proto.RegisterType((*QueryLimitOrdersByPairRequest)(nil), "cosmos.simpleswap.v1.QueryLimitOrdersByPairRequest")
+	proto.RegisterType((*QueryLimitOrdersByPairResponse)(nil), "cosmos.simpleswap.v1.QueryLimitOrdersByPairResponse")
+	proto.RegisterType((*QueryDCAPlansByOwnerRequest)(nil), "cosmos.simpleswap.v1.QueryDCAPlansByOwnerRequest")
+	proto.RegisterType((*QueryDCAPlansByOwnerResponse)(nil), "cosmos.simpleswap.v1.QueryDCAPlansByOwnerResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/simpleswap/v1/query.proto", fileDescriptor_a2e4e806b4add1b6) }
+
+var fileDescriptor_a2e4e806b4add1b6 = []byte{
+	// 2058 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xdd, 0x6f, 0x1c, 0x57,
+	0x15, 0xcf, 0xd8, 0xbb, 0x4e, 0x7c, 0xd6, 0x71, 0x9b, 0x1b, 0xc7, 0x5d, 0x4f, 0x9c, 0xb5, 0x3b,
+	0x90, 0x64, 0xf3, 0xe1, 0x1d, 0xdb, 0x8d, 0x9b, 0x96, 0x96, 0x16, 0x7f, 0xc4, 0x21, 0xc5, 0x52,
+	0xcc, 0x46, 0x02, 0x09, 0x21, 0x96, 0xf1, 0xee, 0xcd, 0x66, 0x9a, 0xdd, 0xb9, 0x93, 0x99, 0x59,
+	0xbb, 0xab, 0x95, 0x79, 0xa0, 0xe2, 0x01, 0x21, 0xa1, 0x4a, 0x7c, 0x88, 0x07, 0xc4, 0x1b, 0xa2,
+	0x2a, 0x52, 0x0b, 0x52, 0xde, 0x10, 0xef, 0x7d, 0xe0, 0xa1, 0x6a, 0x91, 0x40, 0x3c, 0x14, 0x94,
+	0x20, 0xf1, 0x5f, 0x20, 0x74, 0xef, 0x3d, 0x33, 0x3b, 0xb3, 0x3b, 0xb3, 0x9e, 0x5d, 0x4c, 0xc4,
+	0x4b, 0xe2, 0xb9, 0x73, 0x7e, 0xe7, 0xfc, 0xee, 0xb9, 0x67, 0xce, 0xc7, 0x5d, 0x58, 0xac, 0x32,
+	0xb7, 0xc9, 0x5c, 0xdd, 0x35, 0x9b, 0x76, 0x83, 0xba, 0x07, 0x86, 0xad, 0xef, 0xaf, 0xe8, 0x8f,
+	0x5a, 0xd4, 0x69, 0x97, 0x6c, 0x87, 0x79, 0x8c, 0xcc, 0x48, 0x89, 0x52, 0x57, 0xa2, 0xb4, 0xbf,
+	0xa2, 0x16, 0x10, 0xb7, 0x67, 0xb8, 0x54, 0xdf, 0x5f, 0xd9, 0xa3, 0x9e, 0xb1, 0xa2, 0x57, 0x99,
+	0x69, 0x49, 0x94, 0x7a, 0x35, 0xfc, 0x5e, 0xa8, 0x0b, 0xa4, 0x6c, 0xa3, 0x6e, 0x5a, 0x86, 0x67,
+	0x32, 0x5f, 0x36, 0x9e, 0x83, 0xd7, 0xb6, 0xa9, 0x8b, 0x12, 0xf3, 0x75, 0xc6, 0xea, 0x0d, 0xaa,
+	0x1b, 0xb6, 0xa9, 0x1b, 0x96, 0xc5, 0x3c, 0x01, 0xf7, 0xdf, 0x9e, 0x47, 0xbc, 0x6f, 0x26, 0x4c,
+	0x5f, 0x3d, 0x63, 0x34, 0x4d, 0x8b, 0xe9, 0xe2, 0x5f, 0x5c, 0x9a, 0xa9, 0xb3, 0x3a, 0x13, 0x7f,
+	0xea, 0xfc, 0x2f, 0x5c, 0x9d, 0x93, 0x5a, 0x2a, 0xf2, 0x05, 0x6e, 0x5a, 0x3c, 0x68, 0x33, 0x40,
+	0xbe, 0xce, 0x55, 0xee, 0x1a, 0x8e, 0xd1, 0x74, 0xcb, 0xf4, 0x51, 0x8b, 0xba, 0x9e, 0xf6, 0x0d,
+	0x38, 0x1b, 0x59, 0x75, 0x6d, 0x66, 0xb9, 0x94, 0xbc, 0x09, 0x13, 0xb6, 0x58, 0xc9, 0x2b, 0x8b,
+	0x4a, 0x31, 0xb7, 0x3a, 0x5f, 0x8a, 0x73, 0x60, 0x49, 0xa2, 0x36, 0x26, 0x3f, 0xfe, 0x7c, 0xe1,
+	0xc4, 0xfb, 0xff, 0xfa, 0xdd, 0x55, 0xa5, 0x8c, 0x30, 0x8d, 0xc0, 0xf3, 0x52, 0x2f, 0x63, 0x0d,
+	0xdf, 0xd6, 0x4f, 0x15, 0x38, 0x13, 0x5a, 0x44, 0x53, 0xaf, 0x42, 0xc6, 0x66, 0xac, 0x81, 0x86,
+	0xd4, 0x04, 0x43, 0x8c, 0x35, 0xc2, 0x66, 0x04, 0x84, 0x6c, 0xc1, 0x84, 0xe1, 0xba, 0xd4, 0x73,
+	0xf3, 0x63, 0x8b, 0xe3, 0xc5, 0xdc, 0xea, 0x8b, 0xf1, 0xe0, 0x2d, 0x6a, 0xb1, 0xe6, 0x5d, 0xc7,
+	0xac, 0x9b, 0x56, 0x84, 0xaa, 0xc4, 0x6a, 0x2f, 0xc0, 0xb9, 0x80, 0xd5, 0x3d, 0xcf, 0xf0, 0x02,
+	0xdf, 0x7c, 0xa0, 0xc0, 0x6c, 0xef, 0x1b, 0x24, 0xbd, 0x0d, 0xd9, 0x9a, 0x61, 0x36, 0xda, 0xc8,
+	0xfa, 0x62, 0x32, 0x6b, 0x81, 0xfb, 0xa6, 0x69, 0xd5, 0xd8, 0x41, 0xd8, 0xb8, 0x84, 0x93, 0xaf,
+	0xc2, 0xc4, 0x01, 0xa5, 0x0f, 0x1b, 0xed, 0xfc, 0xd8, 0x88, 0x8a, 0x10, 0xaf, 0x7d, 0x36, 0x06,
+	0xcf, 0xf5, 0x88, 0x71, 0xed, 0xfb, 0xac, 0xd1, 0x6a, 0x52, 0x41, 0x73, 0x72, 0x63, 0x99, 0xc3,
+	0xfe, 0xf6, 0xf9, 0xc2, 0x39, 0x69, 0xc4, 0xad, 0x3d, 0x2c, 0x99, 0x4c, 0x6f, 0x1a, 0xde, 0x83,
+	0xd2, 0x1d, 0xcb, 0xfb, 0xf4, 0xf1, 0x12, 0xa0, 0xf5, 0x3b, 0x96, 0x87, 0xda, 0x25, 0x9e, 0x6c,
+	0x41, 0xe6, 0x3e, 0xa5, 0x6e, 0x7e, 0x6c, 0x44, 0x3d, 0x02, 0x4d, 0x2e, 0x00, 0xf0, 0x1d, 0x55,
+	0xaa, 0xac, 0x65, 0x79, 0xf9, 0xf1, 0x45, 0xa5, 0x98, 0x29, 0x4f, 0xf2, 0x95, 0x4d, 0xbe, 0x40,
+	0xee, 0xc2, 0xc9, 0xfb, 0x94, 0x56, 0x0c, 0xdb, 0xc9, 0x67, 0x84, 0x9d, 0x97, 0xd1, 0xce, 0xf9,
+	0x7e, 0x3b, 0x3b, 0xb4, 0x6e, 0x54, 0xdb, 0x5b, 0xb4, 0x1a, 0xb2, 0xb6, 0x45, 0xab, 0xc8, 0xfa,
+	0x3e, 0xa5, 0xeb, 0xb6, 0x43, 0xbe, 0x02, 0x59, 0xdb, 0x30, 0x1d, 0x37, 0x9f, 0x15, 0xe1, 0xb1,
+	0x90, 0x14, 0xc4, 0xa6, 0x23, 0xbc, 0x16, 0x39, 0x1f, 0x01, 0xd4, 0xfe, 0xad, 0xc0, 0x64, 0xf0,
+	0x9e, 0x2c, 0x40, 0xce, 0xb4, 0xec, 0x96, 0x57, 0xa9, 0xf1, 0x88, 0x92, 0x4e, 0x2d, 0x83, 0x58,
+	0x12, 0x31, 0x46, 0x5e, 0x84, 0x29, 0xd6, 0xf2, 0xba, 0x12, 0xc2, 0x5d, 0xe5, 0x9c, 0x5c, 0x93,
+	0x22, 0xdd, 0x33, 0x19, 0x3f, 0xa6, 0x33, 0xc9, 0x1c, 0xe3, 0x99, 0x64, 0x7b, 0xce, 0x44, 0x7b,
+	0x03, 0x2e, 0x88, 0x4f, 0x60, 0xc7, 0x7c, 0xd4, 0x32, 0x6b, 0xa6, 0xd7, 0xde, 0x75, 0xd8, 0xbe,
+	0x59, 0xa3, 0x0e, 0x7e, 0x24, 0x1c, 0xdf, 0xb0, 0x2b, 0x46, 0xad, 0xe6, 0x50, 0xd7, 0x45, 0x97,
+	0x4c, 0x36, 0xec, 0x75, 0xb9, 0xa0, 0xbd, 0xab, 0x40, 0x21, 0x49, 0x01, 0x7e, 0x4b, 0x06, 0x90,
+	0x86, 0xff, 0xb2, 0x62, 0xe3, 0x5b, 0xfc, 0xb0, 0x2e, 0xc7, 0x1f, 0x59, 0x9f, 0xb2, 0xf0, 0xd1,
+	0x9d, 0x69, 0xf4, 0xbe, 0xd5, 0xde, 0x82, 0x19, 0xfc, 0x90, 0x5d, 0x93, 0x27, 0x5d, 0x9f, 0xfc,
+	0x2a, 0x9c, 0x8c, 0x30, 0xdf, 0xc8, 0x7f, 0xfa, 0x78, 0xc9, 0xaf, 0x15, 0xb8, 0x85, 0x7b, 0x9e,
+	0x63, 0x5a, 0xf5, 0xb2, 0x2f, 0xa8, 0xfd, 0x3c, 0x03, 0xe7, 0x7a, 0x94, 0x3d, 0xb3, 0x8d, 0x90,
+	0xdb, 0x30, 0x65, 0x53, 0xab, 0x66, 0x5a, 0xf5, 0x4a, 0xf0, 0x3d, 0xe6, 0x56, 0xe7, 0x7c, 0xe5,
+	0xbc, 0x50, 0x95, 0xb0, 0x44, 0x95, 0x36, 0x59, 0x34, 0xdf, 0xe5, 0x10, 0xb9, 0xcd, 0x8f, 0xfd,
+	0x6d, 0x38, 0xc7, 0x53, 0x68, 0xc5, 0x7d, 0x60, 0x38, 0xb4, 0x62, 0x53, 0xa7, 0x4a, 0x2d, 0xcf,
+	0xa8, 0xfb, 0x51, 0x39, 0xea, 0x97, 0x77, 0x96, 0x2b, 0xbd, 0xc7, 0x75, 0xee, 0x06, 0x2a, 0xc9,
+	0x01, 0x4c, 0x3b, 0xd4, 0xa5, 0xce, 0x3e, 0x95, 0xe6, 0x78, 0xc8, 0x8e, 0x0f, 0xa6, 0xbd, 0xc6,
+	0xed, 0x7f, 0xf0, 0xf7, 0x85, 0x62, 0xdd, 0xf4, 0x1e, 0xb4, 0xf6, 0x4a, 0x55, 0xd6, 0xc4, 0x6a,
+	0x86, 0xff, 0x2d, 0xb9, 0xb5, 0x87, 0x58, 0x5d, 0x39, 0xc0, 0x95, 0xe6, 0x4f, 0xa3, 0x1d, 0xc1,
+	0xc0, 0x25, 0xb7, 0x20, 0x47, 0xdf, 0x31, 0xbd, 0x8a, 0x6d, 0xb4, 0x59, 0x4b, 0x06, 0x77, 0x5a,
+	0x67, 0x01, 0x07, 0xee, 0x0a, 0x1c, 0xb9, 0x08, 0xd3, 0x42, 0x8d, 0xb1, 0x6f, 0x98, 0x0d, 0x63,
+	0xaf, 0x41, 0xf3, 0x13, 0x8b, 0x4a, 0xf1, 0x54, 0xf9, 0x34, 0x5f, 0x5d, 0xf7, 0x17, 0xb5, 0xef,
+	0x25, 0x45, 0xba, 0x5f, 0x50, 0xc8, 0x0c, 0x64, 0xc3, 0x99, 0x43, 0x3e, 0x90, 0x6d, 0x80, 0x6e,
+	0x37, 0x81, 0x27, 0x7a, 0x29, 0x42, 0x52, 0xb6, 0x02, 0x3e, 0xd5, 0x5d, 0xa3, 0x4e, 0x51, 0x63,
+	0x39, 0x84, 0xd4, 0xfe, 0xac, 0xc0, 0x42, 0x22, 0x01, 0x0c, 0xd1, 0x07, 0x70, 0xb6, 0x3f, 0x44,
+	0x79, 0xf0, 0xf3, 0xf3, 0xb8, 0x9e, 0x32, 0x46, 0x6f, 0x59, 0x9e, 0xd3, 0x0e, 0x3b, 0x8b, 0xf4,
+	0x05, 0xaa, 0x4b, 0x6e, 0xc7, 0xec, 0xea, 0xf2, 0x91, 0xbb, 0x92, 0x34, 0x23, 0xdb, 0xfa, 0x81,
+	0x02, 0x17, 0x13, 0xb6, 0xb5, 0xd1, 0x16, 0x39, 0xf5, 0xd9, 0xb8, 0xf7, 0xfd, 0x31, 0xb8, 0x74,
+	0x14, 0x8f, 0x67, 0xee, 0xe5, 0xaf, 0xc1, 0xb4, 0xc7, 0x3c, 0xa3, 0x51, 0xa1, 0xef, 0xd8, 0xcc,
+	0x6d, 0x39, 0x74, 0xa8, 0x8c, 0x70, 0x5a, 0x60, 0x6f, 0x21, 0xb4, 0xe7, 0xc8, 0xc6, 0x47, 0x3f,
+	0xb2, 0xc7, 0x63, 0x30, 0x1b, 0xbf, 0x9f, 0x51, 0x32, 0x6e, 0x42, 0x5e, 0x1d, 0x3b, 0xce, 0xbc,
+	0xfa, 0x5d, 0x78, 0x3e, 0x94, 0x0e, 0x1d, 0xbe, 0x8d, 0xff, 0x32, 0x13, 0x4e, 0x07, 0x99, 0xb0,
+	0xcc, 0xb5, 0xf1, 0xd6, 0x20, 0x92, 0xb9, 0x79, 0xd5, 0x1e, 0x8f, 0xe4, 0x64, 0xed, 0x15, 0x78,
+	0x41, 0x04, 0x18, 0x3f, 0xa6, 0xb2, 0x4c, 0x64, 0xa1, 0x2a, 0xcb, 0xe7, 0x92, 0x48, 0xe3, 0x31,
+	0xc9, 0x57, 0x44, 0xe0, 0x69, 0x1d, 0xc8, 0xf7, 0x23, 0x31, 0x18, 0x6f, 0xc3, 0x94, 0x80, 0x62,
+	0x6a, 0xcc, 0x2b, 0x43, 0x04, 0x48, 0xae, 0xda, 0x55, 0x48, 0x66, 0x61, 0x82, 0x89, 0x26, 0x1a,
+	0xdb, 0x1a, 0x7c, 0xd2, 0xd4, 0x7e, 0xe3, 0x41, 0x0b, 0xfd, 0xa1, 0x02, 0x73, 0x31, 0x2f, 0x91,
+	0xda, 0x1d, 0x38, 0x1d, 0xa6, 0xe6, 0x7f, 0x21, 0xe9, 0xb8, 0x4d, 0x85, 0xb8, 0xb9, 0x64, 0x1b,
+	0x4e, 0x4a, 0x3a, 0xa3, 0xcd, 0x02, 0x3e, 0x58, 0x2b, 0xe1, 0x88, 0x72, 0xdb, 0x68, 0x05, 0x69,
+	0x80, 0xcc, 0xc1, 0xa9, 0x3a, 0x7f, 0xae, 0x98, 0x35, 0xe1, 0xbe, 0x4c, 0xf9, 0xa4, 0x78, 0xbe,
+	0x53, 0xd3, 0xca, 0x40, 0xc2, 0xf2, 0xb8, 0xb1, 0xd7, 0x21, 0x2b, 0x04, 0xd0, 0xd9, 0xe7, 0xe3,
+	0xb9, 0x08, 0x4c, 0xa4, 0xe9, 0x14, 0x20, 0xed, 0xdb, 0x61, 0x9d, 0x41, 0xf1, 0x88, 0xe6, 0x31,
+	0x65, 0xe4, 0x3c, 0xf6, 0x2b, 0x05, 0xce, 0x46, 0xd4, 0x23, 0xe7, 0x37, 0x60, 0x42, 0x98, 0xf7,
+	0x4f, 0x21, 0x2d, 0x69, 0x44, 0x1d, 0x5f, 0xc2, 0x7f, 0x0d, 0x54, 0xd9, 0x5f, 0xc9, 0x4f, 0xa3,
+	0x4c, 0x0f, 0x0c, 0xa7, 0xe6, 0xa6, 0xec, 0x37, 0x7f, 0xa8, 0xc0, 0xf9, 0x58, 0x34, 0xee, 0xf2,
+	0x6d, 0x38, 0xe9, 0xc8, 0xa5, 0xbc, 0xf2, 0x3f, 0x6a, 0x42, 0x7c, 0x03, 0xda, 0x32, 0x7e, 0x18,
+	0xeb, 0x55, 0xd1, 0x5c, 0xef, 0xb0, 0xea, 0xc3, 0x70, 0x2b, 0xc0, 0x0e, 0x2c, 0x6c, 0x0f, 0x27,
+	0xcb, 0xf2, 0x41, 0xfb, 0x0e, 0xcc, 0xc5, 0x20, 0x90, 0xfa, 0x3a, 0x64, 0x1b, 0x7c, 0x01, 0x89,
+	0x2f, 0x26, 0x4c, 0x33, 0xd4, 0x31, 0x59, 0x8d, 0x23, 0x23, 0x91, 0x25, 0x90, 0xda, 0x12, 0xb6,
+	0xae, 0xeb, 0xae, 0x4b, 0xbd, 0xb2, 0xe1, 0xd1, 0x81, 0xa5, 0x53, 0xab, 0xc1, 0x6c, 0xaf, 0x38,
+	0x72, 0x79, 0x0b, 0x32, 0x8e, 0xe1, 0xf9, 0x73, 0xe5, 0xa8, 0x39, 0x52, 0xe8, 0x08, 0xf5, 0x4d,
+	0x4d, 0xd3, 0xbb, 0xeb, 0xc8, 0x82, 0x7a, 0x97, 0xfb, 0x63, 0xa0, 0xb3, 0x8e, 0xad, 0xb0, 0x7f,
+	0xd4, 0xed, 0x9b, 0xfa, 0x09, 0xe0, 0x7e, 0x37, 0x79, 0xee, 0x0b, 0x15, 0xf1, 0xc5, 0xa4, 0xb2,
+	0xe3, 0x6b, 0x88, 0x7c, 0x21, 0x12, 0x7a, 0x7c, 0x5f, 0xc8, 0x6f, 0x95, 0x60, 0x2a, 0x0b, 0x31,
+	0xe6, 0x73, 0xaa, 0xef, 0xb1, 0xe3, 0x98, 0x54, 0xb7, 0x63, 0xda, 0x81, 0x51, 0xfc, 0xfb, 0xa1,
+	0x02, 0x85, 0x24, 0xb6, 0xff, 0x97, 0xee, 0xed, 0x60, 0x0a, 0xd9, 0xda, 0x5c, 0xdf, 0x6d, 0x18,
+	0xd6, 0xb3, 0x8d, 0xc6, 0xdf, 0x28, 0x30, 0x1f, 0x6f, 0x3d, 0xc8, 0xd3, 0x59, 0x9b, 0xaf, 0xa3,
+	0xab, 0x2e, 0x24, 0xd4, 0x39, 0x89, 0x8e, 0x5e, 0x69, 0x70, 0xd8, 0xb1, 0xb9, 0x69, 0xf5, 0xc7,
+	0xb3, 0x90, 0x15, 0x4c, 0xc9, 0xbb, 0x0a, 0x4c, 0xc8, 0xab, 0x40, 0x52, 0x8c, 0xa7, 0xd3, 0x7f,
+	0xf3, 0xa8, 0x5e, 0x49, 0x21, 0x29, 0xad, 0x6a, 0x5f, 0xfc, 0xfe, 0x67, 0xff, 0xfc, 0xc9, 0x58,
+	0x81, 0xcc, 0xeb, 0xb1, 0x97, 0xac, 0xf2, 0xca, 0x91, 0x74, 0x20, 0xc3, 0x2f, 0xc0, 0xc8, 0xa5,
+	0x41, 0x8a, 0xbb, 0xd7, 0x91, 0xea, 0xe5, 0x23, 0xe5, 0xd0, 0xbc, 0x26, 0xcc, 0xcf, 0x13, 0x35,
+	0xc1, 0x3c, 0x37, 0xfa, 0x1e, 0xbf, 0x28, 0xf2, 0xaf, 0xdf, 0xc8, 0xb5, 0x23, 0x54, 0x87, 0xaf,
+	0x19, 0xd5, 0xeb, 0xe9, 0x84, 0x91, 0x4c, 0x51, 0x90, 0xd1, 0xc8, 0x62, 0x32, 0x19, 0xdd, 0x15,
+	0x24, 0xfe, 0xa8, 0xc0, 0x99, 0xbe, 0x3e, 0x98, 0xbc, 0x34, 0xc0, 0x5a, 0xd2, 0x25, 0x8f, 0x7a,
+	0x63, 0x38, 0x10, 0x52, 0xfd, 0xb2, 0xa0, 0x7a, 0x93, 0xac, 0xc5, 0x53, 0xed, 0xef, 0xe9, 0xf5,
+	0x4e, 0xb7, 0xb4, 0x1f, 0x92, 0x9f, 0x29, 0x70, 0xca, 0xbf, 0x63, 0x21, 0x57, 0x07, 0x3a, 0x29,
+	0x72, 0xab, 0xa3, 0x5e, 0x4b, 0x25, 0x8b, 0x24, 0x97, 0x05, 0xc9, 0xab, 0xa4, 0x98, 0xe4, 0x4f,
+	0x29, 0xaf, 0x77, 0x02, 0x5e, 0x8f, 0x15, 0x20, 0x3b, 0xfd, 0xa3, 0xd8, 0x50, 0x3e, 0x0a, 0x0e,
+	0x7f, 0x6d, 0x48, 0x14, 0xb2, 0x5e, 0x11, 0xac, 0xaf, 0x91, 0x2b, 0x69, 0x5d, 0xeb, 0x92, 0xbf,
+	0x28, 0x30, 0x97, 0x38, 0xba, 0x92, 0xd7, 0x86, 0xe2, 0x11, 0x1d, 0xbc, 0xd5, 0xd7, 0x47, 0x03,
+	0xe3, 0x5e, 0xde, 0x14, 0x7b, 0x79, 0x95, 0xdc, 0x4c, 0xbd, 0x17, 0x5d, 0xd4, 0x2c, 0xbd, 0x23,
+	0xfe, 0x3b, 0x24, 0xbf, 0x56, 0x20, 0x17, 0x9a, 0x2f, 0xc8, 0xd2, 0x00, 0x3a, 0xfd, 0xb3, 0x95,
+	0x5a, 0x4a, 0x2b, 0x8e, 0x7c, 0x6f, 0x0a, 0xbe, 0x2b, 0x44, 0x8f, 0xe7, 0x1b, 0x9e, 0x68, 0xf4,
+	0x4e, 0x77, 0x6a, 0x3b, 0x24, 0xbf, 0x54, 0x60, 0x6a, 0x33, 0x3c, 0xb4, 0xa4, 0xb4, 0x1c, 0x04,
+	0x8b, 0x9e, 0x5a, 0x1e, 0xa9, 0x5e, 0x13, 0x54, 0x2f, 0x92, 0x2f, 0x1c, 0x4d, 0xd5, 0x25, 0x3f,
+	0x52, 0x20, 0x2b, 0xba, 0x7b, 0x32, 0x28, 0x33, 0x86, 0x07, 0x23, 0xb5, 0x78, 0xb4, 0x20, 0x32,
+	0xd1, 0x05, 0x93, 0x2b, 0xe4, 0x72, 0x3c, 0x13, 0x39, 0x43, 0xe8, 0x1d, 0x7f, 0xcc, 0x3a, 0x14,
+	0x35, 0x45, 0xa8, 0x18, 0x5c, 0x53, 0x22, 0x33, 0x92, 0x7a, 0x25, 0x85, 0x64, 0xba, 0x9a, 0x82,
+	0x43, 0xcd, 0xef, 0x15, 0x98, 0x8e, 0x4e, 0x12, 0x64, 0x79, 0x50, 0x76, 0x89, 0x1b, 0x59, 0xd4,
+	0x95, 0x21, 0x10, 0xc8, 0xee, 0x4b, 0x82, 0xdd, 0x0d, 0xb2, 0x9a, 0x90, 0x95, 0xf0, 0x26, 0x01,
+	0x27, 0x8d, 0x68, 0xde, 0xe4, 0x61, 0x16, 0x1e, 0x20, 0x06, 0x86, 0x59, 0xcc, 0x6c, 0xa2, 0xea,
+	0xa9, 0xe5, 0xd3, 0x85, 0x99, 0x98, 0x3d, 0xf4, 0x8e, 0xe8, 0x93, 0x0e, 0xc9, 0x2f, 0x14, 0x98,
+	0x0c, 0x06, 0x8a, 0x81, 0x95, 0xb2, 0x77, 0x4a, 0x51, 0xaf, 0xa7, 0x13, 0x4e, 0x97, 0xd9, 0xc5,
+	0xaf, 0x7f, 0xfc, 0xaa, 0x87, 0x06, 0x89, 0xe4, 0x0f, 0x22, 0xb3, 0xf7, 0x0e, 0x01, 0x47, 0x64,
+	0xf6, 0x84, 0xa1, 0x45, 0x5d, 0x1b, 0x12, 0x85, 0xac, 0x5f, 0x11, 0xac, 0x57, 0xc9, 0x72, 0x52,
+	0x36, 0x6c, 0x9a, 0x5e, 0x45, 0x76, 0xbc, 0xba, 0xf0, 0x68, 0xe0, 0xd8, 0x3f, 0x89, 0x7a, 0xdf,
+	0xd3, 0x62, 0x1f, 0x51, 0xef, 0xe3, 0xc7, 0x07, 0xf5, 0xc6, 0x70, 0x20, 0xa4, 0xbe, 0x23, 0xa8,
+	0x6f, 0x93, 0xad, 0x14, 0xd4, 0xf9, 0xcf, 0x6b, 0x7a, 0x27, 0x34, 0xa3, 0x1c, 0xea, 0x9d, 0xf0,
+	0x40, 0x72, 0x48, 0x3e, 0x52, 0xe0, 0xb9, 0x9e, 0x1e, 0x98, 0x0c, 0xfa, 0x92, 0xe2, 0xbb, 0x75,
+	0x75, 0x75, 0x18, 0x08, 0x6e, 0x64, 0x4d, 0x6c, 0x44, 0x27, 0x4b, 0xf1, 0x1b, 0xa9, 0x55, 0x8d,
+	0x8a, 0xe8, 0xa5, 0xa3, 0x07, 0xb0, 0xf1, 0xf2, 0xc7, 0x4f, 0x0a, 0xca, 0x27, 0x4f, 0x0a, 0xca,
+	0x3f, 0x9e, 0x14, 0x94, 0xf7, 0x9e, 0x16, 0x4e, 0x7c, 0xf2, 0xb4, 0x70, 0xe2, 0xaf, 0x4f, 0x0b,
+	0x27, 0xbe, 0x35, 0xdf, 0x7f, 0x83, 0xd0, 0x55, 0xb9, 0x37, 0x21, 0x7e, 0xa0, 0x7f, 0xe9, 0x3f,
+	0x03, 0x00, 0x9d, 0xb8, 0x24, 0x4e, 0xc7, 0x20, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns the module parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// GetPool returns the pool information.
+	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
+	// PoolStats returns the volume, the fees and the number of the swaps of the pool over the last
+	// 24 hours and 7 days, with the fee APR they earn the liquidity providers.
+	PoolStats(ctx context.Context, in *QueryPoolStatsRequest, opts ...grpc.CallOption) (*QueryPoolStatsResponse, error)
+	// GetLiquidityProvider returns the liquidity provider information.
+	LiquidityProvider(ctx context.Context, in *QueryLiquidityProviderRequest, opts ...grpc.CallOption) (*QueryLiquidityProviderResponse, error)
+	// Position returns the valuation of the position of a liquidity provider: its pending fees, its
+	// share of the pool and of each reserve, and the payout of a full exit.
+	Position(ctx context.Context, in *QueryPositionRequest, opts ...grpc.CallOption) (*QueryPositionResponse, error)
+	// LiquidityProviders returns the liquidity providers with their share of the pool and their
+	// pending fees, optionally filtered by the denom of their stable coin.
+	LiquidityProviders(ctx context.Context, in *QueryLiquidityProvidersRequest, opts ...grpc.CallOption) (*QueryLiquidityProvidersResponse, error)
+	// LiquidityProvidersByDenom returns the liquidity providers of a stable coin, found by the denom
+	// index, and the total amount of the stable coin they provided.
+	LiquidityProvidersByDenom(ctx context.Context, in *QueryLiquidityProvidersByDenomRequest, opts ...grpc.CallOption) (*QueryLiquidityProvidersByDenomResponse, error)
+	// GetCoinReserve returns the coin reserve information for a given coin denom.
+	CoinReserve(ctx context.Context, in *QueryCoinReserveRequest, opts ...grpc.CallOption) (*QueryCoinReserveResponse, error)
+	// GetCoinReserves returns the coin reserves information for all coin denoms.
+	CoinReserves(ctx context.Context, in *QueryCoinReservesRequest, opts ...grpc.CallOption) (*QueryCoinReservesResponse, error)
+	// Gauge returns the gauge information for a given gauge id.
+	Gauge(ctx context.Context, in *QueryGaugeRequest, opts ...grpc.CallOption) (*QueryGaugeResponse, error)
+	// Gauges returns all the gauges.
+	Gauges(ctx context.Context, in *QueryGaugesRequest, opts ...grpc.CallOption) (*QueryGaugesResponse, error)
+	// PendingRewards returns the gauge rewards earned by the share locks of an owner and not yet claimed.
+	PendingRewards(ctx context.Context, in *QueryPendingRewardsRequest, opts ...grpc.CallOption) (*QueryPendingRewardsResponse, error)
+	// AccountLocks returns the share locks of an account.
+	AccountLocks(ctx context.Context, in *QueryAccountLocksRequest, opts ...grpc.CallOption) (*QueryAccountLocksResponse, error)
+	// AssetRate returns the redemption rate of a whitelisted coin from its rate provider.
+	AssetRate(ctx context.Context, in *QueryAssetRateRequest, opts ...grpc.CallOption) (*QueryAssetRateResponse, error)
+	// LimitOrdersByOwner returns the open limit orders of an account.
+	LimitOrdersByOwner(ctx context.Context, in *QueryLimitOrdersByOwnerRequest, opts ...grpc.CallOption) (*QueryLimitOrdersByOwnerResponse, error)
+	// LimitOrdersByPair returns the open limit orders swapping the input denom for the output denom.
+	LimitOrdersByPair(ctx context.Context, in *QueryLimitOrdersByPairRequest, opts ...grpc.CallOption) (*QueryLimitOrdersByPairResponse, error)
+	// DCAPlansByOwner returns the DCA plans of an account.
+	DCAPlansByOwner(ctx context.Context, in *QueryDCAPlansByOwnerRequest, opts ...grpc.CallOption) (*QueryDCAPlansByOwnerResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.simpleswap.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error) {
+	out := new(QueryPoolResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.simpleswap.v1.Query/Pool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoolStats(ctx context.Context, in *QueryPoolStatsRequest, opts ...grpc.CallOption) (*QueryPoolStatsResponse, error) {
+	out := new(QueryPoolStatsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.simpleswap.v1.Query/PoolStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LiquidityProvider(ctx context.Context, in *QueryLiquidityProviderRequest, opts ...grpc.CallOption) (*QueryLiquidityProviderResponse, error) {
+	out := new(QueryLiquidityProviderResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.simpleswap.v1.Query/LiquidityProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Position(ctx context.Context, in *QueryPositionRequest, opts ...grpc.CallOption) (*QueryPositionResponse, error) {
+	out := new(QueryPositionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.simpleswap.v1.Query/Position", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LiquidityProviders(ctx context.Context, in *QueryLiquidityProvidersRequest, opts ...grpc.CallOption) (*QueryLiquidityProvidersResponse, error) {
+	out := new(QueryLiquidityProvidersResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.simpleswap.v1.Query/LiquidityProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LiquidityProvidersByDenom(ctx context.Context, in *QueryLiquidityProvidersByDenomRequest, opts ...grpc.CallOption) (*QueryLiquidityProvidersByDenomResponse, error) {
+	out := new(QueryLiquidityProvidersByDenomResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.simpleswap.v1.Query/LiquidityProvidersByDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CoinReserve(ctx context.Context, in *QueryCoinReserveRequest, opts ...grpc.CallOption) (*QueryCoinReserveResponse, error) {
+	out := new(QueryCoinReserveResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.simpleswap.v1.Query/CoinReserve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CoinReserves(ctx context.Context, in *QueryCoinReservesRequest, opts ...grpc.CallOption) (*QueryCoinReservesResponse, error) {
+	out := new(QueryCoinReservesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.simpleswap.v1.Query/CoinReserves", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Gauge(ctx context.Context, in *QueryGaugeRequest, opts ...grpc.CallOption) (*QueryGaugeResponse, error) {
+	out := new(QueryGaugeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.simpleswap.v1.Query/Gauge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Gauges(ctx context.Context, in *QueryGaugesRequest, opts ...grpc.CallOption) (*QueryGaugesResponse, error) {
+	out := new(QueryGaugesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.simpleswap.v1.Query/Gauges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingRewards(ctx context.Context, in *QueryPendingRewardsRequest, opts ...grpc.CallOption) (*QueryPendingRewardsResponse, error) {
+	out := new(QueryPendingRewardsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.simpleswap.v1.Query/PendingRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountLocks(ctx context.Context, in *QueryAccountLocksRequest, opts ...grpc.CallOption) (*QueryAccountLocksResponse, error) {
+	out := new(QueryAccountLocksResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.simpleswap.v1.Query/AccountLocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AssetRate(ctx context.Context, in *QueryAssetRateRequest, opts ...grpc.CallOption) (*QueryAssetRateResponse, error) {
+	out := new(QueryAssetRateResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.simpleswap.v1.Query/AssetRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LimitOrdersByOwner(ctx context.Context, in *QueryLimitOrdersByOwnerRequest, opts ...grpc.CallOption) (*QueryLimitOrdersByOwnerResponse, error) {
+	out := new(QueryLimitOrdersByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.simpleswap.v1.Query/LimitOrdersByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LimitOrdersByPair(ctx context.Context, in *QueryLimitOrdersByPairRequest, opts ...grpc.CallOption) (*QueryLimitOrdersByPairResponse, error) {
+	out := new(QueryLimitOrdersByPairResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.simpleswap.v1.Query/LimitOrdersByPair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DCAPlansByOwner(ctx context.Context, in *QueryDCAPlansByOwnerRequest, opts ...grpc.CallOption) (*QueryDCAPlansByOwnerResponse, error) {
+	out := new(QueryDCAPlansByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.simpleswap.v1.Query/DCAPlansByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns the module parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// GetPool returns the pool information.
+	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
+	// PoolStats returns the volume, the fees and the number of the swaps of the pool over the last
+	// 24 hours and 7 days, with the fee APR they earn the liquidity providers.
+	PoolStats(context.Context, *QueryPoolStatsRequest) (*QueryPoolStatsResponse, error)
+	// GetLiquidityProvider returns the liquidity provider information.
+	LiquidityProvider(context.Context, *QueryLiquidityProviderRequest) (*QueryLiquidityProviderResponse, error)
+	// Position returns the valuation of the position of a liquidity provider: its pending fees, its
+	// share of the pool and of each reserve, and the payout of a full exit.
+	Position(context.Context, *QueryPositionRequest) (*QueryPositionResponse, error)
+	// LiquidityProviders returns the liquidity providers with their share of the pool and their
+	// pending fees, optionally filtered by the denom of their stable coin.
+	LiquidityProviders(context.Context, *QueryLiquidityProvidersRequest) (*QueryLiquidityProvidersResponse, error)
+	// LiquidityProvidersByDenom returns the liquidity providers of a stable coin, found by the denom
+	// index, and the total amount of the stable coin they provided.
+	LiquidityProvidersByDenom(context.Context, *QueryLiquidityProvidersByDenomRequest) (*QueryLiquidityProvidersByDenomResponse, error)
+	// GetCoinReserve returns the coin reserve information for a given coin denom.
+	CoinReserve(context.Context, *QueryCoinReserveRequest) (*QueryCoinReserveResponse, error)
+	// GetCoinReserves returns the coin reserves information for all coin denoms.
+	CoinReserves(context.Context, *QueryCoinReservesRequest) (*QueryCoinReservesResponse, error)
+	// Gauge returns the gauge information for a given gauge id.
+	Gauge(context.Context, *QueryGaugeRequest) (*QueryGaugeResponse, error)
+	// Gauges returns all the gauges.
+	Gauges(context.Context, *QueryGaugesRequest) (*QueryGaugesResponse, error)
+	// PendingRewards returns the gauge rewards earned by the share locks of an owner and not yet claimed.
+	PendingRewards(context.Context, *QueryPendingRewardsRequest) (*QueryPendingRewardsResponse, error)
+	// AccountLocks returns the share locks of an account.
+	AccountLocks(context.Context, *QueryAccountLocksRequest) (*QueryAccountLocksResponse, error)
+	// AssetRate returns the redemption rate of a whitelisted coin from its rate provider.
+	AssetRate(context.Context, *QueryAssetRateRequest) (*QueryAssetRateResponse, error)
+	// LimitOrdersByOwner returns the open limit orders of an account.
+	LimitOrdersByOwner(context.Context, *QueryLimitOrdersByOwnerRequest) (*QueryLimitOrdersByOwnerResponse, error)
+	// LimitOrdersByPair returns the open limit orders swapping the input denom for the output denom.
+	LimitOrdersByPair(context.Context, *QueryLimitOrdersByPairRequest) (*QueryLimitOrdersByPairResponse, error)
+	// DCAPlansByOwner returns the DCA plans of an account.
+	DCAPlansByOwner(context.Context, *QueryDCAPlansByOwnerRequest) (*QueryDCAPlansByOwnerResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryPoolRequest) (*QueryPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
+}
+func (*UnimplementedQueryServer) PoolStats(ctx context.Context, req *QueryPoolStatsRequest) (*QueryPoolStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolStats not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityProvider(ctx context.Context, req *QueryLiquidityProviderRequest) (*QueryLiquidityProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityProvider not implemented")
+}
+func (*UnimplementedQueryServer) Position(ctx context.Context, req *QueryPositionRequest) (*QueryPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Position not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityProviders(ctx context.Context, req *QueryLiquidityProvidersRequest) (*QueryLiquidityProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityProviders not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityProvidersByDenom(ctx context.Context, req *QueryLiquidityProvidersByDenomRequest) (*QueryLiquidityProvidersByDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityProvidersByDenom not implemented")
+}
+func (*UnimplementedQueryServer) CoinReserve(ctx context.Context, req *QueryCoinReserveRequest) (*QueryCoinReserveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CoinReserve not implemented")
+}
+func (*UnimplementedQueryServer) CoinReserves(ctx context.Context, req *QueryCoinReservesRequest) (*QueryCoinReservesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CoinReserves not implemented")
+}
+func (*UnimplementedQueryServer) Gauge(ctx context.Context, req *QueryGaugeRequest) (*QueryGaugeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Gauge not implemented")
+}
+func (*UnimplementedQueryServer) Gauges(ctx context.Context, req *QueryGaugesRequest) (*QueryGaugesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Gauges not implemented")
+}
+func (*UnimplementedQueryServer) PendingRewards(ctx context.Context, req *QueryPendingRewardsRequest) (*QueryPendingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingRewards not implemented")
+}
+func (*UnimplementedQueryServer) AccountLocks(ctx context.Context, req *QueryAccountLocksRequest) (*QueryAccountLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountLocks not implemented")
+}
+func (*UnimplementedQueryServer) AssetRate(ctx context.Context, req *QueryAssetRateRequest) (*QueryAssetRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssetRate not implemented")
+}
+func (*UnimplementedQueryServer) LimitOrdersByOwner(ctx context.Context, req *QueryLimitOrdersByOwnerRequest) (*QueryLimitOrdersByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LimitOrdersByOwner not implemented")
+}
+func (*UnimplementedQueryServer) LimitOrdersByPair(ctx context.Context, req *QueryLimitOrdersByPairRequest) (*QueryLimitOrdersByPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LimitOrdersByPair not implemented")
+}
+func (*UnimplementedQueryServer) DCAPlansByOwner(ctx context.Context, req *QueryDCAPlansByOwnerRequest) (*QueryDCAPlansByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DCAPlansByOwner not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.simpleswap.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Pool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.simpleswap.v1.Query/Pool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pool(ctx, req.(*QueryPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.simpleswap.v1.Query/PoolStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolStats(ctx, req.(*QueryPoolStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiquidityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.simpleswap.v1.Query/LiquidityProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityProvider(ctx, req.(*QueryLiquidityProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Position_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Position(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.simpleswap.v1.Query/Position",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Position(ctx, req.(*QueryPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiquidityProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.simpleswap.v1.Query/LiquidityProviders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityProviders(ctx, req.(*QueryLiquidityProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiquidityProvidersByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityProvidersByDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityProvidersByDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.simpleswap.v1.Query/LiquidityProvidersByDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityProvidersByDenom(ctx, req.(*QueryLiquidityProvidersByDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CoinReserve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCoinReserveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CoinReserve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.simpleswap.v1.Query/CoinReserve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CoinReserve(ctx, req.(*QueryCoinReserveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CoinReserves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCoinReservesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CoinReserves(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.simpleswap.v1.Query/CoinReserves",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CoinReserves(ctx, req.(*QueryCoinReservesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Gauge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGaugeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Gauge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.simpleswap.v1.Query/Gauge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Gauge(ctx, req.(*QueryGaugeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Gauges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGaugesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Gauges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.simpleswap.v1.Query/Gauges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Gauges(ctx, req.(*QueryGaugesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.simpleswap.v1.Query/PendingRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingRewards(ctx, req.(*QueryPendingRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountLocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.simpleswap.v1.Query/AccountLocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountLocks(ctx, req.(*QueryAccountLocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AssetRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAssetRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AssetRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.simpleswap.v1.Query/AssetRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AssetRate(ctx, req.(*QueryAssetRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LimitOrdersByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLimitOrdersByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LimitOrdersByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.simpleswap.v1.Query/LimitOrdersByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LimitOrdersByOwner(ctx, req.(*QueryLimitOrdersByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LimitOrdersByPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLimitOrdersByPairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LimitOrdersByPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.simpleswap.v1.Query/LimitOrdersByPair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LimitOrdersByPair(ctx, req.(*QueryLimitOrdersByPairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DCAPlansByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDCAPlansByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DCAPlansByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.simpleswap.v1.Query/DCAPlansByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DCAPlansByOwner(ctx, req.(*QueryDCAPlansByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.simpleswap.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Pool",
+			Handler:    _Query_Pool_Handler,
+		},
+		{
+			MethodName: "PoolStats",
+			Handler:    _Query_PoolStats_Handler,
+		},
+		{
+			MethodName: "LiquidityProvider",
+			Handler:    _Query_LiquidityProvider_Handler,
+		},
+		{
+			MethodName: "Position",
+			Handler:    _Query_Position_Handler,
+		},
+		{
+			MethodName: "LiquidityProviders",
+			Handler:    _Query_LiquidityProviders_Handler,
+		},
+		{
+			MethodName: "LiquidityProvidersByDenom",
+			Handler:    _Query_LiquidityProvidersByDenom_Handler,
+		},
+		{
+			MethodName: "CoinReserve",
+			Handler:    _Query_CoinReserve_Handler,
+		},
+		{
+			MethodName: "CoinReserves",
+			Handler:    _Query_CoinReserves_Handler,
+		},
+		{
+			MethodName: "Gauge",
+			Handler:    _Query_Gauge_Handler,
+		},
+		{
+			MethodName: "Gauges",
+			Handler:    _Query_Gauges_Handler,
+		},
+		{
+			MethodName: "PendingRewards",
+			Handler:    _Query_PendingRewards_Handler,
+		},
+		{
+			MethodName: "AccountLocks",
+			Handler:    _Query_AccountLocks_Handler,
+		},
+		{
+			MethodName: "AssetRate",
+			Handler:    _Query_AssetRate_Handler,
+		},
+		{
+			MethodName: "LimitOrdersByOwner",
+			Handler:    _Query_LimitOrdersByOwner_Handler,
+		},
+		{
+			MethodName: "LimitOrdersByPair",
+			Handler:    _Query_LimitOrdersByPair_Handler,
+		},
+		{
+			MethodName: "DCAPlansByOwner",
+			Handler:    _Query_DCAPlansByOwner_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/simpleswap/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Assets) > 0 {
+		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Weekly.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Daily.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolStatsWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolStatsWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolStatsWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pairs) > 0 {
+		for iNdEx := len(m.Pairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.FeeApr.Size()
+		i -= size
+		if _, err := m.FeeApr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.SwapCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SwapCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Fees.Size()
+		i -= size
+		if _, err := m.Fees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PairStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SwapCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SwapCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Fees.Size()
+		i -= size
+		if _, err := m.Fees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.OutputDenom) > 0 {
+		i -= len(m.OutputDenom)
+		copy(dAtA[i:], m.OutputDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OutputDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InputDenom) > 0 {
+		i -= len(m.InputDenom)
+		copy(dAtA[i:], m.InputDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InputDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityProviderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityProviderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LpAddress) > 0 {
+		i -= len(m.LpAddress)
+		copy(dAtA[i:], m.LpAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LpAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LiquidityProvider.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExitAvailable {
+		i--
+		if m.ExitAvailable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.ExitPayout.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ReserveShares) > 0 {
+		for iNdEx := len(m.ReserveShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReserveShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.PoolSharePercentage.Size()
+		i -= size
+		if _, err := m.PoolSharePercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.PendingFees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.LiquidityProvider.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityProvidersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityProvidersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityProvidersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityProvidersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LiquidityProviders) > 0 {
+		for iNdEx := len(m.LiquidityProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityProvidersByDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityProvidersByDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityProvidersByDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityProvidersByDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityProvidersByDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityProvidersByDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.TotalExposure.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.LiquidityProviders) > 0 {
+		for iNdEx := len(m.LiquidityProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidityProviderEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityProviderEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityProviderEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingFees != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PendingFees))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.PoolShareRatio.Size()
+		i -= size
+		if _, err := m.PoolShareRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.LiquidityProvider.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCoinReserveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCoinReserveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCoinReserveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CoinDenom) > 0 {
+		i -= len(m.CoinDenom)
+		copy(dAtA[i:], m.CoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CoinDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCoinReserveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCoinReserveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCoinReserveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Origin) > 0 {
+		i -= len(m.Origin)
+		copy(dAtA[i:], m.Origin)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Origin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.CoinReserve.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCoinReservesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCoinReservesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCoinReservesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCoinReservesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCoinReservesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCoinReservesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Origins) > 0 {
+		for iNdEx := len(m.Origins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Origins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CoinReserves) > 0 {
+		for iNdEx := len(m.CoinReserves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoinReserves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGaugeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGaugeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGaugeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GaugeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GaugeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGaugeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGaugeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Gauge.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGaugesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGaugesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGaugesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGaugesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGaugesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGaugesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Gauges) > 0 {
+		for iNdEx := len(m.Gauges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Gauges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LpAddress) > 0 {
+		i -= len(m.LpAddress)
+		copy(dAtA[i:], m.LpAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LpAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountLocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountLocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountLocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountLocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountLocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountLocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAssetRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAssetRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAssetRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAssetRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAssetRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAssetRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLimitOrdersByOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLimitOrdersByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLimitOrdersByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLimitOrdersByOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLimitOrdersByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLimitOrdersByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLimitOrdersByPairRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLimitOrdersByPairRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLimitOrdersByPairRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OutputDenom) > 0 {
+		i -= len(m.OutputDenom)
+		copy(dAtA[i:], m.OutputDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OutputDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InputDenom) > 0 {
+		i -= len(m.InputDenom)
+		copy(dAtA[i:], m.InputDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InputDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLimitOrdersByPairResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLimitOrdersByPairResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLimitOrdersByPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDCAPlansByOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDCAPlansByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDCAPlansByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDCAPlansByOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDCAPlansByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDCAPlansByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Plans) > 0 {
+		for iNdEx := len(m.Plans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Plans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Assets) > 0 {
+		for _, e := range m.Assets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPoolStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPoolStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Daily.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Weekly.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *PoolStatsWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Volume.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Fees.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.SwapCount != 0 {
+		n += 1 + sovQuery(uint64(m.SwapCount))
+	}
+	l = m.FeeApr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Pairs) > 0 {
+		for _, e := range m.Pairs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PairStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InputDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OutputDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Volume.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Fees.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.SwapCount != 0 {
+		n += 1 + sovQuery(uint64(m.SwapCount))
+	}
+	return n
+}
+
+func (m *QueryLiquidityProviderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LpAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiquidityProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LiquidityProvider.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPositionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LiquidityProvider.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PendingFees.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PoolSharePercentage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.ReserveShares) > 0 {
+		for _, e := range m.ReserveShares {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.ExitPayout.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ExitAvailable {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryLiquidityProvidersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiquidityProvidersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LiquidityProviders) > 0 {
+		for _, e := range m.LiquidityProviders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiquidityProvidersByDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiquidityProvidersByDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LiquidityProviders) > 0 {
+		for _, e := range m.LiquidityProviders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TotalExposure.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *LiquidityProviderEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.LiquidityProvider.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PoolShareRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.PendingFees != 0 {
+		n += 1 + sovQuery(uint64(m.PendingFees))
+	}
+	return n
+}
+
+func (m *QueryCoinReserveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
 
 func (m *QueryCoinReserveResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	l = m.CoinReserve.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
+	var l int
+	_ = l
+	l = m.CoinReserve.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Origin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCoinReservesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCoinReservesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CoinReserves) > 0 {
+		for _, e := range m.CoinReserves {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Origins) > 0 {
+		for _, e := range m.Origins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGaugeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GaugeId != 0 {
+		n += 1 + sovQuery(uint64(m.GaugeId))
+	}
+	return n
+}
+
+func (m *QueryGaugeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Gauge.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGaugesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGaugesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Gauges) > 0 {
+		for _, e := range m.Gauges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LpAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAccountLocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountLocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAssetRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAssetRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLimitOrdersByOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLimitOrdersByOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLimitOrdersByPairRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InputDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OutputDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLimitOrdersByPairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDCAPlansByOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDCAPlansByOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Plans) > 0 {
+		for _, e := range m.Plans {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assets = append(m.Assets, DenomOrigin{})
+			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Daily", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Daily.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weekly", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weekly.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolStatsWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolStatsWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolStatsWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapCount", wireType)
+			}
+			m.SwapCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwapCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeApr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeApr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pairs = append(m.Pairs, PairStats{})
+			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InputDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InputDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapCount", wireType)
+			}
+			m.SwapCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwapCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityProviderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityProviderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LpAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LpAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProvider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPositionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPositionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPositionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProvider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolSharePercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolSharePercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveShares = append(m.ReserveShares, types.Coin{})
+			if err := m.ReserveShares[len(m.ReserveShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitPayout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExitPayout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitAvailable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExitAvailable = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityProvidersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityProvidersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityProvidersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityProvidersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProviders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityProviders = append(m.LiquidityProviders, LiquidityProviderEntry{})
+			if err := m.LiquidityProviders[len(m.LiquidityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityProvidersByDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityProvidersByDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityProvidersByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityProvidersByDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityProvidersByDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityProvidersByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProviders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityProviders = append(m.LiquidityProviders, LiquidityProviderEntry{})
+			if err := m.LiquidityProviders[len(m.LiquidityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalExposure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalExposure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityProviderEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityProviderEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityProviderEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProvider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolShareRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolShareRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingFees", wireType)
+			}
+			m.PendingFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingFees |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCoinReserveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCoinReserveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCoinReserveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCoinReserveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCoinReserveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCoinReserveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinReserve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoinReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Origin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCoinReservesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCoinReservesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCoinReservesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCoinReservesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCoinReservesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCoinReservesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinReserves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoinReserves = append(m.CoinReserves, types.Coin{})
+			if err := m.CoinReserves[len(m.CoinReserves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Origins = append(m.Origins, DenomOrigin{})
+			if err := m.Origins[len(m.Origins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGaugeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGaugeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGaugeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
+			}
+			m.GaugeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GaugeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGaugeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGaugeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGaugesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGaugesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGaugesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGaugesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGaugesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGaugesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gauges = append(m.Gauges, Gauge{})
+			if err := m.Gauges[len(m.Gauges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LpAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LpAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryCoinReservesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *QueryCoinReservesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.CoinReserves) > 0 {
-		for _, e := range m.CoinReserves {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPendingRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountLocksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1203,12 +7878,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountLocksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountLocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1230,7 +7937,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountLocksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1253,15 +7960,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountLocksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountLocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1288,7 +7995,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Locks = append(m.Locks, PeriodLock{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1313,7 +8021,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAssetRateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1336,12 +8044,44 @@ func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAssetRateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAssetRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1363,7 +8103,7 @@ func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAssetRateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1386,17 +8126,17 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAssetRateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAssetRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1406,22 +8146,23 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1446,7 +8187,7 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLiquidityProviderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLimitOrdersByOwnerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1469,15 +8210,15 @@ func (m *QueryLiquidityProviderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidityProviderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLimitOrdersByOwnerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidityProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLimitOrdersByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LpAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1489,23 +8230,59 @@ func (m *QueryLiquidityProviderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LpAddress = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1528,7 +8305,7 @@ func (m *QueryLiquidityProviderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLiquidityProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLimitOrdersByOwnerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1551,15 +8328,15 @@ func (m *QueryLiquidityProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidityProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLimitOrdersByOwnerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLimitOrdersByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProvider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1586,7 +8363,44 @@ func (m *QueryLiquidityProviderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidityProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Orders = append(m.Orders, LimitOrder{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1611,7 +8425,7 @@ func (m *QueryLiquidityProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCoinReserveRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLimitOrdersByPairRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1634,15 +8448,15 @@ func (m *QueryCoinReserveRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCoinReserveRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLimitOrdersByPairRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCoinReserveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLimitOrdersByPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoinDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InputDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1670,7 +8484,75 @@ func (m *QueryCoinReserveRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CoinDenom = string(dAtA[iNdEx:postIndex])
+			m.InputDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1693,7 +8575,7 @@ func (m *QueryCoinReserveRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCoinReserveResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLimitOrdersByPairResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1716,15 +8598,15 @@ func (m *QueryCoinReserveResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCoinReserveResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLimitOrdersByPairResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCoinReserveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLimitOrdersByPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoinReserve", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1751,7 +8633,44 @@ func (m *QueryCoinReserveResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CoinReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Orders = append(m.Orders, LimitOrder{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1776,7 +8695,7 @@ func (m *QueryCoinReserveResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCoinReservesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDCAPlansByOwnerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1799,12 +8718,80 @@ func (m *QueryCoinReservesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCoinReservesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDCAPlansByOwnerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCoinReservesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDCAPlansByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1826,7 +8813,7 @@ func (m *QueryCoinReservesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCoinReservesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDCAPlansByOwnerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1849,15 +8836,15 @@ func (m *QueryCoinReservesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCoinReservesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDCAPlansByOwnerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCoinReservesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDCAPlansByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoinReserves", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Plans", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1884,8 +8871,44 @@ func (m *QueryCoinReservesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CoinReserves = append(m.CoinReserves, types.Coin{})
-			if err := m.CoinReserves[len(m.CoinReserves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Plans = append(m.Plans, DCAPlan{})
+			if err := m.Plans[len(m.Plans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,23 @@
+package simpleswap
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+)
+
+// RateProvider returns the redemption rate of a whitelisted coin, i.e. the value of one
+// unit of the coin in the pool reference asset. The pool scales the balances by that
+// rate before pricing swaps, liquidity and fees.
+type RateProvider interface {
+	GetRate(ctx context.Context, denom string) (math.LegacyDec, error)
+}
+
+// RateProviderWrapper is a wrapper for modules to inject the RateProvider of a denom using depinject.
+type RateProviderWrapper struct {
+	Denom    string
+	Provider RateProvider
+}
+
+// IsManyPerContainerType implements the depinject.ManyPerContainerType interface.
+func (RateProviderWrapper) IsManyPerContainerType() {}
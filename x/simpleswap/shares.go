@@ -0,0 +1,32 @@
+package simpleswap
+
+import (
+	"fmt"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// PoolShareDenom returns the denom of the share token of a pool, e.g. simpleswap/pool/1.
+func PoolShareDenom(poolId uint64) string {
+	return fmt.Sprintf("%s/pool/%d", ModuleName, poolId)
+}
+
+// PoolShareDenomMetadata returns the bank metadata of the share token of a pool. The
+// shares are minted one to one with the base units of the stable coins, the display
+// unit therefore has the exponent of the pool decimals.
+func PoolShareDenomMetadata(poolId uint64, decimals uint32) banktypes.Metadata {
+	base := PoolShareDenom(poolId)
+	display := fmt.Sprintf("SSP-%d", poolId)
+
+	return banktypes.Metadata{
+		Description: fmt.Sprintf("Share token of the SimpleSwap pool %d", poolId),
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: base, Exponent: 0},
+			{Denom: display, Exponent: decimals},
+		},
+		Base:    base,
+		Display: display,
+		Name:    fmt.Sprintf("SimpleSwap Pool %d Share", poolId),
+		Symbol:  display,
+	}
+}
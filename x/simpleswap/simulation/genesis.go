@@ -0,0 +1,120 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/simpleswap"
+)
+
+// Simulation parameter constants
+const (
+	SwapFeePercentage      = "swap_fee_percentage"
+	FlashSwapFeePercentage = "flash_swap_fee_percentage"
+	IncentivesEpochBlocks  = "incentives_epoch_blocks"
+	BatchAuction           = "batch_auction"
+	LiquidityFunds         = "liquidity_funds"
+)
+
+// GenSwapFeePercentage randomized SwapFeePercentage, up to 0.1% of the output.
+func GenSwapFeePercentage(r *rand.Rand) int32 {
+	return r.Int31n(100_000) + 1
+}
+
+// GenFlashSwapFeePercentage randomized FlashSwapFeePercentage, 0 charges the swap fee.
+func GenFlashSwapFeePercentage(r *rand.Rand) int32 {
+	return r.Int31n(100_000)
+}
+
+// GenIncentivesEpochBlocks randomized IncentivesEpochBlocks.
+func GenIncentivesEpochBlocks(r *rand.Rand) int64 {
+	return int64(r.Intn(200) + 1)
+}
+
+// GenBatchAuction randomized BatchAuction, enabled one time out of five.
+func GenBatchAuction(r *rand.Rand) bool {
+	return r.Intn(5) == 0
+}
+
+// GenLiquidityFunds randomized amount of every whitelisted coin held by each account, from 1 to 100
+// coins in the pool decimals.
+func GenLiquidityFunds(r *rand.Rand) math.Int {
+	return math.NewInt(r.Int63n(99_000_000) + 1_000_000)
+}
+
+// RandomizedGenState generates a random GenesisState for simpleswap, and funds the simulation
+// accounts with the whitelisted coins in the bank genesis.
+func RandomizedGenState(simState *module.SimulationState) {
+	var swapFeePercentage int32
+	simState.AppParams.GetOrGenerate(SwapFeePercentage, &swapFeePercentage, simState.Rand, func(r *rand.Rand) { swapFeePercentage = GenSwapFeePercentage(r) })
+
+	var flashSwapFeePercentage int32
+	simState.AppParams.GetOrGenerate(FlashSwapFeePercentage, &flashSwapFeePercentage, simState.Rand, func(r *rand.Rand) { flashSwapFeePercentage = GenFlashSwapFeePercentage(r) })
+
+	var incentivesEpochBlocks int64
+	simState.AppParams.GetOrGenerate(IncentivesEpochBlocks, &incentivesEpochBlocks, simState.Rand, func(r *rand.Rand) { incentivesEpochBlocks = GenIncentivesEpochBlocks(r) })
+
+	var batchAuction bool
+	simState.AppParams.GetOrGenerate(BatchAuction, &batchAuction, simState.Rand, func(r *rand.Rand) { batchAuction = GenBatchAuction(r) })
+
+	var liquidityFunds math.Int
+	simState.AppParams.GetOrGenerate(LiquidityFunds, &liquidityFunds, simState.Rand, func(r *rand.Rand) { liquidityFunds = GenLiquidityFunds(r) })
+
+	// The oracle guard is disabled, the simulated app has no oracle
+	params := simpleswap.DefaultParams()
+	params.SwapFeePercentage = swapFeePercentage
+	params.FlashSwapFeePercentage = flashSwapFeePercentage
+	params.IncentivesEpochBlocks = incentivesEpochBlocks
+	params.BatchAuction = batchAuction
+
+	simpleswapGenesis := simpleswap.NewGenesisState()
+	simpleswapGenesis.Params = params
+
+	bz, err := json.MarshalIndent(&simpleswapGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated simpleswap parameters:\n%s\n", bz)
+	simState.GenState[simpleswap.ModuleName] = simState.Cdc.MustMarshalJSON(simpleswapGenesis)
+
+	fundAccounts(simState, params, liquidityFunds)
+}
+
+// fundAccounts adds the funds in every whitelisted coin to the balances of the simulation accounts
+// and to the supply of the bank genesis, which is generated before the simpleswap genesis.
+func fundAccounts(simState *module.SimulationState, params simpleswap.Params, funds math.Int) {
+	bankGenesisBz, ok := simState.GenState[banktypes.ModuleName]
+	if !ok {
+		panic("the bank genesis must be generated before the simpleswap genesis")
+	}
+
+	var bankGenesis banktypes.GenesisState
+	simState.Cdc.MustUnmarshalJSON(bankGenesisBz, &bankGenesis)
+
+	accountFunds := sdk.NewCoins()
+	for _, denom := range params.WhitelistedDenoms() {
+		accountFunds = accountFunds.Add(sdk.NewCoin(denom, funds))
+	}
+
+	accounts := make(map[string]bool, len(simState.Accounts))
+	for _, account := range simState.Accounts {
+		accounts[account.Address.String()] = true
+	}
+
+	for i, balance := range bankGenesis.Balances {
+		if !accounts[balance.Address] {
+			continue
+		}
+
+		bankGenesis.Balances[i].Coins = balance.Coins.Add(accountFunds...)
+		bankGenesis.Supply = bankGenesis.Supply.Add(accountFunds...)
+	}
+
+	simState.GenState[banktypes.ModuleName] = simState.Cdc.MustMarshalJSON(&bankGenesis)
+}
@@ -0,0 +1,83 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banksim "github.com/cosmos/cosmos-sdk/x/bank/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/simpleswap"
+	"github.com/cosmos/simpleswap/simulation"
+)
+
+// TestRandomizedGenState tests the normal scenario of applying RandomizedGenState after the bank
+// genesis is generated.
+func TestRandomizedGenState(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+
+	r := rand.New(rand.NewSource(1))
+	simState := module.SimulationState{
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          encCfg.Codec,
+		Rand:         r,
+		NumBonded:    3,
+		BondDenom:    sdk.DefaultBondDenom,
+		Accounts:     simtypes.RandomAccounts(r, 3),
+		InitialStake: math.NewInt(1000),
+		GenState:     make(map[string]json.RawMessage),
+	}
+
+	banksim.RandomizedGenState(&simState)
+	simulation.RandomizedGenState(&simState)
+
+	var simpleswapGenesis simpleswap.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[simpleswap.ModuleName], &simpleswapGenesis)
+	require.NoError(t, simpleswapGenesis.Validate())
+	require.Positive(t, simpleswapGenesis.Params.SwapFeePercentage)
+	require.Equal(t, simpleswap.ORACLE_GUARD_MODE_DISABLED, simpleswapGenesis.Params.OracleGuardMode)
+
+	// Every account holds the same funds in each whitelisted coin, which are added to the supply
+	var bankGenesis banktypes.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[banktypes.ModuleName], &bankGenesis)
+	require.Len(t, bankGenesis.Balances, 3)
+
+	funds := bankGenesis.Balances[0].Coins.AmountOf("ETH")
+	require.True(t, funds.IsPositive())
+	for _, balance := range bankGenesis.Balances {
+		require.Equal(t, math.NewInt(1000), balance.Coins.AmountOf(sdk.DefaultBondDenom))
+		for _, denom := range simpleswapGenesis.Params.WhitelistedDenoms() {
+			require.Equal(t, funds, balance.Coins.AmountOf(denom))
+		}
+	}
+
+	for _, denom := range simpleswapGenesis.Params.WhitelistedDenoms() {
+		require.Equal(t, funds.MulRaw(3), bankGenesis.Supply.AmountOf(denom))
+	}
+}
+
+// TestRandomizedGenStateWithoutBankGenesis tests RandomizedGenState panics when the bank genesis
+// is not generated first.
+func TestRandomizedGenStateWithoutBankGenesis(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+
+	r := rand.New(rand.NewSource(1))
+	simState := module.SimulationState{
+		AppParams: make(simtypes.AppParams),
+		Cdc:       encCfg.Codec,
+		Rand:      r,
+		Accounts:  simtypes.RandomAccounts(r, 3),
+		GenState:  make(map[string]json.RawMessage),
+	}
+
+	require.PanicsWithValue(t, "the bank genesis must be generated before the simpleswap genesis", func() {
+		simulation.RandomizedGenState(&simState)
+	})
+}
@@ -0,0 +1,321 @@
+package simulation
+
+import (
+	"errors"
+	"math/rand"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/cosmos/simpleswap"
+	expectedkeepers "github.com/cosmos/simpleswap/expected_keepers"
+	"github.com/cosmos/simpleswap/keeper"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgAddLiquidity    = "op_weight_msg_add_liquidity"
+	OpWeightMsgSwapLiquidity   = "op_weight_msg_swap_liquidity"
+	OpWeightMsgRemoveLiquidity = "op_weight_msg_remove_liquidity"
+
+	DefaultWeightMsgAddLiquidity    int = 100
+	DefaultWeightMsgSwapLiquidity   int = 100
+	DefaultWeightMsgRemoveLiquidity int = 50
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	_ codec.JSONCodec,
+	txConfig client.TxConfig,
+	ak expectedkeepers.AccountKeeper,
+	bk expectedkeepers.BankKeeper,
+	k keeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgAddLiquidity int
+	appParams.GetOrGenerate(OpWeightMsgAddLiquidity, &weightMsgAddLiquidity, nil, func(_ *rand.Rand) {
+		weightMsgAddLiquidity = DefaultWeightMsgAddLiquidity
+	})
+
+	var weightMsgSwapLiquidity int
+	appParams.GetOrGenerate(OpWeightMsgSwapLiquidity, &weightMsgSwapLiquidity, nil, func(_ *rand.Rand) {
+		weightMsgSwapLiquidity = DefaultWeightMsgSwapLiquidity
+	})
+
+	var weightMsgRemoveLiquidity int
+	appParams.GetOrGenerate(OpWeightMsgRemoveLiquidity, &weightMsgRemoveLiquidity, nil, func(_ *rand.Rand) {
+		weightMsgRemoveLiquidity = DefaultWeightMsgRemoveLiquidity
+	})
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgAddLiquidity,
+			SimulateMsgAddLiquidity(txConfig, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSwapLiquidity,
+			SimulateMsgSwapLiquidity(txConfig, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRemoveLiquidity,
+			SimulateMsgRemoveLiquidity(txConfig, ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgAddLiquidity generates a MsgAddLiquidity of a random part of the balance of a random
+// account in a whitelisted coin.
+func SimulateMsgAddLiquidity(txConfig client.TxConfig, ak expectedkeepers.AccountKeeper, bk expectedkeepers.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&simpleswap.MsgAddLiquidity{})
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(simpleswap.ModuleName, msgType, "error getting params"), nil, err
+		}
+
+		// A liquidity provider keeps adding the coin it provides
+		denoms := params.WhitelistedDenoms()
+		denom := denoms[r.Intn(len(denoms))]
+		provider, err := k.LiquidityProviders.Get(ctx, simAccount.Address.String())
+		switch {
+		case err == nil:
+			denom = provider.StableCoin.Denom
+		case !errors.Is(err, collections.ErrNotFound):
+			return simtypes.NoOpMsg(simpleswap.ModuleName, msgType, "error getting liquidity provider"), nil, err
+		}
+
+		balance := bk.SpendableCoins(ctx, simAccount.Address).AmountOf(denom)
+		if !balance.IsPositive() {
+			return simtypes.NoOpMsg(simpleswap.ModuleName, msgType, "no balance to add"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, balance)
+		if err != nil {
+			return simtypes.NoOpMsg(simpleswap.ModuleName, msgType, "unable to generate positive amount"), nil, err
+		}
+
+		token := sdk.NewCoin(denom, amount)
+		msg := &simpleswap.MsgAddLiquidity{
+			LiquidityProvider: simAccount.Address.String(),
+			Token:             token,
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      simpleswap.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(token),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgSwapLiquidity generates a MsgSwapLiquidity of a random part of the balance of a random
+// account between two whitelisted coins, for an output the pool can pay.
+func SimulateMsgSwapLiquidity(txConfig client.TxConfig, ak expectedkeepers.AccountKeeper, bk expectedkeepers.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&simpleswap.MsgSwapLiquidity{})
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(simpleswap.ModuleName, msgType, "error getting params"), nil, err
+		}
+
+		denoms := params.WhitelistedDenoms()
+		if len(denoms) < 2 {
+			return simtypes.NoOpMsg(simpleswap.ModuleName, msgType, "not enough whitelisted coins"), nil, nil
+		}
+
+		perm := r.Perm(len(denoms))
+		inputDenom, outputDenom := denoms[perm[0]], denoms[perm[1]]
+
+		// The output is paid from the reserve, held by the module account
+		reserve, err := k.CoinsReserve.Get(ctx, outputDenom)
+		if err != nil {
+			return simtypes.NoOpMsg(simpleswap.ModuleName, msgType, "error getting coins reserve"), nil, err
+		}
+
+		moduleBalance := bk.SpendableCoin(ctx, ak.GetModuleAddress(simpleswap.ModuleName), outputDenom)
+		available := sdk.NewCoin(outputDenom, math.MinInt(reserve.Amount, moduleBalance.Amount))
+		if !available.IsPositive() {
+			return simtypes.NoOpMsg(simpleswap.ModuleName, msgType, "insufficient liquidity"), nil, nil
+		}
+
+		// The input is a part of the balance of the trader worth at most the available output
+		liquidity, err := k.EquivalentCoin(ctx, available, inputDenom)
+		if err != nil {
+			return simtypes.NoOpMsg(simpleswap.ModuleName, msgType, "no input worth the liquidity"), nil, nil
+		}
+
+		maxInput := math.MinInt(bk.SpendableCoins(ctx, simAccount.Address).AmountOf(inputDenom), liquidity.Amount)
+		if !maxInput.IsPositive() {
+			return simtypes.NoOpMsg(simpleswap.ModuleName, msgType, "no balance to swap"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, maxInput)
+		if err != nil {
+			return simtypes.NoOpMsg(simpleswap.ModuleName, msgType, "unable to generate positive amount"), nil, err
+		}
+
+		input := sdk.NewCoin(inputDenom, amount)
+		output, err := k.EquivalentCoin(ctx, input, outputDenom)
+		if err != nil {
+			return simtypes.NoOpMsg(simpleswap.ModuleName, msgType, "no output worth the input"), nil, nil
+		}
+
+		if output.IsZero() || output.Amount.GT(available.Amount) {
+			return simtypes.NoOpMsg(simpleswap.ModuleName, msgType, "insufficient liquidity"), nil, nil
+		}
+
+		msg := &simpleswap.MsgSwapLiquidity{
+			Trader: simAccount.Address.String(),
+			Input:  input,
+			Output: output,
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      simpleswap.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(input),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgRemoveLiquidity generates a MsgRemoveLiquidity of a random part of the liquidity of a
+// random liquidity provider.
+func SimulateMsgRemoveLiquidity(txConfig client.TxConfig, ak expectedkeepers.AccountKeeper, bk expectedkeepers.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&simpleswap.MsgRemoveLiquidity{})
+		simAccount, provider, err := randomLiquidityProvider(r, ctx, k, accs)
+		if err != nil {
+			return simtypes.NoOpMsg(simpleswap.ModuleName, msgType, "error getting liquidity providers"), nil, err
+		}
+
+		if provider == nil {
+			return simtypes.NoOpMsg(simpleswap.ModuleName, msgType, "no liquidity provider"), nil, nil
+		}
+
+		// The provider removes the coin it provides, within the reserve of the coin
+		denom := provider.StableCoin.Denom
+		reserve, err := k.CoinsReserve.Get(ctx, denom)
+		if err != nil {
+			return simtypes.NoOpMsg(simpleswap.ModuleName, msgType, "error getting coins reserve"), nil, err
+		}
+
+		available := math.MinInt(provider.StableCoin.Amount, reserve.Amount)
+		if !available.IsPositive() {
+			return simtypes.NoOpMsg(simpleswap.ModuleName, msgType, "no liquidity to remove"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, available)
+		if err != nil {
+			return simtypes.NoOpMsg(simpleswap.ModuleName, msgType, "unable to generate positive amount"), nil, err
+		}
+
+		// The shares of the provider are burned, they must not have been sent away
+		shares := sdk.NewCoin(provider.PoolShare.Denom, provider.PoolShare.Amount)
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+		if spendable.AmountOf(shares.Denom).LT(shares.Amount) {
+			return simtypes.NoOpMsg(simpleswap.ModuleName, msgType, "the pool shares are not spendable"), nil, nil
+		}
+
+		// The accrued fees are paid with the removed liquidity by the module account, valued one to
+		// one as the simulated coins have no rates
+		pool, err := k.Pool.Get(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(simpleswap.ModuleName, msgType, "error getting pool"), nil, err
+		}
+
+		accruedFees := provider.AccruedFees
+		if pool.TotalAccruedFees != provider.GloballyAccruedFees && provider.PoolShare.Amount.IsPositive() {
+			accruedFees += (pool.TotalAccruedFees - provider.GloballyAccruedFees) * provider.PoolShare.Amount.Int64() / pool.TotalLiquidity
+		}
+
+		moduleBalance := bk.SpendableCoin(ctx, ak.GetModuleAddress(simpleswap.ModuleName), denom)
+		if accruedFees < 0 || moduleBalance.Amount.LT(amount.AddRaw(accruedFees)) {
+			return simtypes.NoOpMsg(simpleswap.ModuleName, msgType, "insufficient module balance"), nil, nil
+		}
+
+		msg := &simpleswap.MsgRemoveLiquidity{
+			LiquidityProvider: simAccount.Address.String(),
+			Token:             sdk.NewCoin(denom, amount),
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      simpleswap.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(shares),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// randomLiquidityProvider returns a random simulation account providing liquidity to the pool, nil
+// if there is none.
+func randomLiquidityProvider(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (simtypes.Account, *simpleswap.LiquidityProvider, error) {
+	var addresses []string
+	var providers []simpleswap.LiquidityProvider
+	err := k.LiquidityProviders.Walk(ctx, nil, func(address string, provider simpleswap.LiquidityProvider) (bool, error) {
+		addresses = append(addresses, address)
+		providers = append(providers, provider)
+		return false, nil
+	})
+	if err != nil || len(providers) == 0 {
+		return simtypes.Account{}, nil, err
+	}
+
+	i := r.Intn(len(providers))
+	address, err := sdk.AccAddressFromBech32(addresses[i])
+	if err != nil {
+		return simtypes.Account{}, nil, err
+	}
+
+	simAccount, found := simtypes.FindAccount(accs, address)
+	if !found {
+		return simtypes.Account{}, nil, nil
+	}
+
+	return simAccount, &providers[i], nil
+}
@@ -0,0 +1,42 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/simpleswap"
+	"github.com/cosmos/simpleswap/simulation"
+)
+
+func TestProposalMsgs(t *testing.T) {
+	// initialize parameters
+	r := rand.New(rand.NewSource(1))
+
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, true, nil)
+	accounts := simtypes.RandomAccounts(r, 3)
+
+	// execute ProposalMsgs function
+	weightedProposalMsgs := simulation.ProposalMsgs()
+	require.Len(t, weightedProposalMsgs, 1)
+
+	w0 := weightedProposalMsgs[0]
+
+	// tests w0 interface:
+	require.Equal(t, simulation.OpWeightMsgUpdateParams, w0.AppParamsKey())
+	require.Equal(t, simulation.DefaultWeightMsgUpdateParams, w0.DefaultWeight())
+
+	msg := w0.MsgSimulatorFn()(r, ctx, accounts)
+	msgUpdateParams, ok := msg.(*simpleswap.MsgUpdateParams)
+	require.True(t, ok)
+
+	require.Equal(t, sdk.AccAddress(address.Module("gov")).String(), msgUpdateParams.Authority)
+	require.NoError(t, msgUpdateParams.Params.Validate())
+	require.Positive(t, msgUpdateParams.Params.SwapFeePercentage)
+	require.Equal(t, simpleswap.DefaultParams().WhitelistedCoins, msgUpdateParams.Params.WhitelistedCoins)
+}
@@ -60,10 +60,14 @@ func TestIntegration(t *testing.T) {
 				"consensus",
 				simpleswap.ModuleName,
 			),
+			configurator.WithCustomEndBlockersOrder(
+				"staking",
+				simpleswap.ModuleName,
+			),
 		),
 		depinject.Supply(logger))
 
-	var keeper keeper.Keeper
+	var keeper *keeper.Keeper
 	app, err := simtestutil.Setup(appConfig, &keeper)
 	require.NoError(t, err)
 	require.NotNil(t, app) // use the app or the keeper for running integration tests
@@ -4,21 +4,28 @@
 package simpleswap
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,12 +33,86 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// OracleGuardMode defines the action taken by the oracle price guard on a depegged swap.
+type OracleGuardMode int32
+
+const (
+	// ORACLE_GUARD_MODE_DISABLED disables the oracle price guard.
+	ORACLE_GUARD_MODE_DISABLED OracleGuardMode = 0
+	// ORACLE_GUARD_MODE_REJECT rejects swaps exceeding the maximum deviation.
+	ORACLE_GUARD_MODE_REJECT OracleGuardMode = 1
+	// ORACLE_GUARD_MODE_SURCHARGE charges the deviation as an additional fee on the output.
+	ORACLE_GUARD_MODE_SURCHARGE OracleGuardMode = 2
+)
+
+var OracleGuardMode_name = map[int32]string{
+	0: "ORACLE_GUARD_MODE_DISABLED",
+	1: "ORACLE_GUARD_MODE_REJECT",
+	2: "ORACLE_GUARD_MODE_SURCHARGE",
+}
+
+var OracleGuardMode_value = map[string]int32{
+	"ORACLE_GUARD_MODE_DISABLED":  0,
+	"ORACLE_GUARD_MODE_REJECT":    1,
+	"ORACLE_GUARD_MODE_SURCHARGE": 2,
+}
+
+func (x OracleGuardMode) String() string {
+	return proto.EnumName(OracleGuardMode_name, int32(x))
+}
+
+func (OracleGuardMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_23045ec30cd6e897, []int{0}
+}
+
 // Params defines the parameters of the module.
 type Params struct {
+	// coins that are listed by the module, by native denom or by the transfer path trace of a bridged
+	// coin, e.g. transfer/channel-0/weth, which resolves to the ibc/<hash> denom of the coin.
 	WhitelistedCoins  []*types.Coin `protobuf:"bytes,1,rep,name=whitelistedCoins,proto3" json:"whitelistedCoins,omitempty"`
 	SwapFeePercentage int32         `protobuf:"varint,2,opt,name=swapFeePercentage,proto3" json:"swapFeePercentage,omitempty"`
 	Decimals          int64         `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
-	ShareToken        *types.Coin   `protobuf:"bytes,4,opt,name=shareToken,proto3" json:"shareToken,omitempty"`
+	// oracleGuardMode defines how swaps are treated when the oracle price ratio
+	// between the input and output coins deviates from 1 by more than oracleMaxDeviation,
+	// in favour of the trader against the pool.
+	OracleGuardMode OracleGuardMode `protobuf:"varint,5,opt,name=oracleGuardMode,proto3,enum=cosmos.simpleswap.v1.OracleGuardMode" json:"oracleGuardMode,omitempty"`
+	// oracleMaxDeviation is the maximum tolerated deviation of the oracle price
+	// ratio between the input and output coins from 1 in favour of the trader, e.g.
+	// 0.02 for 2%.
+	OracleMaxDeviation cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=oracleMaxDeviation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"oracleMaxDeviation"`
+	// incentivesEpochBlocks is the number of blocks between two gauge reward distributions, 0 disables them.
+	IncentivesEpochBlocks int64 `protobuf:"varint,7,opt,name=incentivesEpochBlocks,proto3" json:"incentivesEpochBlocks,omitempty"`
+	// lockDurations are the allowed share lock durations and their reward-weight multipliers.
+	LockDurations []LockDuration `protobuf:"bytes,8,rep,name=lockDurations,proto3" json:"lockDurations"`
+	// assetExponents are the decimal exponents of the whitelisted coins. A whitelisted coin without
+	// an entry uses the display exponent of its bank denom metadata, or the pool decimals.
+	AssetExponents []AssetExponent `protobuf:"bytes,9,rep,name=assetExponents,proto3" json:"assetExponents"`
+	// feeSwapDenoms are the whitelisted coins accepted to pay the transaction fees. The fees are
+	// swapped through the pool into the native fee denom, which must be whitelisted too.
+	FeeSwapDenoms []string `protobuf:"bytes,10,rep,name=feeSwapDenoms,proto3" json:"feeSwapDenoms,omitempty"`
+	// feeSwapMaxSlippage is the highest share, e.g. 0.01 for 1%, a fee swap output may fall below the
+	// value of the fee at a price independent of the pool: the oracle prices, or without an oracle
+	// the ratio of the validator minimum gas prices in CheckTx. Without either, the output is bounded
+	// against the pool price, which only caps the swap fee and the oracle surcharge.
+	FeeSwapMaxSlippage cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=feeSwapMaxSlippage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"feeSwapMaxSlippage"`
+	// batchAuction enables the batch auction mode of the pool: the swap messages are queued during
+	// the block and cleared at its end at a uniform price per pair, netting the opposite swaps.
+	BatchAuction bool `protobuf:"varint,12,opt,name=batchAuction,proto3" json:"batchAuction,omitempty"`
+	// flashSwapFeePercentage is the fee charged on the output of a flash swap, in the units of
+	// swapFeePercentage, 0 to charge the swap fee percentage of the pool.
+	FlashSwapFeePercentage int32 `protobuf:"varint,13,opt,name=flashSwapFeePercentage,proto3" json:"flashSwapFeePercentage,omitempty"`
+	// statsRetention is how long the hourly pool stats buckets are kept before they are pruned, at
+	// least 7 days for the 7 days stats, 0 to keep them 7 days.
+	StatsRetention time.Duration `protobuf:"bytes,14,opt,name=statsRetention,proto3,stdduration" json:"statsRetention"`
+	// limitOrderMatchesPerBlock is the highest number of open limit orders tried at the end of a
+	// block, resuming after the last order tried in the previous block, 0 to try 100 orders.
+	LimitOrderMatchesPerBlock uint32 `protobuf:"varint,15,opt,name=limitOrderMatchesPerBlock,proto3" json:"limitOrderMatchesPerBlock,omitempty"`
+	// dcaPlanMaxSkips is the number of slices of a DCA plan skipped in a row closing the plan and
+	// refunding its escrow, 0 to close it after 10 skips.
+	DcaPlanMaxSkips uint32 `protobuf:"varint,16,opt,name=dcaPlanMaxSkips,proto3" json:"dcaPlanMaxSkips,omitempty"`
+	// dcaSlicesPerBlock is the highest number of due DCA slices executed at the end of a block, the
+	// slices not executed stay due and go first in the next block, 0 to execute 100 slices.
+	DcaSlicesPerBlock uint32 `protobuf:"varint,17,opt,name=dcaSlicesPerBlock,proto3" json:"dcaSlicesPerBlock,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -88,13 +169,184 @@ func (m *Params) GetDecimals() int64 {
 	return 0
 }
 
-func (m *Params) GetShareToken() *types.Coin {
+func (m *Params) GetOracleGuardMode() OracleGuardMode {
 	if m != nil {
-		return m.ShareToken
+		return m.OracleGuardMode
+	}
+	return ORACLE_GUARD_MODE_DISABLED
+}
+
+func (m *Params) GetIncentivesEpochBlocks() int64 {
+	if m != nil {
+		return m.IncentivesEpochBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetLockDurations() []LockDuration {
+	if m != nil {
+		return m.LockDurations
+	}
+	return nil
+}
+
+func (m *Params) GetAssetExponents() []AssetExponent {
+	if m != nil {
+		return m.AssetExponents
+	}
+	return nil
+}
+
+func (m *Params) GetFeeSwapDenoms() []string {
+	if m != nil {
+		return m.FeeSwapDenoms
 	}
 	return nil
 }
 
+func (m *Params) GetBatchAuction() bool {
+	if m != nil {
+		return m.BatchAuction
+	}
+	return false
+}
+
+func (m *Params) GetFlashSwapFeePercentage() int32 {
+	if m != nil {
+		return m.FlashSwapFeePercentage
+	}
+	return 0
+}
+
+func (m *Params) GetStatsRetention() time.Duration {
+	if m != nil {
+		return m.StatsRetention
+	}
+	return 0
+}
+
+func (m *Params) GetLimitOrderMatchesPerBlock() uint32 {
+	if m != nil {
+		return m.LimitOrderMatchesPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetDcaPlanMaxSkips() uint32 {
+	if m != nil {
+		return m.DcaPlanMaxSkips
+	}
+	return 0
+}
+
+func (m *Params) GetDcaSlicesPerBlock() uint32 {
+	if m != nil {
+		return m.DcaSlicesPerBlock
+	}
+	return 0
+}
+
+// AssetExponent declares the decimal exponent of a whitelisted coin, e.g. 18 for ETH in wei.
+type AssetExponent struct {
+	Denom    string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Exponent uint32 `protobuf:"varint,2,opt,name=exponent,proto3" json:"exponent,omitempty"`
+}
+
+func (m *AssetExponent) Reset()         { *m = AssetExponent{} }
+func (m *AssetExponent) String() string { return proto.CompactTextString(m) }
+func (*AssetExponent) ProtoMessage()    {}
+func (*AssetExponent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23045ec30cd6e897, []int{1}
+}
+func (m *AssetExponent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetExponent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetExponent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssetExponent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetExponent.Merge(m, src)
+}
+func (m *AssetExponent) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetExponent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetExponent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetExponent proto.InternalMessageInfo
+
+func (m *AssetExponent) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *AssetExponent) GetExponent() uint32 {
+	if m != nil {
+		return m.Exponent
+	}
+	return 0
+}
+
+// LockDuration is an allowed share lock duration with its reward-weight multiplier.
+type LockDuration struct {
+	// duration is the unbonding duration of the lock.
+	Duration time.Duration `protobuf:"bytes,1,opt,name=duration,proto3,stdduration" json:"duration"`
+	// multiplier is applied to the locked shares when weighting the gauge rewards, e.g. 2 for twice the rewards.
+	Multiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=multiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"multiplier"`
+}
+
+func (m *LockDuration) Reset()         { *m = LockDuration{} }
+func (m *LockDuration) String() string { return proto.CompactTextString(m) }
+func (*LockDuration) ProtoMessage()    {}
+func (*LockDuration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23045ec30cd6e897, []int{2}
+}
+func (m *LockDuration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockDuration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockDuration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockDuration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockDuration.Merge(m, src)
+}
+func (m *LockDuration) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockDuration) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockDuration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockDuration proto.InternalMessageInfo
+
+func (m *LockDuration) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
 type LiquidityProvider struct {
 	StableCoin          *types.Coin `protobuf:"bytes,1,opt,name=stableCoin,proto3" json:"stableCoin,omitempty"`
 	PoolShare           *types.Coin `protobuf:"bytes,2,opt,name=poolShare,proto3" json:"poolShare,omitempty"`
@@ -106,7 +358,7 @@ func (m *LiquidityProvider) Reset()         { *m = LiquidityProvider{} }
 func (m *LiquidityProvider) String() string { return proto.CompactTextString(m) }
 func (*LiquidityProvider) ProtoMessage()    {}
 func (*LiquidityProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23045ec30cd6e897, []int{1}
+	return fileDescriptor_23045ec30cd6e897, []int{3}
 }
 func (m *LiquidityProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -169,13 +421,14 @@ type Pool struct {
 	Decimals          int64       `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
 	ShareToken        *types.Coin `protobuf:"bytes,4,opt,name=shareToken,proto3" json:"shareToken,omitempty"`
 	SwapFeePercentage int32       `protobuf:"varint,5,opt,name=swapFeePercentage,proto3" json:"swapFeePercentage,omitempty"`
+	Id                uint64      `protobuf:"varint,6,opt,name=id,proto3" json:"id,omitempty"`
 }
 
 func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23045ec30cd6e897, []int{2}
+	return fileDescriptor_23045ec30cd6e897, []int{4}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -239,26 +492,43 @@ func (m *Pool) GetSwapFeePercentage() int32 {
 	return 0
 }
 
-// GenesisState is the state that must be provided at genesis.
-type GenesisState struct {
-	// Pool is the liquidity pool invovlved in our simpleswap module.
-	Pool *Pool `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
-	// params defines all the parameters of the module.
-	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+func (m *Pool) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *GenesisState) Reset()         { *m = GenesisState{} }
-func (m *GenesisState) String() string { return proto.CompactTextString(m) }
-func (*GenesisState) ProtoMessage()    {}
-func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23045ec30cd6e897, []int{3}
+// Gauge distributes reward coins to the share locks of a pool over a number of epochs.
+type Gauge struct {
+	// id is the unique identifier of the gauge.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// poolId is the pool whose share locks are rewarded.
+	PoolId uint64 `protobuf:"varint,2,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	// owner is the address that created the gauge.
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	// coins are the total rewards funded to the gauge.
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// distributedCoins are the rewards already distributed to the lock owners.
+	DistributedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=distributedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributedCoins"`
+	// numEpochs is the number of epochs the rewards are distributed over.
+	NumEpochs uint64 `protobuf:"varint,6,opt,name=numEpochs,proto3" json:"numEpochs,omitempty"`
+	// filledEpochs is the number of epochs already distributed.
+	FilledEpochs uint64 `protobuf:"varint,7,opt,name=filledEpochs,proto3" json:"filledEpochs,omitempty"`
 }
-func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+
+func (m *Gauge) Reset()         { *m = Gauge{} }
+func (m *Gauge) String() string { return proto.CompactTextString(m) }
+func (*Gauge) ProtoMessage()    {}
+func (*Gauge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23045ec30cd6e897, []int{5}
+}
+func (m *Gauge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Gauge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Gauge.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -268,430 +538,6380 @@ func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *GenesisState) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GenesisState.Merge(m, src)
+func (m *Gauge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Gauge.Merge(m, src)
 }
-func (m *GenesisState) XXX_Size() int {
+func (m *Gauge) XXX_Size() int {
 	return m.Size()
 }
-func (m *GenesisState) XXX_DiscardUnknown() {
-	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+func (m *Gauge) XXX_DiscardUnknown() {
+	xxx_messageInfo_Gauge.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+var xxx_messageInfo_Gauge proto.InternalMessageInfo
 
-func (m *GenesisState) GetPool() *Pool {
+func (m *Gauge) GetId() uint64 {
 	if m != nil {
-		return m.Pool
+		return m.Id
 	}
-	return nil
+	return 0
 }
 
-func (m *GenesisState) GetParams() Params {
+func (m *Gauge) GetPoolId() uint64 {
 	if m != nil {
-		return m.Params
+		return m.PoolId
 	}
-	return Params{}
+	return 0
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "cosmos.simpleswap.v1.Params")
-	proto.RegisterType((*LiquidityProvider)(nil), "cosmos.simpleswap.v1.LiquidityProvider")
-	proto.RegisterType((*Pool)(nil), "cosmos.simpleswap.v1.Pool")
-	proto.RegisterType((*GenesisState)(nil), "cosmos.simpleswap.v1.GenesisState")
+func (m *Gauge) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("cosmos/simpleswap/v1/types.proto", fileDescriptor_23045ec30cd6e897) }
+func (m *Gauge) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
 
-var fileDescriptor_23045ec30cd6e897 = []byte{
-	// 498 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xce, 0x91, 0xb4, 0xa2, 0x57, 0x84, 0x9a, 0xa3, 0x83, 0x1b, 0x15, 0x63, 0x65, 0x40, 0x51,
-	0x84, 0xce, 0x04, 0x24, 0x10, 0x2c, 0x88, 0x22, 0xca, 0xc2, 0x10, 0xb9, 0x4c, 0x2c, 0xe8, 0xec,
-	0x3c, 0x39, 0x27, 0xce, 0x7e, 0xc6, 0x77, 0x49, 0x95, 0x89, 0x9d, 0x89, 0x9f, 0xc1, 0xd8, 0x9f,
-	0xd1, 0xb1, 0x23, 0x12, 0x12, 0xa0, 0x64, 0xe8, 0x0f, 0xe0, 0x0f, 0x20, 0x9f, 0x4d, 0x1a, 0xe1,
-	0xb4, 0x4c, 0x2c, 0x96, 0xef, 0x7b, 0xdf, 0xf7, 0xee, 0xbd, 0xef, 0xbd, 0xa3, 0x5e, 0x84, 0x3a,
-	0x41, 0xed, 0x6b, 0x99, 0x64, 0x0a, 0xf4, 0xb1, 0xc8, 0xfc, 0xe9, 0xc0, 0x37, 0xb3, 0x0c, 0x34,
-	0xcf, 0x72, 0x34, 0xc8, 0x76, 0x4b, 0x06, 0xbf, 0x60, 0xf0, 0xe9, 0xa0, 0xe3, 0x56, 0xba, 0x50,
-	0x68, 0xf0, 0xa7, 0x83, 0x10, 0x8c, 0x18, 0xf8, 0x11, 0xca, 0xb4, 0x54, 0x75, 0xf6, 0xca, 0xf8,
-	0x3b, 0x7b, 0xf2, 0xab, 0x14, 0x65, 0x68, 0x37, 0xc6, 0x18, 0x4b, 0xbc, 0xf8, 0xab, 0xd0, 0xb6,
-	0x48, 0x64, 0x8a, 0xbe, 0xfd, 0x96, 0x50, 0xf7, 0x17, 0xa1, 0x9b, 0x43, 0x91, 0x8b, 0x44, 0xb3,
-	0x97, 0x74, 0xe7, 0x78, 0x2c, 0x0d, 0x28, 0xa9, 0x0d, 0x8c, 0x5e, 0xa0, 0x4c, 0xb5, 0x43, 0xbc,
-	0x66, 0x6f, 0xfb, 0xc1, 0x1e, 0xaf, 0x92, 0x17, 0x95, 0xf0, 0xaa, 0x12, 0x5e, 0x30, 0x82, 0x9a,
-	0x84, 0xdd, 0xa3, 0xed, 0xa2, 0x81, 0x43, 0x80, 0x21, 0xe4, 0x11, 0xa4, 0x46, 0xc4, 0xe0, 0x5c,
-	0xf3, 0x48, 0x6f, 0x23, 0xa8, 0x07, 0x58, 0x87, 0x5e, 0x1f, 0x41, 0x24, 0x13, 0xa1, 0xb4, 0xd3,
-	0xf4, 0x48, 0xaf, 0x19, 0x2c, 0xcf, 0xec, 0x09, 0xa5, 0x7a, 0x2c, 0x72, 0x78, 0x83, 0xef, 0x21,
-	0x75, 0x5a, 0x1e, 0xb9, 0xba, 0x94, 0x15, 0xf2, 0xd3, 0xdb, 0x9f, 0xce, 0x4f, 0xfa, 0x4e, 0xdd,
-	0xf7, 0xb2, 0xd5, 0xee, 0x37, 0x42, 0xdb, 0xaf, 0xe5, 0x87, 0x89, 0x1c, 0x49, 0x33, 0x1b, 0xe6,
-	0x38, 0x95, 0x23, 0xc8, 0xed, 0x7d, 0x46, 0x84, 0x0a, 0x8a, 0x74, 0x0e, 0xf9, 0xf7, 0x7d, 0x4b,
-	0x32, 0x7b, 0x4c, 0xb7, 0x32, 0x44, 0x75, 0x54, 0x54, 0x60, 0x9b, 0xbd, 0x52, 0x79, 0xc1, 0x65,
-	0x1e, 0xdd, 0x16, 0x51, 0x94, 0x4f, 0x60, 0x74, 0x08, 0xf0, 0xc7, 0x82, 0x55, 0x88, 0xdd, 0xa7,
-	0xb7, 0x62, 0x85, 0xa1, 0x50, 0x6a, 0xf6, 0x7c, 0x85, 0xd9, 0xb2, 0xcc, 0x75, 0xa1, 0xee, 0x0f,
-	0x42, 0x5b, 0x43, 0x44, 0xc5, 0xfa, 0x74, 0xc7, 0xa0, 0x11, 0x6a, 0x55, 0x47, 0xac, 0xae, 0x86,
-	0xb3, 0xbb, 0xf4, 0xa6, 0xc5, 0x96, 0xb6, 0xd8, 0x36, 0x9a, 0xc1, 0x5f, 0xe8, 0x7f, 0x1a, 0xd8,
-	0xfa, 0xad, 0xd9, 0xb8, 0x64, 0x6b, 0xba, 0x1f, 0xe9, 0x8d, 0x57, 0x90, 0x82, 0x96, 0xfa, 0xc8,
-	0x08, 0x03, 0x8c, 0xd3, 0x56, 0x61, 0x69, 0x35, 0xb3, 0x0e, 0x5f, 0xf7, 0x9c, 0x78, 0x61, 0x49,
-	0x60, 0x79, 0xec, 0x19, 0xdd, 0xcc, 0xec, 0x26, 0x54, 0xb3, 0xda, 0xbf, 0x44, 0x61, 0x39, 0x07,
-	0x5b, 0xa7, 0xdf, 0xef, 0x34, 0xbe, 0x9c, 0x9f, 0xf4, 0x49, 0x50, 0xc9, 0x0e, 0x1e, 0x9d, 0xce,
-	0x5d, 0x72, 0x36, 0x77, 0xc9, 0xcf, 0xb9, 0x4b, 0x3e, 0x2f, 0xdc, 0xc6, 0xd9, 0xc2, 0x6d, 0x7c,
-	0x5d, 0xb8, 0x8d, 0xb7, 0xfb, 0xb1, 0x34, 0xe3, 0x49, 0xc8, 0x23, 0x4c, 0xfc, 0xda, 0xfe, 0x85,
-	0x9b, 0xf6, 0xd5, 0x3d, 0xfc, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xd3, 0x5f, 0xd5, 0xf6, 0x13, 0x04,
-	0x00, 0x00,
+func (m *Gauge) GetDistributedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DistributedCoins
+	}
+	return nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Gauge) GetNumEpochs() uint64 {
+	if m != nil {
+		return m.NumEpochs
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Gauge) GetFilledEpochs() uint64 {
+	if m != nil {
+		return m.FilledEpochs
+	}
+	return 0
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ShareToken != nil {
-		{
-			size, err := m.ShareToken.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+// Rewards are the gauge rewards credited to a lock owner and not yet claimed, with the reward
+// weight of its locks.
+type Rewards struct {
+	// coins are the rewards settled and not yet claimed.
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// weight is the sum of the reward weights of the locks of the owner.
+	Weight cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=weight,proto3,customtype=cosmossdk.io/math.Int" json:"weight"`
+	// rewardsPerWeight is the rewards per unit of weight of the accumulator when the rewards were
+	// last settled, the weight earns the increase since then.
+	RewardsPerWeight github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=rewardsPerWeight,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewardsPerWeight"`
+}
+
+func (m *Rewards) Reset()         { *m = Rewards{} }
+func (m *Rewards) String() string { return proto.CompactTextString(m) }
+func (*Rewards) ProtoMessage()    {}
+func (*Rewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23045ec30cd6e897, []int{6}
+}
+func (m *Rewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Rewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Rewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Decimals != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Decimals))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.SwapFeePercentage != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.SwapFeePercentage))
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	if len(m.WhitelistedCoins) > 0 {
-		for iNdEx := len(m.WhitelistedCoins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.WhitelistedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *Rewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Rewards.Merge(m, src)
+}
+func (m *Rewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *Rewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_Rewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Rewards proto.InternalMessageInfo
+
+func (m *Rewards) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *LiquidityProvider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Rewards) GetRewardsPerWeight() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.RewardsPerWeight
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *LiquidityProvider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RewardAccumulator is the running total of the gauge rewards distributed per unit of reward
+// weight, with the total weight of the locks.
+type RewardAccumulator struct {
+	// totalWeight is the sum of the reward weights of all the locks.
+	TotalWeight cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=totalWeight,proto3,customtype=cosmossdk.io/math.Int" json:"totalWeight"`
+	// rewardsPerWeight are the rewards distributed per unit of weight since the genesis.
+	RewardsPerWeight github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=rewardsPerWeight,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewardsPerWeight"`
 }
 
-func (m *LiquidityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GloballyAccruedFees != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.GloballyAccruedFees))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.AccruedFees != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.AccruedFees))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.PoolShare != nil {
-		{
-			size, err := m.PoolShare.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.StableCoin != nil {
-		{
-			size, err := m.StableCoin.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+func (m *RewardAccumulator) Reset()         { *m = RewardAccumulator{} }
+func (m *RewardAccumulator) String() string { return proto.CompactTextString(m) }
+func (*RewardAccumulator) ProtoMessage()    {}
+func (*RewardAccumulator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23045ec30cd6e897, []int{7}
+}
+func (m *RewardAccumulator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardAccumulator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardAccumulator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RewardAccumulator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardAccumulator.Merge(m, src)
+}
+func (m *RewardAccumulator) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardAccumulator) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardAccumulator.DiscardUnknown(m)
 }
 
-func (m *Pool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RewardAccumulator proto.InternalMessageInfo
+
+func (m *RewardAccumulator) GetRewardsPerWeight() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.RewardsPerWeight
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DenomOrigin is the human-readable origin of a denom of the pool.
+type DenomOrigin struct {
+	// denom is the denom of the coins on this chain, e.g. ibc/<hash> for a bridged coin.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// origin is the transfer path trace of a bridged coin, e.g. transfer/channel-0/weth, or the denom
+	// itself for a native coin.
+	Origin string `protobuf:"bytes,2,opt,name=origin,proto3" json:"origin,omitempty"`
 }
 
-func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SwapFeePercentage != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.SwapFeePercentage))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.ShareToken != nil {
-		{
-			size, err := m.ShareToken.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+func (m *DenomOrigin) Reset()         { *m = DenomOrigin{} }
+func (m *DenomOrigin) String() string { return proto.CompactTextString(m) }
+func (*DenomOrigin) ProtoMessage()    {}
+func (*DenomOrigin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23045ec30cd6e897, []int{8}
+}
+func (m *DenomOrigin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomOrigin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomOrigin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Decimals != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Decimals))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.TotalLiquidity != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.TotalLiquidity))
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	if m.TotalAccruedFees != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.TotalAccruedFees))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *DenomOrigin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomOrigin.Merge(m, src)
+}
+func (m *DenomOrigin) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomOrigin) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomOrigin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomOrigin proto.InternalMessageInfo
+
+func (m *DenomOrigin) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DenomOrigin) GetOrigin() string {
+	if m != nil {
+		return m.Origin
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// AssetRate is the governance-set redemption rate of a whitelisted coin, i.e. the value of one
+// unit of the coin in the pool reference asset, e.g. 1.05 for a stkETH worth 1.05 ETH.
+type AssetRate struct {
+	Denom string                      `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Rate  cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *AssetRate) Reset()         { *m = AssetRate{} }
+func (m *AssetRate) String() string { return proto.CompactTextString(m) }
+func (*AssetRate) ProtoMessage()    {}
+func (*AssetRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23045ec30cd6e897, []int{9}
+}
+func (m *AssetRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.Pool != nil {
-		{
-			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *AssetRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetRate.Merge(m, src)
+}
+func (m *AssetRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetRate.DiscardUnknown(m)
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_AssetRate proto.InternalMessageInfo
+
+func (m *AssetRate) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.WhitelistedCoins) > 0 {
-		for _, e := range m.WhitelistedCoins {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+
+// PeriodLock holds pool shares locked by their owner for a duration.
+type PeriodLock struct {
+	// id is the unique identifier of the lock.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address that locked the shares.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// shares are the locked pool shares.
+	Shares types.Coin `protobuf:"bytes,3,opt,name=shares,proto3" json:"shares"`
+	// duration is the unbonding duration of the lock.
+	Duration time.Duration `protobuf:"bytes,4,opt,name=duration,proto3,stdduration" json:"duration"`
+	// endTime is the time the shares are released, zero until the unlock begins.
+	EndTime time.Time `protobuf:"bytes,5,opt,name=endTime,proto3,stdtime" json:"endTime"`
+	// rewardWeight is the weight of the lock in the gauge distribution, its shares times the
+	// multiplier of its duration when it was created.
+	RewardWeight cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=rewardWeight,proto3,customtype=cosmossdk.io/math.Int" json:"rewardWeight"`
+}
+
+func (m *PeriodLock) Reset()         { *m = PeriodLock{} }
+func (m *PeriodLock) String() string { return proto.CompactTextString(m) }
+func (*PeriodLock) ProtoMessage()    {}
+func (*PeriodLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23045ec30cd6e897, []int{10}
+}
+func (m *PeriodLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeriodLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeriodLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.SwapFeePercentage != 0 {
-		n += 1 + sovTypes(uint64(m.SwapFeePercentage))
-	}
-	if m.Decimals != 0 {
-		n += 1 + sovTypes(uint64(m.Decimals))
-	}
-	if m.ShareToken != nil {
-		l = m.ShareToken.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
+}
+func (m *PeriodLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeriodLock.Merge(m, src)
+}
+func (m *PeriodLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeriodLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeriodLock.DiscardUnknown(m)
 }
 
-func (m *LiquidityProvider) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.StableCoin != nil {
-		l = m.StableCoin.Size()
-		n += 1 + l + sovTypes(uint64(l))
+var xxx_messageInfo_PeriodLock proto.InternalMessageInfo
+
+func (m *PeriodLock) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if m.PoolShare != nil {
-		l = m.PoolShare.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *PeriodLock) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	if m.AccruedFees != 0 {
-		n += 1 + sovTypes(uint64(m.AccruedFees))
+	return ""
+}
+
+func (m *PeriodLock) GetShares() types.Coin {
+	if m != nil {
+		return m.Shares
 	}
-	if m.GloballyAccruedFees != 0 {
-		n += 1 + sovTypes(uint64(m.GloballyAccruedFees))
+	return types.Coin{}
+}
+
+func (m *PeriodLock) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
 	}
-	return n
+	return 0
 }
 
-func (m *Pool) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PeriodLock) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
 	}
-	var l int
-	_ = l
-	if m.TotalAccruedFees != 0 {
-		n += 1 + sovTypes(uint64(m.TotalAccruedFees))
+	return time.Time{}
+}
+
+// LimitOrder is an order of its owner to swap the escrowed input coin for the output denom once
+// the pool pays at least the limit price, swap fee included.
+type LimitOrder struct {
+	// id is the unique identifier of the order, the orders are filled in the order of their ids.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address that placed the order and receives the output.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// input is the escrowed coin to swap.
+	Input types.Coin `protobuf:"bytes,3,opt,name=input,proto3" json:"input"`
+	// outputDenom is the denom of the coin to receive.
+	OutputDenom string `protobuf:"bytes,4,opt,name=outputDenom,proto3" json:"outputDenom,omitempty"`
+	// limitPrice is the lowest output paid per unit of input, net of the swap fee, in the base
+	// units of the coins, e.g. 0.999 to sell 10 WETH for at least 9.99 ETH.
+	LimitPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=limitPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"limitPrice"`
+}
+
+func (m *LimitOrder) Reset()         { *m = LimitOrder{} }
+func (m *LimitOrder) String() string { return proto.CompactTextString(m) }
+func (*LimitOrder) ProtoMessage()    {}
+func (*LimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23045ec30cd6e897, []int{11}
+}
+func (m *LimitOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.TotalLiquidity != 0 {
-		n += 1 + sovTypes(uint64(m.TotalLiquidity))
+}
+func (m *LimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrder.Merge(m, src)
+}
+func (m *LimitOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitOrder proto.InternalMessageInfo
+
+func (m *LimitOrder) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if m.Decimals != 0 {
-		n += 1 + sovTypes(uint64(m.Decimals))
+	return 0
+}
+
+func (m *LimitOrder) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	if m.ShareToken != nil {
-		l = m.ShareToken.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	return ""
+}
+
+func (m *LimitOrder) GetInput() types.Coin {
+	if m != nil {
+		return m.Input
 	}
-	if m.SwapFeePercentage != 0 {
-		n += 1 + sovTypes(uint64(m.SwapFeePercentage))
+	return types.Coin{}
+}
+
+func (m *LimitOrder) GetOutputDenom() string {
+	if m != nil {
+		return m.OutputDenom
 	}
-	return n
+	return ""
 }
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+// DCAPlan is a plan of its owner to swap an escrowed amount of coins for the output denom in
+// slices, one every interval of blocks, to average the price of a large conversion.
+type DCAPlan struct {
+	// id is the unique identifier of the plan, the slices due at the same height are executed in
+	// the order of the ids.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address that created the plan and receives the output.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// total is the input coin escrowed when the plan was created.
+	Total types.Coin `protobuf:"bytes,3,opt,name=total,proto3" json:"total"`
+	// remaining is the escrowed input not yet swapped.
+	Remaining types.Coin `protobuf:"bytes,4,opt,name=remaining,proto3" json:"remaining"`
+	// sliceAmount is the input amount swapped by each execution, the last slice swaps the remainder.
+	SliceAmount cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=sliceAmount,proto3,customtype=cosmossdk.io/math.Int" json:"sliceAmount"`
+	// outputDenom is the denom of the coin to receive.
+	OutputDenom string `protobuf:"bytes,6,opt,name=outputDenom,proto3" json:"outputDenom,omitempty"`
+	// minOutputPerSlice is the lowest output of a full slice, net of the swap fee. A slice paying
+	// less is skipped and retried at the next execution.
+	MinOutputPerSlice cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=minOutputPerSlice,proto3,customtype=cosmossdk.io/math.Int" json:"minOutputPerSlice"`
+	// intervalBlocks is the number of blocks between two executions.
+	IntervalBlocks uint64 `protobuf:"varint,8,opt,name=intervalBlocks,proto3" json:"intervalBlocks,omitempty"`
+	// nextExecutionHeight is the height at the end of which the next slice is due.
+	NextExecutionHeight int64 `protobuf:"varint,9,opt,name=nextExecutionHeight,proto3" json:"nextExecutionHeight,omitempty"`
+	// consecutiveSkips is the number of slices skipped in a row since the last executed slice. The
+	// plan is closed and its escrow refunded once it reaches dcaPlanMaxSkips.
+	ConsecutiveSkips uint32 `protobuf:"varint,10,opt,name=consecutiveSkips,proto3" json:"consecutiveSkips,omitempty"`
+}
+
+func (m *DCAPlan) Reset()         { *m = DCAPlan{} }
+func (m *DCAPlan) String() string { return proto.CompactTextString(m) }
+func (*DCAPlan) ProtoMessage()    {}
+func (*DCAPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23045ec30cd6e897, []int{12}
+}
+func (m *DCAPlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DCAPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DCAPlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Pool != nil {
-		l = m.Pool.Size()
-		n += 1 + l + sovTypes(uint64(l))
+}
+func (m *DCAPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DCAPlan.Merge(m, src)
+}
+func (m *DCAPlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *DCAPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_DCAPlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DCAPlan proto.InternalMessageInfo
+
+func (m *DCAPlan) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+	return 0
 }
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *DCAPlan) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *DCAPlan) GetTotal() types.Coin {
+	if m != nil {
+		return m.Total
+	}
+	return types.Coin{}
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+
+func (m *DCAPlan) GetRemaining() types.Coin {
+	if m != nil {
+		return m.Remaining
+	}
+	return types.Coin{}
+}
+
+func (m *DCAPlan) GetOutputDenom() string {
+	if m != nil {
+		return m.OutputDenom
+	}
+	return ""
+}
+
+func (m *DCAPlan) GetIntervalBlocks() uint64 {
+	if m != nil {
+		return m.IntervalBlocks
+	}
+	return 0
+}
+
+func (m *DCAPlan) GetNextExecutionHeight() int64 {
+	if m != nil {
+		return m.NextExecutionHeight
+	}
+	return 0
+}
+
+func (m *DCAPlan) GetConsecutiveSkips() uint32 {
+	if m != nil {
+		return m.ConsecutiveSkips
+	}
+	return 0
+}
+
+// BatchSwap is a swap message queued in the batch auction mode, cleared at the end of the block.
+type BatchSwap struct {
+	// id is the unique identifier of the queued swap.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// trader is the address that swaps and receives the output.
+	Trader string `protobuf:"bytes,2,opt,name=trader,proto3" json:"trader,omitempty"`
+	// input is the escrowed coin to swap.
+	Input types.Coin `protobuf:"bytes,3,opt,name=input,proto3" json:"input"`
+	// outputDenom is the denom of the coin to receive.
+	OutputDenom string `protobuf:"bytes,4,opt,name=outputDenom,proto3" json:"outputDenom,omitempty"`
+	// maxSwapFeePercentage is the highest swap fee the trader accepts, the oracle surcharge
+	// included, 0 for no bound. The swap is refunded if the fee at clearing exceeds it.
+	MaxSwapFeePercentage int32 `protobuf:"varint,5,opt,name=maxSwapFeePercentage,proto3" json:"maxSwapFeePercentage,omitempty"`
+}
+
+func (m *BatchSwap) Reset()         { *m = BatchSwap{} }
+func (m *BatchSwap) String() string { return proto.CompactTextString(m) }
+func (*BatchSwap) ProtoMessage()    {}
+func (*BatchSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23045ec30cd6e897, []int{13}
+}
+func (m *BatchSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchSwap.Merge(m, src)
+}
+func (m *BatchSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchSwap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchSwap proto.InternalMessageInfo
+
+func (m *BatchSwap) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *BatchSwap) GetTrader() string {
+	if m != nil {
+		return m.Trader
+	}
+	return ""
+}
+
+func (m *BatchSwap) GetInput() types.Coin {
+	if m != nil {
+		return m.Input
+	}
+	return types.Coin{}
+}
+
+func (m *BatchSwap) GetOutputDenom() string {
+	if m != nil {
+		return m.OutputDenom
+	}
+	return ""
+}
+
+func (m *BatchSwap) GetMaxSwapFeePercentage() int32 {
+	if m != nil {
+		return m.MaxSwapFeePercentage
+	}
+	return 0
+}
+
+// PoolStatsBucket accumulates the swaps of a pair of coins during an hour, in the pool decimals.
+type PoolStatsBucket struct {
+	// startTime is the block time the hour of the bucket starts at.
+	StartTime time.Time `protobuf:"bytes,1,opt,name=startTime,proto3,stdtime" json:"startTime"`
+	// inputDenom is the denom of the coin swapped.
+	InputDenom string `protobuf:"bytes,2,opt,name=inputDenom,proto3" json:"inputDenom,omitempty"`
+	// outputDenom is the denom of the coin received.
+	OutputDenom string `protobuf:"bytes,3,opt,name=outputDenom,proto3" json:"outputDenom,omitempty"`
+	// volume is the value of the outputs of the swaps, before the swap fee.
+	Volume cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=volume,proto3,customtype=cosmossdk.io/math.Int" json:"volume"`
+	// fees are the swap fees accrued by the liquidity providers.
+	Fees cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=fees,proto3,customtype=cosmossdk.io/math.Int" json:"fees"`
+	// swapCount is the number of swaps.
+	SwapCount uint64 `protobuf:"varint,6,opt,name=swapCount,proto3" json:"swapCount,omitempty"`
+}
+
+func (m *PoolStatsBucket) Reset()         { *m = PoolStatsBucket{} }
+func (m *PoolStatsBucket) String() string { return proto.CompactTextString(m) }
+func (*PoolStatsBucket) ProtoMessage()    {}
+func (*PoolStatsBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23045ec30cd6e897, []int{14}
+}
+func (m *PoolStatsBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolStatsBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolStatsBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolStatsBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolStatsBucket.Merge(m, src)
+}
+func (m *PoolStatsBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolStatsBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolStatsBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolStatsBucket proto.InternalMessageInfo
+
+func (m *PoolStatsBucket) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *PoolStatsBucket) GetInputDenom() string {
+	if m != nil {
+		return m.InputDenom
+	}
+	return ""
+}
+
+func (m *PoolStatsBucket) GetOutputDenom() string {
+	if m != nil {
+		return m.OutputDenom
+	}
+	return ""
+}
+
+func (m *PoolStatsBucket) GetSwapCount() uint64 {
+	if m != nil {
+		return m.SwapCount
+	}
+	return 0
+}
+
+// GenesisState is the state that must be provided at genesis.
+type GenesisState struct {
+	// Pool is the liquidity pool invovlved in our simpleswap module.
+	Pool *Pool `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	// params defines all the parameters of the module.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// liquidityProviders are the liquidity providers of the pool by address.
+	LiquidityProviders []GenesisLiquidityProvider `protobuf:"bytes,3,rep,name=liquidityProviders,proto3" json:"liquidityProviders"`
+	// coinsReserve are the reserves of the pool.
+	CoinsReserve []types.Coin `protobuf:"bytes,4,rep,name=coinsReserve,proto3" json:"coinsReserve"`
+	// gauges are the incentive gauges and gaugeSequence the next gauge id.
+	Gauges        []Gauge `protobuf:"bytes,5,rep,name=gauges,proto3" json:"gauges"`
+	GaugeSequence uint64  `protobuf:"varint,6,opt,name=gaugeSequence,proto3" json:"gaugeSequence,omitempty"`
+	// rewards are the unclaimed gauge rewards by address.
+	Rewards []GenesisRewards `protobuf:"bytes,7,rep,name=rewards,proto3" json:"rewards"`
+	// locks are the share locks and lockSequence the next lock id.
+	Locks        []PeriodLock `protobuf:"bytes,8,rep,name=locks,proto3" json:"locks"`
+	LockSequence uint64       `protobuf:"varint,9,opt,name=lockSequence,proto3" json:"lockSequence,omitempty"`
+	// assetRates are the governance set rates of the whitelisted coins.
+	AssetRates []AssetRate `protobuf:"bytes,10,rep,name=assetRates,proto3" json:"assetRates"`
+	// limitOrders are the open limit orders and limitOrderSequence the next order id.
+	LimitOrders        []LimitOrder `protobuf:"bytes,11,rep,name=limitOrders,proto3" json:"limitOrders"`
+	LimitOrderSequence uint64       `protobuf:"varint,12,opt,name=limitOrderSequence,proto3" json:"limitOrderSequence,omitempty"`
+	// dcaPlans are the DCA plans and dcaPlanSequence the next plan id.
+	DcaPlans        []DCAPlan `protobuf:"bytes,13,rep,name=dcaPlans,proto3" json:"dcaPlans"`
+	DcaPlanSequence uint64    `protobuf:"varint,14,opt,name=dcaPlanSequence,proto3" json:"dcaPlanSequence,omitempty"`
+	// batchSwapSequence is the next batch swap id, the batch swaps are cleared at the end of every block.
+	BatchSwapSequence uint64 `protobuf:"varint,15,opt,name=batchSwapSequence,proto3" json:"batchSwapSequence,omitempty"`
+	// poolStats are the hourly pool stats buckets kept for the retention of the params.
+	PoolStats []PoolStatsBucket `protobuf:"bytes,16,rep,name=poolStats,proto3" json:"poolStats"`
+	// rewardAccumulator is the gauge rewards distributed per unit of weight and the total weight of
+	// the locks.
+	RewardAccumulator RewardAccumulator `protobuf:"bytes,17,opt,name=rewardAccumulator,proto3" json:"rewardAccumulator"`
+	// limitOrderCursor is the position of the EndBlocker among the limit orders, unset until the
+	// first orders are tried.
+	LimitOrderCursor *LimitOrderCursor `protobuf:"bytes,18,opt,name=limitOrderCursor,proto3" json:"limitOrderCursor,omitempty"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23045ec30cd6e897, []int{15}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetPool() *Pool {
+	if m != nil {
+		return m.Pool
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetLiquidityProviders() []GenesisLiquidityProvider {
+	if m != nil {
+		return m.LiquidityProviders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCoinsReserve() []types.Coin {
+	if m != nil {
+		return m.CoinsReserve
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGauges() []Gauge {
+	if m != nil {
+		return m.Gauges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGaugeSequence() uint64 {
+	if m != nil {
+		return m.GaugeSequence
+	}
+	return 0
+}
+
+func (m *GenesisState) GetRewards() []GenesisRewards {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLocks() []PeriodLock {
+	if m != nil {
+		return m.Locks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLockSequence() uint64 {
+	if m != nil {
+		return m.LockSequence
+	}
+	return 0
+}
+
+func (m *GenesisState) GetAssetRates() []AssetRate {
+	if m != nil {
+		return m.AssetRates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLimitOrders() []LimitOrder {
+	if m != nil {
+		return m.LimitOrders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLimitOrderSequence() uint64 {
+	if m != nil {
+		return m.LimitOrderSequence
+	}
+	return 0
+}
+
+func (m *GenesisState) GetDcaPlans() []DCAPlan {
+	if m != nil {
+		return m.DcaPlans
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDcaPlanSequence() uint64 {
+	if m != nil {
+		return m.DcaPlanSequence
+	}
+	return 0
+}
+
+func (m *GenesisState) GetBatchSwapSequence() uint64 {
+	if m != nil {
+		return m.BatchSwapSequence
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPoolStats() []PoolStatsBucket {
+	if m != nil {
+		return m.PoolStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardAccumulator() RewardAccumulator {
+	if m != nil {
+		return m.RewardAccumulator
+	}
+	return RewardAccumulator{}
+}
+
+func (m *GenesisState) GetLimitOrderCursor() *LimitOrderCursor {
+	if m != nil {
+		return m.LimitOrderCursor
+	}
+	return nil
+}
+
+// LimitOrderCursor is the position of the EndBlocker among the limit orders, in the genesis.
+type LimitOrderCursor struct {
+	// lastOrderId is the id of the last limit order tried, the next block resumes after it.
+	LastOrderId uint64 `protobuf:"varint,1,opt,name=lastOrderId,proto3" json:"lastOrderId,omitempty"`
+}
+
+func (m *LimitOrderCursor) Reset()         { *m = LimitOrderCursor{} }
+func (m *LimitOrderCursor) String() string { return proto.CompactTextString(m) }
+func (*LimitOrderCursor) ProtoMessage()    {}
+func (*LimitOrderCursor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23045ec30cd6e897, []int{16}
+}
+func (m *LimitOrderCursor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrderCursor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrderCursor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LimitOrderCursor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrderCursor.Merge(m, src)
+}
+func (m *LimitOrderCursor) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrderCursor) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrderCursor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitOrderCursor proto.InternalMessageInfo
+
+func (m *LimitOrderCursor) GetLastOrderId() uint64 {
+	if m != nil {
+		return m.LastOrderId
+	}
+	return 0
+}
+
+// GenesisLiquidityProvider is a liquidity provider of the pool with its address, in the genesis.
+type GenesisLiquidityProvider struct {
+	Address           string            `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	LiquidityProvider LiquidityProvider `protobuf:"bytes,2,opt,name=liquidityProvider,proto3" json:"liquidityProvider"`
+}
+
+func (m *GenesisLiquidityProvider) Reset()         { *m = GenesisLiquidityProvider{} }
+func (m *GenesisLiquidityProvider) String() string { return proto.CompactTextString(m) }
+func (*GenesisLiquidityProvider) ProtoMessage()    {}
+func (*GenesisLiquidityProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23045ec30cd6e897, []int{17}
+}
+func (m *GenesisLiquidityProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisLiquidityProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisLiquidityProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisLiquidityProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisLiquidityProvider.Merge(m, src)
+}
+func (m *GenesisLiquidityProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisLiquidityProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisLiquidityProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisLiquidityProvider proto.InternalMessageInfo
+
+func (m *GenesisLiquidityProvider) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GenesisLiquidityProvider) GetLiquidityProvider() LiquidityProvider {
+	if m != nil {
+		return m.LiquidityProvider
+	}
+	return LiquidityProvider{}
+}
+
+// GenesisRewards are the unclaimed gauge rewards of an address, in the genesis.
+type GenesisRewards struct {
+	Address string  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Rewards Rewards `protobuf:"bytes,2,opt,name=rewards,proto3" json:"rewards"`
+}
+
+func (m *GenesisRewards) Reset()         { *m = GenesisRewards{} }
+func (m *GenesisRewards) String() string { return proto.CompactTextString(m) }
+func (*GenesisRewards) ProtoMessage()    {}
+func (*GenesisRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23045ec30cd6e897, []int{18}
+}
+func (m *GenesisRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisRewards.Merge(m, src)
+}
+func (m *GenesisRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisRewards proto.InternalMessageInfo
+
+func (m *GenesisRewards) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GenesisRewards) GetRewards() Rewards {
+	if m != nil {
+		return m.Rewards
+	}
+	return Rewards{}
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.simpleswap.v1.OracleGuardMode", OracleGuardMode_name, OracleGuardMode_value)
+	proto.RegisterType((*Params)(nil), "cosmos.simpleswap.v1.Params")
+	proto.RegisterType((*AssetExponent)(nil), "cosmos.simpleswap.v1.AssetExponent")
+	proto.RegisterType((*LockDuration)(nil), "cosmos.simpleswap.v1.LockDuration")
+	proto.RegisterType((*LiquidityProvider)(nil), "cosmos.simpleswap.v1.LiquidityProvider")
+	proto.RegisterType((*Pool)(nil), "cosmos.simpleswap.v1.Pool")
+	proto.RegisterType((*Gauge)(nil), "cosmos.simpleswap.v1.Gauge")
+	proto.RegisterType((*Rewards)(nil), "cosmos.simpleswap.v1.Rewards")
+	proto.RegisterType((*RewardAccumulator)(nil), "cosmos.simpleswap.v1.RewardAccumulator")
+	proto.RegisterType((*DenomOrigin)(nil), "cosmos.simpleswap.v1.DenomOrigin")
+	proto.RegisterType((*AssetRate)(nil), "cosmos.simpleswap.v1.AssetRate")
+	proto.RegisterType((*PeriodLock)(nil), "cosmos.simpleswap.v1.PeriodLock")
+	proto.RegisterType((*LimitOrder)(nil), "cosmos.simpleswap.v1.LimitOrder")
+	proto.RegisterType((*DCAPlan)(nil), "cosmos.simpleswap.v1.DCAPlan")
+	proto.RegisterType((*BatchSwap)(nil), "cosmos.simpleswap.v1.BatchSwap")
+	proto.RegisterType((*PoolStatsBucket)(nil), "cosmos.simpleswap.v1.PoolStatsBucket")
+	proto.RegisterType((*GenesisState)(nil), "cosmos.simpleswap.v1.GenesisState")
+	proto.RegisterType((*LimitOrderCursor)(nil), "cosmos.simpleswap.v1.LimitOrderCursor")
+	proto.RegisterType((*GenesisLiquidityProvider)(nil), "cosmos.simpleswap.v1.GenesisLiquidityProvider")
+	proto.RegisterType((*GenesisRewards)(nil), "cosmos.simpleswap.v1.GenesisRewards")
+}
+
+func init() { proto.RegisterFile("cosmos/simpleswap/v1/types.proto", fileDescriptor_23045ec30cd6e897) }
+
+var fileDescriptor_23045ec30cd6e897 = []byte{
+	// 2063 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcf, 0x6f, 0x1b, 0xc7,
+	0xf5, 0xd7, 0x52, 0x24, 0x25, 0x3e, 0xea, 0x07, 0x35, 0x5f, 0xc7, 0x58, 0xcb, 0x36, 0x45, 0xec,
+	0x37, 0x49, 0x09, 0xb7, 0x26, 0x6d, 0x35, 0x75, 0xdb, 0x34, 0x68, 0x41, 0x8a, 0x8c, 0x2c, 0x43,
+	0xaa, 0x88, 0xa5, 0x93, 0x02, 0x3d, 0xd4, 0x1d, 0xee, 0x8e, 0xa8, 0x81, 0xf6, 0x07, 0xb3, 0x33,
+	0x4b, 0xc9, 0x40, 0x4f, 0xb9, 0x34, 0xe8, 0x29, 0xc7, 0xde, 0x8b, 0x02, 0x45, 0xd1, 0x83, 0x0f,
+	0x39, 0x14, 0xe8, 0xa5, 0xc7, 0xa0, 0xa7, 0x20, 0xa7, 0xa2, 0x05, 0xe2, 0xc2, 0x3e, 0xe4, 0xd0,
+	0x02, 0xfd, 0x17, 0x8a, 0x99, 0x1d, 0x2e, 0x97, 0x5c, 0x52, 0xb2, 0xe5, 0xba, 0x17, 0x89, 0xfb,
+	0xe6, 0xbd, 0x37, 0xf3, 0xde, 0xbc, 0xf9, 0xbc, 0xcf, 0x0c, 0x54, 0x2c, 0x9f, 0xb9, 0x3e, 0xab,
+	0x33, 0xea, 0x0e, 0x1c, 0xc2, 0x4e, 0xf1, 0xa0, 0x3e, 0xbc, 0x5b, 0xe7, 0x8f, 0x07, 0x84, 0xd5,
+	0x06, 0x81, 0xcf, 0x7d, 0x74, 0x25, 0xd2, 0xa8, 0x8d, 0x35, 0x6a, 0xc3, 0xbb, 0x9b, 0x65, 0x65,
+	0xd7, 0xc3, 0x8c, 0xd4, 0x87, 0x77, 0x7b, 0x84, 0xe3, 0xbb, 0x75, 0xcb, 0xa7, 0x5e, 0x64, 0xb5,
+	0x79, 0x2d, 0x1a, 0x7f, 0x24, 0xbf, 0xea, 0xca, 0x45, 0x34, 0x74, 0xa5, 0xef, 0xf7, 0xfd, 0x48,
+	0x2e, 0x7e, 0x29, 0xe9, 0x06, 0x76, 0xa9, 0xe7, 0xd7, 0xe5, 0x5f, 0x25, 0x2a, 0xf7, 0x7d, 0xbf,
+	0xef, 0x90, 0xba, 0xfc, 0xea, 0x85, 0x47, 0x75, 0x3b, 0x0c, 0x30, 0xa7, 0xfe, 0x68, 0x8e, 0xad,
+	0xe9, 0x71, 0x4e, 0x5d, 0xc2, 0x38, 0x76, 0x07, 0x91, 0x82, 0xf1, 0xc7, 0x65, 0xc8, 0x77, 0x70,
+	0x80, 0x5d, 0x86, 0xda, 0x50, 0x3a, 0x3d, 0xa6, 0x9c, 0x38, 0x94, 0x71, 0x62, 0xef, 0xf8, 0xd4,
+	0x63, 0xba, 0x56, 0x59, 0xac, 0x16, 0xb7, 0xaf, 0xd5, 0xd4, 0xea, 0x44, 0x28, 0x35, 0x15, 0x4a,
+	0x4d, 0x68, 0x98, 0x29, 0x13, 0xf4, 0x2d, 0xd8, 0x10, 0x19, 0x78, 0x9f, 0x90, 0x0e, 0x09, 0x2c,
+	0xe2, 0x71, 0xdc, 0x27, 0x7a, 0xa6, 0xa2, 0x55, 0x73, 0x66, 0x7a, 0x00, 0x6d, 0xc2, 0xb2, 0x4d,
+	0x2c, 0xea, 0x62, 0x87, 0xe9, 0x8b, 0x15, 0xad, 0xba, 0x68, 0xc6, 0xdf, 0xe8, 0x10, 0xd6, 0xfd,
+	0x00, 0x5b, 0x0e, 0xd9, 0x0d, 0x71, 0x60, 0x1f, 0xf8, 0x36, 0xd1, 0x73, 0x15, 0xad, 0xba, 0xb6,
+	0xfd, 0x56, 0x6d, 0x56, 0xc2, 0x6b, 0x87, 0x93, 0xca, 0xe6, 0xb4, 0x35, 0x3a, 0x02, 0x14, 0x89,
+	0x0e, 0xf0, 0x59, 0x8b, 0x0c, 0xa9, 0xcc, 0x94, 0x9e, 0xaf, 0x68, 0xd5, 0x42, 0xf3, 0xde, 0xe7,
+	0x5f, 0x6d, 0x2d, 0xfc, 0xed, 0xab, 0xad, 0xeb, 0x91, 0x6b, 0x66, 0x9f, 0xd4, 0xa8, 0x5f, 0x77,
+	0x31, 0x3f, 0xae, 0xed, 0x93, 0x3e, 0xb6, 0x1e, 0xb7, 0x88, 0xf5, 0xe5, 0x67, 0xb7, 0x41, 0xcd,
+	0xdc, 0x22, 0xd6, 0xef, 0xbe, 0x7e, 0x72, 0x4b, 0x33, 0x67, 0x78, 0x44, 0xef, 0xc0, 0x1b, 0xd4,
+	0x13, 0x11, 0xd2, 0x21, 0x61, 0xed, 0x81, 0x6f, 0x1d, 0x37, 0x1d, 0xdf, 0x3a, 0x61, 0xfa, 0x92,
+	0x8c, 0x70, 0xf6, 0x20, 0xea, 0xc2, 0xaa, 0xf8, 0xd1, 0x52, 0x3b, 0xc8, 0xf4, 0x65, 0x99, 0x7c,
+	0x63, 0x76, 0xb0, 0xfb, 0x09, 0xd5, 0x66, 0x41, 0x2c, 0x3e, 0x5a, 0xcf, 0xa4, 0x0f, 0xf4, 0x21,
+	0xac, 0x61, 0xc6, 0x08, 0x6f, 0x9f, 0x0d, 0x7c, 0x8f, 0x78, 0x9c, 0xe9, 0x05, 0xe9, 0xf5, 0xff,
+	0x67, 0x7b, 0x6d, 0x24, 0x75, 0x93, 0x6e, 0xa7, 0xbc, 0xa0, 0x37, 0x61, 0xf5, 0x88, 0x90, 0xee,
+	0x29, 0x1e, 0xb4, 0x88, 0xe7, 0xbb, 0x4c, 0x87, 0xca, 0x62, 0xb5, 0x60, 0x4e, 0x0a, 0x45, 0xc2,
+	0x95, 0xe0, 0x00, 0x9f, 0x75, 0x1d, 0x3a, 0x18, 0x88, 0x62, 0x28, 0xbe, 0x5a, 0xc2, 0xd3, 0x1e,
+	0x91, 0x01, 0x2b, 0x3d, 0xcc, 0xad, 0xe3, 0x46, 0x68, 0xc9, 0x2d, 0x5d, 0xa9, 0x68, 0xd5, 0x65,
+	0x73, 0x42, 0x86, 0xee, 0xc1, 0xd5, 0x23, 0x07, 0xb3, 0xe3, 0x6e, 0xaa, 0x38, 0x57, 0x65, 0x71,
+	0xce, 0x19, 0x45, 0x1d, 0x58, 0x63, 0x1c, 0x73, 0x66, 0x12, 0x2e, 0x36, 0xcd, 0xf7, 0xf4, 0xb5,
+	0x8a, 0x26, 0x0f, 0x45, 0x74, 0xb6, 0x6a, 0xa3, 0xb3, 0x55, 0x8b, 0xb7, 0x63, 0x55, 0x84, 0xf6,
+	0xeb, 0xa7, 0x5b, 0x9a, 0xca, 0xdd, 0xa4, 0x3d, 0x7a, 0x0f, 0xae, 0x39, 0xd4, 0xa5, 0xfc, 0x30,
+	0xb0, 0x49, 0x70, 0x20, 0xd6, 0x48, 0x58, 0x87, 0x04, 0xb2, 0x0c, 0xf4, 0xf5, 0x8a, 0x56, 0x5d,
+	0x35, 0xe7, 0x2b, 0xa0, 0x2a, 0xac, 0xdb, 0x16, 0xee, 0x38, 0xd8, 0x13, 0x19, 0x38, 0xa1, 0x03,
+	0xa6, 0x97, 0xa4, 0xcd, 0xb4, 0x58, 0x9c, 0x44, 0xdb, 0xc2, 0x5d, 0x87, 0x5a, 0x09, 0xff, 0x1b,
+	0x52, 0x37, 0x3d, 0xf0, 0xee, 0xcd, 0x5f, 0x7d, 0xfd, 0xe4, 0x96, 0x9e, 0xc6, 0xba, 0x08, 0x1d,
+	0x1e, 0x64, 0x97, 0xb3, 0xa5, 0x9c, 0x09, 0xec, 0x18, 0x07, 0xe4, 0xa1, 0x7f, 0x42, 0x3c, 0xa3,
+	0x01, 0xab, 0x13, 0xe5, 0x82, 0xae, 0x40, 0xce, 0x16, 0xfb, 0xae, 0x6b, 0x62, 0x83, 0xcd, 0xe8,
+	0x43, 0x9c, 0x70, 0xa2, 0x34, 0x24, 0x0c, 0xac, 0x9a, 0xf1, 0xb7, 0xf1, 0x07, 0x0d, 0x56, 0x92,
+	0x85, 0x8c, 0x5a, 0xb0, 0x3c, 0x42, 0x30, 0x5d, 0x7b, 0xc9, 0x34, 0xc7, 0x96, 0xe8, 0x43, 0x00,
+	0x37, 0x74, 0x38, 0x1d, 0x38, 0x94, 0x04, 0x7a, 0xe6, 0x95, 0xca, 0x2d, 0xe1, 0xc9, 0xf8, 0xbb,
+	0x06, 0x1b, 0xfb, 0xf4, 0xa3, 0x90, 0xda, 0x94, 0x3f, 0xee, 0x04, 0xfe, 0x90, 0xda, 0x24, 0x40,
+	0xdf, 0x07, 0x60, 0x1c, 0xf7, 0x1c, 0x22, 0xf0, 0x2f, 0x5e, 0xf5, 0x5c, 0xc4, 0x4c, 0x28, 0xa3,
+	0xef, 0x42, 0x61, 0xe0, 0xfb, 0x4e, 0x57, 0x24, 0x55, 0xcf, 0x5c, 0x64, 0x39, 0xd6, 0x45, 0x15,
+	0x28, 0x62, 0xcb, 0x0a, 0x42, 0x62, 0xbf, 0x4f, 0xc8, 0x08, 0x39, 0x93, 0x22, 0x74, 0x07, 0xfe,
+	0xaf, 0xef, 0xf8, 0x3d, 0xec, 0x38, 0x8f, 0x1b, 0x09, 0xcd, 0xac, 0xd4, 0x9c, 0x35, 0x64, 0xfc,
+	0x5b, 0x83, 0x6c, 0xc7, 0xf7, 0x1d, 0x74, 0x0b, 0x4a, 0xdc, 0xe7, 0xd8, 0x49, 0xda, 0x69, 0xd2,
+	0x2e, 0x25, 0x47, 0x6f, 0xc3, 0x9a, 0x94, 0xc5, 0x69, 0x91, 0x61, 0x2c, 0x9a, 0x53, 0xd2, 0x73,
+	0x71, 0x5e, 0x24, 0x30, 0x2e, 0x2b, 0x3d, 0x7b, 0x51, 0x1a, 0x12, 0xca, 0xb3, 0x9b, 0x4d, 0x6e,
+	0x5e, 0xb3, 0x59, 0x83, 0x0c, 0xb5, 0x25, 0xde, 0x67, 0xcd, 0x0c, 0xb5, 0x8d, 0x5f, 0x2e, 0x42,
+	0x6e, 0x17, 0x87, 0xf1, 0x88, 0x36, 0x1a, 0x41, 0x57, 0x21, 0x2f, 0x92, 0xbd, 0x67, 0xcb, 0x70,
+	0xb2, 0xa6, 0xfa, 0x42, 0x35, 0xc8, 0xf9, 0xa7, 0x1e, 0x09, 0x64, 0x0c, 0x85, 0xa6, 0xfe, 0xe5,
+	0x67, 0xb7, 0x47, 0xcd, 0xbf, 0x61, 0xdb, 0x01, 0x61, 0xac, 0xcb, 0x03, 0xea, 0xf5, 0xcd, 0x48,
+	0x0d, 0x1d, 0x41, 0xce, 0x92, 0x8d, 0x34, 0x7b, 0x41, 0x23, 0x6d, 0x7e, 0x47, 0xd4, 0xe7, 0xef,
+	0x9f, 0x6e, 0x55, 0xfb, 0x94, 0x1f, 0x87, 0xbd, 0x9a, 0xe5, 0xbb, 0x8a, 0x13, 0xa8, 0x7f, 0xb7,
+	0x99, 0x7d, 0xa2, 0x58, 0x87, 0x30, 0x60, 0x51, 0x79, 0x46, 0xee, 0xd1, 0x2f, 0xa0, 0x64, 0x53,
+	0xc6, 0x03, 0xda, 0x0b, 0xe3, 0xde, 0x9d, 0x7b, 0x4d, 0x53, 0xa6, 0x66, 0x42, 0x37, 0xa0, 0xe0,
+	0x85, 0xae, 0xec, 0x65, 0x4c, 0xa5, 0x77, 0x2c, 0x10, 0xe0, 0x7c, 0x44, 0x1d, 0x87, 0xd8, 0x4a,
+	0x61, 0x49, 0x2a, 0x4c, 0xc8, 0x8c, 0x3f, 0x67, 0x60, 0xc9, 0x24, 0xa7, 0x38, 0xb0, 0xd9, 0x38,
+	0x67, 0xda, 0xeb, 0xcd, 0xd9, 0x7d, 0xc8, 0x9f, 0x12, 0xda, 0x3f, 0xe6, 0x0a, 0x21, 0xee, 0x28,
+	0x84, 0x78, 0x23, 0x8d, 0x10, 0x7b, 0x1e, 0x4f, 0x60, 0xc3, 0x9e, 0xc7, 0x23, 0x47, 0xca, 0x1e,
+	0x7d, 0xac, 0x41, 0x29, 0x88, 0x56, 0xdf, 0x21, 0xc1, 0x4f, 0x22, 0xa7, 0x8b, 0x72, 0xf5, 0x37,
+	0x66, 0xae, 0xbe, 0x45, 0x2c, 0x19, 0xc0, 0xf7, 0x54, 0x00, 0xdf, 0x7c, 0x81, 0x00, 0x94, 0xcd,
+	0x68, 0x13, 0xa6, 0xe7, 0x33, 0xfe, 0xa5, 0xc1, 0x46, 0x94, 0xc2, 0x86, 0x65, 0x85, 0x6e, 0xe8,
+	0x60, 0xee, 0x07, 0xc8, 0x84, 0xa2, 0x3c, 0x89, 0x6a, 0x51, 0xda, 0x25, 0x23, 0x4d, 0x3a, 0x99,
+	0x1d, 0x6e, 0xe6, 0x7f, 0x1c, 0xee, 0x0f, 0xa0, 0x28, 0x49, 0xc6, 0x61, 0x40, 0xfb, 0xd4, 0x9b,
+	0xd3, 0x7b, 0xae, 0x42, 0xde, 0x97, 0xe3, 0xd1, 0x16, 0x9b, 0xea, 0xcb, 0x70, 0xa1, 0x20, 0x5b,
+	0x97, 0x89, 0x39, 0x99, 0x63, 0xfa, 0x00, 0xb2, 0x01, 0xe6, 0xe4, 0x15, 0xbb, 0x87, 0xf4, 0x61,
+	0xfc, 0x33, 0x03, 0xd0, 0x21, 0x01, 0xf5, 0x6d, 0xd1, 0xec, 0x52, 0x60, 0x13, 0x83, 0x4a, 0xe6,
+	0xc5, 0x40, 0xe5, 0x3d, 0xc8, 0x4b, 0x08, 0x8c, 0x90, 0xf4, 0xdc, 0x13, 0x92, 0x60, 0x70, 0xca,
+	0x66, 0xa2, 0xc5, 0x66, 0x2f, 0xdd, 0x62, 0x77, 0x60, 0x89, 0x78, 0xf6, 0x43, 0xea, 0x46, 0x70,
+	0x5b, 0xdc, 0xde, 0x4c, 0x39, 0x79, 0x38, 0xba, 0x6a, 0x44, 0x5e, 0x3e, 0x8d, 0xbd, 0x8c, 0x2c,
+	0xd1, 0x43, 0x58, 0x89, 0xf6, 0x55, 0xd5, 0x50, 0xfe, 0x92, 0xd5, 0x39, 0xe1, 0xc5, 0xf8, 0x38,
+	0x03, 0xb0, 0x1f, 0xd3, 0xa7, 0x57, 0xce, 0xf6, 0xbb, 0x90, 0xa3, 0xde, 0x20, 0xe4, 0x2f, 0x95,
+	0xec, 0xc8, 0x44, 0xb4, 0x69, 0x3f, 0xe4, 0x83, 0x90, 0xcb, 0x52, 0x95, 0xe9, 0x2e, 0x98, 0x49,
+	0x91, 0xa0, 0x2a, 0x92, 0xea, 0x75, 0x02, 0x6a, 0x45, 0xa9, 0x7c, 0x05, 0xaa, 0x32, 0xf6, 0x64,
+	0xfc, 0x36, 0x0b, 0x4b, 0xad, 0x9d, 0x86, 0xe0, 0x83, 0xff, 0x8d, 0x0c, 0xc8, 0xe3, 0xff, 0x72,
+	0x19, 0x90, 0x26, 0xa8, 0x09, 0x85, 0x80, 0xb8, 0x98, 0x7a, 0xd4, 0xeb, 0xeb, 0xd9, 0x97, 0xb0,
+	0x1f, 0x9b, 0x09, 0x0c, 0x63, 0x82, 0xab, 0x36, 0x5c, 0x3f, 0xf4, 0xb8, 0x9e, 0xbb, 0x64, 0x95,
+	0x24, 0x9d, 0x4c, 0xef, 0x4c, 0x3e, 0xbd, 0x33, 0x3f, 0x83, 0x0d, 0x97, 0x7a, 0x87, 0x52, 0xd2,
+	0x21, 0x81, 0x64, 0xcb, 0xfa, 0xd2, 0x25, 0xe7, 0x4e, 0xbb, 0x12, 0xcc, 0x89, 0x7a, 0x9c, 0x04,
+	0x43, 0xec, 0xa8, 0xdb, 0xe1, 0xb2, 0xdc, 0xa1, 0x29, 0xa9, 0x20, 0x72, 0x1e, 0x39, 0xe3, 0xed,
+	0x33, 0x62, 0x85, 0xe2, 0xe8, 0xdd, 0x8f, 0xce, 0x4a, 0x21, 0x22, 0x72, 0x33, 0x86, 0x04, 0x7f,
+	0xb3, 0x7c, 0x8f, 0x49, 0xe1, 0x90, 0x44, 0x57, 0x04, 0x90, 0xcc, 0x3b, 0x25, 0x37, 0x9e, 0x6a,
+	0x50, 0x68, 0x8a, 0x1b, 0x86, 0xb8, 0xf8, 0xa4, 0x2a, 0xe5, 0x0e, 0xe4, 0x79, 0x80, 0xed, 0x17,
+	0x28, 0x15, 0xa5, 0xf7, 0x9a, 0x4f, 0xcb, 0x36, 0x5c, 0x71, 0xf1, 0x59, 0x77, 0x0e, 0xe3, 0x9b,
+	0x39, 0x66, 0xfc, 0x25, 0x03, 0xeb, 0x82, 0xd6, 0x76, 0xc5, 0x25, 0xac, 0x19, 0x5a, 0x27, 0x84,
+	0xa3, 0x5d, 0x28, 0x30, 0x8e, 0x03, 0x2e, 0xf1, 0x4b, 0x7b, 0x59, 0xfc, 0x1a, 0xdb, 0xa2, 0x32,
+	0x00, 0xf5, 0x46, 0xcb, 0x53, 0x4d, 0x26, 0x21, 0x99, 0x0e, 0x69, 0x31, 0x1d, 0xd2, 0x7d, 0xc8,
+	0x0f, 0x7d, 0x27, 0x74, 0x89, 0x9e, 0xbd, 0x64, 0x6d, 0x29, 0x7b, 0xd4, 0x82, 0xec, 0x91, 0xa0,
+	0xea, 0x97, 0x3d, 0x1f, 0xd2, 0x5a, 0x70, 0x39, 0x41, 0x9c, 0x77, 0xe4, 0x51, 0x53, 0x5c, 0x2e,
+	0x16, 0x18, 0x7f, 0x2a, 0xc0, 0xca, 0x2e, 0xf1, 0x08, 0xa3, 0x4c, 0xe4, 0x93, 0xa0, 0x1a, 0x64,
+	0x05, 0x35, 0x8e, 0x93, 0x38, 0xf3, 0x55, 0x41, 0xa4, 0xdf, 0x94, 0x7a, 0xe8, 0x47, 0x90, 0x1f,
+	0xc8, 0x0b, 0xa5, 0xba, 0xee, 0xdc, 0x98, 0x63, 0x21, 0x75, 0x26, 0xda, 0x57, 0x64, 0x86, 0x28,
+	0x20, 0x67, 0xfa, 0x0a, 0xc6, 0x14, 0xd9, 0xaa, 0xcd, 0x76, 0xa6, 0x16, 0x9c, 0xba, 0xb9, 0x25,
+	0xdd, 0xcf, 0x70, 0x8a, 0xee, 0xc3, 0x8a, 0x64, 0x8a, 0x26, 0x61, 0x24, 0x18, 0x92, 0x8b, 0x39,
+	0x7c, 0xc2, 0xdf, 0x84, 0x25, 0xfa, 0x21, 0xe4, 0xfb, 0xe2, 0x9e, 0x31, 0x22, 0xe5, 0xd7, 0xe7,
+	0x2c, 0x54, 0xe8, 0x4c, 0x04, 0x1d, 0x59, 0x89, 0xd7, 0x16, 0xf9, 0xab, 0x4b, 0x3e, 0x0a, 0x89,
+	0x67, 0x11, 0xb5, 0x31, 0x93, 0x42, 0xb4, 0x07, 0x4b, 0x8a, 0x26, 0xe9, 0x4b, 0x72, 0x9a, 0x37,
+	0xcf, 0xcd, 0x87, 0xe2, 0xdb, 0xc9, 0xf9, 0x46, 0xf6, 0xa8, 0x01, 0xb9, 0x11, 0x26, 0x09, 0x47,
+	0x95, 0x39, 0xbb, 0x14, 0x73, 0x9a, 0x89, 0xd3, 0x1c, 0xe1, 0x96, 0x01, 0x2b, 0xe2, 0x47, 0xbc,
+	0xe4, 0x42, 0x44, 0xfb, 0x93, 0x32, 0xf4, 0x00, 0x00, 0x8f, 0x78, 0x58, 0xf4, 0x84, 0x54, 0xdc,
+	0xde, 0x3a, 0xe7, 0x65, 0x4a, 0xe8, 0x25, 0xa7, 0x4a, 0x58, 0xa3, 0x03, 0x28, 0x8e, 0x1f, 0x4d,
+	0x98, 0x5e, 0x3c, 0x6f, 0xe1, 0x63, 0x7a, 0x90, 0xf4, 0x96, 0xb4, 0x47, 0x35, 0x40, 0xe3, 0xcf,
+	0x38, 0x88, 0x15, 0x19, 0xc4, 0x8c, 0x11, 0x49, 0xab, 0xa2, 0xf7, 0x17, 0xa6, 0xaf, 0xca, 0xb9,
+	0x6f, 0xce, 0x9e, 0x5b, 0x75, 0xe5, 0xe4, 0xc4, 0xb1, 0x65, 0xe2, 0x71, 0x27, 0x9e, 0x72, 0x4d,
+	0x4e, 0x39, 0x2d, 0x16, 0x37, 0xdf, 0xde, 0x08, 0xb7, 0x63, 0xdd, 0x75, 0xa9, 0x9b, 0x1e, 0x40,
+	0x3f, 0x56, 0x0f, 0x0d, 0x02, 0x03, 0xf5, 0x92, 0x5c, 0xde, 0x5b, 0xf3, 0xcf, 0x6a, 0x02, 0x2a,
+	0x27, 0x5a, 0x72, 0xec, 0x02, 0xfd, 0x1c, 0x36, 0x82, 0xe9, 0xbb, 0x86, 0x7c, 0x5a, 0x2a, 0x6e,
+	0x7f, 0x63, 0xb6, 0xdf, 0xd4, 0xd5, 0x24, 0xe9, 0x39, 0xed, 0x0c, 0x99, 0x50, 0x1a, 0x67, 0x79,
+	0x27, 0x0c, 0x98, 0x1f, 0xe8, 0x48, 0x4e, 0xf0, 0xf6, 0x45, 0x7b, 0x1a, 0x69, 0x9b, 0x29, 0x7b,
+	0xe3, 0x1d, 0x28, 0x4d, 0x6b, 0x09, 0x84, 0x76, 0x30, 0x8b, 0x44, 0x7b, 0xa3, 0xde, 0x97, 0x14,
+	0x19, 0x4f, 0x34, 0xd0, 0xe7, 0x41, 0x08, 0xda, 0x86, 0x25, 0x1c, 0x35, 0x42, 0x5d, 0xbb, 0xa0,
+	0x45, 0x8e, 0x14, 0x45, 0xf2, 0x52, 0x68, 0xa3, 0x67, 0xce, 0x4b, 0xde, 0xb9, 0xd0, 0x95, 0x76,
+	0x66, 0x7c, 0xa2, 0xc1, 0xda, 0xe4, 0x29, 0xbf, 0xd4, 0x42, 0x9b, 0x63, 0x40, 0x89, 0x96, 0x77,
+	0xf3, 0xbc, 0xbd, 0x9d, 0x89, 0x24, 0xb7, 0x86, 0xb0, 0x3e, 0xf5, 0x2e, 0x8f, 0xca, 0xb0, 0x79,
+	0x68, 0x36, 0x76, 0xf6, 0xdb, 0x8f, 0x76, 0x3f, 0x68, 0x98, 0xad, 0x47, 0x07, 0x87, 0xad, 0xf6,
+	0xa3, 0xd6, 0x5e, 0xb7, 0xd1, 0xdc, 0x6f, 0xb7, 0x4a, 0x0b, 0xe8, 0x06, 0xe8, 0xe9, 0x71, 0xb3,
+	0xfd, 0xa0, 0xbd, 0xf3, 0xb0, 0xa4, 0xa1, 0x2d, 0xb8, 0x9e, 0x1e, 0xed, 0x7e, 0x60, 0xee, 0xdc,
+	0x6f, 0x98, 0xbb, 0xed, 0x52, 0x66, 0x33, 0xfb, 0xc9, 0x6f, 0xca, 0x0b, 0xcd, 0x7b, 0x9f, 0x3f,
+	0x2b, 0x6b, 0x5f, 0x3c, 0x2b, 0x6b, 0xff, 0x78, 0x56, 0xd6, 0x3e, 0x7d, 0x5e, 0x5e, 0xf8, 0xe2,
+	0x79, 0x79, 0xe1, 0xaf, 0xcf, 0xcb, 0x0b, 0x3f, 0xbd, 0x91, 0xbe, 0x7d, 0x8e, 0xc3, 0xe9, 0xe5,
+	0x65, 0xff, 0xff, 0xf6, 0x7f, 0x06, 0x00, 0xc2, 0xf9, 0x7e, 0x0c, 0xf6, 0x19, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DcaSlicesPerBlock != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DcaSlicesPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.DcaPlanMaxSkips != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DcaPlanMaxSkips))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.LimitOrderMatchesPerBlock != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LimitOrderMatchesPerBlock))
+		i--
+		dAtA[i] = 0x78
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.StatsRetention, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StatsRetention):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTypes(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x72
+	if m.FlashSwapFeePercentage != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FlashSwapFeePercentage))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.BatchAuction {
+		i--
+		if m.BatchAuction {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.FeeSwapMaxSlippage.Size()
+		i -= size
+		if _, err := m.FeeSwapMaxSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.FeeSwapDenoms) > 0 {
+		for iNdEx := len(m.FeeSwapDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FeeSwapDenoms[iNdEx])
+			copy(dAtA[i:], m.FeeSwapDenoms[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.FeeSwapDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.AssetExponents) > 0 {
+		for iNdEx := len(m.AssetExponents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssetExponents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.LockDurations) > 0 {
+		for iNdEx := len(m.LockDurations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockDurations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.IncentivesEpochBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.IncentivesEpochBlocks))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.OracleMaxDeviation.Size()
+		i -= size
+		if _, err := m.OracleMaxDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.OracleGuardMode != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.OracleGuardMode))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SwapFeePercentage != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SwapFeePercentage))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.WhitelistedCoins) > 0 {
+		for iNdEx := len(m.WhitelistedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WhitelistedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssetExponent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetExponent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetExponent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Exponent != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Exponent))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockDuration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockDuration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockDuration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTypes(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidityProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GloballyAccruedFees != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GloballyAccruedFees))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AccruedFees != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AccruedFees))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolShare != nil {
+		{
+			size, err := m.PoolShare.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StableCoin != nil {
+		{
+			size, err := m.StableCoin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Pool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SwapFeePercentage != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SwapFeePercentage))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ShareToken != nil {
+		{
+			size, err := m.ShareToken.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalLiquidity != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TotalLiquidity))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TotalAccruedFees != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TotalAccruedFees))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Gauge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Gauge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FilledEpochs != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FilledEpochs))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.NumEpochs != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NumEpochs))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.DistributedCoins) > 0 {
+		for iNdEx := len(m.DistributedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Rewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Rewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Rewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardsPerWeight) > 0 {
+		for iNdEx := len(m.RewardsPerWeight) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardsPerWeight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardAccumulator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardAccumulator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardAccumulator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardsPerWeight) > 0 {
+		for iNdEx := len(m.RewardsPerWeight) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardsPerWeight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.TotalWeight.Size()
+		i -= size
+		if _, err := m.TotalWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomOrigin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomOrigin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomOrigin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Origin) > 0 {
+		i -= len(m.Origin)
+		copy(dAtA[i:], m.Origin)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Origin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssetRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PeriodLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeriodLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeriodLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RewardWeight.Size()
+		i -= size
+		if _, err := m.RewardWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTypes(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x2a
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintTypes(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Shares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LimitPrice.Size()
+		i -= size
+		if _, err := m.LimitPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.OutputDenom) > 0 {
+		i -= len(m.OutputDenom)
+		copy(dAtA[i:], m.OutputDenom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.OutputDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DCAPlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DCAPlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DCAPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsecutiveSkips != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ConsecutiveSkips))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.NextExecutionHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NextExecutionHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.IntervalBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.IntervalBlocks))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.MinOutputPerSlice.Size()
+		i -= size
+		if _, err := m.MinOutputPerSlice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.OutputDenom) > 0 {
+		i -= len(m.OutputDenom)
+		copy(dAtA[i:], m.OutputDenom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.OutputDenom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.SliceAmount.Size()
+		i -= size
+		if _, err := m.SliceAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Remaining.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Total.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxSwapFeePercentage != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxSwapFeePercentage))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.OutputDenom) > 0 {
+		i -= len(m.OutputDenom)
+		copy(dAtA[i:], m.OutputDenom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.OutputDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Trader) > 0 {
+		i -= len(m.Trader)
+		copy(dAtA[i:], m.Trader)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Trader)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolStatsBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolStatsBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolStatsBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SwapCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SwapCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Fees.Size()
+		i -= size
+		if _, err := m.Fees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.OutputDenom) > 0 {
+		i -= len(m.OutputDenom)
+		copy(dAtA[i:], m.OutputDenom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.OutputDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.InputDenom) > 0 {
+		i -= len(m.InputDenom)
+		copy(dAtA[i:], m.InputDenom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.InputDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintTypes(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LimitOrderCursor != nil {
+		{
+			size, err := m.LimitOrderCursor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	{
+		size, err := m.RewardAccumulator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if len(m.PoolStats) > 0 {
+		for iNdEx := len(m.PoolStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.BatchSwapSequence != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BatchSwapSequence))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.DcaPlanSequence != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DcaPlanSequence))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.DcaPlans) > 0 {
+		for iNdEx := len(m.DcaPlans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DcaPlans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.LimitOrderSequence != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LimitOrderSequence))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.LimitOrders) > 0 {
+		for iNdEx := len(m.LimitOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.AssetRates) > 0 {
+		for iNdEx := len(m.AssetRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssetRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.LockSequence != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LockSequence))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.GaugeSequence != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GaugeSequence))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Gauges) > 0 {
+		for iNdEx := len(m.Gauges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Gauges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.CoinsReserve) > 0 {
+		for iNdEx := len(m.CoinsReserve) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoinsReserve[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.LiquidityProviders) > 0 {
+		for iNdEx := len(m.LiquidityProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Pool != nil {
+		{
+			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LimitOrderCursor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrderCursor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrderCursor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastOrderId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastOrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisLiquidityProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisLiquidityProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisLiquidityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LiquidityProvider.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.WhitelistedCoins) > 0 {
+		for _, e := range m.WhitelistedCoins {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.SwapFeePercentage != 0 {
+		n += 1 + sovTypes(uint64(m.SwapFeePercentage))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovTypes(uint64(m.Decimals))
+	}
+	if m.OracleGuardMode != 0 {
+		n += 1 + sovTypes(uint64(m.OracleGuardMode))
+	}
+	l = m.OracleMaxDeviation.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.IncentivesEpochBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.IncentivesEpochBlocks))
+	}
+	if len(m.LockDurations) > 0 {
+		for _, e := range m.LockDurations {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.AssetExponents) > 0 {
+		for _, e := range m.AssetExponents {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.FeeSwapDenoms) > 0 {
+		for _, s := range m.FeeSwapDenoms {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = m.FeeSwapMaxSlippage.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.BatchAuction {
+		n += 2
+	}
+	if m.FlashSwapFeePercentage != 0 {
+		n += 1 + sovTypes(uint64(m.FlashSwapFeePercentage))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StatsRetention)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.LimitOrderMatchesPerBlock != 0 {
+		n += 1 + sovTypes(uint64(m.LimitOrderMatchesPerBlock))
+	}
+	if m.DcaPlanMaxSkips != 0 {
+		n += 2 + sovTypes(uint64(m.DcaPlanMaxSkips))
+	}
+	if m.DcaSlicesPerBlock != 0 {
+		n += 2 + sovTypes(uint64(m.DcaSlicesPerBlock))
+	}
+	return n
+}
+
+func (m *AssetExponent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Exponent != 0 {
+		n += 1 + sovTypes(uint64(m.Exponent))
+	}
+	return n
+}
+
+func (m *LockDuration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Multiplier.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *LiquidityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StableCoin != nil {
+		l = m.StableCoin.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.PoolShare != nil {
+		l = m.PoolShare.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.AccruedFees != 0 {
+		n += 1 + sovTypes(uint64(m.AccruedFees))
+	}
+	if m.GloballyAccruedFees != 0 {
+		n += 1 + sovTypes(uint64(m.GloballyAccruedFees))
+	}
+	return n
+}
+
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalAccruedFees != 0 {
+		n += 1 + sovTypes(uint64(m.TotalAccruedFees))
+	}
+	if m.TotalLiquidity != 0 {
+		n += 1 + sovTypes(uint64(m.TotalLiquidity))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovTypes(uint64(m.Decimals))
+	}
+	if m.ShareToken != nil {
+		l = m.ShareToken.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SwapFeePercentage != 0 {
+		n += 1 + sovTypes(uint64(m.SwapFeePercentage))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *Gauge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTypes(uint64(m.PoolId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.DistributedCoins) > 0 {
+		for _, e := range m.DistributedCoins {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.NumEpochs != 0 {
+		n += 1 + sovTypes(uint64(m.NumEpochs))
+	}
+	if m.FilledEpochs != 0 {
+		n += 1 + sovTypes(uint64(m.FilledEpochs))
+	}
+	return n
+}
+
+func (m *Rewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.RewardsPerWeight) > 0 {
+		for _, e := range m.RewardsPerWeight {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RewardAccumulator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalWeight.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.RewardsPerWeight) > 0 {
+		for _, e := range m.RewardsPerWeight {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomOrigin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Origin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *AssetRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *PeriodLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.RewardWeight.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *LimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Input.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.OutputDenom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.LimitPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *DCAPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Total.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Remaining.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.SliceAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.OutputDenom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.MinOutputPerSlice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.IntervalBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.IntervalBlocks))
+	}
+	if m.NextExecutionHeight != 0 {
+		n += 1 + sovTypes(uint64(m.NextExecutionHeight))
+	}
+	if m.ConsecutiveSkips != 0 {
+		n += 1 + sovTypes(uint64(m.ConsecutiveSkips))
+	}
+	return n
+}
+
+func (m *BatchSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Input.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.OutputDenom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.MaxSwapFeePercentage != 0 {
+		n += 1 + sovTypes(uint64(m.MaxSwapFeePercentage))
+	}
+	return n
+}
+
+func (m *PoolStatsBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.InputDenom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.OutputDenom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Volume.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Fees.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.SwapCount != 0 {
+		n += 1 + sovTypes(uint64(m.SwapCount))
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pool != nil {
+		l = m.Pool.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.LiquidityProviders) > 0 {
+		for _, e := range m.LiquidityProviders {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.CoinsReserve) > 0 {
+		for _, e := range m.CoinsReserve {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Gauges) > 0 {
+		for _, e := range m.Gauges {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.GaugeSequence != 0 {
+		n += 1 + sovTypes(uint64(m.GaugeSequence))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.LockSequence != 0 {
+		n += 1 + sovTypes(uint64(m.LockSequence))
+	}
+	if len(m.AssetRates) > 0 {
+		for _, e := range m.AssetRates {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.LimitOrders) > 0 {
+		for _, e := range m.LimitOrders {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.LimitOrderSequence != 0 {
+		n += 1 + sovTypes(uint64(m.LimitOrderSequence))
+	}
+	if len(m.DcaPlans) > 0 {
+		for _, e := range m.DcaPlans {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.DcaPlanSequence != 0 {
+		n += 1 + sovTypes(uint64(m.DcaPlanSequence))
+	}
+	if m.BatchSwapSequence != 0 {
+		n += 1 + sovTypes(uint64(m.BatchSwapSequence))
+	}
+	if len(m.PoolStats) > 0 {
+		for _, e := range m.PoolStats {
+			l = e.Size()
+			n += 2 + l + sovTypes(uint64(l))
+		}
+	}
+	l = m.RewardAccumulator.Size()
+	n += 2 + l + sovTypes(uint64(l))
+	if m.LimitOrderCursor != nil {
+		l = m.LimitOrderCursor.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *LimitOrderCursor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastOrderId != 0 {
+		n += 1 + sovTypes(uint64(m.LastOrderId))
+	}
+	return n
+}
+
+func (m *GenesisLiquidityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.LiquidityProvider.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *GenesisRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Rewards.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WhitelistedCoins = append(m.WhitelistedCoins, &types.Coin{})
+			if err := m.WhitelistedCoins[len(m.WhitelistedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeePercentage", wireType)
+			}
+			m.SwapFeePercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwapFeePercentage |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleGuardMode", wireType)
+			}
+			m.OracleGuardMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleGuardMode |= OracleGuardMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleMaxDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OracleMaxDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivesEpochBlocks", wireType)
+			}
+			m.IncentivesEpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IncentivesEpochBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockDurations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockDurations = append(m.LockDurations, LockDuration{})
+			if err := m.LockDurations[len(m.LockDurations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetExponents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetExponents = append(m.AssetExponents, AssetExponent{})
+			if err := m.AssetExponents[len(m.AssetExponents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSwapDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSwapDenoms = append(m.FeeSwapDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSwapMaxSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeSwapMaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchAuction", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BatchAuction = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlashSwapFeePercentage", wireType)
+			}
+			m.FlashSwapFeePercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FlashSwapFeePercentage |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatsRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.StatsRetention, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrderMatchesPerBlock", wireType)
+			}
+			m.LimitOrderMatchesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LimitOrderMatchesPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DcaPlanMaxSkips", wireType)
+			}
+			m.DcaPlanMaxSkips = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DcaPlanMaxSkips |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DcaSlicesPerBlock", wireType)
+			}
+			m.DcaSlicesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DcaSlicesPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetExponent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetExponent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetExponent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			}
+			m.Exponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockDuration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockDuration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockDuration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StableCoin == nil {
+				m.StableCoin = &types.Coin{}
+			}
+			if err := m.StableCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolShare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PoolShare == nil {
+				m.PoolShare = &types.Coin{}
+			}
+			if err := m.PoolShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccruedFees", wireType)
+			}
+			m.AccruedFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccruedFees |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GloballyAccruedFees", wireType)
+			}
+			m.GloballyAccruedFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GloballyAccruedFees |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAccruedFees", wireType)
+			}
+			m.TotalAccruedFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalAccruedFees |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidity", wireType)
+			}
+			m.TotalLiquidity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalLiquidity |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ShareToken == nil {
+				m.ShareToken = &types.Coin{}
+			}
+			if err := m.ShareToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeePercentage", wireType)
+			}
+			m.SwapFeePercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwapFeePercentage |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Gauge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Gauge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributedCoins = append(m.DistributedCoins, types.Coin{})
+			if err := m.DistributedCoins[len(m.DistributedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEpochs", wireType)
+			}
+			m.NumEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilledEpochs", wireType)
+			}
+			m.FilledEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FilledEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Rewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Rewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Rewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsPerWeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsPerWeight = append(m.RewardsPerWeight, types.DecCoin{})
+			if err := m.RewardsPerWeight[len(m.RewardsPerWeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardAccumulator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardAccumulator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardAccumulator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsPerWeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsPerWeight = append(m.RewardsPerWeight, types.DecCoin{})
+			if err := m.RewardsPerWeight[len(m.RewardsPerWeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomOrigin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomOrigin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomOrigin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Origin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeriodLock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeriodLock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeriodLock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LimitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DCAPlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DCAPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DCAPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Remaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SliceAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SliceAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOutputPerSlice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinOutputPerSlice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalBlocks", wireType)
+			}
+			m.IntervalBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextExecutionHeight", wireType)
+			}
+			m.NextExecutionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextExecutionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveSkips", wireType)
+			}
+			m.ConsecutiveSkips = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveSkips |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapFeePercentage", wireType)
+			}
+			m.MaxSwapFeePercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSwapFeePercentage |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolStatsBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolStatsBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolStatsBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InputDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InputDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapCount", wireType)
+			}
+			m.SwapCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwapCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pool == nil {
+				m.Pool = &Pool{}
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProviders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityProviders = append(m.LiquidityProviders, GenesisLiquidityProvider{})
+			if err := m.LiquidityProviders[len(m.LiquidityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinsReserve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoinsReserve = append(m.CoinsReserve, types.Coin{})
+			if err := m.CoinsReserve[len(m.CoinsReserve)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gauges = append(m.Gauges, Gauge{})
+			if err := m.Gauges[len(m.Gauges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeSequence", wireType)
+			}
+			m.GaugeSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GaugeSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, GenesisRewards{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locks = append(m.Locks, PeriodLock{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockSequence", wireType)
+			}
+			m.LockSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetRates = append(m.AssetRates, AssetRate{})
+			if err := m.AssetRates[len(m.AssetRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitOrders = append(m.LimitOrders, LimitOrder{})
+			if err := m.LimitOrders[len(m.LimitOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrderSequence", wireType)
+			}
+			m.LimitOrderSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LimitOrderSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DcaPlans", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -718,16 +6938,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WhitelistedCoins = append(m.WhitelistedCoins, &types.Coin{})
-			if err := m.WhitelistedCoins[len(m.WhitelistedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DcaPlans = append(m.DcaPlans, DCAPlan{})
+			if err := m.DcaPlans[len(m.DcaPlans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeePercentage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DcaPlanSequence", wireType)
 			}
-			m.SwapFeePercentage = 0
+			m.DcaPlanSequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -737,16 +6957,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SwapFeePercentage |= int32(b&0x7F) << shift
+				m.DcaPlanSequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSwapSequence", wireType)
 			}
-			m.Decimals = 0
+			m.BatchSwapSequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -756,14 +6976,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Decimals |= int64(b&0x7F) << shift
+				m.BatchSwapSequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolStats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -790,66 +7010,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ShareToken == nil {
-				m.ShareToken = &types.Coin{}
-			}
-			if err := m.ShareToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PoolStats = append(m.PoolStats, PoolStatsBucket{})
+			if err := m.PoolStats[len(m.PoolStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LiquidityProvider) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityProvider: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAccumulator", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -876,16 +7044,13 @@ func (m *LiquidityProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StableCoin == nil {
-				m.StableCoin = &types.Coin{}
-			}
-			if err := m.StableCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RewardAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolShare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrderCursor", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -912,37 +7077,68 @@ func (m *LiquidityProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PoolShare == nil {
-				m.PoolShare = &types.Coin{}
+			if m.LimitOrderCursor == nil {
+				m.LimitOrderCursor = &LimitOrderCursor{}
 			}
-			if err := m.PoolShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LimitOrderCursor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccruedFees", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.AccruedFees = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AccruedFees |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
 			}
-		case 4:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LimitOrderCursor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LimitOrderCursor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LimitOrderCursor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GloballyAccruedFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOrderId", wireType)
 			}
-			m.GloballyAccruedFees = 0
+			m.LastOrderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -952,7 +7148,7 @@ func (m *LiquidityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GloballyAccruedFees |= int64(b&0x7F) << shift
+				m.LastOrderId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -978,7 +7174,7 @@ func (m *LiquidityProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pool) Unmarshal(dAtA []byte) error {
+func (m *GenesisLiquidityProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1001,17 +7197,17 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+			return fmt.Errorf("proto: GenesisLiquidityProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GenesisLiquidityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalAccruedFees", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.TotalAccruedFees = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1021,52 +7217,27 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalAccruedFees |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidity", wireType)
-			}
-			m.TotalLiquidity = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalLiquidity |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
 			}
-			m.Decimals = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Decimals |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 4:
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProvider", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1093,32 +7264,10 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ShareToken == nil {
-				m.ShareToken = &types.Coin{}
-			}
-			if err := m.ShareToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidityProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeePercentage", wireType)
-			}
-			m.SwapFeePercentage = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SwapFeePercentage |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1140,7 +7289,7 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *GenesisRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1163,17 +7312,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: GenesisRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GenesisRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1183,31 +7332,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pool == nil {
-				m.Pool = &Pool{}
-			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1234,7 +7379,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex